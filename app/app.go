@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
@@ -11,6 +12,8 @@ import (
 	"gopkg.in/tomb.v2"
 
 	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/lnurl"
+	"github.com/c13n-io/c13n-go/model"
 	"github.com/c13n-io/c13n-go/slog"
 	"github.com/c13n-io/c13n-go/store"
 )
@@ -24,6 +27,26 @@ type App struct {
 	LNManager lnchat.LightManager
 	Database  store.Database
 
+	// verifySigFallback enables signature verification
+	// through the underlying node, if local verification fails.
+	verifySigFallback bool
+
+	// payloadFormat is the format of the payloads of sent messages.
+	payloadFormat model.PayloadFormat
+
+	// reconcileOnStartup and reconcileInterval control the reconciliation
+	// of the stored invoices, payments and messages.
+	reconcileOnStartup bool
+	reconcileInterval  time.Duration
+	// reconcileMtx serializes reconciliations.
+	reconcileMtx sync.Mutex
+
+	// retryPolicy controls the retries of failed payments.
+	retryPolicy RetryPolicy
+
+	// lnurl resolves LNURLs and Lightning Addresses.
+	lnurl *lnurl.Client
+
 	bus *gochannel.GoChannel
 
 	Tomb *tomb.Tomb
@@ -37,6 +60,7 @@ func New(lnChat lnchat.LightManager, database store.Database,
 		Log:       slog.NewLogger("app"),
 		LNManager: lnChat,
 		Database:  database,
+		lnurl:     &lnurl.Client{},
 	}
 
 	for _, option := range options {
@@ -59,6 +83,28 @@ func WithDefaultFeeLimitMsat(defaultLimitMsat int64) func(*App) error {
 	}
 }
 
+// WithSignatureVerificationFallback enables signature verification
+// through the underlying node for signatures that could not be verified locally.
+func WithSignatureVerificationFallback(enabled bool) func(*App) error {
+	return func(app *App) error {
+		app.verifySigFallback = enabled
+		return nil
+	}
+}
+
+// WithCompactPayloads enables sending message payloads in the compact format,
+// which fits larger messages and groups in a payment.
+// Compact payloads are not understood by earlier c13n versions.
+func WithCompactPayloads(enabled bool) func(*App) error {
+	return func(app *App) error {
+		app.payloadFormat = model.PayloadFormatJSON
+		if enabled {
+			app.payloadFormat = model.PayloadFormatCompact
+		}
+		return nil
+	}
+}
+
 func backoff(n int) time.Duration {
 	startBackoff, maxCeilOffset := 5., 595.
 
@@ -75,6 +121,12 @@ func runGo(t *tomb.Tomb, log *slog.Logger, name string, run func(context.Context
 			// tomb.Tomb returns the previously provided parent (or a background context)
 			// if called with nil context
 			if err := run(t.Context(nil)); err != nil { //nolint:staticcheck // See above
+				// Unsupported operations will not succeed on retry.
+				if errors.Is(err, lnchat.ErrNotSupported) {
+					log.WithError(err).Warnf("%s is not supported by the backend", name)
+					return nil
+				}
+
 				log.WithError(err).Warnf("%s terminated erroneously", name)
 				switch {
 				case errors.Is(err, lnchat.ErrNetworkUnavailable):
@@ -137,6 +189,20 @@ func (app *App) Init(ctx context.Context, infoTimeoutSecs uint) error {
 
 		return app.subscribePayments(ctx, lastPaymentIdx)
 	})
+	runGo(app.Tomb, app.Log, "channel event subscription", func(ctx context.Context) error {
+		return app.subscribeChannelEvents(ctx)
+	})
+	runGo(app.Tomb, app.Log, "custom message subscription", func(ctx context.Context) error {
+		return app.subscribeCustomMessages(ctx)
+	})
+	runGo(app.Tomb, app.Log, "persistent peer reconnection", func(ctx context.Context) error {
+		return app.maintainPersistentPeers(ctx)
+	})
+	if app.reconcileOnStartup || app.reconcileInterval > 0 {
+		runGo(app.Tomb, app.Log, "reconciliation", func(ctx context.Context) error {
+			return app.runReconciliation(ctx)
+		})
+	}
 
 	return nil
 }
@@ -45,12 +45,12 @@ func TestNewSuccess(t *testing.T) {
 }
 
 func createInitializedApp(t *testing.T, mockInstaller func(*lnmock.LightManager, *dbmock.Database) (
-	*lnmock.LightManager, *dbmock.Database, func())) (*App, func(), func()) {
+	*lnmock.LightManager, *dbmock.Database, func()), options ...func(*App) error) (*App, func(), func()) {
 
 	mockLNManager, mockDB, mockStopFunc := mockInstaller(
 		new(lnmock.LightManager), new(dbmock.Database))
 
-	app, err := New(mockLNManager, mockDB)
+	app, err := New(mockLNManager, mockDB, options...)
 	require.NoError(t, err)
 	appTestStartFunc := func() {
 		// Initialize the application
@@ -84,11 +84,15 @@ func TestAppInitSuccess(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -217,11 +221,15 @@ func TestAppInitSubscriptionIndex(t *testing.T) {
 
 	mockDB.On("GetLastInvoiceIndex").Return(lastInvoiceIdx, nil).Once()
 	mockDB.On("GetLastPaymentIndex").Return(lastPaymentIdx, nil).Once()
+	mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 	mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, lastInvoiceIdx,
 		mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 	mockLNManager.On("SubscribePaymentUpdates", mock.Anything, lastPaymentIdx,
 		mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+	mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+	mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+	mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 	mockDB.On("Close").Return(nil).Once()
 
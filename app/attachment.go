@@ -0,0 +1,605 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+)
+
+var (
+	// ErrAttachmentTooLarge indicates that an attachment
+	// exceeds the maximum attachment size.
+	ErrAttachmentTooLarge = fmt.Errorf("attachment too large")
+	// ErrAnonymousAttachment indicates that an anonymous
+	// attachment send was requested.
+	ErrAnonymousAttachment = fmt.Errorf("anonymous attachments are disallowed")
+)
+
+const (
+	// attachmentChunkVersion is the current attachment chunk record version.
+	attachmentChunkVersion = 1
+	// attachmentTransferIDSize is the size of attachment transfer ids.
+	attachmentTransferIDSize = 16
+	// attachmentChunkSize is the maximum size of the data of a chunk,
+	// leaving room for the message payload carried by the first chunk
+	// and for intermediate hops.
+	attachmentChunkSize = 400
+	// maxAttachmentSize is the maximum size of an attachment.
+	maxAttachmentSize = 256 * 1024
+	// maxAttachmentChunks is the maximum number of chunks of an attachment.
+	maxAttachmentChunks = (maxAttachmentSize + attachmentChunkSize - 1) /
+		attachmentChunkSize
+	// attachmentChunkAmtMsat is the amount paid to
+	// each recipient for carrying a chunk.
+	attachmentChunkAmtMsat = 1000
+)
+
+// attachmentSignedKeys are the TLV types of the attachment chunk records
+// covered by the chunk signature.
+var attachmentSignedKeys = []uint64{AttachmentChunkTypeKey,
+	AttachmentInfoTypeKey, PayloadTypeKey, CompactPayloadTypeKey}
+
+// attachmentChunk is the content of an attachment chunk record,
+// structured as:
+//
+//	version (1 byte) | transfer id (16 bytes) | index (uvarint) |
+//	total chunks (uvarint) | content hash (32 bytes) | data
+type attachmentChunk struct {
+	transferID  []byte
+	index       uint32
+	total       uint32
+	contentHash []byte
+	data        []byte
+}
+
+// attachmentInfo is the content of the attachment info record,
+// carried by the first chunk of an attachment.
+type attachmentInfo struct {
+	Name     string `json:"name,omitempty"`
+	MimeType string `json:"mime_type,omitempty"`
+	Size     uint64 `json:"size"`
+}
+
+func (c *attachmentChunk) marshal() []byte {
+	var buf bytes.Buffer
+
+	var varint [binary.MaxVarintLen64]byte
+	buf.WriteByte(attachmentChunkVersion)
+	buf.Write(c.transferID)
+	buf.Write(varint[:binary.PutUvarint(varint[:], uint64(c.index))])
+	buf.Write(varint[:binary.PutUvarint(varint[:], uint64(c.total))])
+	buf.Write(c.contentHash)
+	buf.Write(c.data)
+
+	return buf.Bytes()
+}
+
+func unmarshalAttachmentChunk(data []byte) (*attachmentChunk, error) {
+	if len(data) < 1+attachmentTransferIDSize {
+		return nil, fmt.Errorf("attachment chunk too short")
+	}
+	if version := data[0]; version != attachmentChunkVersion {
+		return nil, fmt.Errorf("unsupported attachment chunk version %d", version)
+	}
+	chunk := &attachmentChunk{
+		transferID: data[1 : 1+attachmentTransferIDSize],
+	}
+	data = data[1+attachmentTransferIDSize:]
+
+	index, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid attachment chunk index")
+	}
+	data = data[n:]
+	total, n := binary.Uvarint(data)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid attachment chunk count")
+	}
+	data = data[n:]
+	if total == 0 || total > maxAttachmentChunks || index >= total {
+		return nil, fmt.Errorf("invalid attachment chunk %d of %d", index, total)
+	}
+	chunk.index, chunk.total = uint32(index), uint32(total)
+
+	if len(data) < sha256.Size {
+		return nil, fmt.Errorf("attachment chunk too short")
+	}
+	chunk.contentHash, chunk.data = data[:sha256.Size], data[sha256.Size:]
+
+	return chunk, nil
+}
+
+// isAttachmentChunk returns whether a set of HTLC records
+// carries an attachment chunk.
+func isAttachmentChunk(records []map[uint64][]byte) bool {
+	if len(records) == 0 {
+		return false
+	}
+	_, ok := records[0][AttachmentChunkTypeKey]
+
+	return ok
+}
+
+// attachmentSignedData returns the data covered by the signature
+// of an attachment chunk: each of the signed records, length-prefixed.
+func attachmentSignedData(records map[uint64][]byte) []byte {
+	var buf bytes.Buffer
+
+	var varint [binary.MaxVarintLen64]byte
+	for _, key := range attachmentSignedKeys {
+		value := records[key]
+		buf.Write(varint[:binary.PutUvarint(varint[:], uint64(len(value)))])
+		buf.Write(value)
+	}
+
+	return buf.Bytes()
+}
+
+// SendAttachment sends an attachment to the participants of a discussion,
+// along with a message (which may be empty).
+// The attachment is split into chunks, each sent as a separate payment.
+// The first chunk additionally carries the attachment name,
+// MIME type and size, as well as the message.
+// If the transfer is interrupted, it can be resumed with ResumeAttachment.
+// Once all chunks are delivered, the message is stored and published.
+// Note: Anonymous attachments are disallowed.
+func (app *App) SendAttachment(ctx context.Context, discID uint64,
+	name, mimeType string, data []byte, payload string,
+	opts model.MessageOptions) (*model.Attachment, error) {
+
+	switch {
+	case len(data) == 0:
+		return nil, fmt.Errorf("empty attachment")
+	case len(data) > maxAttachmentSize:
+		return nil, fmt.Errorf("%w: exceeds %d bytes",
+			ErrAttachmentTooLarge, maxAttachmentSize)
+	}
+
+	disc, err := app.retrieveDiscussion(ctx, discID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve discussion")
+	}
+
+	options := overrideOptions(disc.Options, true, opts)
+	if options.Anonymous {
+		return nil, ErrAnonymousAttachment
+	}
+
+	rawMsg, err := model.NewRawMessage(disc, payload, app.payloadFormat)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create raw message")
+	}
+
+	transferID := make([]byte, attachmentTransferIDSize)
+	if _, err := rand.Read(transferID); err != nil {
+		return nil, errors.Wrap(err, "could not generate transfer id")
+	}
+	contentHash := sha256.Sum256(data)
+
+	att := &model.Attachment{
+		TransferID:      hex.EncodeToString(transferID),
+		DiscussionID:    disc.ID,
+		Sender:          app.Self.Node.Address,
+		SenderVerified:  true,
+		Name:            name,
+		MimeType:        mimeType,
+		Size:            uint64(len(data)),
+		ContentHash:     contentHash[:],
+		TotalChunks:     uint32((len(data) + attachmentChunkSize - 1) / attachmentChunkSize),
+		DeliveredChunks: make(map[string][]uint32),
+		RawPayload:      rawMsg.RawPayload,
+		CreatedTimeNs:   time.Now().UnixNano(),
+	}
+
+	// Store the chunks, so that the transfer can be resumed.
+	for i := uint32(0); i < att.TotalChunks; i++ {
+		start, end := int(i)*attachmentChunkSize, int(i+1)*attachmentChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		if err := app.Database.AddAttachmentChunk(&model.AttachmentChunk{
+			TransferID: att.TransferID,
+			Index:      i,
+			Data:       data[start:end],
+		}); err != nil {
+			return nil, errors.Wrap(err, "could not store attachment chunk")
+		}
+	}
+	if err := app.Database.SaveAttachment(att); err != nil {
+		return nil, errors.Wrap(err, "could not store attachment")
+	}
+
+	return app.sendAttachmentChunks(ctx, disc, att, options)
+}
+
+// ResumeAttachment resumes the transfer of an outgoing attachment,
+// sending the chunks not yet delivered to each discussion participant.
+func (app *App) ResumeAttachment(ctx context.Context, transferID string,
+	opts model.MessageOptions) (*model.Attachment, error) {
+
+	att, err := app.Database.GetAttachment(transferID)
+	if err != nil {
+		return nil, newErrorf(err, "GetAttachment")
+	}
+	switch {
+	case att.Incoming:
+		return nil, fmt.Errorf("cannot resume incoming attachment %s: "+
+			"missing chunks are resent by the sender", transferID)
+	case att.Complete:
+		return att, nil
+	}
+
+	disc, err := app.retrieveDiscussion(ctx, att.DiscussionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve discussion")
+	}
+
+	options := overrideOptions(disc.Options, true, opts)
+	if options.Anonymous {
+		return nil, ErrAnonymousAttachment
+	}
+
+	return app.sendAttachmentChunks(ctx, disc, att, options)
+}
+
+// GetAttachment retrieves an attachment along with its contents.
+// The contents of incomplete attachments are not returned.
+func (app *App) GetAttachment(_ context.Context, transferID string) (
+	*model.Attachment, []byte, error) {
+
+	att, err := app.Database.GetAttachment(transferID)
+	if err != nil {
+		return nil, nil, newErrorf(err, "GetAttachment")
+	}
+	if !att.Complete {
+		return att, nil, nil
+	}
+
+	data, err := app.assembleAttachment(att)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return att, data, nil
+}
+
+// sendAttachmentChunks sends the chunks of an outgoing attachment
+// not yet delivered to each discussion participant.
+// Chunks are sent in order, stopping at the first failed delivery,
+// so that the transfer can be resumed.
+// Once all chunks are delivered, the attachment message
+// is stored and published.
+func (app *App) sendAttachmentChunks(ctx context.Context, disc *model.Discussion,
+	att *model.Attachment, options model.MessageOptions) (*model.Attachment, error) {
+
+	chunks, err := app.Database.GetAttachmentChunks(att.TransferID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve attachment chunks")
+	}
+	if len(chunks) != int(att.TotalChunks) {
+		return nil, fmt.Errorf("attachment %s is missing chunks", att.TransferID)
+	}
+	if att.DeliveredChunks == nil {
+		att.DeliveredChunks = make(map[string][]uint32)
+	}
+	payOpts := options.GetPaymentOptions()
+
+	var payments []*model.Payment
+	for _, chunk := range chunks {
+		records, err := app.attachmentChunkRecords(ctx, att, chunk)
+		if err != nil {
+			return att, err
+		}
+		if err := checkPayloadSize(records); err != nil {
+			return att, err
+		}
+
+		for _, recipient := range disc.Participants {
+			if model.HasChunk(att.DeliveredChunks[recipient], chunk.Index) {
+				continue
+			}
+
+			payment, err := app.sendAttachmentChunk(ctx, recipient,
+				payOpts, options.MaxFeeLimitMsat, records)
+			if payment != nil {
+				payments = append(payments, payment)
+				att.PaymentIndexes = append(att.PaymentIndexes, payment.PaymentIndex)
+			}
+			if err != nil {
+				if saveErr := app.Database.SaveAttachment(att); saveErr != nil {
+					app.Log.WithError(saveErr).Error("attachment storage failed")
+				}
+				return att, newErrorf(err, "delivery of chunk %d to %s failed",
+					chunk.Index, recipient)
+			}
+			att.DeliveredChunks[recipient] = append(
+				att.DeliveredChunks[recipient], chunk.Index)
+		}
+		if !model.HasChunk(att.SentChunks, chunk.Index) {
+			att.SentChunks = append(att.SentChunks, chunk.Index)
+		}
+
+		if err := app.Database.SaveAttachment(att); err != nil {
+			return att, errors.Wrap(err, "could not store attachment")
+		}
+	}
+
+	// All chunks are delivered, store and publish the attachment message.
+	rawMsg := &model.RawMessage{
+		DiscussionID:      att.DiscussionID,
+		RawPayload:        att.RawPayload,
+		Sender:            att.Sender,
+		Signature:         att.Signature,
+		SignatureVerified: true,
+		PaymentIndexes:    att.PaymentIndexes,
+		Source:            model.SourceC13N,
+		AttachmentID:      att.TransferID,
+	}
+	if err := app.Database.AddRawMessage(rawMsg); err != nil {
+		return att, errors.Wrap(err, "could not store message")
+	}
+
+	att.Complete = true
+	if err := app.Database.SaveAttachment(att); err != nil {
+		return att, errors.Wrap(err, "could not store attachment")
+	}
+
+	if err := app.publishMessage(model.MessageAggregate{
+		RawMessage: rawMsg,
+		Payments:   payments,
+	}); err != nil {
+		return att, errors.Wrap(err, "message notification failed")
+	}
+
+	return att, nil
+}
+
+// sendAttachmentChunk sends a payment carrying a chunk to a recipient,
+// storing the payment.
+// If the payment was initiated, it is returned
+// even if its delivery failed.
+func (app *App) sendAttachmentChunk(ctx context.Context, recipient string,
+	payOpts lnchat.PaymentOptions, maxFeeLimitMsat int64,
+	records map[uint64][]byte) (*model.Payment, error) {
+
+	result, err := app.send(ctx, app.newRouteDiagnostics(),
+		recipient, attachmentChunkAmtMsat, "",
+		payOpts, maxFeeLimitMsat, records)
+	switch {
+	case err != nil:
+		return nil, err
+	case result.Err != nil && result.Payment == nil:
+		return nil, result.Err
+	}
+
+	payment := &model.Payment{
+		PayerAddress: app.Self.Node.Address,
+		PayeeAddress: recipient,
+		Payment:      *result.Payment,
+	}
+	if err := app.Database.AddPayments(payment); err != nil {
+		var existsErr *store.AlreadyExistsError
+		if !errors.As(err, &existsErr) {
+			return payment, err
+		}
+	}
+
+	switch {
+	case result.Err != nil:
+		return payment, result.Err
+	case payment.Status != lnchat.PaymentSUCCEEDED:
+		return payment, payment.ClassifyFailure().Err()
+	}
+
+	return payment, nil
+}
+
+// attachmentChunkRecords returns the signed records carrying
+// a chunk of an outgoing attachment.
+func (app *App) attachmentChunkRecords(ctx context.Context,
+	att *model.Attachment, chunk model.AttachmentChunk) (map[uint64][]byte, error) {
+
+	transferID, err := hex.DecodeString(att.TransferID)
+	if err != nil || len(transferID) != attachmentTransferIDSize {
+		return nil, fmt.Errorf("invalid transfer id %q", att.TransferID)
+	}
+
+	records := make(map[uint64][]byte)
+	if chunk.Index == 0 {
+		// The first chunk also carries the attachment info and message.
+		info, err := json.Marshal(attachmentInfo{
+			Name:     att.Name,
+			MimeType: att.MimeType,
+			Size:     att.Size,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not marshal attachment info")
+		}
+		records = marshalPayload(&model.RawMessage{
+			RawPayload: att.RawPayload,
+		})
+		records[AttachmentInfoTypeKey] = info
+	}
+	records[AttachmentChunkTypeKey] = (&attachmentChunk{
+		transferID:  transferID,
+		index:       chunk.Index,
+		total:       att.TotalChunks,
+		contentHash: att.ContentHash,
+		data:        chunk.Data,
+	}).marshal()
+
+	sig, err := app.LNManager.SignMessage(ctx, attachmentSignedData(records))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign attachment chunk")
+	}
+	sender, err := lnchat.NewNodeFromString(att.Sender)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+	records[SenderTypeKey] = sender.Bytes()
+	records[SignatureTypeKey] = sig
+
+	if chunk.Index == 0 {
+		att.Signature = sig
+	}
+
+	return records, nil
+}
+
+// receiveAttachmentChunk stores a received attachment chunk.
+// Once all chunks of the attachment are received, the attachment
+// is reassembled and verified, and the message carried by
+// its first chunk is returned, associated with the appropriate discussion.
+// Otherwise, a nil message is returned.
+func (app *App) receiveAttachmentChunk(inv *lnchat.Invoice,
+	verifySignature func([]byte, []byte, string) (bool, error)) (
+	*model.RawMessage, error) {
+
+	customRecords := inv.GetCustomRecords()
+	records := customRecords[0]
+	keys := append([]uint64{SenderTypeKey, SignatureTypeKey}, attachmentSignedKeys...)
+	for _, r := range customRecords[1:] {
+		if !samePayloadRecords(keys, records, r) {
+			return nil, fmt.Errorf("attachment chunk extraction failed " +
+				"for HTLCs carrying different chunks")
+		}
+	}
+
+	chunk, err := unmarshalAttachmentChunk(records[AttachmentChunkTypeKey])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode attachment chunk: %w", err)
+	}
+	senderAddr, err := lnchat.NewNodeFromBytes(records[SenderTypeKey])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode attachment sender address: %w", err)
+	}
+	sender, sig := senderAddr.String(), records[SignatureTypeKey]
+
+	verified, err := verifySignature(attachmentSignedData(records), sig, sender)
+	if err != nil {
+		return nil, fmt.Errorf("cannot verify attachment chunk signature: %w", err)
+	}
+
+	transferID := hex.EncodeToString(chunk.transferID)
+	att, err := app.Database.GetAttachment(transferID)
+	switch {
+	case errors.Is(err, store.ErrAttachmentNotFound):
+		att = &model.Attachment{
+			TransferID:     transferID,
+			Sender:         sender,
+			SenderVerified: true,
+			Incoming:       true,
+			ContentHash:    chunk.contentHash,
+			TotalChunks:    chunk.total,
+			CreatedTimeNs:  time.Now().UnixNano(),
+		}
+	case err != nil:
+		return nil, errors.Wrap(err, "could not retrieve attachment")
+	}
+
+	if !att.Incoming || att.Sender != sender || att.TotalChunks != chunk.total ||
+		!bytes.Equal(att.ContentHash, chunk.contentHash) {
+
+		return nil, fmt.Errorf("chunk %d does not match attachment %s",
+			chunk.index, transferID)
+	}
+	if att.Complete || model.HasChunk(att.ReceivedChunks, chunk.index) {
+		return nil, nil
+	}
+	att.SenderVerified = att.SenderVerified && verified
+
+	if chunk.index == 0 {
+		var info attachmentInfo
+		if err := json.Unmarshal(records[AttachmentInfoTypeKey], &info); err != nil {
+			return nil, fmt.Errorf("could not decode attachment info: %w", err)
+		}
+		att.Name, att.MimeType, att.Size = info.Name, info.MimeType, info.Size
+
+		rawMsg, _, err := decodeC13NPayload(records, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not decode attachment message: %w", err)
+		}
+		att.RawPayload, att.Signature = rawMsg.RawPayload, sig
+	}
+
+	if err := app.Database.AddAttachmentChunk(&model.AttachmentChunk{
+		TransferID: transferID,
+		Index:      chunk.index,
+		Data:       chunk.data,
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not store attachment chunk")
+	}
+	att.ReceivedChunks = append(att.ReceivedChunks, chunk.index)
+
+	if len(att.MissingChunks(att.ReceivedChunks)) != 0 {
+		return nil, errors.Wrap(app.Database.SaveAttachment(att),
+			"could not store attachment")
+	}
+
+	// All chunks are received, verify the attachment contents.
+	if _, err := app.assembleAttachment(att); err != nil {
+		return nil, err
+	}
+
+	rawMsg := &model.RawMessage{
+		RawPayload:        att.RawPayload,
+		Sender:            att.Sender,
+		Signature:         att.Signature,
+		SignatureVerified: att.SenderVerified,
+		Source:            model.SourceC13N,
+		AttachmentID:      att.TransferID,
+	}
+	disc, err := app.retrieveOrCreateRawMsgDiscussion(rawMsg)
+	if err != nil {
+		return nil, fmt.Errorf("discussion retrieval failed: %w", err)
+	}
+	rawMsg.DiscussionID = disc.ID
+
+	att.DiscussionID, att.Complete = disc.ID, true
+	if err := app.Database.SaveAttachment(att); err != nil {
+		return nil, errors.Wrap(err, "could not store attachment")
+	}
+
+	return rawMsg, nil
+}
+
+// assembleAttachment reassembles the contents of an attachment
+// from its stored chunks, verifying their size and hash.
+func (app *App) assembleAttachment(att *model.Attachment) ([]byte, error) {
+	chunks, err := app.Database.GetAttachmentChunks(att.TransferID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve attachment chunks")
+	}
+	if len(chunks) != int(att.TotalChunks) {
+		return nil, fmt.Errorf("attachment %s is missing chunks", att.TransferID)
+	}
+
+	var data []byte
+	for i, chunk := range chunks {
+		if chunk.Index != uint32(i) {
+			return nil, fmt.Errorf("attachment %s is missing chunk %d",
+				att.TransferID, i)
+		}
+		data = append(data, chunk.Data...)
+	}
+
+	contentHash := sha256.Sum256(data)
+	if uint64(len(data)) != att.Size || !bytes.Equal(contentHash[:], att.ContentHash) {
+		return nil, fmt.Errorf("attachment %s contents do not match "+
+			"the attachment size and hash", att.TransferID)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,201 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat/simnet"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestAttachmentChunkRecord(t *testing.T) {
+	contentHash := sha256.Sum256([]byte("contents"))
+	chunk := &attachmentChunk{
+		transferID:  bytes.Repeat([]byte{0xab}, attachmentTransferIDSize),
+		index:       300,
+		total:       301,
+		contentHash: contentHash[:],
+		data:        []byte("data"),
+	}
+
+	decoded, err := unmarshalAttachmentChunk(chunk.marshal())
+	require.NoError(t, err)
+	assert.Equal(t, chunk, decoded)
+
+	for name, c := range map[string]*attachmentChunk{
+		"index out of range": {
+			transferID: chunk.transferID, index: 2, total: 2,
+			contentHash: chunk.contentHash,
+		},
+		"too many chunks": {
+			transferID: chunk.transferID, total: maxAttachmentChunks + 1,
+			contentHash: chunk.contentHash,
+		},
+		"missing hash": {
+			transferID: chunk.transferID, total: 1,
+		},
+	} {
+		_, err := unmarshalAttachmentChunk(c.marshal())
+		assert.Error(t, err, name)
+	}
+
+	_, err = unmarshalAttachmentChunk(append([]byte{2}, chunk.marshal()[1:]...))
+	assert.Error(t, err, "unsupported version")
+}
+
+func TestAttachmentChunkFitsOnion(t *testing.T) {
+	contentHash := sha256.Sum256([]byte("contents"))
+	disc := &model.Discussion{Participants: []string{
+		"022bd806c97f0e00af1a1fc3328fa763a9269723c8db8fac4f93af71db186d6e90",
+	}}
+	rawMsg, err := model.NewRawMessage(disc, string(bytes.Repeat([]byte{'a'}, 100)),
+		model.PayloadFormatJSON)
+	require.NoError(t, err)
+
+	records := marshalPayload(rawMsg)
+	records[AttachmentInfoTypeKey] = []byte(`{"name":"picture.jpeg",` +
+		`"mime_type":"image/jpeg","size":262144}`)
+	records[AttachmentChunkTypeKey] = (&attachmentChunk{
+		transferID:  make([]byte, attachmentTransferIDSize),
+		index:       0,
+		total:       maxAttachmentChunks,
+		contentHash: contentHash[:],
+		data:        make([]byte, attachmentChunkSize),
+	}).marshal()
+	// A signature and sender address.
+	records[SignatureTypeKey] = make([]byte, 104)
+	records[SenderTypeKey] = make([]byte, 33)
+
+	// The first chunk, carrying a 100 character message,
+	// fits in routes of up to three hops.
+	assert.GreaterOrEqual(t, payloadBudget(3, records), 0)
+}
+
+func TestSimnetSendAttachment(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	net := simnet.NewNetwork()
+	alice, err := net.AddNode("alice", 10000000)
+	require.NoError(t, err)
+	bob, err := net.AddNode("bob", 10000000)
+	require.NoError(t, err)
+
+	_, err = net.ConnectNodes(alice, bob, 1000000, 0, false)
+	require.NoError(t, err)
+
+	sender, cleanupSender := createSimnetApp(t, alice)
+	defer cleanupSender()
+	receiver, cleanupReceiver := createSimnetApp(t, bob)
+	defer cleanupReceiver()
+
+	msgs, err := receiver.SubscribeMessages(ctx)
+	require.NoError(t, err)
+
+	disc, err := sender.AddDiscussion(ctx, &model.Discussion{
+		Participants: []string{bob.Address()},
+	})
+	require.NoError(t, err)
+
+	data := make([]byte, 2*attachmentChunkSize+100)
+	for i := range data {
+		data[i] = byte(i)
+	}
+
+	sent, err := sender.SendAttachment(ctx, disc.ID, "picture.png", "image/png",
+		data, "look", model.MessageOptions{})
+	require.NoError(t, err)
+	assert.True(t, sent.Complete)
+	assert.Equal(t, uint32(3), sent.TotalChunks)
+	assert.Equal(t, []uint32{0, 1, 2}, sent.DeliveredChunks[bob.Address()])
+	assert.Equal(t, []uint32{0, 1, 2}, sent.SentChunks)
+	assert.Len(t, sent.PaymentIndexes, 3)
+
+	select {
+	case msg := <-msgs:
+		require.NotNil(t, msg.RawMessage)
+		payload, _, err := msg.RawMessage.UnmarshalPayload()
+		require.NoError(t, err)
+		assert.Equal(t, "look", payload)
+		assert.Equal(t, sent.TransferID, msg.RawMessage.AttachmentID)
+		assert.Equal(t, alice.Address(), msg.RawMessage.Sender)
+		assert.True(t, msg.RawMessage.SignatureVerified)
+	case <-ctx.Done():
+		require.FailNow(t, "timed out waiting for received attachment")
+	}
+
+	received, contents, err := receiver.GetAttachment(ctx, sent.TransferID)
+	require.NoError(t, err)
+	assert.True(t, received.Incoming)
+	assert.True(t, received.Complete)
+	assert.True(t, received.SenderVerified)
+	assert.Equal(t, "picture.png", received.Name)
+	assert.Equal(t, "image/png", received.MimeType)
+	assert.Equal(t, data, contents)
+
+	_, contents, err = sender.GetAttachment(ctx, sent.TransferID)
+	require.NoError(t, err)
+	assert.Equal(t, data, contents)
+}
+
+func TestSimnetResumeAttachment(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	net := simnet.NewNetwork()
+	alice, err := net.AddNode("alice", 10000000)
+	require.NoError(t, err)
+	bob, err := net.AddNode("bob", 10000000)
+	require.NoError(t, err)
+
+	sender, cleanupSender := createSimnetApp(t, alice)
+	defer cleanupSender()
+	receiver, cleanupReceiver := createSimnetApp(t, bob)
+	defer cleanupReceiver()
+
+	disc, err := sender.AddDiscussion(ctx, &model.Discussion{
+		Participants: []string{bob.Address()},
+	})
+	require.NoError(t, err)
+
+	data := bytes.Repeat([]byte("attachment"), 50)
+
+	// Without a route to the recipient, no chunk is delivered.
+	sent, err := sender.SendAttachment(ctx, disc.ID, "notes.txt", "text/plain",
+		data, "", model.MessageOptions{})
+	require.Error(t, err)
+	require.NotNil(t, sent)
+	assert.False(t, sent.Complete)
+	assert.Empty(t, sent.DeliveredChunks[bob.Address()])
+	assert.Empty(t, sent.SentChunks)
+
+	_, contents, err := sender.GetAttachment(ctx, sent.TransferID)
+	require.NoError(t, err)
+	assert.Nil(t, contents)
+
+	_, err = net.ConnectNodes(alice, bob, 1000000, 0, false)
+	require.NoError(t, err)
+
+	resumed, err := sender.ResumeAttachment(ctx, sent.TransferID, model.MessageOptions{})
+	require.NoError(t, err)
+	assert.True(t, resumed.Complete)
+
+	require.Eventually(t, func() bool {
+		att, _, err := receiver.GetAttachment(ctx, sent.TransferID)
+		return err == nil && att.Complete
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// Incoming attachments cannot be resumed.
+	_, err = receiver.ResumeAttachment(ctx, sent.TransferID, model.MessageOptions{})
+	assert.Error(t, err)
+
+	_, contents, err = receiver.GetAttachment(ctx, sent.TransferID)
+	require.NoError(t, err)
+	assert.Equal(t, data, contents)
+}
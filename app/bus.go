@@ -42,6 +42,7 @@ const (
 	messageTopic = "message"
 	invoiceTopic = "invoice"
 	paymentTopic = "payment"
+	channelTopic = "channel"
 )
 
 func (app *App) publishMessage(msg model.MessageAggregate) error {
@@ -155,3 +156,39 @@ func (app *App) SubscribePayments(ctx context.Context) (<-chan *model.Payment, e
 	}()
 	return clientCh, nil
 }
+
+func (app *App) publishChannelEvent(event *model.ChannelEvent) error {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return BusError{op: "publish", topic: channelTopic, e: err}
+	}
+
+	return app.publish(channelTopic, eventBytes)
+}
+
+// SubscribeChannelEvents returns a subscription for channel event notifications.
+func (app *App) SubscribeChannelEvents(ctx context.Context) (<-chan *model.ChannelEvent, error) {
+	subCh, err := app.subscribe(ctx, channelTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCh := make(chan *model.ChannelEvent)
+	go func() {
+		defer close(clientCh)
+
+		for subMsg := range subCh {
+			subMsg.Ack()
+
+			event := new(model.ChannelEvent)
+			if err := json.Unmarshal(subMsg.Payload, event); err != nil {
+				e := BusError{op: "subscribe", topic: channelTopic, e: err}
+				app.Log.Error(e)
+				continue
+			}
+
+			clientCh <- event
+		}
+	}()
+	return clientCh, nil
+}
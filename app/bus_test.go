@@ -10,4 +10,5 @@ func TestTopicConsts(t *testing.T) {
 	assert.EqualValues(t, "message", messageTopic)
 	assert.EqualValues(t, "invoice", invoiceTopic)
 	assert.EqualValues(t, "payment", paymentTopic)
+	assert.EqualValues(t, "channel", channelTopic)
 }
@@ -28,3 +28,90 @@ func (app *App) OpenChannel(ctx context.Context, address string,
 
 	return &model.ChannelPoint{*chanPoint}, nil
 }
+
+// ListChannels returns the open channels of the underlying node
+// matching the provided filter.
+func (app *App) ListChannels(ctx context.Context,
+	filter model.ChannelFilter) ([]model.Channel, error) {
+
+	channels, err := app.LNManager.ListChannels(ctx, lnchat.ChannelFilter{
+		ActiveOnly:   filter.ActiveOnly,
+		InactiveOnly: filter.InactiveOnly,
+		PublicOnly:   filter.PublicOnly,
+		PrivateOnly:  filter.PrivateOnly,
+		Peer:         filter.Peer,
+	})
+	if err != nil {
+		return nil, newErrorf(err, "ListChannels")
+	}
+
+	res := make([]model.Channel, len(channels))
+	for i, ch := range channels {
+		res[i] = model.Channel{Channel: ch}
+	}
+
+	return res, nil
+}
+
+// ListPendingChannels returns the channels of the underlying node
+// that are pending opening or closing.
+func (app *App) ListPendingChannels(ctx context.Context) ([]model.PendingChannel, error) {
+	channels, err := app.LNManager.ListPendingChannels(ctx)
+	if err != nil {
+		return nil, newErrorf(err, "ListPendingChannels")
+	}
+
+	res := make([]model.PendingChannel, len(channels))
+	for i, ch := range channels {
+		res[i] = model.PendingChannel{PendingChannel: ch}
+	}
+
+	return res, nil
+}
+
+// CloseChannel closes the channel identified by the provided channel point.
+// If force is set, the channel is closed unilaterally.
+// The function returns a channel over which the progress
+// of the closing is reported, which is closed
+// once the closing transaction is confirmed.
+func (app *App) CloseChannel(ctx context.Context,
+	chanPoint model.ChannelPoint, force bool,
+	txOptions model.TxFeeOptions) (<-chan model.ChannelCloseUpdate, error) {
+
+	closeUpdates, err := app.LNManager.CloseChannel(ctx,
+		chanPoint.ChannelPoint, force,
+		lnchat.TxFeeOptions{
+			SatPerVByte:     txOptions.SatPerVByte,
+			TargetConfBlock: txOptions.TargetConfBlock,
+		},
+	)
+	if err != nil {
+		return nil, newErrorf(err, "CloseChannel: channel closing failed")
+	}
+
+	updateCh := make(chan model.ChannelCloseUpdate)
+	go func() {
+		defer close(updateCh)
+
+		for update := range closeUpdates {
+			var res model.ChannelCloseUpdate
+			switch {
+			case update.Err != nil:
+				res.Err = newErrorf(update.Err,
+					"CloseChannel: channel closing failed")
+			default:
+				res.Status = &model.ChannelCloseStatus{
+					ChannelCloseStatus: *update.Status,
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- res:
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
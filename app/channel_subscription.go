@@ -0,0 +1,37 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func (app *App) subscribeChannelEvents(ctx context.Context) error {
+	// Create subscription for channel events
+	chanSubscription, err := app.LNManager.SubscribeChannelEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-chanSubscription:
+			if !ok {
+				return fmt.Errorf("channel event subscription channel closed")
+			}
+			event, err := update.Event, update.Err
+			if err != nil {
+				return fmt.Errorf("channel event update failed: %w", err)
+			}
+
+			if err := app.publishChannelEvent(&model.ChannelEvent{
+				ChannelEvent: *event,
+			}); err != nil {
+				app.Log.WithError(err).Error("channel event notification failed")
+			}
+		}
+	}
+}
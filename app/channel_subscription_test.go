@@ -0,0 +1,146 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestSubscribeChannelEvents(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	chanPoint := lnchat.ChannelPoint{
+		FundingTxid: "6ef1d1ad3f0ee5fcd2ca0a6fc0fde7c2fa4d9b1a6d5c45ef0a1c6e0c8e7a2a11",
+		OutputIndex: 1,
+	}
+
+	eventList := []lnchat.ChannelEvent{
+		{
+			Type:         lnchat.ChannelEventPENDINGOPEN,
+			ChannelPoint: chanPoint,
+		},
+		{
+			Type:         lnchat.ChannelEventOPEN,
+			ChannelPoint: chanPoint,
+			Channel: &lnchat.Channel{
+				ChannelPoint:  chanPoint,
+				ChannelID:     1234567890,
+				RemoteAddress: "111111111111111111111111111111111111111111111111111111111111111111",
+				Initiator:     true,
+				CapacityMsat:  100000000,
+			},
+		},
+		{
+			Type:         lnchat.ChannelEventACTIVE,
+			ChannelPoint: chanPoint,
+		},
+	}
+
+	cases := []struct {
+		name   string
+		events []lnchat.ChannelEvent
+	}{
+		{
+			name:   "success",
+			events: eventList,
+		},
+		{
+			name:   "subscription terminated",
+			events: []lnchat.ChannelEvent{},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			// Events are sent only after the test has subscribed,
+			// since events published without subscribers are dropped.
+			subscribed := make(chan bool)
+
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				testcaseTermination := make(chan bool)
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
+					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+
+				eventUpdateCh := func() <-chan lnchat.ChannelEventUpdate {
+					ch := make(chan lnchat.ChannelEventUpdate)
+
+					go func(channel chan lnchat.ChannelEventUpdate) {
+						defer close(channel)
+
+						<-subscribed
+						for i := range c.events {
+							channel <- lnchat.ChannelEventUpdate{
+								Event: &c.events[i],
+							}
+						}
+
+						<-testcaseTermination
+					}(ch)
+					return ch
+				}()
+
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(
+					eventUpdateCh, nil).Once()
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				mockStopFunc := func() {
+					close(testcaseTermination)
+				}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxc, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			eventCh, err := app.SubscribeChannelEvents(ctxc)
+			assert.NoError(t, err)
+			close(subscribed)
+
+			expected := make([]*model.ChannelEvent, 0, len(c.events))
+			var received []*model.ChannelEvent
+			for _, e := range c.events {
+				expected = append(expected, &model.ChannelEvent{ChannelEvent: e})
+				if event, ok := <-eventCh; ok {
+					received = append(received, event)
+				}
+			}
+
+			assert.ElementsMatch(t, expected, received)
+		})
+	}
+}
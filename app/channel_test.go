@@ -0,0 +1,354 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestListChannels(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	peer := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	channels := []lnchat.Channel{
+		{
+			ChannelPoint: lnchat.ChannelPoint{
+				FundingTxid: "6ef1d1ad3f0ee5fcd2ca0a6fc0fde7c2fa4d9b1a6d5c45ef0a1c6e0c8e7a2a11",
+				OutputIndex: 1,
+			},
+			ChannelID:     1234567890,
+			RemoteAddress: peer,
+			Active:        true,
+			Initiator:     true,
+			CapacityMsat:  100000000,
+			Balance: lnchat.BalanceAllocation{
+				LocalMsat:  90000000,
+				RemoteMsat: 6000000,
+			},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		filter        model.ChannelFilter
+		expectedCall  func(*lnmock.LightManager)
+		expected      []model.Channel
+		expectedError error
+	}{
+		{
+			name: "Active with peer",
+			filter: model.ChannelFilter{
+				ActiveOnly: true,
+				Peer:       peer,
+			},
+			expectedCall: func(m *lnmock.LightManager) {
+				m.On("ListChannels", mock.Anything, lnchat.ChannelFilter{
+					ActiveOnly: true,
+					Peer:       peer,
+				}).Return(channels, nil).Once()
+			},
+			expected: []model.Channel{
+				{Channel: channels[0]},
+			},
+		},
+		{
+			name:   "Error",
+			filter: model.ChannelFilter{},
+			expectedCall: func(m *lnmock.LightManager) {
+				m.On("ListChannels", mock.Anything, lnchat.ChannelFilter{}).Return(
+					nil, lnchat.ErrNetworkUnavailable).Once()
+			},
+			expectedError: Error{
+				Kind:    NetworkError,
+				details: "ListChannels",
+				Err:     lnchat.ErrNetworkUnavailable,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
+					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				c.expectedCall(mockLNManager)
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+			defer cancel()
+
+			res, err := app.ListChannels(ctxt, c.filter)
+			if c.expectedError != nil {
+				assert.EqualError(t, err, c.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.EqualValues(t, c.expected, res)
+		})
+	}
+}
+
+func TestListPendingChannels(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	pending := []lnchat.PendingChannel{
+		{
+			ChannelPoint: lnchat.ChannelPoint{
+				FundingTxid: "6ef1d1ad3f0ee5fcd2ca0a6fc0fde7c2fa4d9b1a6d5c45ef0a1c6e0c8e7a2a11",
+				OutputIndex: 0,
+			},
+			RemoteAddress: "111111111111111111111111111111111111111111111111111111111111111111",
+			State:         lnchat.PendingOPEN,
+			Initiator:     true,
+			CapacityMsat:  100000000,
+		},
+		{
+			ChannelPoint: lnchat.ChannelPoint{
+				FundingTxid: "0a6fc0fde7c2fa4d9b1a6d5c45ef0a1c6e0c8e7a2a116ef1d1ad3f0ee5fcd2ca",
+				OutputIndex: 1,
+			},
+			RemoteAddress:     "222222222222222222222222222222222222222222222222222222222222222222",
+			State:             lnchat.PendingFORCECLOSING,
+			CapacityMsat:      50000000,
+			ClosingTxid:       "c45ef0a1c6e0c8e7a2a116ef1d1ad3f0ee5fcd2ca0a6fc0fde7c2fa4d9b1a6d5",
+			LimboBalanceMsat:  20000000,
+			MaturityHeight:    1200,
+			BlocksTilMaturity: 144,
+		},
+	}
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+		mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
+			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockLNManager.On("ListPendingChannels", mock.Anything).Return(pending, nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	res, err := app.ListPendingChannels(ctxt)
+	assert.NoError(t, err)
+	assert.EqualValues(t, []model.PendingChannel{
+		{PendingChannel: pending[0]},
+		{PendingChannel: pending[1]},
+	}, res)
+}
+
+func TestCloseChannel(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	chanPoint := lnchat.ChannelPoint{
+		FundingTxid: "6ef1d1ad3f0ee5fcd2ca0a6fc0fde7c2fa4d9b1a6d5c45ef0a1c6e0c8e7a2a11",
+		OutputIndex: 1,
+	}
+	txOpts := lnchat.TxFeeOptions{
+		SatPerVByte: 12,
+	}
+	closingTxid := "c45ef0a1c6e0c8e7a2a116ef1d1ad3f0ee5fcd2ca0a6fc0fde7c2fa4d9b1a6d5"
+
+	cases := []struct {
+		name            string
+		force           bool
+		updates         []lnchat.ChannelCloseUpdate
+		expectedUpdates []model.ChannelCloseUpdate
+		expectedErrs    []error
+	}{
+		{
+			name:  "Cooperative",
+			force: false,
+			updates: []lnchat.ChannelCloseUpdate{
+				{
+					Status: &lnchat.ChannelCloseStatus{
+						State:       lnchat.ChannelClosePENDING,
+						ClosingTxid: closingTxid,
+					},
+				},
+				{
+					Status: &lnchat.ChannelCloseStatus{
+						State:       lnchat.ChannelCloseCONFIRMED,
+						ClosingTxid: closingTxid,
+					},
+				},
+			},
+			expectedUpdates: []model.ChannelCloseUpdate{
+				{
+					Status: &model.ChannelCloseStatus{
+						ChannelCloseStatus: lnchat.ChannelCloseStatus{
+							State:       lnchat.ChannelClosePENDING,
+							ClosingTxid: closingTxid,
+						},
+					},
+				},
+				{
+					Status: &model.ChannelCloseStatus{
+						ChannelCloseStatus: lnchat.ChannelCloseStatus{
+							State:       lnchat.ChannelCloseCONFIRMED,
+							ClosingTxid: closingTxid,
+						},
+					},
+				},
+			},
+			expectedErrs: []error{nil, nil},
+		},
+		{
+			name:  "Forced with error",
+			force: true,
+			updates: []lnchat.ChannelCloseUpdate{
+				{
+					Err: lnchat.ErrNetworkUnavailable,
+				},
+			},
+			expectedUpdates: []model.ChannelCloseUpdate{{}},
+			expectedErrs: []error{
+				Error{
+					Kind:    NetworkError,
+					details: "CloseChannel: channel closing failed",
+					Err:     lnchat.ErrNetworkUnavailable,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
+					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				updateCh := make(chan lnchat.ChannelCloseUpdate, len(c.updates))
+				for _, u := range c.updates {
+					updateCh <- u
+				}
+				close(updateCh)
+
+				mockLNManager.On("CloseChannel", mock.Anything, chanPoint,
+					c.force, txOpts).Return(
+					(<-chan lnchat.ChannelCloseUpdate)(updateCh), nil).Once()
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+			defer cancel()
+
+			updates, err := app.CloseChannel(ctxt, model.ChannelPoint{ChannelPoint: chanPoint},
+				c.force, model.TxFeeOptions{
+					SatPerVByte: txOpts.SatPerVByte,
+				})
+			assert.NoError(t, err)
+
+			var i int
+			for update := range updates {
+				if c.expectedErrs[i] != nil {
+					assert.EqualError(t, update.Err, c.expectedErrs[i].Error())
+				} else {
+					assert.NoError(t, update.Err)
+					assert.EqualValues(t, c.expectedUpdates[i], update)
+				}
+				i++
+			}
+			assert.Equal(t, len(c.expectedUpdates), i)
+		})
+	}
+}
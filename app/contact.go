@@ -3,11 +3,21 @@ package app
 import (
 	"context"
 
+	"github.com/c13n-io/c13n-go/lnurl"
 	"github.com/c13n-io/c13n-go/model"
 )
 
 // AddContact adds a contact to the database if it doesn't exist.
+// The Lightning Address of the contact, if present, must be valid.
 func (app *App) AddContact(_ context.Context, contact *model.Contact) (*model.Contact, error) {
+	if contact.LightningAddress != "" {
+		user, domain, err := lnurl.ParseLightningAddress(contact.LightningAddress)
+		if err != nil {
+			return nil, newErrorf(err, "AddContact")
+		}
+		contact.LightningAddress = user + "@" + domain
+	}
+
 	contact, err := app.Database.AddContact(contact)
 	if err != nil {
 		return nil, newErrorf(err, "AddContact")
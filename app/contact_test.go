@@ -88,11 +88,15 @@ func TestAddContact(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				mockDB.On("Close").Return(nil).Once()
 				mockLNManager.On("Close").Return(nil).Once()
@@ -178,11 +182,15 @@ func TestGetContactsSuccess(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -260,11 +268,15 @@ func TestRemoveContactByAddress(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				mockDB.On("Close").Return(nil).Once()
 				mockLNManager.On("Close").Return(nil).Once()
@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+var (
+	// ErrForeignMessageControl indicates that an edit or deletion
+	// of a message not sent by the node was requested.
+	ErrForeignMessageControl = fmt.Errorf("only messages sent " +
+		"by the node can be edited or deleted")
+	// ErrAnonymousMessageControl indicates that an anonymous
+	// edit or deletion was requested.
+	ErrAnonymousMessageControl = fmt.Errorf("anonymous edits " +
+		"and deletions are disallowed")
+)
+
+// SendControlMessage sends a message carrying a control
+// (reply, reaction, edit or deletion request) to a discussion,
+// referencing a message of the discussion by its hash.
+// Reactions carry the reaction as payload, while edits
+// carry the new message contents.
+// Edits and deletions may only reference messages sent by the node,
+// and cannot be anonymous, since recipients apply them
+// only if signed by the sender of the referenced message.
+func (app *App) SendControlMessage(ctx context.Context, discID uint64,
+	amtMsat int64, ctrl model.Control, payload string,
+	opts model.MessageOptions) (*model.MessageAggregate, error) {
+
+	if err := ctrl.Validate(); err != nil {
+		return nil, err
+	}
+
+	ref, err := app.Database.GetMessageByHash(discID, hex.EncodeToString(ctrl.Ref))
+	if err != nil {
+		return nil, newErrorf(err, "could not retrieve referenced message")
+	}
+
+	switch ctrl.Type {
+	case model.ControlReaction:
+		if payload == "" {
+			return nil, fmt.Errorf("empty reaction")
+		}
+	case model.ControlEdit, model.ControlDelete:
+		if ref.Sender != app.Self.Node.Address {
+			return nil, ErrForeignMessageControl
+		}
+
+		disc, err := app.retrieveDiscussion(ctx, discID)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve discussion")
+		}
+		if overrideOptions(disc.Options, true, opts).Anonymous {
+			return nil, ErrAnonymousMessageControl
+		}
+	}
+
+	return app.sendMessage(ctx, discID, amtMsat, "", &ctrl, payload, opts)
+}
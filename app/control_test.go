@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat/simnet"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestSimnetSendControlMessage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	net := simnet.NewNetwork()
+	alice, err := net.AddNode("alice", 10000000)
+	require.NoError(t, err)
+	bob, err := net.AddNode("bob", 10000000)
+	require.NoError(t, err)
+
+	_, err = net.ConnectNodes(alice, bob, 1000000, 0, false)
+	require.NoError(t, err)
+	_, err = net.ConnectNodes(bob, alice, 1000000, 0, false)
+	require.NoError(t, err)
+
+	sender, cleanupSender := createSimnetApp(t, alice)
+	defer cleanupSender()
+	receiver, cleanupReceiver := createSimnetApp(t, bob)
+	defer cleanupReceiver()
+
+	received, err := receiver.SubscribeMessages(ctx)
+	require.NoError(t, err)
+	receive := func() model.MessageAggregate {
+		select {
+		case msg := <-received:
+			require.NotNil(t, msg.RawMessage)
+			return msg
+		case <-ctx.Done():
+			require.FailNow(t, "timed out waiting for received message")
+		}
+		return model.MessageAggregate{}
+	}
+
+	disc, err := sender.AddDiscussion(ctx, &model.Discussion{
+		Participants: []string{bob.Address()},
+	})
+	require.NoError(t, err)
+
+	sent, err := sender.SendMessage(ctx, disc.ID, 1000, "", "hello",
+		model.MessageOptions{})
+	require.NoError(t, err)
+	ref := sent.RawMessage.Hash()
+	msg := receive()
+	assert.Equal(t, ref, msg.RawMessage.Hash())
+
+	recvDisc, err := receiver.GetDiscussions(ctx)
+	require.NoError(t, err)
+	require.Len(t, recvDisc, 1)
+	recvDiscID := recvDisc[0].ID
+
+	// Only the sender of a message can edit it.
+	_, err = receiver.SendControlMessage(ctx, recvDiscID, 1000,
+		model.Control{Type: model.ControlEdit, Ref: ref}, "forged",
+		model.MessageOptions{})
+	assert.ErrorIs(t, err, ErrForeignMessageControl)
+
+	_, err = receiver.SendControlMessage(ctx, recvDiscID, 1000,
+		model.Control{Type: model.ControlReaction, Ref: ref}, "",
+		model.MessageOptions{})
+	assert.Error(t, err)
+
+	_, err = receiver.SendControlMessage(ctx, recvDiscID, 1000,
+		model.Control{Type: model.ControlReaction, Ref: ref[1:]}, "+1",
+		model.MessageOptions{})
+	assert.Error(t, err)
+
+	_, err = receiver.SendControlMessage(ctx, recvDiscID, 1000,
+		model.Control{Type: model.ControlReaction, Ref: ref}, "+1",
+		model.MessageOptions{})
+	require.NoError(t, err)
+	// Sent messages are published to subscribers as well.
+	reaction := receive()
+	assert.Equal(t, model.ControlReaction, reaction.RawMessage.ControlType)
+	assert.Equal(t, sent.RawMessage.MessageHash, reaction.RawMessage.ControlRef)
+
+	_, err = sender.SendControlMessage(ctx, disc.ID, 1000,
+		model.Control{Type: model.ControlEdit, Ref: ref}, "hello, bob",
+		model.MessageOptions{})
+	require.NoError(t, err)
+	edit := receive()
+	assert.Equal(t, model.ControlEdit, edit.RawMessage.ControlType)
+
+	_, err = sender.SendControlMessage(ctx, disc.ID, 1000,
+		model.Control{Type: model.ControlEdit, Ref: ref}, "anonymous",
+		model.MessageOptions{Anonymous: true})
+	assert.ErrorIs(t, err, ErrAnonymousMessageControl)
+
+	_, err = sender.SendControlMessage(ctx, disc.ID, 1000,
+		model.Control{Type: model.ControlReply, Ref: ref}, "a reply",
+		model.MessageOptions{})
+	require.NoError(t, err)
+	receive()
+
+	history, err := receiver.GetDiscussionHistory(ctx, recvDiscID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	assert.Len(t, history[0].Annotations, 2)
+	require.Len(t, history[0].Reactions, 1)
+	assert.Equal(t, bob.Address(), history[0].Reactions[0].Sender)
+	assert.Equal(t, "+1", history[0].Reactions[0].Reaction)
+	require.Len(t, history[0].Edits, 2)
+	assert.Equal(t, "hello", history[0].Edits[0].Payload)
+	assert.Equal(t, "hello, bob", history[0].Edits[1].Payload)
+	assert.False(t, history[0].Deleted)
+
+	assert.Equal(t, model.ControlReply, history[1].RawMessage.ControlType)
+	assert.Equal(t, sent.RawMessage.MessageHash, history[1].RawMessage.ControlRef)
+
+	_, err = sender.SendControlMessage(ctx, disc.ID, 1000,
+		model.Control{Type: model.ControlDelete, Ref: ref}, "",
+		model.MessageOptions{})
+	require.NoError(t, err)
+	receive()
+
+	history, err = receiver.GetDiscussionHistory(ctx, recvDiscID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.True(t, history[0].Deleted)
+	assert.Empty(t, history[0].Reactions)
+	assert.Empty(t, history[0].Edits)
+}
@@ -0,0 +1,178 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+const (
+	// routeDiagnosticsWindow is the period within which pair failures
+	// are attached to route discovery errors.
+	routeDiagnosticsWindow = time.Hour
+	// routeDiagnosticsMaxPairs is the maximum number of pairs
+	// attached to route discovery errors.
+	routeDiagnosticsMaxPairs = 10
+)
+
+// NoRouteError is returned when no route to a destination could be found,
+// and carries the node pairs that recently failed to forward payments.
+type NoRouteError struct {
+	// The destination no route was found to.
+	Destination string
+	// The recently failed pairs on or next to routes to the destination,
+	// with the ones reaching the destination first
+	// and the most recent first otherwise.
+	Pairs []model.PairHistory
+	Err   error
+}
+
+func (e *NoRouteError) Error() string {
+	if len(e.Pairs) == 0 {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("%v (%d node pairs failed within the last %v)",
+		e.Err, len(e.Pairs), routeDiagnosticsWindow)
+}
+
+// Unwrap returns the underlying error of a NoRouteError.
+func (e *NoRouteError) Unwrap() error {
+	return e.Err
+}
+
+// QueryMissionControl returns the payment attempt history
+// of the underlying node for all node pairs.
+func (app *App) QueryMissionControl(ctx context.Context) ([]model.PairHistory, error) {
+	pairs, err := app.LNManager.QueryMissionControl(ctx)
+	if err != nil {
+		return nil, newErrorf(err, "QueryMissionControl")
+	}
+
+	res := make([]model.PairHistory, len(pairs))
+	for i, p := range pairs {
+		res[i] = model.PairHistory{PairHistory: p}
+	}
+
+	return res, nil
+}
+
+// QueryProbability returns the estimated probability that the underlying node
+// can forward the provided amount from a node to another,
+// along with the payment attempt history of the pair.
+func (app *App) QueryProbability(ctx context.Context, from, to string,
+	amtMsat int64) (float64, *model.PairHistory, error) {
+
+	prob, history, err := app.LNManager.QueryProbability(ctx,
+		from, to, lnchat.NewAmount(amtMsat))
+	if err != nil {
+		return 0, nil, newErrorf(err, "QueryProbability")
+	}
+
+	return prob, &model.PairHistory{PairHistory: *history}, nil
+}
+
+// ResetMissionControl clears the payment attempt history of the underlying node.
+func (app *App) ResetMissionControl(ctx context.Context) error {
+	if err := app.LNManager.ResetMissionControl(ctx); err != nil {
+		return newErrorf(err, "ResetMissionControl")
+	}
+
+	return nil
+}
+
+// routeDiagnostics attaches recent pair failures to route discovery errors.
+// The mission control of the underlying node is queried at most once,
+// so that a single diagnostics instance can serve all payments of an operation.
+type routeDiagnostics struct {
+	app *App
+
+	once  sync.Once
+	pairs []lnchat.PairHistory
+	err   error
+}
+
+// newRouteDiagnostics returns route diagnostics for a single operation.
+func (app *App) newRouteDiagnostics() *routeDiagnostics {
+	return &routeDiagnostics{app: app}
+}
+
+// withRouteDiagnostics attaches the node pairs that recently failed
+// to route discovery errors for a destination.
+// Other errors, or errors retrieving the pair history, leave err unchanged.
+func (app *App) withRouteDiagnostics(ctx context.Context, dest string, err error) error {
+	return app.newRouteDiagnostics().attach(ctx, dest, err)
+}
+
+// attach attaches the node pairs that recently failed on or next to
+// routes to a destination to route discovery errors for it,
+// including the errors of payments that failed for lack of a route.
+// Other errors, or errors retrieving the pair history, leave err unchanged.
+func (d *routeDiagnostics) attach(ctx context.Context, dest string, err error) error {
+	if !errors.Is(err, lnchat.ErrNoRouteFound) {
+		return err
+	}
+
+	d.once.Do(func() {
+		d.pairs, d.err = d.app.LNManager.QueryMissionControl(ctx)
+	})
+	if d.err != nil {
+		return err
+	}
+
+	return &NoRouteError{
+		Destination: dest,
+		Pairs:       recentRouteFailures(d.pairs, d.app.Self.Node.Address, dest),
+		Err:         err,
+	}
+}
+
+// recentRouteFailures returns the pairs that recently failed
+// on or next to routes from self to dest: pairs starting from self
+// or its known first hops, and pairs ending at dest
+// or its known last hops. Pairs unrelated to such routes are dropped.
+// Pairs reaching the destination come first, then the most recent.
+func recentRouteFailures(pairs []lnchat.PairHistory,
+	self, dest string) []model.PairHistory {
+
+	firstHops, lastHops := make(map[string]bool), make(map[string]bool)
+	for _, p := range pairs {
+		if self != "" && p.NodeFrom == self {
+			firstHops[p.NodeTo] = true
+		}
+		if p.NodeTo == dest {
+			lastHops[p.NodeFrom] = true
+		}
+	}
+
+	since := time.Now().Add(-routeDiagnosticsWindow).Unix()
+	var recent []model.PairHistory
+	for _, p := range pairs {
+		if p.FailTimeSec == 0 || p.FailTimeSec < since {
+			continue
+		}
+		if (self != "" && p.NodeFrom == self) || firstHops[p.NodeFrom] ||
+			p.NodeTo == dest || lastHops[p.NodeTo] {
+
+			recent = append(recent, model.PairHistory{PairHistory: p})
+		}
+	}
+	sort.SliceStable(recent, func(i, j int) bool {
+		toDest := func(p model.PairHistory) bool { return p.NodeTo == dest }
+		if toDest(recent[i]) != toDest(recent[j]) {
+			return toDest(recent[i])
+		}
+		return recent[i].FailTimeSec > recent[j].FailTimeSec
+	})
+	if len(recent) > routeDiagnosticsMaxPairs {
+		recent = recent[:routeDiagnosticsMaxPairs]
+	}
+
+	return recent
+}
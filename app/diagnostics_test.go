@@ -0,0 +1,144 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestRouteDiagnostics(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	hopAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+	destAddress := "222222222222222222222222222222222222222222222222222222222222222222"
+	otherAddress := "333333333333333333333333333333333333333333333333333333333333333333"
+	farAddress := "444444444444444444444444444444444444444444444444444444444444444444"
+
+	now := time.Now().Unix()
+	pairs := []lnchat.PairHistory{
+		{NodeFrom: srcAddress, NodeTo: hopAddress, FailTimeSec: now - 60, FailAmtMsat: 1000},
+		{NodeFrom: hopAddress, NodeTo: destAddress, FailTimeSec: now - 600, FailAmtMsat: 1000},
+		{NodeFrom: srcAddress, NodeTo: destAddress, FailTimeSec: now - 7200, FailAmtMsat: 1000},
+		{NodeFrom: srcAddress, NodeTo: hopAddress, SuccessTimeSec: now, SuccessAmtMsat: 1000},
+		// Unrelated to routes to the destination.
+		{NodeFrom: otherAddress, NodeTo: farAddress, FailTimeSec: now - 30, FailAmtMsat: 1000},
+	}
+
+	cases := []struct {
+		name          string
+		routeErr      error
+		pairs         []lnchat.PairHistory
+		pairsErr      error
+		expectedPairs []lnchat.PairHistory
+	}{
+		{
+			name:          "recent failures",
+			routeErr:      lnchat.ErrNoRouteFound,
+			pairs:         pairs,
+			expectedPairs: []lnchat.PairHistory{pairs[1], pairs[0]},
+		},
+		{
+			name:     "no recent failures",
+			routeErr: lnchat.ErrNoRouteFound,
+			pairs:    pairs[2:],
+		},
+		{
+			name:     "mission control unavailable",
+			routeErr: lnchat.ErrNoRouteFound,
+			pairsErr: lnchat.ErrNotSupported,
+		},
+		{
+			name:     "other error",
+			routeErr: lnchat.ErrNetworkUnavailable,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockLNManager := new(lnmock.LightManager)
+			app, err := New(mockLNManager, new(dbmock.Database))
+			require.NoError(t, err)
+
+			mockLNManager.On("GetRoute", mock.Anything, destAddress,
+				lnchat.NewAmount(1000), "", lnchat.PaymentOptions{},
+				map[uint64][]byte(nil)).Return(nil, .0, c.routeErr).Once()
+			if errors.Is(c.routeErr, lnchat.ErrNoRouteFound) {
+				mockLNManager.On("QueryMissionControl", mock.Anything).
+					Return(c.pairs, c.pairsErr).Once()
+			}
+
+			_, err = app.GetRoute(context.Background(), destAddress,
+				1000, "", lnchat.PaymentOptions{})
+			mockLNManager.AssertExpectations(t)
+			require.ErrorIs(t, err, c.routeErr)
+
+			var noRouteErr *NoRouteError
+			if c.pairsErr != nil || !errors.Is(c.routeErr, lnchat.ErrNoRouteFound) {
+				assert.False(t, errors.As(err, &noRouteErr))
+				return
+			}
+			require.ErrorAs(t, err, &noRouteErr)
+			assert.Equal(t, destAddress, noRouteErr.Destination)
+
+			var expected []model.PairHistory
+			for _, p := range c.expectedPairs {
+				expected = append(expected, model.PairHistory{PairHistory: p})
+			}
+			assert.Equal(t, expected, noRouteErr.Pairs)
+		})
+	}
+}
+
+func TestSendMessageRouteDiagnostics(t *testing.T) {
+	participants := []string{
+		"111111111111111111111111111111111111111111111111111111111111111111",
+		"222222222222222222222222222222222222222222222222222222222222222222",
+	}
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	app, err := New(mockLNManager, mockDB)
+	require.NoError(t, err)
+	app.Self.Node.Address = "000000000000000000000000000000000000000000000000000000000000000000"
+
+	mockDB.On("GetDiscussion", uint64(1)).Return(&model.Discussion{
+		ID:           1,
+		Participants: participants,
+	}, nil).Once()
+	mockLNManager.On("SignMessage", mock.Anything, mock.Anything).
+		Return([]byte("signature"), nil).Once()
+	// lnd reports the lack of a route as a failed payment.
+	failed := &lnchat.Payment{
+		Status:        lnchat.PaymentFAILED,
+		FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE,
+	}
+	for _, p := range participants {
+		mockLNManager.On("SendPayment", mock.Anything, p, lnchat.NewAmount(1000), "",
+			mock.Anything, mock.Anything, mock.Anything).
+			Return(paymentUpdates(failed), nil).Once()
+	}
+	// Mission control is queried once for all recipients.
+	mockLNManager.On("QueryMissionControl", mock.Anything).
+		Return([]lnchat.PairHistory{{
+			NodeFrom:    app.Self.Node.Address,
+			NodeTo:      participants[0],
+			FailTimeSec: time.Now().Unix(),
+		}}, nil).Once()
+
+	_, err = app.SendMessage(context.Background(), 1, 1000, "",
+		"hello", model.MessageOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "node pairs failed")
+
+	mockLNManager.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+}
@@ -36,9 +36,22 @@ func (app *App) GetDiscussionStatistics(ctx context.Context, id uint64) (
 		return nil, err
 	}
 
-	var amtSent, amtRcv, amtFees, msgsSent, msgsRcv int64
+	// Annotations of messages are also accounted for.
+	var aggregates []model.MessageAggregate
 	for _, m := range msgAggregates {
+		aggregates = append(aggregates, m)
+		aggregates = append(aggregates, m.Annotations...)
+	}
+
+	var amtSent, amtRcv, amtFees, msgsSent, msgsRcv int64
+	for _, m := range aggregates {
 		switch {
+		case m.RawMessage.PeerTransport:
+			if m.RawMessage.Sender == app.Self.Node.Address {
+				msgsSent++
+			} else {
+				msgsRcv++
+			}
 		case len(m.Payments) == 0:
 			msgsRcv++
 			amtRcv += m.Invoice.AmtPaid.Msat()
@@ -69,6 +82,8 @@ func (app *App) GetDiscussionStatistics(ctx context.Context, id uint64) (
 }
 
 // GetDiscussionHistory returns the requested range of messages for a specific discussion.
+// Reactions, edits and deletions are not returned as separate messages,
+// but are applied to the messages they reference.
 func (app *App) GetDiscussionHistory(_ context.Context, discID uint64,
 	pageOpts model.PageOptions) ([]model.MessageAggregate, error) {
 
@@ -77,6 +92,12 @@ func (app *App) GetDiscussionHistory(_ context.Context, discID uint64,
 		return nil, newErrorf(err, "could not retrieve discussion messages")
 	}
 
+	for i := range msgAggregates {
+		if err := msgAggregates[i].ApplyAnnotations(); err != nil {
+			return nil, newErrorf(err, "could not apply message annotations")
+		}
+	}
+
 	return msgAggregates, nil
 }
 
@@ -113,18 +134,28 @@ func (app *App) retrieveDiscussion(_ context.Context, discussionID uint64) (*mod
 	return discussion, newErrorf(err, "GetDiscussion")
 }
 
-// Retrieve a discussion by its participant list, or
-// insert it if it doesn't exist.
+// Retrieve a discussion by its participant list
+// (or its LNURL, if it is paid through one),
+// or insert it if it doesn't exist.
 func (app *App) retrieveOrCreateDiscussion(disc *model.Discussion) (*model.Discussion, error) {
 
 	if disc == nil {
 		return nil, fmt.Errorf("cannot retrieve empty discussion")
 	}
 
-	discussion, err := app.Database.GetDiscussionByParticipants(disc.Participants)
+	var discussion *model.Discussion
+	var err error
+	op := "GetDiscussionByParticipants"
+	switch disc.LNURL {
+	case "":
+		discussion, err = app.Database.GetDiscussionByParticipants(disc.Participants)
+	default:
+		op = "GetDiscussionByLNURL"
+		discussion, err = app.Database.GetDiscussionByLNURL(disc.LNURL)
+	}
 	if err != nil {
 		if !errors.Is(err, store.ErrDiscussionNotFound) {
-			return nil, newErrorf(err, "retrieveOrCreateDiscussion: GetDiscussionByParticipants")
+			return nil, newErrorf(err, "retrieveOrCreateDiscussion: %s", op)
 		}
 
 		discussion, err = app.Database.AddDiscussion(disc)
@@ -97,11 +97,15 @@ func TestAddDiscussion(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				mockDB.On("Close").Return(nil).Once()
 				mockLNManager.On("Close").Return(nil).Once()
@@ -189,11 +193,15 @@ func TestRemoveDiscussion(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				mockDB.On("Close").Return(nil).Once()
 				mockLNManager.On("Close").Return(nil).Once()
@@ -365,11 +373,15 @@ func TestGetDiscussionStatistics(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				mockDB.On("GetMessages",
 					discussionID, model.PageOptions{}).Return(
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/lnurl"
 	"github.com/c13n-io/c13n-go/store"
 )
 
@@ -26,6 +27,13 @@ const (
 	DiscussionNotFound
 	UnknownError
 	InternalError
+	NodeNotFound
+	PersistentPeerNotFound
+	AttachmentNotFound
+	MessageNotFound
+	NotSupported
+	IncorrectPaymentDetails
+	CommentTooLong
 )
 
 func kindFromErr(err error) ErrKind {
@@ -44,10 +52,17 @@ func kindFromErr(err error) ErrKind {
 		return InternalError
 	case errors.Is(err, lnchat.ErrNoRouteFound):
 		return NoRouteFound
-	case errors.Is(err, lnchat.ErrInvalidAddress):
+	case errors.Is(err, lnchat.ErrInvalidAddress),
+		errors.Is(err, lnurl.ErrInvalidLNURL):
 		return InvalidAddress
 	case errors.Is(err, lnchat.ErrInsufficientBalance):
 		return InsufficientBalance
+	case errors.Is(err, lnchat.ErrIncorrectPaymentDetails):
+		return IncorrectPaymentDetails
+	case errors.Is(err, lnurl.ErrCommentTooLong):
+		return CommentTooLong
+	case errors.Is(err, lnchat.ErrNodeNotFound):
+		return NodeNotFound
 	case errors.Is(err, store.ErrContactNotFound):
 		return ContactNotFound
 	case errors.Is(err, store.ErrContactAlreadyExists):
@@ -56,6 +71,14 @@ func kindFromErr(err error) ErrKind {
 		return DiscussionNotFound
 	case errors.Is(err, store.ErrDiscussionAlreadyExists):
 		return DiscussionAlreadyExists
+	case errors.Is(err, store.ErrPersistentPeerNotFound):
+		return PersistentPeerNotFound
+	case errors.Is(err, store.ErrAttachmentNotFound):
+		return AttachmentNotFound
+	case errors.Is(err, store.ErrMessageNotFound):
+		return MessageNotFound
+	case errors.Is(err, lnchat.ErrNotSupported):
+		return NotSupported
 	default:
 		return InternalError
 	}
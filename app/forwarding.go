@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// forwardingHistoryMaxEvents is the maximum number of forwarding events
+// retrieved when no page size is specified.
+const forwardingHistoryMaxEvents = 50000
+
+// GetChannelPolicy returns the forwarding policy
+// of the underlying node for the channel identified by the provided channel point.
+func (app *App) GetChannelPolicy(ctx context.Context,
+	chanPoint model.ChannelPoint) (*model.ChannelPolicy, error) {
+
+	policy, err := app.LNManager.GetChannelPolicy(ctx, chanPoint.ChannelPoint)
+	if err != nil {
+		return nil, newErrorf(err, "GetChannelPolicy")
+	}
+
+	return &model.ChannelPolicy{ChannelPolicy: *policy}, nil
+}
+
+// UpdateChannelPolicy updates the forwarding policy of the underlying node
+// for the channel identified by the provided channel point,
+// or for all channels if no channel point is provided.
+// A zero minimum or maximum HTLC amount leaves the respective limit unchanged.
+func (app *App) UpdateChannelPolicy(ctx context.Context,
+	chanPoint *model.ChannelPoint, policy model.ChannelPolicy) error {
+
+	var cp *lnchat.ChannelPoint
+	if chanPoint != nil {
+		cp = &chanPoint.ChannelPoint
+	}
+
+	if err := app.LNManager.UpdateChannelPolicy(ctx,
+		cp, policy.ChannelPolicy); err != nil {
+
+		return newErrorf(err, "UpdateChannelPolicy: channel policy update failed")
+	}
+
+	return nil
+}
+
+// ForwardingHistory returns the forwarding events of the underlying node
+// within the provided time range (in seconds since Unix epoch),
+// along with per-channel aggregates over the returned events.
+// Events are identified by their index within the time range,
+// and the pageOpts parameter controls the requested event range.
+// A zero end time signifies the current time.
+func (app *App) ForwardingHistory(ctx context.Context,
+	startTimeSec, endTimeSec int64,
+	pageOpts model.PageOptions) (*model.ForwardingHistory, error) {
+
+	if pageOpts.Reverse && pageOpts.LastID == 0 {
+		return nil, fmt.Errorf("reverse pagination without anchor is disallowed")
+	}
+
+	var offset, maxEvents uint64
+	switch {
+	case pageOpts.Reverse:
+		if pageOpts.PageSize != 0 && pageOpts.PageSize < pageOpts.LastID {
+			offset = pageOpts.LastID - pageOpts.PageSize
+		}
+		maxEvents = pageOpts.LastID - offset
+	default:
+		if pageOpts.LastID > 0 {
+			offset = pageOpts.LastID - 1
+		}
+		maxEvents = pageOpts.PageSize
+	}
+	if maxEvents == 0 || maxEvents > forwardingHistoryMaxEvents {
+		maxEvents = forwardingHistoryMaxEvents
+	}
+
+	events, err := app.LNManager.ForwardingHistory(ctx,
+		startTimeSec, endTimeSec, offset, uint32(maxEvents))
+	if err != nil {
+		return nil, newErrorf(err, "ForwardingHistory")
+	}
+
+	res := &model.ForwardingHistory{
+		Events:   make([]model.ForwardingEvent, len(events)),
+		Channels: aggregateForwards(events),
+	}
+	for i, e := range events {
+		res.Events[i] = model.ForwardingEvent{ForwardingEvent: e}
+	}
+	if pageOpts.Reverse {
+		for i, j := 0, len(res.Events)-1; i < j; i, j = i+1, j-1 {
+			res.Events[i], res.Events[j] = res.Events[j], res.Events[i]
+		}
+	}
+
+	return res, nil
+}
+
+// aggregateForwards computes per-channel aggregates over forwarding events,
+// sorted by channel ID. Fees are attributed to the outgoing channel.
+func aggregateForwards(events []lnchat.ForwardingEvent) []model.ChannelForwardingStats {
+	stats := make(map[uint64]*model.ChannelForwardingStats)
+	channel := func(id uint64) *model.ChannelForwardingStats {
+		s, ok := stats[id]
+		if !ok {
+			s = &model.ChannelForwardingStats{ChannelID: id}
+			stats[id] = s
+		}
+		return s
+	}
+
+	for _, e := range events {
+		in := channel(e.ChanIDIn)
+		in.ForwardsIn++
+		in.AmtInMsat += e.AmtInMsat
+
+		out := channel(e.ChanIDOut)
+		out.ForwardsOut++
+		out.AmtOutMsat += e.AmtOutMsat
+		out.FeeMsat += e.FeeMsat
+	}
+
+	res := make([]model.ChannelForwardingStats, 0, len(stats))
+	for _, s := range stats {
+		res = append(res, *s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ChannelID < res[j].ChannelID
+	})
+
+	return res
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestForwardingHistory(t *testing.T) {
+	events := []lnchat.ForwardingEvent{
+		{Index: 5, ChanIDIn: 1, ChanIDOut: 2, AmtInMsat: 1010, AmtOutMsat: 1000, FeeMsat: 10},
+		{Index: 6, ChanIDIn: 3, ChanIDOut: 2, AmtInMsat: 2020, AmtOutMsat: 2000, FeeMsat: 20},
+		{Index: 7, ChanIDIn: 2, ChanIDOut: 1, AmtInMsat: 3030, AmtOutMsat: 3000, FeeMsat: 30},
+	}
+
+	cases := []struct {
+		name              string
+		pageOpts          model.PageOptions
+		expectedOffset    uint64
+		expectedMaxEvents uint32
+		reversed          bool
+	}{
+		{
+			name:              "forward",
+			pageOpts:          model.PageOptions{LastID: 5, PageSize: 3},
+			expectedOffset:    4,
+			expectedMaxEvents: 3,
+		},
+		{
+			name:              "reverse",
+			pageOpts:          model.PageOptions{LastID: 7, PageSize: 3, Reverse: true},
+			expectedOffset:    4,
+			expectedMaxEvents: 3,
+			reversed:          true,
+		},
+		{
+			name:              "unlimited",
+			pageOpts:          model.PageOptions{},
+			expectedMaxEvents: forwardingHistoryMaxEvents,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockLNManager := new(lnmock.LightManager)
+			app, err := New(mockLNManager, new(dbmock.Database))
+			require.NoError(t, err)
+
+			mockLNManager.On("ForwardingHistory", mock.Anything,
+				int64(100), int64(200), c.expectedOffset, c.expectedMaxEvents).
+				Return(events, nil).Once()
+
+			res, err := app.ForwardingHistory(context.Background(), 100, 200, c.pageOpts)
+			require.NoError(t, err)
+			mockLNManager.AssertExpectations(t)
+
+			require.Len(t, res.Events, len(events))
+			first, last := res.Events[0], res.Events[len(events)-1]
+			if c.reversed {
+				first, last = last, first
+			}
+			assert.Equal(t, events[0], first.ForwardingEvent)
+			assert.Equal(t, events[len(events)-1], last.ForwardingEvent)
+
+			assert.Equal(t, []model.ChannelForwardingStats{
+				{ChannelID: 1, ForwardsIn: 1, ForwardsOut: 1,
+					AmtInMsat: 1010, AmtOutMsat: 3000, FeeMsat: 30},
+				{ChannelID: 2, ForwardsIn: 1, ForwardsOut: 2,
+					AmtInMsat: 3030, AmtOutMsat: 3000, FeeMsat: 30},
+				{ChannelID: 3, ForwardsIn: 1,
+					AmtInMsat: 2020},
+			}, res.Channels)
+		})
+	}
+
+	t.Run("reverse without anchor", func(t *testing.T) {
+		app, err := New(new(lnmock.LightManager), new(dbmock.Database))
+		require.NoError(t, err)
+
+		_, err = app.ForwardingHistory(context.Background(), 0, 0,
+			model.PageOptions{PageSize: 3, Reverse: true})
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateChannelPolicy(t *testing.T) {
+	chanPoint := lnchat.ChannelPoint{
+		FundingTxid: "6ef1d1ad3f0ee5fcd2ca0a6fc0fde7c2fa4d9b1a6d5c45ef0a1c6e0c8e7a2a11",
+		OutputIndex: 1,
+	}
+	policy := lnchat.ChannelPolicy{
+		BaseFeeMsat:   1000,
+		FeeRatePPM:    100,
+		TimeLockDelta: 40,
+	}
+
+	mockLNManager := new(lnmock.LightManager)
+	app, err := New(mockLNManager, new(dbmock.Database))
+	require.NoError(t, err)
+
+	mockLNManager.On("UpdateChannelPolicy", mock.Anything,
+		&chanPoint, policy).Return(nil).Once()
+	mockLNManager.On("UpdateChannelPolicy", mock.Anything,
+		(*lnchat.ChannelPoint)(nil), policy).Return(lnchat.ErrNetworkUnavailable).Once()
+
+	err = app.UpdateChannelPolicy(context.Background(),
+		&model.ChannelPoint{ChannelPoint: chanPoint},
+		model.ChannelPolicy{ChannelPolicy: policy})
+	assert.NoError(t, err)
+
+	err = app.UpdateChannelPolicy(context.Background(),
+		nil, model.ChannelPolicy{ChannelPolicy: policy})
+	var appErr Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, NetworkError, appErr.Kind)
+
+	mockLNManager.AssertExpectations(t)
+}
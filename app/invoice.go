@@ -23,6 +23,43 @@ func (app *App) CreateInvoice(ctx context.Context, memo string,
 	}, nil
 }
 
+// CreateHoldInvoice creates a hold invoice for the provided payment hash
+// and returns it.
+// Payments to a hold invoice are accepted, but not settled
+// until SettleInvoice is called with the corresponding preimage.
+// Messages carried by the payments are published
+// once accepted, and stored once the invoice is settled.
+func (app *App) CreateHoldInvoice(ctx context.Context, memo, hash string,
+	amtMsat int64, expiry int64, private bool) (*model.Invoice, error) {
+
+	inv, err := app.LNManager.CreateHoldInvoice(ctx, memo, hash,
+		lnchat.NewAmount(amtMsat), expiry, private)
+	if err != nil {
+		return nil, newErrorf(err, "CreateHoldInvoice")
+	}
+
+	return &model.Invoice{
+		CreatorAddress: app.Self.Node.Address,
+		Invoice:        *inv,
+	}, nil
+}
+
+// SettleInvoice settles an accepted hold invoice
+// with the preimage of its payment hash.
+func (app *App) SettleInvoice(ctx context.Context, preimage string) error {
+	err := app.LNManager.SettleInvoice(ctx, preimage)
+
+	return newErrorf(err, "SettleInvoice")
+}
+
+// CancelInvoice cancels an open or accepted invoice,
+// failing back any accepted payments.
+func (app *App) CancelInvoice(ctx context.Context, hash string) error {
+	err := app.LNManager.CancelInvoice(ctx, hash)
+
+	return newErrorf(err, "CancelInvoice")
+}
+
 // LookupInvoice retrieves an invoice and returns it.
 func (app *App) LookupInvoice(ctx context.Context, payReq string) (*model.Invoice, error) {
 	res, err := app.LNManager.DecodePayReq(ctx, payReq)
@@ -10,20 +10,30 @@ import (
 )
 
 // defaultInvoiceFilter is an invoice update filter,
-// accepting only settled invoice updates.
+// accepting only accepted, settled and cancelled invoice updates.
 func defaultInvoiceFilter(inv *lnchat.Invoice) bool {
-	return inv.State == lnchat.InvoiceSETTLED ||
+	return inv.State == lnchat.InvoiceACCEPTED ||
+		inv.State == lnchat.InvoiceSETTLED ||
 		inv.State == lnchat.InvoiceCANCELLED
 }
 
 // verifySignature verifies the signature over the message and asserts that the
 // recovered public key matches the provided address.
+// The signature is verified locally, falling back to
+// the underlying node if enabled and local verification fails.
 func (app *App) verifySignature(ctx context.Context,
 	msg, sig []byte, senderAddr string) (bool, error) {
 
-	ctxt, cancel := context.WithTimeout(ctx, 2*time.Second)
-	defer cancel()
-	addr, err := app.LNManager.VerifySignatureExtractPubkey(ctxt, msg, sig)
+	addr, err := lnchat.RecoverSignerAddress(msg, sig)
+	switch {
+	case err != nil && app.verifySigFallback:
+		ctxt, cancel := context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+		addr, err = app.LNManager.VerifySignatureExtractPubkey(ctxt, msg, sig)
+	case err != nil:
+		// An invalid signature leaves the payload unverified.
+		addr, err = "", nil
+	}
 
 	// A payload is considered verified if
 	// the sender address and the signing address match.
@@ -44,6 +54,11 @@ func (app *App) subscribeInvoices(ctx context.Context, lastInvoiceIdx uint64) er
 		return app.verifySignature(ctx, msg, sig, sender)
 	}
 
+	// published holds the hashes of accepted invoices
+	// whose message was published, so that it is not
+	// published again once the invoice is settled.
+	published := make(map[string]bool)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -70,55 +85,110 @@ func (app *App) subscribeInvoices(ctx context.Context, lastInvoiceIdx uint64) er
 				app.Log.WithError(err).Error("invoice notification failed")
 			}
 
-			// Store settled invoices, regardless of payload presence.
-			if inv.State != lnchat.InvoiceSETTLED {
-				continue
-			}
-
-			if err = app.Database.AddInvoice(invoice); err != nil {
-				app.Log.WithError(err).Error("invoice storage failed")
-			}
-
-			// Attempt payload extraction if the invoice is settled
-			// and the HTLCs fulfilling it carry payload.
-			records := inv.GetCustomRecords()
-			if len(records) == 0 {
-				continue
+			switch inv.State {
+			case lnchat.InvoiceACCEPTED:
+				// Accepted (hold) invoices are not stored,
+				// but any message they carry is published so that
+				// the recipient can act on it, by settling
+				// or cancelling the invoice.
+				// The message is stored once the invoice is settled,
+				// without being published again (unless the
+				// subscription restarted in the meantime).
+				rawMsg, err := app.extractInvoiceMessage(inv, verifySignature)
+				if err != nil {
+					app.Log.WithError(err).Warn("message extraction failed")
+					continue
+				}
+				if rawMsg == nil {
+					continue
+				}
+
+				if err := app.publishMessage(model.MessageAggregate{
+					RawMessage: rawMsg,
+					Invoice:    invoice,
+				}); err != nil {
+					app.Log.WithError(err).Error("message notification failed")
+					continue
+				}
+				published[inv.Hash] = true
+			case lnchat.InvoiceCANCELLED:
+				delete(published, inv.Hash)
+			case lnchat.InvoiceSETTLED:
+				// Messages published on acceptance are only stored.
+				wasPublished := published[inv.Hash]
+				delete(published, inv.Hash)
+
+				// Store settled invoices, regardless of payload presence.
+				if err = app.Database.AddInvoice(invoice); err != nil {
+					app.Log.WithError(err).Error("invoice storage failed")
+				}
+
+				// Attachment chunks are stored until the attachment
+				// is complete, at which point its message is returned.
+				var rawMsg *model.RawMessage
+				switch {
+				case isAttachmentChunk(inv.GetCustomRecords()):
+					rawMsg, err = app.receiveAttachmentChunk(inv, verifySignature)
+				default:
+					rawMsg, err = app.extractInvoiceMessage(inv, verifySignature)
+				}
+				if err != nil {
+					app.Log.WithError(err).Warn("message extraction failed")
+					continue
+				}
+				if rawMsg == nil {
+					continue
+				}
+				rawMsg.InvoiceSettleIndex = inv.SettleIndex
+
+				// Store and publish the raw message.
+				if err := app.Database.AddRawMessage(rawMsg); err != nil {
+					app.Log.WithError(err).Error("message storage failed")
+					continue
+				}
+				if wasPublished {
+					continue
+				}
+
+				if err := app.publishMessage(model.MessageAggregate{
+					RawMessage: rawMsg,
+					Invoice:    invoice,
+				}); err != nil {
+					app.Log.WithError(err).Error("message notification failed")
+				}
 			}
+		}
+	}
 
-			rawMsg, err := payloadExtractor(records, verifySignature)
-			if err != nil {
-				app.Log.WithError(err).Warn("message extraction failed")
-				continue
-			}
-			rawMsg.InvoiceSettleIndex = inv.SettleIndex
+	return nil
+}
 
-			// Retrieve (or create) the appropriate discussion.
-			var disc *model.Discussion
-			disc, err = app.retrieveOrCreateRawMsgDiscussion(rawMsg)
-			if err != nil {
-				app.Log.WithError(err).Error("discussion retrieval failed")
-				continue
-			}
-			rawMsg.DiscussionID = disc.ID
+// extractInvoiceMessage extracts the message carried by the HTLCs
+// paying an invoice and associates it with the appropriate discussion.
+// If the HTLCs carry no payload, or carry an attachment chunk
+// (which carries no message by itself), a nil message is returned.
+func (app *App) extractInvoiceMessage(inv *lnchat.Invoice,
+	verifySignature func([]byte, []byte, string) (bool, error)) (
+	*model.RawMessage, error) {
+
+	records := inv.GetCustomRecords()
+	if len(records) == 0 || isAttachmentChunk(records) {
+		return nil, nil
+	}
 
-			// Store and publish the raw message.
-			if err := app.Database.AddRawMessage(rawMsg); err != nil {
-				app.Log.WithError(err).Error("message storage failed")
-				continue
-			}
+	rawMsg, err := payloadExtractor(records, app.Self.Node.Address, verifySignature)
+	if err != nil {
+		return nil, err
+	}
 
-			if err := app.publishMessage(model.MessageAggregate{
-				RawMessage: rawMsg,
-				Invoice:    invoice,
-			}); err != nil {
-				app.Log.WithError(err).Error("message notification failed")
-				continue
-			}
-		}
+	// Retrieve (or create) the appropriate discussion.
+	disc, err := app.retrieveOrCreateRawMsgDiscussion(rawMsg)
+	if err != nil {
+		return nil, fmt.Errorf("discussion retrieval failed: %w", err)
 	}
+	rawMsg.DiscussionID = disc.ID
 
-	return nil
+	return rawMsg, nil
 }
 
 func (app *App) retrieveOrCreateRawMsgDiscussion(raw *model.RawMessage) (
@@ -38,7 +38,7 @@ func mustExtractRawMessage(t *testing.T, inv *lnchat.Invoice) *model.RawMessage
 		return true, nil
 	}
 
-	rawMsg, err := payloadExtractor(inv.GetCustomRecords(), noopVerify)
+	rawMsg, err := payloadExtractor(inv.GetCustomRecords(), "", noopVerify)
 	require.NoError(t, err)
 
 	rawMsg.InvoiceSettleIndex = inv.SettleIndex
@@ -131,6 +131,28 @@ func TestSubscribeInvoices(t *testing.T) {
 			},
 			Err: nil,
 		},
+		{
+			Inv: &lnchat.Invoice{
+				Hash:           "0000000000000000000000000000000000000000000000000000000000000003",
+				PaymentRequest: "hold invoice payreq",
+				Value:          lnchat.NewAmount(500),
+				AmtPaid:        lnchat.NewAmount(500),
+				CreatedTimeSec: time.Now().Unix(),
+				State:          lnchat.InvoiceACCEPTED,
+				Htlcs: []lnchat.InvoiceHTLC{
+					{
+						State: lnrpc.InvoiceHTLCState_ACCEPTED,
+						CustomRecords: map[uint64][]byte{
+							PayloadTypeKey: mustJSONMarshalMessage(t,
+								[]string{selfAddr.String()}, "escrow message"),
+							SenderTypeKey:    srcAddr.Bytes(),
+							SignatureTypeKey: []byte("a dummy signature"),
+						},
+					},
+				},
+			},
+			Err: nil,
+		},
 	}
 
 	type invoiceUpdateOp struct {
@@ -195,6 +217,7 @@ func TestSubscribeInvoices(t *testing.T) {
 								CustomRecords[SignatureTypeKey],
 							SignatureVerified:  true,
 							InvoiceSettleIndex: invoiceUpdateList[0].Inv.SettleIndex,
+							Source:             model.SourceC13N,
 						},
 						Invoice: &model.Invoice{
 							CreatorAddress: selfAddr.String(),
@@ -204,6 +227,45 @@ func TestSubscribeInvoices(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                "Accepted hold invoice",
+			subscrInvUpdatesErr: nil,
+			invoiceUpdateOps: []invoiceUpdateOp{
+				{
+					data:                     invoiceUpdateList[3],
+					carriesPayload:           true,
+					payloadSigned:            true,
+					verifySigExtractedPubkey: srcAddr.String(),
+					verifySigErr:             nil,
+					canExtractPayload:        true,
+					discAlreadyExists:        true,
+					discParticipants:         []string{srcAddr.String()},
+					discussion: &model.Discussion{
+						Participants:  []string{srcAddr.String()},
+						LastReadID:    0,
+						LastMessageID: 33,
+						Options:       DefaultOptions,
+					},
+					discID: 13,
+					message: &model.MessageAggregate{
+						RawMessage: &model.RawMessage{
+							DiscussionID: 13,
+							RawPayload: invoiceUpdateList[3].Inv.Htlcs[0].
+								CustomRecords[PayloadTypeKey],
+							Sender: srcAddr.String(),
+							Signature: invoiceUpdateList[3].Inv.Htlcs[0].
+								CustomRecords[SignatureTypeKey],
+							SignatureVerified: true,
+							Source:            model.SourceC13N,
+						},
+						Invoice: &model.Invoice{
+							CreatorAddress: selfAddr.String(),
+							Invoice:        *invoiceUpdateList[3].Inv,
+						},
+					},
+				},
+			},
+		},
 		{
 			name:                "Subscription terminated",
 			subscrInvUpdatesErr: nil,
@@ -312,9 +374,13 @@ func TestSubscribeInvoices(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				// Mock invoice update subscription channel
 				invoiceUpdateCh := func() <-chan lnchat.InvoiceUpdate {
@@ -395,7 +461,8 @@ func TestSubscribeInvoices(t *testing.T) {
 			}
 
 			app, appTestStartFunc, appTestStopFunc :=
-				createInitializedApp(t, mockInstaller)
+				createInitializedApp(t, mockInstaller,
+					WithSignatureVerificationFallback(true))
 
 			appTestStartFunc()
 			defer appTestStopFunc()
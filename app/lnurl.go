@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c13n-io/c13n-go/lnurl"
+)
+
+// WithLNURLClient sets the client used for resolving
+// LNURLs and Lightning Addresses.
+func WithLNURLClient(client *lnurl.Client) func(*App) error {
+	return func(app *App) error {
+		if client == nil {
+			return fmt.Errorf("LNURL client must not be nil")
+		}
+
+		app.lnurl = client
+		return nil
+	}
+}
+
+// lnurlPayReq resolves an LNURL or a Lightning Address and requests
+// an invoice for the provided amount, carrying the comment.
+// The invoice is verified against the service metadata and the amount.
+func (app *App) lnurlPayReq(ctx context.Context,
+	target string, amtMsat int64, comment string) (string, error) {
+
+	params, err := app.lnurl.Resolve(ctx, target)
+	if err != nil {
+		return "", err
+	}
+
+	payReq, err := app.lnurl.FetchInvoice(ctx, params, amtMsat, comment)
+	if err != nil {
+		return "", err
+	}
+
+	decoded, err := app.LNManager.DecodePayReq(ctx, payReq)
+	if err != nil {
+		return "", err
+	}
+	if err := params.VerifyInvoice(decoded.DescriptionHash,
+		decoded.Amt.Msat(), amtMsat); err != nil {
+
+		return "", err
+	}
+
+	return payReq, nil
+}
@@ -0,0 +1,238 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/lnurl"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+const testLNURLMetadata = `[["text/plain","Pay to alice"]]`
+
+// newTestLNURLService starts an LNURL-pay service for user alice,
+// returning the Lightning Address of alice and a client for the service.
+// Comments of invoice requests are sent over the returned channel.
+func newTestLNURLService(t *testing.T) (string, *lnurl.Client, <-chan string) {
+	comments := make(chan string, 1)
+
+	mux := http.NewServeMux()
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+
+	mux.HandleFunc("/.well-known/lnurlp/alice", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"tag":            "payRequest",
+			"callback":       srv.URL + "/callback",
+			"minSendable":    1000,
+			"maxSendable":    100000,
+			"metadata":       testLNURLMetadata,
+			"commentAllowed": 5,
+		})
+	})
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		comments <- r.URL.Query().Get("comment")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"pr": "lnbcrt" + r.URL.Query().Get("amount"),
+		})
+	})
+
+	address := "alice@" + strings.TrimPrefix(srv.URL, "https://")
+
+	return address, &lnurl.Client{HTTPClient: srv.Client()}, comments
+}
+
+func TestSendPaymentLNURL(t *testing.T) {
+	payee := "111111111111111111111111111111111111111111111111111111111111111111"
+	node, err := lnchat.NewNodeFromString(payee)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256([]byte(testLNURLMetadata))
+	descHash := hex.EncodeToString(hash[:])
+
+	cases := []struct {
+		name        string
+		descHash    string
+		expectedErr error
+	}{
+		{
+			name:     "valid invoice",
+			descHash: descHash,
+		},
+		{
+			name:        "description hash mismatch",
+			descHash:    strings.Repeat("0", 64),
+			expectedErr: lnurl.ErrInvoiceMismatch,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			address, client, comments := newTestLNURLService(t)
+
+			mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+			app, err := New(mockLNManager, mockDB, WithLNURLClient(client))
+			require.NoError(t, err)
+
+			opts := lnchat.PaymentOptions{FeeLimitMsat: 1000}
+			mockLNManager.On("DecodePayReq", mock.Anything, "lnbcrt5000").
+				Return(&lnchat.PayReq{
+					Destination:     node,
+					Amt:             lnchat.NewAmount(5000),
+					DescriptionHash: c.descHash,
+				}, nil)
+
+			if c.expectedErr == nil {
+				mockLNManager.On("SendPayment", mock.Anything, "", lnchat.NewAmount(0),
+					"lnbcrt5000", opts, map[uint64][]byte(nil), mock.Anything).
+					Return(paymentUpdates(&lnchat.Payment{
+						Status: lnchat.PaymentSUCCEEDED,
+					}), nil).Once()
+				mockDB.On("AddPayments", mock.AnythingOfType("*model.Payment")).
+					Return(nil).Once()
+			}
+
+			payment, err := app.SendPayment(context.Background(),
+				strings.ToUpper(address), 5000, "", opts, nil)
+			assert.Empty(t, <-comments)
+
+			switch c.expectedErr {
+			case nil:
+				require.NoError(t, err)
+				assert.Equal(t, payee, payment.PayeeAddress)
+			default:
+				assert.ErrorIs(t, err, c.expectedErr)
+				assert.Nil(t, payment)
+			}
+
+			mockLNManager.AssertExpectations(t)
+			mockDB.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSendMessageLNURLComment(t *testing.T) {
+	address, client, comments := newTestLNURLService(t)
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	app, err := New(mockLNManager, mockDB, WithLNURLClient(client))
+	require.NoError(t, err)
+
+	// Payloads longer than the accepted comment length are rejected.
+	_, err = app.SendMessage(context.Background(), 0, 5000, address,
+		"hello alice", model.MessageOptions{})
+	require.ErrorIs(t, err, lnurl.ErrCommentTooLong)
+
+	var appErr Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, CommentTooLong, appErr.Kind)
+	assert.Empty(t, comments)
+
+	mockLNManager.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+}
+
+func TestSendMessageLNURLDiscussion(t *testing.T) {
+	address, client, comments := newTestLNURLService(t)
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	app, err := New(mockLNManager, mockDB, WithLNURLClient(client))
+	require.NoError(t, err)
+
+	mockDB.On("GetDiscussion", uint64(1)).Return(&model.Discussion{
+		ID: 1,
+		Participants: []string{
+			"111111111111111111111111111111111111111111111111111111111111111111",
+		},
+		LNURL: address,
+	}, nil).Once()
+	mockLNManager.On("DecodePayReq", mock.Anything, "lnbcrt5000").
+		Return(nil, assert.AnError).Once()
+
+	// Messages to the discussion are paid through its Lightning Address.
+	_, err = app.SendMessage(context.Background(), 1, 5000, "",
+		"hello", model.MessageOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, "hello", <-comments)
+
+	mockLNManager.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+}
+
+func TestSendMessageToContact(t *testing.T) {
+	payee := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	address, client, comments := newTestLNURLService(t)
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	app, err := New(mockLNManager, mockDB, WithLNURLClient(client))
+	require.NoError(t, err)
+
+	// A contact with a Lightning Address is paid through it.
+	mockDB.On("GetContactByID", uint64(1)).Return(&model.Contact{
+		ID:               1,
+		LightningAddress: address,
+		Node:             model.Node{Address: payee},
+	}, nil).Once()
+	mockLNManager.On("DecodePayReq", mock.Anything, "lnbcrt5000").
+		Return(nil, assert.AnError).Once()
+
+	_, err = app.SendMessageToContact(context.Background(), 1, 5000,
+		"hello", model.MessageOptions{})
+	assert.Error(t, err)
+	assert.Equal(t, "hello", <-comments)
+
+	// A contact without one is sent to over its node discussion.
+	mockDB.On("GetContactByID", uint64(2)).Return(&model.Contact{
+		ID:   2,
+		Node: model.Node{Address: payee},
+	}, nil).Once()
+	mockDB.On("GetDiscussionByParticipants", []string{payee}).
+		Return(nil, assert.AnError).Once()
+
+	_, err = app.SendMessageToContact(context.Background(), 2, 5000,
+		"hello", model.MessageOptions{})
+	assert.Error(t, err)
+
+	mockLNManager.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+}
+
+func TestAddContactLightningAddress(t *testing.T) {
+	mockDB := new(dbmock.Database)
+	app, err := New(new(lnmock.LightManager), mockDB)
+	require.NoError(t, err)
+
+	contact := &model.Contact{
+		DisplayName:      "alice",
+		LightningAddress: "Alice@Example.com",
+	}
+	mockDB.On("AddContact", mock.MatchedBy(func(c *model.Contact) bool {
+		return c.LightningAddress == "alice@example.com"
+	})).Return(contact, nil).Once()
+
+	_, err = app.AddContact(context.Background(), contact)
+	require.NoError(t, err)
+
+	_, err = app.AddContact(context.Background(), &model.Contact{
+		LightningAddress: "alice",
+	})
+	var appErr Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, InvalidAddress, appErr.Kind)
+
+	mockDB.AssertExpectations(t)
+}
@@ -2,15 +2,38 @@ package app
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
 )
 
+// newTestSigner returns a new private key and the corresponding node address.
+func newTestSigner(t *testing.T) (*btcec.PrivateKey, string) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+
+	return key, hex.EncodeToString(key.PubKey().SerializeCompressed())
+}
+
+// signTestMessage signs a message the way lnd SignMessage does.
+func signTestMessage(t *testing.T, key *btcec.PrivateKey, msg []byte) []byte {
+	digest := chainhash.DoubleHashB(
+		append([]byte("Lightning Signed Message:"), msg...))
+
+	sig, err := btcec.SignCompact(btcec.S256(), key, digest, true)
+	require.NoError(t, err)
+
+	return sig
+}
+
 func TestVerifySignature(t *testing.T) {
 	address := "000000000000000000000000000000000000000000000000000000000000000000"
 	payload := []byte("payload")
@@ -104,7 +127,8 @@ func TestVerifySignature(t *testing.T) {
 					c.mockCallResp, c.mockCallErr).Once()
 
 				return &App{
-					LNManager: lm,
+					LNManager:         lm,
+					verifySigFallback: true,
 				}
 			}()
 
@@ -122,3 +146,81 @@ func TestVerifySignature(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifySignatureLocal(t *testing.T) {
+	key, address := newTestSigner(t)
+	_, otherAddress := newTestSigner(t)
+
+	payload := []byte("payload")
+	signature := signTestMessage(t, key, payload)
+
+	cases := []struct {
+		name             string
+		sender           string
+		msg              []byte
+		sig              []byte
+		fallback         bool
+		mockCallResp     string
+		expectedVerified bool
+	}{
+		{
+			name:             "signing address matches sender",
+			sender:           address,
+			msg:              payload,
+			sig:              signature,
+			expectedVerified: true,
+		},
+		{
+			name:             "sender address does not match signing address",
+			sender:           otherAddress,
+			msg:              payload,
+			sig:              signature,
+			expectedVerified: false,
+		},
+		{
+			name:             "tampered message",
+			sender:           address,
+			msg:              []byte("tampered"),
+			sig:              signature,
+			expectedVerified: false,
+		},
+		{
+			name:             "invalid signature",
+			sender:           address,
+			msg:              payload,
+			sig:              []byte("dummy signature"),
+			expectedVerified: false,
+		},
+		{
+			name:             "invalid signature, verified by fallback",
+			sender:           address,
+			msg:              payload,
+			sig:              []byte("dummy signature"),
+			fallback:         true,
+			mockCallResp:     address,
+			expectedVerified: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			lm := new(lnmock.LightManager)
+			if c.fallback {
+				lm.On("VerifySignatureExtractPubkey", mock.Anything,
+					c.msg, c.sig).Return(c.mockCallResp, nil).Once()
+			}
+
+			app := &App{
+				LNManager:         lm,
+				verifySigFallback: c.fallback,
+			}
+
+			ver, err := app.verifySignature(
+				context.Background(), c.msg, c.sig, c.sender)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expectedVerified, ver)
+
+			lm.AssertExpectations(t)
+		})
+	}
+}
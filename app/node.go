@@ -68,6 +68,16 @@ func (app *App) GetNodesByAddress(ctx context.Context, address string) ([]model.
 	return result, nil
 }
 
+// GetNodeInfo returns the details of a node, as advertised to the network.
+func (app *App) GetNodeInfo(ctx context.Context, address string) (*model.NodeInfo, error) {
+	info, err := app.LNManager.GetNodeInfo(ctx, address)
+	if err != nil {
+		return nil, newErrorf(err, "GetNodeInfo")
+	}
+
+	return &model.NodeInfo{NodeInfo: *info}, nil
+}
+
 // ConnectNode connects a node as a peer.
 func (app *App) ConnectNode(ctx context.Context, address string, hostport string) error {
 	err := app.LNManager.ConnectNode(ctx, address, hostport)
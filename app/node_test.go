@@ -65,11 +65,15 @@ func TestGetNodesSuccess(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -146,11 +150,15 @@ func TestGetNodesByAliasSuccess(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -216,11 +224,15 @@ func TestGetNodesByAliasNoMatch(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -286,11 +298,15 @@ func TestGetNodesByAddressSuccess(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -363,11 +379,15 @@ func TestGetNodesByAddressNoMatch(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -394,3 +414,104 @@ func TestGetNodesByAddressNoMatch(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, nodeResp)
 }
+
+func TestGetNodeInfo(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	address := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	info := &lnchat.NodeInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "alice_alias",
+			Address: address,
+		},
+		Addresses: []lnchat.NodeAddress{
+			{Network: "tcp", Addr: "127.0.0.1:9735"},
+		},
+		Features: []lnchat.Feature{
+			{Bit: 9, Name: "tlv-onion", IsKnown: true},
+		},
+		Color:             "#3399ff",
+		NumChannels:       2,
+		TotalCapacityMsat: 300000000,
+		HasChannel:        true,
+	}
+
+	cases := []struct {
+		name          string
+		info          *lnchat.NodeInfo
+		infoErr       error
+		expected      *model.NodeInfo
+		expectedError error
+	}{
+		{
+			name:     "Success",
+			info:     info,
+			expected: &model.NodeInfo{NodeInfo: *info},
+		},
+		{
+			name:    "Not found",
+			infoErr: lnchat.ErrNodeNotFound,
+			expectedError: Error{
+				Kind:    NodeNotFound,
+				details: "GetNodeInfo",
+				Err:     lnchat.ErrNodeNotFound,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
+					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				mockLNManager.On("GetNodeInfo", mock.Anything,
+					address).Return(c.info, c.infoErr).Once()
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+			defer cancel()
+
+			res, err := app.GetNodeInfo(ctxt, address)
+			if c.expectedError != nil {
+				assert.EqualError(t, err, c.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.EqualValues(t, c.expected, res)
+		})
+	}
+}
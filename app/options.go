@@ -19,13 +19,47 @@ var (
 // with the first argument and returns the result.
 // If relaxation of the fee limit is not allowed,
 // the fee limit is capped by the initial value of opts.
-// A fee limit of 0 is ignored and does not override a previous value.
+// A fee limit of 0 is ignored and does not override a previous value,
+// and the same holds for the automatic message transport,
+// the maximum fee limit of retries, the partial payment limits
+// and unset route restrictions.
 func overrideOptions(opts model.MessageOptions, allowRelax bool,
 	overrides ...model.MessageOptions) model.MessageOptions {
 
 	res := opts
 	for _, o := range overrides {
 		res.Anonymous = o.Anonymous
+		res.AMP = o.AMP
+		if o.Transport != model.TransportAUTO {
+			res.Transport = o.Transport
+		}
+		if o.MaxFeeLimitMsat != 0 {
+			res.MaxFeeLimitMsat = o.MaxFeeLimitMsat
+		}
+		if o.MaxShards != 0 {
+			res.MaxShards = o.MaxShards
+		}
+		if o.MaxShardSizeMsat != 0 {
+			res.MaxShardSizeMsat = o.MaxShardSizeMsat
+		}
+		if len(o.OutgoingChanIDs) != 0 {
+			res.OutgoingChanIDs = o.OutgoingChanIDs
+		}
+		if o.LastHopAddress != "" {
+			res.LastHopAddress = o.LastHopAddress
+		}
+		if len(o.IgnoredNodes) != 0 {
+			res.IgnoredNodes = o.IgnoredNodes
+		}
+		if len(o.IgnoredChannels) != 0 {
+			res.IgnoredChannels = o.IgnoredChannels
+		}
+		if o.CltvLimit != 0 {
+			res.CltvLimit = o.CltvLimit
+		}
+		if o.MinSuccessProb != 0 {
+			res.MinSuccessProb = o.MinSuccessProb
+		}
 
 		relaxFee := o.FeeLimitMsat > opts.FeeLimitMsat
 		switch {
@@ -146,6 +146,105 @@ func TestOverrideOptions(t *testing.T) {
 				Anonymous:    false,
 			},
 		},
+		{
+			opts: model.MessageOptions{
+				FeeLimitMsat: 3000,
+				Transport:    model.TransportPEER,
+			},
+			overrideOpts: []model.MessageOptions{
+				model.MessageOptions{
+					Transport: model.TransportAUTO,
+				},
+			},
+			allowRelax: false,
+			expected: model.MessageOptions{
+				FeeLimitMsat: 3000,
+				Transport:    model.TransportPEER,
+			},
+		},
+		{
+			opts: model.MessageOptions{
+				FeeLimitMsat: 3000,
+				Transport:    model.TransportPEER,
+			},
+			overrideOpts: []model.MessageOptions{
+				model.MessageOptions{
+					Transport: model.TransportPAYMENT,
+				},
+			},
+			allowRelax: false,
+			expected: model.MessageOptions{
+				FeeLimitMsat: 3000,
+				Transport:    model.TransportPAYMENT,
+			},
+		},
+		{
+			opts: model.MessageOptions{
+				FeeLimitMsat:     3000,
+				MaxShards:        4,
+				MaxShardSizeMsat: 100000,
+			},
+			overrideOpts: []model.MessageOptions{
+				model.MessageOptions{
+					MaxShards: 8,
+					AMP:       true,
+				},
+			},
+			allowRelax: false,
+			expected: model.MessageOptions{
+				FeeLimitMsat:     3000,
+				MaxShards:        8,
+				MaxShardSizeMsat: 100000,
+				AMP:              true,
+			},
+		},
+		{
+			opts: model.MessageOptions{
+				FeeLimitMsat:    3000,
+				OutgoingChanIDs: []uint64{1},
+				IgnoredNodes:    []string{"node"},
+				CltvLimit:       200,
+			},
+			overrideOpts: []model.MessageOptions{
+				model.MessageOptions{
+					IgnoredChannels: []uint64{2},
+					LastHopAddress:  "hop",
+					MinSuccessProb:  0.5,
+				},
+				model.MessageOptions{
+					OutgoingChanIDs: []uint64{3, 4},
+				},
+			},
+			allowRelax: false,
+			expected: model.MessageOptions{
+				FeeLimitMsat:    3000,
+				OutgoingChanIDs: []uint64{3, 4},
+				LastHopAddress:  "hop",
+				IgnoredNodes:    []string{"node"},
+				IgnoredChannels: []uint64{2},
+				CltvLimit:       200,
+				MinSuccessProb:  0.5,
+			},
+		},
+		{
+			opts: model.MessageOptions{
+				FeeLimitMsat:    3000,
+				MaxFeeLimitMsat: 10000,
+			},
+			overrideOpts: []model.MessageOptions{
+				model.MessageOptions{
+					FeeLimitMsat: 2000,
+				},
+				model.MessageOptions{
+					MaxFeeLimitMsat: 6000,
+				},
+			},
+			allowRelax: false,
+			expected: model.MessageOptions{
+				FeeLimitMsat:    2000,
+				MaxFeeLimitMsat: 6000,
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/c13n-io/c13n-go/lnchat"
@@ -19,28 +20,85 @@ const (
 	SenderTypeKey
 	// SignatureTypeKey is the key of the sender signature.
 	SignatureTypeKey
+	// CompactPayloadTypeKey is the key of the payload in the compact format.
+	CompactPayloadTypeKey
+	// AttachmentChunkTypeKey is the key of an attachment chunk.
+	AttachmentChunkTypeKey
+	// AttachmentInfoTypeKey is the key of the attachment info
+	// (name, MIME type and size), carried by the first chunk.
+	AttachmentInfoTypeKey
 )
 
-// payloadExtractor extracts a RawMessage from a set of custom records.
-func payloadExtractor(customRecords []map[uint64][]byte,
+// c13nPayloadKeys are the TLV types of c13n payloads.
+var c13nPayloadKeys = []uint64{PayloadTypeKey, SenderTypeKey,
+	SignatureTypeKey, CompactPayloadTypeKey}
+
+// payloadExtractor extracts a RawMessage from a set of custom records,
+// using the first registered payload codec the records follow.
+// Records following no registered codec are decoded as c13n payloads.
+// Signatures are verified by the codec verifier, if any,
+// or else by the provided function.
+// A payment split into multiple HTLCs carries the same payload
+// in every HTLC, so the record sets are required to agree
+// on the payload records.
+// The recipient is the address of the node the records were addressed to.
+func payloadExtractor(customRecords []map[uint64][]byte, recipient string,
 	verifySig func([]byte, []byte, string) (bool, error)) (*model.RawMessage, error) {
 
-	if len(customRecords) != 1 {
-		return nil, fmt.Errorf("payload extraction failed "+
-			"for records with length %d", len(customRecords))
+	if len(customRecords) == 0 {
+		return nil, fmt.Errorf("payload extraction failed " +
+			"for empty record set")
 	}
 	records := customRecords[0]
+	codec := payloadCodecFor(records)
+	for _, r := range customRecords[1:] {
+		if !samePayloadRecords(codec.Keys, records, r) {
+			return nil, fmt.Errorf("payload extraction failed " +
+				"for HTLCs carrying different payloads")
+		}
+	}
+
+	rawMsg, signed, err := codec.Decode(records, recipient)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %s payload: %w", codec.Source, err)
+	}
+	rawMsg.Source = codec.Source
+
+	if codec.Verify != nil {
+		verifySig = codec.Verify
+	}
+	switch verified, err := verifySig(signed,
+		rawMsg.Signature, rawMsg.Sender); err {
+	case nil:
+		rawMsg.SignatureVerified = verified
+	default:
+		return nil, fmt.Errorf("cannot verify message signature: %w", err)
+	}
+
+	return rawMsg, nil
+}
+
+// decodeC13NPayload decodes a c13n payload from a set of custom records.
+// The signature of c13n payloads is over the raw payload.
+func decodeC13NPayload(records map[uint64][]byte, _ string) (
+	*model.RawMessage, []byte, error) {
 
 	rawMsg := new(model.RawMessage)
 
 	if payload, ok := records[PayloadTypeKey]; ok {
 		rawMsg.RawPayload = payload
 	}
+	if payload, ok := records[CompactPayloadTypeKey]; ok {
+		if !model.IsCompactPayload(payload) {
+			return nil, nil, fmt.Errorf("invalid compact payload")
+		}
+		rawMsg.RawPayload = payload
+	}
 
 	if sender, ok := records[SenderTypeKey]; ok {
 		senderAddr, err := lnchat.NewNodeFromBytes(sender)
 		if err != nil {
-			return nil, fmt.Errorf("could not decode sender address: %w", err)
+			return nil, nil, fmt.Errorf("could not decode sender address: %w", err)
 		}
 		rawMsg.Sender = senderAddr.String()
 	}
@@ -49,15 +107,21 @@ func payloadExtractor(customRecords []map[uint64][]byte,
 		rawMsg.Signature = signature
 	}
 
-	switch verified, err := verifySig(rawMsg.RawPayload,
-		rawMsg.Signature, rawMsg.Sender); err {
-	case nil:
-		rawMsg.SignatureVerified = verified
-	default:
-		return nil, fmt.Errorf("cannot verify message signature: %w", err)
+	return rawMsg, rawMsg.RawPayload, nil
+}
+
+// samePayloadRecords returns whether two record sets
+// carry the same records of the provided types.
+func samePayloadRecords(keys []uint64, a, b map[uint64][]byte) bool {
+	for _, key := range keys {
+		va, oka := a[key]
+		vb, okb := b[key]
+		if oka != okb || !bytes.Equal(va, vb) {
+			return false
+		}
 	}
 
-	return rawMsg, nil
+	return true
 }
 
 // marshalPayload marshals the wire payload of a raw message.
@@ -67,7 +131,10 @@ func marshalPayload(rawMsg *model.RawMessage) map[uint64][]byte {
 	}
 
 	payload := make(map[uint64][]byte)
-	if rawMsg.RawPayload != nil {
+	switch {
+	case model.IsCompactPayload(rawMsg.RawPayload):
+		payload[CompactPayloadTypeKey] = rawMsg.RawPayload
+	case rawMsg.RawPayload != nil:
 		payload[PayloadTypeKey] = rawMsg.RawPayload
 	}
 
@@ -0,0 +1,93 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// ErrPayloadTooLarge indicates that a message payload
+// does not fit in the onion of a payment, even over a single hop.
+var ErrPayloadTooLarge = fmt.Errorf("message payload too large")
+
+// Sizes of the onion routing packet (BOLT 4), used for
+// estimating the space available to message payloads.
+const (
+	// onionPayloadsSize is the size of the hop payloads of an onion packet.
+	onionPayloadsSize = 1300
+	// onionHMACSize is the size of the HMAC following each hop payload.
+	onionHMACSize = 32
+	// maxHopPayloadSize is the maximum size of the TLV payload of
+	// intermediate hops (amount, outgoing CLTV value and channel id).
+	maxHopPayloadSize = 10 + 6 + 10
+	// maxFinalHopPayloadSize is the maximum size of the TLV payload
+	// of the final hop, excluding custom records
+	// (amount, outgoing CLTV value and keysend preimage).
+	maxFinalHopPayloadSize = 10 + 6 + 42
+)
+
+// bigSizeLen returns the length of the BigSize encoding of a value.
+func bigSizeLen(v uint64) int {
+	switch {
+	case v < 0xfd:
+		return 1
+	case v <= 0xffff:
+		return 3
+	case v <= 0xffffffff:
+		return 5
+	default:
+		return 9
+	}
+}
+
+// tlvSize returns the size of the TLV encoding of a set of records.
+func tlvSize(records map[uint64][]byte) int {
+	size := 0
+	for typ, value := range records {
+		size += bigSizeLen(typ) + bigSizeLen(uint64(len(value))) + len(value)
+	}
+
+	return size
+}
+
+// payloadBudget returns an estimate of the onion space (in bytes)
+// remaining after carrying the provided records over a route
+// with the provided number of hops.
+// A negative value signifies that the records do not fit.
+func payloadBudget(hops int, records map[uint64][]byte) int {
+	if hops < 1 {
+		hops = 1
+	}
+
+	hopSize := bigSizeLen(maxHopPayloadSize) + maxHopPayloadSize + onionHMACSize
+	finalPayloadSize := maxFinalHopPayloadSize + tlvSize(records)
+	finalHopSize := bigSizeLen(uint64(finalPayloadSize)) + finalPayloadSize + onionHMACSize
+
+	return onionPayloadsSize - (hops-1)*hopSize - finalHopSize
+}
+
+// checkPayloadSize returns ErrPayloadTooLarge if the provided records
+// do not fit in the onion of a payment to a direct peer.
+func checkPayloadSize(records map[uint64][]byte) error {
+	if budget := payloadBudget(1, records); budget < 0 {
+		return fmt.Errorf("%w: exceeds the onion capacity by %d bytes",
+			ErrPayloadTooLarge, -budget)
+	}
+
+	return nil
+}
+
+// routesPayloadBudget returns the smallest payload budget
+// across the provided routes.
+func routesPayloadBudget(routes map[string]lnchat.Route,
+	records map[uint64][]byte) int {
+
+	budget := payloadBudget(1, records)
+	for _, route := range routes {
+		if b := payloadBudget(len(route.Hops), records); b < budget {
+			budget = b
+		}
+	}
+
+	return budget
+}
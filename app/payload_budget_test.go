@@ -0,0 +1,60 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestPayloadBudget(t *testing.T) {
+	records := map[uint64][]byte{
+		PayloadTypeKey: bytes.Repeat([]byte{'a'}, 100),
+	}
+	// 5 byte type, 1 byte length and the value.
+	require.Equal(t, 106, tlvSize(records))
+
+	// 1300 - (1 + 58 + 106 + 32) for a single-hop route.
+	assert.Equal(t, 1103, payloadBudget(1, records))
+	// Each intermediate hop takes 1 + 26 + 32 bytes.
+	assert.Equal(t, 1103-2*59, payloadBudget(3, records))
+
+	assert.NoError(t, checkPayloadSize(records))
+
+	records[PayloadTypeKey] = bytes.Repeat([]byte{'a'}, 1200)
+	assert.Less(t, payloadBudget(1, records), 0)
+	assert.ErrorIs(t, checkPayloadSize(records), ErrPayloadTooLarge)
+}
+
+func TestMarshalCompactPayload(t *testing.T) {
+	participants := []string{
+		"020000000000000000000000000000000000000000000000000000000000000001",
+		"030000000000000000000000000000000000000000000000000000000000000002",
+	}
+	disc := &model.Discussion{Participants: participants}
+
+	legacy, err := model.NewRawMessage(disc, "hello", model.PayloadFormatJSON)
+	require.NoError(t, err)
+	compact, err := model.NewRawMessage(disc, "hello", model.PayloadFormatCompact)
+	require.NoError(t, err)
+
+	legacyRecords, compactRecords := marshalPayload(legacy), marshalPayload(compact)
+	assert.Contains(t, legacyRecords, uint64(PayloadTypeKey))
+	assert.NotContains(t, compactRecords, uint64(PayloadTypeKey))
+	assert.Contains(t, compactRecords, uint64(CompactPayloadTypeKey))
+	assert.Greater(t, payloadBudget(1, compactRecords), payloadBudget(1, legacyRecords))
+
+	extracted, err := payloadExtractor([]map[uint64][]byte{compactRecords}, "",
+		func(_, _ []byte, _ string) (bool, error) {
+			return false, nil
+		})
+	require.NoError(t, err)
+
+	message, decoded, err := extracted.UnmarshalPayload()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", message)
+	assert.Equal(t, participants, decoded)
+}
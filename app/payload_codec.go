@@ -0,0 +1,197 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// Definition of the TLV types of other messaging conventions.
+const (
+	// KeysendMessageTypeKey is the key of keysend chat messages.
+	KeysendMessageTypeKey = 34349334
+	// KeysendSignatureTypeKey is the key of the keysend message signature.
+	KeysendSignatureTypeKey = 34349337
+	// KeysendSenderTypeKey is the key of the keysend message sender address.
+	KeysendSenderTypeKey = 34349339
+	// KeysendTimestampTypeKey is the key of the keysend message timestamp.
+	KeysendTimestampTypeKey = 34349343
+	// BoostagramTypeKey is the key of podcasting boostagrams.
+	BoostagramTypeKey = 7629169
+)
+
+// PayloadCodec decodes message payloads following a messaging convention
+// from the custom records of HTLCs or peer messages.
+type PayloadCodec struct {
+	// Source is the tag of the messages decoded by the codec.
+	Source string
+	// Keys are the record types of the convention.
+	// Record sets carrying any of them are decoded by the codec.
+	Keys []uint64
+	// Decode decodes a raw message from a record set addressed to recipient,
+	// along with the data the message signature (if any) is over.
+	Decode func(records map[uint64][]byte, recipient string) (
+		*model.RawMessage, []byte, error)
+	// Verify verifies the message signature over the signed data
+	// against the sender address.
+	// If nil, signatures are verified as lnd signed messages.
+	Verify func(signed, sig []byte, sender string) (bool, error)
+}
+
+var (
+	payloadCodecsMtx sync.RWMutex
+	// payloadCodecs are the registered payload codecs, in order of precedence.
+	// The c13n codec is first and is used for unmatched record sets.
+	payloadCodecs = []PayloadCodec{
+		{
+			Source: model.SourceC13N,
+			Keys:   c13nPayloadKeys,
+			Decode: decodeC13NPayload,
+		},
+		{
+			Source: model.SourceKeysend,
+			Keys: []uint64{KeysendMessageTypeKey, KeysendSignatureTypeKey,
+				KeysendSenderTypeKey, KeysendTimestampTypeKey},
+			Decode: decodeKeysendPayload,
+			Verify: verifyKeysendSignature,
+		},
+		{
+			Source: model.SourceBoostagram,
+			Keys:   []uint64{BoostagramTypeKey},
+			Decode: decodeBoostagramPayload,
+		},
+	}
+)
+
+// RegisterPayloadCodec registers a codec for decoding incoming messages.
+// Codecs are tried in registration order, after the built-in
+// c13n, keysend and boostagram codecs.
+func RegisterPayloadCodec(codec PayloadCodec) error {
+	if codec.Source == "" || len(codec.Keys) == 0 || codec.Decode == nil {
+		return fmt.Errorf("payload codec requires a source, keys and a decoder")
+	}
+
+	payloadCodecsMtx.Lock()
+	defer payloadCodecsMtx.Unlock()
+
+	for _, c := range payloadCodecs {
+		if c.Source == codec.Source {
+			return fmt.Errorf("payload codec for source %q "+
+				"is already registered", codec.Source)
+		}
+	}
+	payloadCodecs = append(payloadCodecs, codec)
+
+	return nil
+}
+
+// payloadCodecFor returns the first registered codec
+// the record set follows, or the c13n codec if there is none.
+func payloadCodecFor(records map[uint64][]byte) PayloadCodec {
+	codec, _ := matchPayloadCodec(records)
+
+	return codec
+}
+
+// matchPayloadCodec returns the first registered codec
+// the record set follows, or the c13n codec and false if there is none.
+func matchPayloadCodec(records map[uint64][]byte) (PayloadCodec, bool) {
+	payloadCodecsMtx.RLock()
+	defer payloadCodecsMtx.RUnlock()
+
+	for _, codec := range payloadCodecs {
+		for _, key := range codec.Keys {
+			if _, ok := records[key]; ok {
+				return codec, true
+			}
+		}
+	}
+
+	return payloadCodecs[0], false
+}
+
+// decodeKeysendPayload decodes a keysend chat message from a set of records.
+// The signature of keysend messages is over the concatenation of
+// the sender and recipient addresses, the timestamp and the message.
+// If any of them is missing, the signature is over no data.
+func decodeKeysendPayload(records map[uint64][]byte, recipient string) (
+	*model.RawMessage, []byte, error) {
+
+	text := records[KeysendMessageTypeKey]
+	if !utf8.Valid(text) {
+		return nil, nil, fmt.Errorf("message is not valid UTF-8")
+	}
+
+	rawMsg, err := model.NewTextRawMessage(model.SourceKeysend, string(text))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sender, ok := records[KeysendSenderTypeKey]
+	if !ok {
+		return rawMsg, nil, nil
+	}
+	senderAddr, err := lnchat.NewNodeFromBytes(sender)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not decode sender address: %w", err)
+	}
+	rawMsg.Sender = senderAddr.String()
+	rawMsg.Signature = records[KeysendSignatureTypeKey]
+
+	timestamp, ok := records[KeysendTimestampTypeKey]
+	if !ok || rawMsg.Signature == nil {
+		return rawMsg, nil, nil
+	}
+	recipientAddr, err := lnchat.NewNodeFromString(recipient)
+	if err != nil {
+		return rawMsg, nil, nil
+	}
+
+	var signed []byte
+	signed = append(signed, sender...)
+	signed = append(signed, recipientAddr.Bytes()...)
+	signed = append(signed, timestamp...)
+	signed = append(signed, text...)
+
+	return rawMsg, signed, nil
+}
+
+// verifyKeysendSignature verifies a keysend message signature, which is
+// an lnd signer signature over the SHA256 digest of the signed data,
+// against the sender address.
+func verifyKeysendSignature(signed, sig []byte, sender string) (bool, error) {
+	if sender == "" || len(signed) == 0 {
+		return false, nil
+	}
+
+	// An invalid signature leaves the message unverified.
+	verified, err := lnchat.VerifyWireSignature(signed, sig, sender)
+	return err == nil && verified, nil
+}
+
+// boostagram represents the podcasting boostagram record.
+type boostagram struct {
+	Message string `json:"message"`
+}
+
+// decodeBoostagramPayload decodes a podcasting boostagram from a set of records.
+// Boostagrams identify their sender only by name, so they carry no sender.
+func decodeBoostagramPayload(records map[uint64][]byte, _ string) (
+	*model.RawMessage, []byte, error) {
+
+	var boost boostagram
+	if err := json.Unmarshal(records[BoostagramTypeKey], &boost); err != nil {
+		return nil, nil, fmt.Errorf("invalid boostagram: %w", err)
+	}
+
+	rawMsg, err := model.NewTextRawMessage(model.SourceBoostagram, boost.Message)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rawMsg, nil, nil
+}
@@ -0,0 +1,211 @@
+package app
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestPayloadExtractorCodecs(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	sender, err := lnchat.NewNodeFromBytes(key.PubKey().SerializeCompressed())
+	require.NoError(t, err)
+	recipient, err := lnchat.NewNodeFromString(
+		"030000000000000000000000000000000000000000000000000000000000000002")
+	require.NoError(t, err)
+
+	timestamp := make([]byte, 8)
+	binary.BigEndian.PutUint64(timestamp, 1600000000000000000)
+
+	// Keysend messages are signed through the lnd signer,
+	// over the SHA256 digest of the signed data.
+	var signed []byte
+	signed = append(signed, sender.Bytes()...)
+	signed = append(signed, recipient.Bytes()...)
+	signed = append(signed, timestamp...)
+	signed = append(signed, "hello"...)
+	ecdsaSig, err := key.Sign(chainhash.HashB(signed))
+	require.NoError(t, err)
+	wireSig, err := lnwire.NewSigFromSignature(ecdsaSig)
+	require.NoError(t, err)
+	signature := wireSig[:]
+
+	// verify rejects all signatures, since keysend messages
+	// are verified by the keysend codec.
+	verify := func(msg, sig []byte, addr string) (bool, error) {
+		return false, nil
+	}
+
+	textPayload := func(text string) []byte {
+		raw, err := model.NewTextRawMessage("", text)
+		require.NoError(t, err)
+		return raw.RawPayload
+	}
+
+	cases := []struct {
+		name          string
+		customRecords []map[uint64][]byte
+		expected      *model.RawMessage
+		expectErr     bool
+	}{
+		{
+			name: "Keysend message",
+			customRecords: []map[uint64][]byte{{
+				KeysendMessageTypeKey:   []byte("hello"),
+				KeysendSenderTypeKey:    sender.Bytes(),
+				KeysendSignatureTypeKey: signature,
+				KeysendTimestampTypeKey: timestamp,
+				5482373484:              []byte("keysend preimage"),
+			}},
+			expected: &model.RawMessage{
+				RawPayload:        textPayload("hello"),
+				Sender:            sender.String(),
+				Signature:         signature,
+				SignatureVerified: true,
+				Source:            model.SourceKeysend,
+			},
+		},
+		{
+			name: "Keysend message with signature over different message",
+			customRecords: []map[uint64][]byte{{
+				KeysendMessageTypeKey:   []byte("hello!"),
+				KeysendSenderTypeKey:    sender.Bytes(),
+				KeysendSignatureTypeKey: signature,
+				KeysendTimestampTypeKey: timestamp,
+			}},
+			expected: &model.RawMessage{
+				RawPayload: textPayload("hello!"),
+				Sender:     sender.String(),
+				Signature:  signature,
+				Source:     model.SourceKeysend,
+			},
+		},
+		{
+			name: "Keysend message with malformed signature",
+			customRecords: []map[uint64][]byte{{
+				KeysendMessageTypeKey:   []byte("hello"),
+				KeysendSenderTypeKey:    sender.Bytes(),
+				KeysendSignatureTypeKey: []byte("signature"),
+				KeysendTimestampTypeKey: timestamp,
+			}},
+			expected: &model.RawMessage{
+				RawPayload: textPayload("hello"),
+				Sender:     sender.String(),
+				Signature:  []byte("signature"),
+				Source:     model.SourceKeysend,
+			},
+		},
+		{
+			name: "Keysend message without sender",
+			customRecords: []map[uint64][]byte{{
+				KeysendMessageTypeKey: []byte("hello"),
+			}},
+			expected: &model.RawMessage{
+				RawPayload: textPayload("hello"),
+				Source:     model.SourceKeysend,
+			},
+		},
+		{
+			name: "Keysend message with invalid sender",
+			customRecords: []map[uint64][]byte{{
+				KeysendMessageTypeKey: []byte("hello"),
+				KeysendSenderTypeKey:  []byte("sender"),
+			}},
+			expectErr: true,
+		},
+		{
+			name: "Boostagram",
+			customRecords: []map[uint64][]byte{{
+				BoostagramTypeKey: []byte(`{"action":"boost",` +
+					`"message":"great episode","sender_name":"alice"}`),
+			}},
+			expected: &model.RawMessage{
+				RawPayload: textPayload("great episode"),
+				Source:     model.SourceBoostagram,
+			},
+		},
+		{
+			name: "Invalid boostagram",
+			customRecords: []map[uint64][]byte{{
+				BoostagramTypeKey: []byte("boost"),
+			}},
+			expectErr: true,
+		},
+		{
+			name: "c13n payload takes precedence",
+			customRecords: []map[uint64][]byte{{
+				PayloadTypeKey:        []byte("payload"),
+				KeysendMessageTypeKey: []byte("hello"),
+			}},
+			expected: &model.RawMessage{
+				RawPayload: []byte("payload"),
+				Source:     model.SourceC13N,
+			},
+		},
+		{
+			name: "No known records",
+			customRecords: []map[uint64][]byte{{
+				5482373484: []byte("keysend preimage"),
+			}},
+			expected: &model.RawMessage{
+				Source: model.SourceC13N,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			rawMsg, err := payloadExtractor(c.customRecords, recipient.String(), verify)
+			if c.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, rawMsg)
+		})
+	}
+}
+
+func TestRegisterPayloadCodec(t *testing.T) {
+	registered := payloadCodecs
+	defer func() {
+		payloadCodecs = registered
+	}()
+
+	codec := PayloadCodec{
+		Source: "custom",
+		Keys:   []uint64{133773310},
+		Decode: func(records map[uint64][]byte, _ string) (
+			*model.RawMessage, []byte, error) {
+
+			raw, err := model.NewTextRawMessage("custom", string(records[133773310]))
+			return raw, nil, err
+		},
+	}
+
+	require.NoError(t, RegisterPayloadCodec(codec))
+	assert.Error(t, RegisterPayloadCodec(codec))
+	assert.Error(t, RegisterPayloadCodec(PayloadCodec{Source: "empty"}))
+
+	rawMsg, err := payloadExtractor([]map[uint64][]byte{
+		{133773310: []byte("hi")},
+	}, "", func(_, _ []byte, _ string) (bool, error) {
+		return false, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "custom", rawMsg.Source)
+
+	text, _, err := rawMsg.UnmarshalPayload()
+	require.NoError(t, err)
+	assert.Equal(t, "hi", text)
+}
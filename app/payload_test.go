@@ -0,0 +1,91 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestPayloadExtractor(t *testing.T) {
+	sender, err := lnchat.NewNodeFromString(
+		"000000000000000000000000000000000000000000000000000000000000000000")
+	assert.NoError(t, err)
+
+	records := map[uint64][]byte{
+		PayloadTypeKey:   []byte("payload"),
+		SenderTypeKey:    sender.Bytes(),
+		SignatureTypeKey: []byte("signature"),
+	}
+
+	verify := func(_, _ []byte, _ string) (bool, error) {
+		return true, nil
+	}
+
+	cases := []struct {
+		name          string
+		customRecords []map[uint64][]byte
+		expected      *model.RawMessage
+		expectErr     bool
+	}{
+		{
+			name:          "Single HTLC",
+			customRecords: []map[uint64][]byte{records},
+			expected: &model.RawMessage{
+				RawPayload:        []byte("payload"),
+				Sender:            sender.String(),
+				Signature:         []byte("signature"),
+				SignatureVerified: true,
+				Source:            model.SourceC13N,
+			},
+		},
+		{
+			name: "Multiple HTLCs",
+			customRecords: []map[uint64][]byte{
+				records,
+				{
+					PayloadTypeKey:   []byte("payload"),
+					SenderTypeKey:    sender.Bytes(),
+					SignatureTypeKey: []byte("signature"),
+					5482373484:       []byte("keysend preimage"),
+				},
+			},
+			expected: &model.RawMessage{
+				RawPayload:        []byte("payload"),
+				Sender:            sender.String(),
+				Signature:         []byte("signature"),
+				SignatureVerified: true,
+				Source:            model.SourceC13N,
+			},
+		},
+		{
+			name: "Multiple HTLCs with different payloads",
+			customRecords: []map[uint64][]byte{
+				records,
+				{
+					PayloadTypeKey: []byte("another payload"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "No HTLCs",
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			rawMsg, err := payloadExtractor(c.customRecords, "", verify)
+			if c.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, rawMsg)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/lnurl"
 	"github.com/c13n-io/c13n-go/model"
 	"github.com/c13n-io/c13n-go/store"
 )
@@ -17,10 +18,56 @@ import (
 // SendMessage attempts to send a message.
 // If a payment request is provided, a discussion with the recipient
 // is created with default options if it does not exist.
+// The payment request may also be an LNURL or a Lightning Address,
+// in which case an invoice for the amount is requested from the service,
+// carrying the payload as comment. Payloads longer than
+// the comments accepted by the service are rejected.
+// Such messages belong to a discussion keyed on the LNURL or
+// Lightning Address, and later messages to that discussion
+// are paid through it as well.
+// Depending on the message transport option, messages not carrying
+// an amount may be sent over custom peer messages instead of payments.
 // Note: Anonymous messages to group discussions are disallowed.
 func (app *App) SendMessage(ctx context.Context, discID uint64, amtMsat int64, payReq string,
 	payload string, opts model.MessageOptions) (*model.MessageAggregate, error) {
 
+	return app.sendMessage(ctx, discID, amtMsat, payReq, nil, payload, opts)
+}
+
+// SendMessageToContact attempts to send a message to a contact.
+// If the contact has a Lightning Address, the message is paid through it,
+// otherwise it is sent to the discussion with the contact node,
+// which is created with default options if it does not exist.
+func (app *App) SendMessageToContact(ctx context.Context, contactID uint64, amtMsat int64,
+	payload string, opts model.MessageOptions) (*model.MessageAggregate, error) {
+
+	contact, err := app.Database.GetContactByID(contactID)
+	if err != nil {
+		return nil, newErrorf(err, "SendMessageToContact")
+	}
+
+	if contact.LightningAddress != "" {
+		return app.sendMessage(ctx, 0, amtMsat,
+			contact.LightningAddress, nil, payload, opts)
+	}
+
+	disc, err := app.retrieveOrCreateDiscussion(&model.Discussion{
+		Participants: []string{contact.Address},
+		Options:      DefaultOptions,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve discussion")
+	}
+
+	return app.sendMessage(ctx, disc.ID, amtMsat, "", nil, payload, opts)
+}
+
+// sendMessage attempts to send a message carrying
+// the provided control (if not nil), as described in SendMessage.
+func (app *App) sendMessage(ctx context.Context, discID uint64, amtMsat int64,
+	payReq string, ctrl *model.Control, payload string,
+	opts model.MessageOptions) (*model.MessageAggregate, error) {
+
 	// Validate arguments
 	if (payReq != "") && (discID != 0) {
 		return nil, fmt.Errorf("exactly one of payment request " +
@@ -28,10 +75,31 @@ func (app *App) SendMessage(ctx context.Context, discID uint64, amtMsat int64, p
 	}
 
 	// Retrieve discussion
-	var err error
 	var disc *model.Discussion
-	switch payReq {
-	default:
+	var err error
+	switch {
+	case lnurl.IsLNURL(payReq):
+		// Discussions paid through an LNURL are keyed on it,
+		// since the paid node is that of the LNURL service.
+		target := lnurl.Normalize(payReq)
+		if payReq, err = app.lnurlPayReq(ctx,
+			target, amtMsat, payload); err != nil {
+
+			return nil, newErrorf(err, "SendMessage: LNURL resolution failed")
+		}
+		payRequest, err := app.LNManager.DecodePayReq(ctx, payReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode payment request")
+		}
+		disc, err = app.retrieveOrCreateDiscussion(&model.Discussion{
+			Participants: []string{payRequest.Destination.String()},
+			LNURL:        target,
+			Options:      DefaultOptions,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve discussion")
+		}
+	case payReq != "":
 		// Create discussion if it does not exist
 		payRequest, err := app.LNManager.DecodePayReq(ctx, payReq)
 		if err != nil {
@@ -44,11 +112,23 @@ func (app *App) SendMessage(ctx context.Context, discID uint64, amtMsat int64, p
 		if err != nil {
 			return nil, errors.Wrap(err, "could not retrieve discussion")
 		}
-	case "":
+	default:
 		disc, err = app.retrieveDiscussion(ctx, discID)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not retrieve discussion")
 		}
+		// Every message to an LNURL discussion requires a fresh invoice.
+		if disc.LNURL != "" {
+			if payReq, err = app.lnurlPayReq(ctx,
+				disc.LNURL, amtMsat, payload); err != nil {
+
+				return nil, newErrorf(err, "SendMessage: LNURL resolution failed")
+			}
+		}
+	}
+	if disc.LNURL != "" {
+		// The amount is specified by the invoice.
+		amtMsat = 0
 	}
 
 	// Disallow anonymous messages in group discussions
@@ -58,12 +138,42 @@ func (app *App) SendMessage(ctx context.Context, discID uint64, amtMsat int64, p
 	}
 	payOpts := options.GetPaymentOptions()
 
+	viaPeers, err := app.usePeerTransport(ctx, disc, amtMsat, payReq, options)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create raw message
-	rawMsg, err := app.createRawMessage(ctx, disc, payload, !options.Anonymous)
+	rawMsg, err := app.createRawMessage(ctx, disc, ctrl, payload, !options.Anonymous)
 	if err != nil {
 		return nil, err
 	}
+
+	// Send over custom peer messages, if applicable
+	if viaPeers {
+		return app.sendPeerMessage(ctx, disc, rawMsg)
+	}
+
 	tlvs := marshalPayload(rawMsg)
+	if err := checkPayloadSize(tlvs); err != nil {
+		return nil, err
+	}
+
+	// Mission control is queried at most once for all recipients.
+	diag := app.newRouteDiagnostics()
+
+	return app.sendMessagePayments(disc, rawMsg,
+		func(dest string) (lnchat.PaymentUpdate, error) {
+			return app.send(ctx, diag, dest, amtMsat, payReq,
+				payOpts, options.MaxFeeLimitMsat, tlvs)
+		})
+}
+
+// sendMessagePayments sends a message to each discussion participant
+// using the provided payment function, then stores and publishes
+// the message along with the resulting payments.
+func (app *App) sendMessagePayments(disc *model.Discussion, rawMsg *model.RawMessage,
+	pay func(dest string) (lnchat.PaymentUpdate, error)) (*model.MessageAggregate, error) {
 
 	// Perform payment attempts in parallel
 	var wg sync.WaitGroup
@@ -73,7 +183,7 @@ func (app *App) SendMessage(ctx context.Context, discID uint64, amtMsat int64, p
 		go func(dest string) {
 			defer wg.Done()
 
-			result, err := app.send(ctx, dest, amtMsat, payReq, payOpts, tlvs)
+			result, err := pay(dest)
 			if err != nil {
 				results.Store(dest, err)
 				return
@@ -100,6 +210,13 @@ func (app *App) SendMessage(ctx context.Context, discID uint64, amtMsat int64, p
 					"for recipient %s: %w", recipient, v.Err))
 				break
 			}
+			// Failed payments did not deliver the message
+			if v.Payment.Status == lnchat.PaymentFAILED {
+				errs = append(errs, fmt.Errorf("payment error "+
+					"for recipient %s: %w", recipient,
+					v.Payment.ClassifyFailure().Err()))
+				break
+			}
 			payments = append(payments, &model.Payment{
 				PayerAddress: app.Self.Node.Address,
 				PayeeAddress: recipient,
@@ -107,7 +224,7 @@ func (app *App) SendMessage(ctx context.Context, discID uint64, amtMsat int64, p
 			})
 		case error:
 			errs = append(errs, fmt.Errorf("could not initiate "+
-				"payment to recipient %s: %w", recipient, err))
+				"payment to recipient %s: %w", recipient, v))
 		}
 		return true
 	})
@@ -153,7 +270,13 @@ func defaultPaymentFilter(p *lnchat.Payment) bool {
 		p.Status == lnchat.PaymentFAILED
 }
 
-// SendPayment attempts to send a payment.
+// SendPayment attempts to send a payment,
+// retrying it according to the retry policy.
+// The destination may also be an LNURL or a Lightning Address,
+// in which case the payment fulfils an invoice for the amount
+// requested from the service.
+// Failed payments are stored and returned along with
+// an error corresponding to their failure category.
 func (app *App) SendPayment(ctx context.Context,
 	dest string, amtMsat int64, payReq string,
 	opts lnchat.PaymentOptions, tlvs map[uint64][]byte) (*model.Payment, error) {
@@ -164,6 +287,16 @@ func (app *App) SendPayment(ctx context.Context,
 			"and destination address must be specified")
 	}
 
+	// Resolve LNURL destinations
+	if lnurl.IsLNURL(dest) {
+		var err error
+		if payReq, err = app.lnurlPayReq(ctx, dest, amtMsat, ""); err != nil {
+			return nil, newErrorf(err, "SendPayment: LNURL resolution failed")
+		}
+		// The amount is specified by the invoice.
+		dest, amtMsat = "", 0
+	}
+
 	recipient := dest
 
 	if payReq != "" {
@@ -175,13 +308,15 @@ func (app *App) SendPayment(ctx context.Context,
 	}
 
 	// Perform payment attempt
-	result, err := app.send(ctx, dest, amtMsat, payReq, opts, tlvs)
+	diag := app.newRouteDiagnostics()
+	result, err := app.send(ctx, diag, dest, amtMsat, payReq,
+		opts, opts.FeeLimitMsat, tlvs)
 	if err != nil {
-		return nil, err
+		return nil, newErrorf(err, "SendPayment")
 	}
 
-	if result.Err != nil {
-		return nil, result.Err
+	if result.Err != nil && result.Payment == nil {
+		return nil, newErrorf(result.Err, "SendPayment")
 	}
 
 	payment := &model.Payment{
@@ -198,27 +333,11 @@ func (app *App) SendPayment(ctx context.Context,
 		}
 	}
 
-	return payment, nil
-}
-
-// Attempts payment and returns the final payment update.
-func (app *App) send(ctx context.Context, dest string, amtMsat int64, payReq string,
-	opts lnchat.PaymentOptions, tlvs map[uint64][]byte) (lnchat.PaymentUpdate, error) {
-
-	var lastUpdate lnchat.PaymentUpdate
-
-	amt := lnchat.NewAmount(amtMsat)
-	updates, err := app.LNManager.SendPayment(ctx, dest, amt, payReq,
-		opts, tlvs, defaultPaymentFilter)
-	if err != nil {
-		return lastUpdate, err
-	}
-
-	for update := range updates {
-		lastUpdate = update
+	if result.Err != nil {
+		return payment, newErrorf(result.Err, "SendPayment: payment failed")
 	}
 
-	return lastUpdate, nil
+	return payment, nil
 }
 
 // GetPayments retrieves stored payments.
@@ -255,9 +374,9 @@ func (app *App) SendPay(ctx context.Context,
 }
 
 func (app *App) createRawMessage(ctx context.Context, discussion *model.Discussion,
-	payload string, withSig bool) (*model.RawMessage, error) {
+	ctrl *model.Control, payload string, withSig bool) (*model.RawMessage, error) {
 
-	rawMsg, err := model.NewRawMessage(discussion, payload)
+	rawMsg, err := model.NewControlRawMessage(discussion, ctrl, payload, app.payloadFormat)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create raw message")
 	}
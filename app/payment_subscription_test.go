@@ -178,6 +178,7 @@ func TestSubscribePayments(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				for _, op := range c.paymentUpdateOps {
 					mockDB.On("AddPayments", op.payment).Return(
@@ -205,6 +206,9 @@ func TestSubscribePayments(t *testing.T) {
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(
 					paymentUpdateCh, c.subscrPayUpdatesErr).Once()
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				mockDB.On("Close").Return(nil).Once()
 				mockLNManager.On("Close").Return(nil).Once()
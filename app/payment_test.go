@@ -234,11 +234,15 @@ func TestSendPay(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				if c.decodedPayReq == nil {
 					mockLNManager.On("DecodePayReq", mock.Anything, c.payReq).Return(
@@ -461,11 +465,15 @@ func TestSendPayment(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(1),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				mockLNManager.On("DecodePayReq", mock.Anything, c.payReq).Return(
 					c.decodedPayReq, nil)
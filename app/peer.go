@@ -0,0 +1,154 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// persistentPeerCheckInterval is the interval between
+// successive checks of the persistent peer connections.
+const persistentPeerCheckInterval = time.Minute
+
+// ListPeers returns the nodes currently connected to the underlying node.
+func (app *App) ListPeers(ctx context.Context) ([]model.Peer, error) {
+	peers, err := app.LNManager.ListPeers(ctx)
+	if err != nil {
+		return nil, newErrorf(err, "ListPeers")
+	}
+
+	res := make([]model.Peer, len(peers))
+	for i, p := range peers {
+		res[i] = model.Peer{Peer: p}
+	}
+
+	return res, nil
+}
+
+// DisconnectPeer terminates the peer connection with a node.
+// Note that persistent peers are reconnected,
+// unless they are removed from the persistent peer list.
+func (app *App) DisconnectPeer(ctx context.Context, address string) error {
+	err := app.LNManager.DisconnectPeer(ctx, address)
+
+	return newErrorf(err, "DisconnectPeer")
+}
+
+// AddPersistentPeer adds a node to the persistent peer list
+// and attempts to connect to it.
+// Nodes in the persistent peer list are reconnected upon peer loss.
+func (app *App) AddPersistentPeer(ctx context.Context, address, hostport string) error {
+	peer := &model.PersistentPeer{
+		Address:  address,
+		Hostport: hostport,
+	}
+	if err := app.Database.AddPersistentPeer(peer); err != nil {
+		return newErrorf(err, "AddPersistentPeer")
+	}
+
+	err := app.LNManager.ConnectNode(ctx, address, hostport)
+
+	return newErrorf(err, "AddPersistentPeer: could not create peer connection")
+}
+
+// GetPersistentPeers returns the persistent peer list.
+func (app *App) GetPersistentPeers(_ context.Context) ([]model.PersistentPeer, error) {
+	peers, err := app.Database.GetPersistentPeers()
+	if err != nil {
+		return nil, newErrorf(err, "GetPersistentPeers")
+	}
+
+	return peers, nil
+}
+
+// RemovePersistentPeer removes a node from the persistent peer list.
+// Any existing peer connection with the node is not terminated.
+func (app *App) RemovePersistentPeer(_ context.Context, address string) error {
+	err := app.Database.RemovePersistentPeer(address)
+
+	return newErrorf(err, "RemovePersistentPeer")
+}
+
+// maintainPersistentPeers reconnects any disconnected persistent peers
+// whenever a peer goes offline, as reported by the peer event subscription.
+// Persistent peers are also checked periodically, as a fallback for missed
+// events and for backends that do not support peer event subscriptions.
+func (app *App) maintainPersistentPeers(ctx context.Context) error {
+	events, err := app.LNManager.SubscribePeerEvents(ctx)
+	switch {
+	case errors.Is(err, lnchat.ErrNotSupported):
+		app.Log.Info("peer events not supported, polling persistent peer connections")
+	case err != nil:
+		return fmt.Errorf("peer event subscription failed: %w", err)
+	}
+
+	ticker := time.NewTicker(persistentPeerCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := app.reconnectPersistentPeers(ctx); err != nil {
+			return err
+		}
+
+	waitLoop:
+		for {
+			// A nil event channel (no subscription) is never selected.
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-ticker.C:
+				break waitLoop
+			case update, ok := <-events:
+				switch {
+				case !ok && ctx.Err() != nil:
+					return nil
+				case !ok:
+					return fmt.Errorf("peer event subscription terminated")
+				case update.Err != nil:
+					return fmt.Errorf("peer event subscription failed: %w", update.Err)
+				case update.Event.Type == lnchat.PeerOFFLINE:
+					break waitLoop
+				}
+			}
+		}
+	}
+}
+
+func (app *App) reconnectPersistentPeers(ctx context.Context) error {
+	persistent, err := app.Database.GetPersistentPeers()
+	if err != nil {
+		return fmt.Errorf("could not retrieve persistent peers: %w", err)
+	}
+	if len(persistent) == 0 {
+		return nil
+	}
+
+	peers, err := app.LNManager.ListPeers(ctx)
+	if err != nil {
+		return fmt.Errorf("peer retrieval failed: %w", err)
+	}
+
+	connected := make(map[string]bool, len(peers))
+	for _, p := range peers {
+		connected[p.Address] = true
+	}
+
+	for _, p := range persistent {
+		if connected[p.Address] {
+			continue
+		}
+
+		app.Log.Infof("reconnecting to persistent peer %s", p.Address)
+		if err := app.LNManager.ConnectNode(ctx,
+			p.Address, p.Hostport); err != nil {
+
+			app.Log.WithError(err).Warnf("could not reconnect to peer %s", p.Address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// ErrPeerTransportUnsupported indicates that a message cannot be sent
+// over custom peer messages, since it carries an amount or payment request,
+// or is anonymous.
+var ErrPeerTransportUnsupported = fmt.Errorf("peer messages cannot " +
+	"carry payments or be anonymous")
+
+// usePeerTransport returns whether a message to a discussion
+// is to be sent over custom peer messages.
+// Messages not carrying an amount or payment request are sent
+// over custom peer messages automatically if every discussion
+// participant is a connected peer, unless they are anonymous.
+func (app *App) usePeerTransport(ctx context.Context, disc *model.Discussion,
+	amtMsat int64, payReq string, opts model.MessageOptions) (bool, error) {
+
+	supported := amtMsat == 0 && payReq == "" && !opts.Anonymous
+
+	switch opts.Transport {
+	case model.TransportPAYMENT:
+		return false, nil
+	case model.TransportPEER:
+		if !supported {
+			return false, ErrPeerTransportUnsupported
+		}
+		return true, nil
+	}
+
+	if !supported {
+		return false, nil
+	}
+
+	peers, err := app.LNManager.ListPeers(ctx)
+	if err != nil {
+		return false, newErrorf(err, "ListPeers")
+	}
+
+	connected := make(map[string]bool, len(peers))
+	for _, p := range peers {
+		connected[p.Address] = true
+	}
+	for _, participant := range disc.Participants {
+		if !connected[participant] {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// sendPeerMessage sends a raw message to the discussion participants
+// over custom peer messages, and stores and publishes it
+// if it was delivered to at least one participant.
+func (app *App) sendPeerMessage(ctx context.Context, disc *model.Discussion,
+	rawMsg *model.RawMessage) (*model.MessageAggregate, error) {
+
+	records := marshalPayload(rawMsg)
+
+	var mtx sync.Mutex
+	var errs []error
+	var wg sync.WaitGroup
+	for _, recipient := range disc.Participants {
+		wg.Add(1)
+		go func(dest string) {
+			defer wg.Done()
+
+			if err := app.LNManager.SendCustomMessage(ctx, dest, records); err != nil {
+				mtx.Lock()
+				errs = append(errs, fmt.Errorf("peer message error "+
+					"for recipient %s: %w", dest, err))
+				mtx.Unlock()
+			}
+		}(recipient)
+	}
+
+	wg.Wait()
+
+	// If the message was not delivered to any participant, fail early
+	if len(errs) == len(disc.Participants) {
+		return nil, newCompositeError(errs)
+	}
+
+	rawMsg.PeerTransport = true
+	msg := model.MessageAggregate{
+		RawMessage: rawMsg,
+	}
+
+	// Store and publish raw message
+	if err := app.Database.AddRawMessage(msg.RawMessage); err != nil {
+		return &msg, errors.Wrap(err, "could not store message")
+	}
+
+	if err := app.publishMessage(msg); err != nil {
+		return &msg, errors.Wrap(err, "message notification failed")
+	}
+
+	return &msg, newCompositeError(errs)
+}
+
+func (app *App) subscribeCustomMessages(ctx context.Context) error {
+	msgSubscription, err := app.LNManager.SubscribeCustomMessages(ctx)
+	if err != nil {
+		return err
+	}
+
+	verifySignature := func(msg, sig []byte, sender string) (bool, error) {
+		return app.verifySignature(ctx, msg, sig, sender)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-msgSubscription:
+			if !ok {
+				return fmt.Errorf("subscription channel closed")
+			}
+			if update.Err != nil {
+				return fmt.Errorf("custom message update failed: %w", update.Err)
+			}
+			peerMsg := update.Msg
+
+			rawMsg, err := payloadExtractor([]map[uint64][]byte{peerMsg.Records},
+				app.Self.Node.Address, verifySignature)
+			if err != nil {
+				app.Log.WithError(err).Warn("message extraction failed")
+				continue
+			}
+
+			// The peer is authenticated by the peer connection,
+			// so a sender different from the peer is rejected.
+			// Unsigned messages are attributed to the peer and,
+			// like signed ones, are marked as verified.
+			switch rawMsg.Sender {
+			case "", peerMsg.Peer:
+				rawMsg.Sender = peerMsg.Peer
+				rawMsg.SignatureVerified = true
+			default:
+				app.Log.Warnf("discarding peer message from %s "+
+					"with sender %s", peerMsg.Peer, rawMsg.Sender)
+				continue
+			}
+			rawMsg.PeerTransport = true
+
+			// Retrieve (or create) the appropriate discussion.
+			disc, err := app.retrieveOrCreateRawMsgDiscussion(rawMsg)
+			if err != nil {
+				app.Log.WithError(err).Error("discussion retrieval failed")
+				continue
+			}
+			rawMsg.DiscussionID = disc.ID
+
+			// Store and publish the raw message.
+			if err := app.Database.AddRawMessage(rawMsg); err != nil {
+				app.Log.WithError(err).Error("message storage failed")
+				continue
+			}
+
+			if err := app.publishMessage(model.MessageAggregate{
+				RawMessage: rawMsg,
+			}); err != nil {
+				app.Log.WithError(err).Error("message notification failed")
+				continue
+			}
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestSendMessagePeerTransport(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: srcAddress,
+		},
+	}
+
+	discussion := &model.Discussion{
+		ID:           1,
+		Participants: []string{destAddress},
+		Options:      DefaultOptions,
+	}
+
+	cases := []struct {
+		name        string
+		amt         int64
+		opts        model.MessageOptions
+		connected   bool
+		expectedErr error
+	}{
+		{
+			name:      "Automatic, recipient connected",
+			connected: true,
+		},
+		{
+			name: "Peer transport",
+			opts: model.MessageOptions{
+				Transport: model.TransportPEER,
+			},
+		},
+		{
+			name: "Peer transport with amount",
+			amt:  1000,
+			opts: model.MessageOptions{
+				Transport: model.TransportPEER,
+			},
+			expectedErr: ErrPeerTransportUnsupported,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
+					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				mockDB.On("GetDiscussion", discussion.ID).Return(discussion, nil).Once()
+				if c.connected {
+					mockLNManager.On("ListPeers", mock.Anything).Return([]lnchat.Peer{
+						{Address: destAddress},
+					}, nil).Once()
+				}
+				mockLNManager.On("SignMessage", mock.Anything,
+					mock.Anything).Return([]byte("signature"), nil).Once()
+				mockLNManager.On("SendCustomMessage", mock.Anything, destAddress,
+					mock.Anything).Return(nil).Once()
+				mockDB.On("AddRawMessage", mock.MatchedBy(func(raw *model.RawMessage) bool {
+					return raw.PeerTransport && len(raw.PaymentIndexes) == 0
+				})).Return(nil).Once()
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+			defer cancel()
+
+			msg, err := app.SendMessage(ctxt, discussion.ID, c.amt, "", "hello", c.opts)
+			if c.expectedErr != nil {
+				assert.EqualError(t, err, c.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, msg.RawMessage.PeerTransport)
+			assert.Equal(t, srcAddress, msg.RawMessage.Sender)
+			assert.Empty(t, msg.Payments)
+		})
+	}
+}
+
+func TestSubscribeCustomMessages(t *testing.T) {
+	selfAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	peerKey, peerAddress := newTestSigner(t)
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: selfAddress,
+		},
+	}
+
+	discussion := &model.Discussion{
+		ID:           3,
+		Participants: []string{peerAddress},
+		Options:      DefaultOptions,
+	}
+
+	cases := []struct {
+		name   string
+		signed bool
+	}{
+		{name: "signed", signed: true},
+		// Unsigned messages are attributed to the
+		// peer authenticated by the connection.
+		{name: "unsigned", signed: false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			rawMsg, err := model.NewRawMessage(&model.Discussion{
+				Participants: []string{selfAddress},
+			}, "hello", model.PayloadFormatJSON)
+			require.NoError(t, err)
+			if tc.signed {
+				require.NoError(t, rawMsg.WithSignature(peerAddress,
+					signTestMessage(t, peerKey, rawMsg.RawPayload)))
+			}
+
+			subscribed := make(chan struct{})
+
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				testcaseTermination := make(chan struct{})
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
+					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+				updateCh := make(chan lnchat.CustomMessageUpdate)
+				go func() {
+					defer close(updateCh)
+
+					<-subscribed
+					updateCh <- lnchat.CustomMessageUpdate{
+						Msg: &lnchat.CustomMessage{
+							Peer:    peerAddress,
+							Records: marshalPayload(rawMsg),
+						},
+					}
+
+					<-testcaseTermination
+				}()
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(
+					(<-chan lnchat.CustomMessageUpdate)(updateCh), nil).Once()
+
+				mockDB.On("GetDiscussionByParticipants",
+					[]string{peerAddress}).Return(discussion, nil).Once()
+				mockDB.On("AddRawMessage", mock.MatchedBy(func(raw *model.RawMessage) bool {
+					return raw.PeerTransport && raw.Sender == peerAddress &&
+						raw.SignatureVerified && raw.DiscussionID == discussion.ID
+				})).Return(nil).Once()
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				mockStopFunc := func() {
+					close(testcaseTermination)
+				}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxc, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			msgCh, err := app.SubscribeMessages(ctxc)
+			require.NoError(t, err)
+			close(subscribed)
+
+			msg, ok := <-msgCh
+			require.True(t, ok)
+			assert.True(t, msg.RawMessage.PeerTransport)
+			assert.Equal(t, peerAddress, msg.RawMessage.Sender)
+			assert.True(t, msg.RawMessage.SignatureVerified)
+			assert.Equal(t, discussion.ID, msg.RawMessage.DiscussionID)
+			assert.Nil(t, msg.Invoice)
+			assert.Empty(t, msg.Payments)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestListPeers(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	peers := []lnchat.Peer{
+		{
+			Address:        "111111111111111111111111111111111111111111111111111111111111111111",
+			Host:           "127.0.0.1:9735",
+			Inbound:        true,
+			PingTimeMicros: 1200,
+			BytesSent:      1024,
+			BytesReceived:  2048,
+			Features: []lnchat.Feature{
+				{
+					Bit:     1,
+					Name:    "data-loss-protect",
+					IsKnown: true,
+				},
+			},
+		},
+	}
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+		mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
+			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockLNManager.On("ListPeers", mock.Anything).Return(peers, nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	res, err := app.ListPeers(ctxt)
+	assert.NoError(t, err)
+	assert.EqualValues(t, []model.Peer{{Peer: peers[0]}}, res)
+}
+
+func TestRemovePersistentPeer(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	address := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	cases := []struct {
+		name          string
+		removeErr     error
+		expectedError error
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:      "Not found",
+			removeErr: store.ErrPersistentPeerNotFound,
+			expectedError: Error{
+				Kind:    PersistentPeerNotFound,
+				details: "RemovePersistentPeer",
+				Err:     store.ErrPersistentPeerNotFound,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+				mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
+					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				mockDB.On("RemovePersistentPeer", address).Return(c.removeErr).Once()
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			err := app.RemovePersistentPeer(context.Background(), address)
+			if c.expectedError != nil {
+				assert.EqualError(t, err, c.expectedError.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPersistentPeerReconnection(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	persistent := []model.PersistentPeer{
+		{
+			Address:  "111111111111111111111111111111111111111111111111111111111111111111",
+			Hostport: "127.0.0.1:9735",
+		},
+		{
+			Address:  "222222222222222222222222222222222222222222222222222222222222222222",
+			Hostport: "127.0.0.1:9736",
+		},
+	}
+
+	reconnected := make(chan string, len(persistent))
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
+		mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(persistent, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
+			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
+			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		// Only the first persistent peer is connected.
+		mockLNManager.On("ListPeers", mock.Anything).Return([]lnchat.Peer{
+			{
+				Address: persistent[0].Address,
+				Host:    persistent[0].Hostport,
+			},
+		}, nil)
+		mockLNManager.On("ConnectNode", mock.Anything,
+			persistent[1].Address, persistent[1].Hostport).Return(nil).Run(
+			func(args mock.Arguments) {
+				reconnected <- args.String(1)
+			})
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	_, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	select {
+	case address := <-reconnected:
+		assert.Equal(t, persistent[1].Address, address)
+	case <-time.After(defaultTimeout):
+		assert.Fail(t, "persistent peer was not reconnected")
+	}
+}
+
+func TestPersistentPeerReconnectionOnPeerEvent(t *testing.T) {
+	persistent := []model.PersistentPeer{
+		{
+			Address:  "111111111111111111111111111111111111111111111111111111111111111111",
+			Hostport: "127.0.0.1:9735",
+		},
+	}
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	app, err := New(mockLNManager, mockDB)
+	assert.NoError(t, err)
+
+	events := make(chan lnchat.PeerEventUpdate)
+	reconnected := make(chan string, 1)
+
+	mockDB.On("GetPersistentPeers").Return(persistent, nil)
+	mockLNManager.On("SubscribePeerEvents", mock.Anything).
+		Return((<-chan lnchat.PeerEventUpdate)(events), nil).Once()
+	// The peer is connected at first, and lost after the event.
+	mockLNManager.On("ListPeers", mock.Anything).Return([]lnchat.Peer{
+		{
+			Address: persistent[0].Address,
+		},
+	}, nil).Once()
+	mockLNManager.On("ListPeers", mock.Anything).Return(nil, nil)
+	mockLNManager.On("ConnectNode", mock.Anything,
+		persistent[0].Address, persistent[0].Hostport).Return(nil).Run(
+		func(args mock.Arguments) {
+			reconnected <- args.String(1)
+		}).Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- app.maintainPersistentPeers(ctx)
+	}()
+
+	events <- lnchat.PeerEventUpdate{
+		Event: &lnchat.PeerEvent{
+			Address: persistent[0].Address,
+			Type:    lnchat.PeerOFFLINE,
+		},
+	}
+
+	select {
+	case address := <-reconnected:
+		assert.Equal(t, persistent[0].Address, address)
+	case <-time.After(defaultTimeout):
+		assert.Fail(t, "persistent peer was not reconnected")
+	}
+
+	cancel()
+	assert.NoError(t, <-done)
+
+	mockLNManager.AssertExpectations(t)
+}
@@ -0,0 +1,388 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+)
+
+// reconciliationPageSize is the number of invoices or payments
+// retrieved per request during reconciliation.
+const reconciliationPageSize = 100
+
+// reconciliationGracePeriod is the time after its settlement (or resolution)
+// before which an invoice (or payment) is not reconciled,
+// since it may still be in the process of being stored.
+var reconciliationGracePeriod = 5 * time.Minute
+
+// WithReconciliation enables the reconciliation of the stored
+// invoices, payments and messages against the Lightning node history,
+// on startup and (if interval is non-zero) periodically.
+func WithReconciliation(onStartup bool, interval time.Duration) func(*App) error {
+	return func(app *App) error {
+		if interval < 0 {
+			return fmt.Errorf("invalid reconciliation interval %s", interval)
+		}
+
+		app.reconcileOnStartup = onStartup
+		app.reconcileInterval = interval
+		return nil
+	}
+}
+
+// runReconciliation performs reconciliation on startup (if enabled)
+// and on every reconciliation interval, until the context is done.
+func (app *App) runReconciliation(ctx context.Context) error {
+	var tick <-chan time.Time
+	if app.reconcileInterval > 0 {
+		ticker := time.NewTicker(app.reconcileInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for reconcile := app.reconcileOnStartup; ; reconcile = true {
+		if reconcile {
+			report, err := app.Reconcile(ctx)
+			if err != nil {
+				return err
+			}
+
+			app.Log.Infof("reconciliation checked %d invoices and %d payments: "+
+				"stored %d missing invoices and %d missing payments, "+
+				"recovered %d messages, %d failures",
+				report.InvoicesChecked, report.PaymentsChecked,
+				len(report.BackfilledInvoices), len(report.BackfilledPayments),
+				len(report.RecoveredMessages), report.Failures)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick:
+		}
+	}
+}
+
+// Reconcile compares the settled invoices and resolved payments
+// of the Lightning node with the stored ones.
+// Any missing invoices and payments are stored, and any messages
+// they carry that are missing are recovered, stored and published.
+// Invoices and payments newer than the last stored ones,
+// or resolved within the grace period, are left to the subscriptions.
+func (app *App) Reconcile(ctx context.Context) (*model.ReconciliationReport, error) {
+	app.reconcileMtx.Lock()
+	defer app.reconcileMtx.Unlock()
+
+	report := new(model.ReconciliationReport)
+
+	if err := app.reconcileInvoices(ctx, report); err != nil {
+		return nil, newErrorf(err, "Reconcile")
+	}
+	if err := app.reconcilePayments(ctx, report); err != nil {
+		return nil, newErrorf(err, "Reconcile")
+	}
+
+	return report, nil
+}
+
+func (app *App) reconcileInvoices(ctx context.Context,
+	report *model.ReconciliationReport) error {
+
+	lastInvoiceIdx, err := app.Database.GetLastInvoiceIndex()
+	if err != nil {
+		return fmt.Errorf("could not retrieve last known invoice: %w", err)
+	}
+	cutoffSec := time.Now().Add(-reconciliationGracePeriod).Unix()
+
+	verifySignature := func(msg, sig []byte, sender string) (bool, error) {
+		return app.verifySignature(ctx, msg, sig, sender)
+	}
+
+	for offset := uint64(0); ; {
+		invoices, err := app.LNManager.ListInvoices(ctx,
+			offset, reconciliationPageSize)
+		if err != nil {
+			return fmt.Errorf("invoice retrieval failed: %w", err)
+		}
+		if len(invoices) == 0 {
+			return nil
+		}
+		offset = invoices[len(invoices)-1].AddIndex
+
+		for _, inv := range invoices {
+			if inv.State != lnchat.InvoiceSETTLED ||
+				inv.SettleIndex > lastInvoiceIdx || inv.SettleTimeSec > cutoffSec {
+
+				continue
+			}
+
+			report.InvoicesChecked++
+			if err := app.reconcileInvoice(inv, verifySignature, report); err != nil {
+				app.Log.WithError(err).Warnf("could not reconcile invoice %d",
+					inv.SettleIndex)
+				report.Failures++
+			}
+		}
+	}
+}
+
+func (app *App) reconcileInvoice(inv *lnchat.Invoice,
+	verifySignature func([]byte, []byte, string) (bool, error),
+	report *model.ReconciliationReport) error {
+
+	invoice := &model.Invoice{
+		CreatorAddress: app.Self.Node.Address,
+		Invoice:        *inv,
+	}
+
+	var existsErr *store.AlreadyExistsError
+	switch err := app.Database.AddInvoice(invoice); {
+	case err == nil:
+		app.Log.Warnf("stored missing invoice %d", inv.SettleIndex)
+		report.BackfilledInvoices = append(report.BackfilledInvoices,
+			inv.SettleIndex)
+	case !errors.As(err, &existsErr):
+		return fmt.Errorf("invoice storage failed: %w", err)
+	}
+
+	if !carriesPayload(inv.GetCustomRecords()) {
+		return nil
+	}
+	switch stored, err := app.Database.HasInvoiceMessage(inv.SettleIndex); {
+	case err != nil:
+		return fmt.Errorf("message retrieval failed: %w", err)
+	case stored:
+		return nil
+	}
+
+	rawMsg, err := app.extractInvoiceMessage(inv, verifySignature)
+	if err != nil {
+		return fmt.Errorf("message extraction failed: %w", err)
+	}
+	rawMsg.InvoiceSettleIndex = inv.SettleIndex
+
+	return app.recoverMessage(model.MessageAggregate{
+		RawMessage: rawMsg,
+		Invoice:    invoice,
+	}, report)
+}
+
+func (app *App) reconcilePayments(ctx context.Context,
+	report *model.ReconciliationReport) error {
+
+	lastPaymentIdx, err := app.Database.GetLastPaymentIndex()
+	if err != nil {
+		return fmt.Errorf("could not retrieve last known payment: %w", err)
+	}
+	cutoffNs := time.Now().Add(-reconciliationGracePeriod).UnixNano()
+
+	// Payments carrying a message without one stored are collected,
+	// since a message sent to multiple recipients spans multiple payments.
+	var orphans []*model.Payment
+	for offset := uint64(0); ; {
+		payments, err := app.LNManager.ListPayments(ctx,
+			offset, reconciliationPageSize)
+		if err != nil {
+			return fmt.Errorf("payment retrieval failed: %w", err)
+		}
+		if len(payments) == 0 {
+			break
+		}
+		offset = payments[len(payments)-1].PaymentIndex
+
+		for _, p := range payments {
+			if !defaultPaymentFilter(p) ||
+				p.PaymentIndex > lastPaymentIdx || resolveTimeNs(p) > cutoffNs {
+
+				continue
+			}
+
+			report.PaymentsChecked++
+			payment, orphan, err := app.reconcilePayment(p, report)
+			if err != nil {
+				app.Log.WithError(err).Warnf("could not reconcile payment %d",
+					p.PaymentIndex)
+				report.Failures++
+				continue
+			}
+			if orphan {
+				orphans = append(orphans, payment)
+			}
+		}
+	}
+
+	verifySignature := func(msg, sig []byte, sender string) (bool, error) {
+		return app.verifySignature(ctx, msg, sig, sender)
+	}
+
+	for _, group := range groupMessagePayments(orphans) {
+		if err := app.recoverPaymentMessage(group,
+			verifySignature, report); err != nil {
+
+			app.Log.WithError(err).Warnf("could not recover message "+
+				"of payment %d", group[0].PaymentIndex)
+			report.Failures += len(group)
+		}
+	}
+
+	return nil
+}
+
+// reconcilePayment stores a payment if it is missing, and returns
+// whether it carries a message that is missing.
+func (app *App) reconcilePayment(p *lnchat.Payment,
+	report *model.ReconciliationReport) (*model.Payment, bool, error) {
+
+	payment := &model.Payment{
+		PayerAddress: app.Self.Node.Address,
+		Payment:      *p,
+	}
+	switch dest, err := p.GetDestination(); err {
+	case nil:
+		payment.PayeeAddress = dest.String()
+	default:
+		app.Log.WithError(err).Warn("could not retrieve payment destination")
+	}
+
+	var existsErr *store.AlreadyExistsError
+	switch err := app.Database.AddPayments(payment); {
+	case err == nil:
+		app.Log.Warnf("stored missing payment %d", p.PaymentIndex)
+		report.BackfilledPayments = append(report.BackfilledPayments,
+			p.PaymentIndex)
+	case !errors.As(err, &existsErr):
+		return nil, false, fmt.Errorf("payment storage failed: %w", err)
+	}
+
+	if p.Status != lnchat.PaymentSUCCEEDED || !carriesPayload(p.GetCustomRecords()) {
+		return payment, false, nil
+	}
+	stored, err := app.Database.HasPaymentMessage(p.PaymentIndex)
+	if err != nil {
+		return nil, false, fmt.Errorf("message retrieval failed: %w", err)
+	}
+
+	return payment, !stored, nil
+}
+
+// recoverPaymentMessage recovers the message carried
+// by a group of payments, associating it with the discussion
+// of the participants included in the payload.
+func (app *App) recoverPaymentMessage(payments []*model.Payment,
+	verifySignature func([]byte, []byte, string) (bool, error),
+	report *model.ReconciliationReport) error {
+
+	rawMsg, err := payloadExtractor(payments[0].GetCustomRecords(),
+		payments[0].PayeeAddress, verifySignature)
+	if err != nil {
+		return fmt.Errorf("message extraction failed: %w", err)
+	}
+
+	_, participants, err := rawMsg.UnmarshalPayload()
+	if err != nil {
+		return fmt.Errorf("cannot retrieve message participant set: %w", err)
+	}
+	disc, err := app.retrieveOrCreateDiscussion(&model.Discussion{
+		Participants: participants,
+		Options:      DefaultOptions,
+	})
+	if err != nil {
+		return fmt.Errorf("discussion retrieval failed: %w", err)
+	}
+	rawMsg.DiscussionID = disc.ID
+
+	for _, p := range payments {
+		rawMsg.WithPaymentIndexes(p.PaymentIndex)
+	}
+
+	return app.recoverMessage(model.MessageAggregate{
+		RawMessage: rawMsg,
+		Payments:   payments,
+	}, report)
+}
+
+// recoverMessage stores and publishes a recovered message.
+func (app *App) recoverMessage(msg model.MessageAggregate,
+	report *model.ReconciliationReport) error {
+
+	if err := app.Database.AddRawMessage(msg.RawMessage); err != nil {
+		return fmt.Errorf("message storage failed: %w", err)
+	}
+	app.Log.Warnf("recovered missing message %d", msg.RawMessage.ID)
+	report.RecoveredMessages = append(report.RecoveredMessages, msg.RawMessage.ID)
+
+	if err := app.publishMessage(msg); err != nil {
+		app.Log.WithError(err).Error("message notification failed")
+	}
+
+	return nil
+}
+
+// groupMessagePayments groups payments carrying the same message,
+// based on their payload records.
+// Since a message is sent once to each recipient, payments
+// to the same recipient are assigned to different groups.
+func groupMessagePayments(payments []*model.Payment) [][]*model.Payment {
+	var groups [][]*model.Payment
+	for _, p := range payments {
+		records := p.GetCustomRecords()[0]
+
+		found := false
+		for i, group := range groups {
+			if !samePayloadRecords(c13nPayloadKeys,
+				group[0].GetCustomRecords()[0], records) {
+				continue
+			}
+
+			sameRecipient := false
+			for _, q := range group {
+				if q.PayeeAddress == p.PayeeAddress {
+					sameRecipient = true
+					break
+				}
+			}
+			if !sameRecipient {
+				groups[i] = append(group, p)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, []*model.Payment{p})
+		}
+	}
+
+	return groups
+}
+
+// carriesPayload returns whether a set of HTLC records carries a payload,
+// following any registered payload codec.
+// Attachment chunks are not considered to carry a payload, since
+// attachment messages are stored once all chunks are transferred.
+func carriesPayload(records []map[uint64][]byte) bool {
+	if len(records) == 0 || isAttachmentChunk(records) {
+		return false
+	}
+	_, ok := matchPayloadCodec(records[0])
+
+	return ok
+}
+
+// resolveTimeNs returns the time a payment was resolved,
+// or its creation time if none of its HTLCs is resolved.
+func resolveTimeNs(p *lnchat.Payment) int64 {
+	resolveTimeNs := p.CreationTimeNs
+	for _, h := range p.Htlcs {
+		if h.ResolveTimeNs > resolveTimeNs {
+			resolveTimeNs = h.ResolveTimeNs
+		}
+	}
+
+	return resolveTimeNs
+}
@@ -0,0 +1,204 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/lnchat/simnet"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// sendReconciliationMessages sends a message from alice to a discussion
+// with bob and carol, followed by a message to carol,
+// returning the latter along with the cleanup of the sending application.
+func sendReconciliationMessages(ctx context.Context, t *testing.T,
+	alice, bob, carol *simnet.Node) (*model.MessageAggregate, func()) {
+
+	sender, cleanup := createSimnetApp(t, alice)
+
+	group, err := sender.AddDiscussion(ctx, &model.Discussion{
+		Participants: []string{bob.Address(), carol.Address()},
+	})
+	require.NoError(t, err)
+	_, err = sender.SendMessage(ctx, group.ID, 1000, "",
+		"hello all", model.MessageOptions{})
+	require.NoError(t, err)
+
+	disc, err := sender.AddDiscussion(ctx, &model.Discussion{
+		Participants: []string{carol.Address()},
+	})
+	require.NoError(t, err)
+	sent, err := sender.SendMessage(ctx, disc.ID, 1000, "",
+		"hello carol", model.MessageOptions{})
+	require.NoError(t, err)
+	require.Len(t, sent.Payments, 1)
+
+	return sent, cleanup
+}
+
+func TestReconcile(t *testing.T) {
+	defer func(gracePeriod time.Duration) {
+		reconciliationGracePeriod = gracePeriod
+	}(reconciliationGracePeriod)
+	reconciliationGracePeriod = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	net := simnet.NewNetwork()
+	alice, err := net.AddNode("alice", 10000000)
+	require.NoError(t, err)
+	bob, err := net.AddNode("bob", 10000000)
+	require.NoError(t, err)
+	carol, err := net.AddNode("carol", 10000000)
+	require.NoError(t, err)
+	_, err = net.ConnectNodes(alice, bob, 1000000, 0, false)
+	require.NoError(t, err)
+	_, err = net.ConnectNodes(bob, carol, 1000000, 0, false)
+	require.NoError(t, err)
+
+	// The sending application remains active, since closing it
+	// closes the underlying node.
+	sent, cleanupSender := sendReconciliationMessages(ctx, t, alice, bob, carol)
+	defer cleanupSender()
+
+	t.Run("payments", func(t *testing.T) {
+		// Only the last payment was stored, without its message.
+		db := createSimnetDB(t)
+		require.NoError(t, db.AddPayments(sent.Payments[0]))
+
+		app, cleanup := initSimnetApp(t, alice, db)
+		defer cleanup()
+
+		report, err := app.Reconcile(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.PaymentsChecked)
+		assert.Len(t, report.BackfilledPayments, 2)
+		assert.NotContains(t, report.BackfilledPayments,
+			sent.Payments[0].PaymentIndex)
+		assert.Len(t, report.RecoveredMessages, 2)
+		assert.Zero(t, report.Failures)
+
+		discs, err := app.GetDiscussions(ctx)
+		require.NoError(t, err)
+		require.Len(t, discs, 2)
+		for _, disc := range discs {
+			msgs, err := app.GetDiscussionHistory(ctx, disc.ID,
+				model.PageOptions{PageSize: 10})
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+			assert.Len(t, msgs[0].Payments, len(disc.Participants))
+			assert.Equal(t, alice.Address(), msgs[0].RawMessage.Sender)
+			assert.True(t, msgs[0].RawMessage.SignatureVerified)
+		}
+
+		// Reconciliation is idempotent.
+		report, err = app.Reconcile(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.PaymentsChecked)
+		assert.Empty(t, report.BackfilledPayments)
+		assert.Empty(t, report.RecoveredMessages)
+	})
+
+	t.Run("invoices on startup", func(t *testing.T) {
+		// Only the last invoice was stored, without its message.
+		invoices, err := carol.ListInvoices(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, invoices, 2)
+
+		db := createSimnetDB(t)
+		require.NoError(t, db.AddInvoice(&model.Invoice{
+			CreatorAddress: carol.Address(),
+			Invoice:        *invoices[1],
+		}))
+
+		app, cleanup := initSimnetApp(t, carol, db, WithReconciliation(true, 0))
+		defer cleanup()
+
+		require.Eventually(t, func() bool {
+			for _, inv := range invoices {
+				has, err := db.HasInvoiceMessage(inv.SettleIndex)
+				if err != nil || !has {
+					return false
+				}
+			}
+			return true
+		}, 5*time.Second, 10*time.Millisecond)
+
+		report, err := app.Reconcile(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.InvoicesChecked)
+		assert.Empty(t, report.BackfilledInvoices)
+		assert.Empty(t, report.RecoveredMessages)
+
+		discs, err := app.GetDiscussions(ctx)
+		require.NoError(t, err)
+		require.Len(t, discs, 2)
+		for _, disc := range discs {
+			assert.Contains(t, disc.Participants, alice.Address())
+		}
+	})
+}
+
+func TestReconcileKeysendInvoice(t *testing.T) {
+	defer func(gracePeriod time.Duration) {
+		reconciliationGracePeriod = gracePeriod
+	}(reconciliationGracePeriod)
+	reconciliationGracePeriod = 0
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	net := simnet.NewNetwork()
+	alice, err := net.AddNode("alice", 10000000)
+	require.NoError(t, err)
+	bob, err := net.AddNode("bob", 10000000)
+	require.NoError(t, err)
+	_, err = net.ConnectNodes(alice, bob, 1000000, 0, false)
+	require.NoError(t, err)
+
+	// Keysend messages received while no application was running.
+	for _, text := range []string{"hello bob", "hello again"} {
+		updates, err := alice.SendPayment(ctx, bob.Address(),
+			lnchat.NewAmount(1000), "", lnchat.PaymentOptions{FeeLimitMsat: 1000},
+			map[uint64][]byte{KeysendMessageTypeKey: []byte(text)},
+			defaultPaymentFilter)
+		require.NoError(t, err)
+		for u := range updates {
+			require.NoError(t, u.Err)
+			require.Equal(t, lnchat.PaymentSUCCEEDED, u.Payment.Status)
+		}
+	}
+
+	// Only the last invoice was stored, without its message.
+	invoices, err := bob.ListInvoices(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, invoices, 2)
+
+	db := createSimnetDB(t)
+	require.NoError(t, db.AddInvoice(&model.Invoice{
+		CreatorAddress: bob.Address(),
+		Invoice:        *invoices[1],
+	}))
+
+	app, cleanup := initSimnetApp(t, bob, db)
+	defer cleanup()
+
+	report, err := app.Reconcile(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.InvoicesChecked)
+	assert.Len(t, report.BackfilledInvoices, 1)
+	assert.Len(t, report.RecoveredMessages, 2)
+	assert.Zero(t, report.Failures)
+
+	for _, inv := range invoices {
+		has, err := db.HasInvoiceMessage(inv.SettleIndex)
+		require.NoError(t, err)
+		assert.True(t, has)
+	}
+}
@@ -0,0 +1,174 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// RetryPolicy controls the retries of failed payments.
+// The zero value does not retry payments.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a failed payment is retried.
+	MaxRetries int
+	// FeeLimitFactor is the factor the fee limit is multiplied by
+	// when retrying payments that failed for lack of a route
+	// or due to insufficient fees, up to the maximum fee limit
+	// of the discussion. Values up to 1 do not raise the fee limit.
+	FeeLimitFactor float64
+	// Delay is the time to wait before retrying payments that timed out,
+	// or that failed for lack of a route or due to insufficient fees
+	// when the fee limit cannot be raised. A value of 0 disables such retries.
+	Delay time.Duration
+}
+
+// WithRetryPolicy sets the policy for retrying failed payments.
+func WithRetryPolicy(policy RetryPolicy) func(*App) error {
+	return func(app *App) error {
+		switch {
+		case policy.MaxRetries < 0:
+			return fmt.Errorf("invalid maximum payment retries %d", policy.MaxRetries)
+		case policy.FeeLimitFactor < 0:
+			return fmt.Errorf("invalid fee limit factor %v", policy.FeeLimitFactor)
+		case policy.Delay < 0:
+			return fmt.Errorf("invalid payment retry delay %s", policy.Delay)
+		}
+
+		app.retryPolicy = policy
+		return nil
+	}
+}
+
+// raiseFeeLimit returns the raised fee limit for a retry,
+// capped by maxFeeLimitMsat.
+func (p RetryPolicy) raiseFeeLimit(feeLimitMsat, maxFeeLimitMsat int64) int64 {
+	if p.FeeLimitFactor <= 1 || feeLimitMsat >= maxFeeLimitMsat {
+		return feeLimitMsat
+	}
+
+	raised := int64(float64(feeLimitMsat) * p.FeeLimitFactor)
+	if raised <= feeLimitMsat || raised > maxFeeLimitMsat {
+		raised = maxFeeLimitMsat
+	}
+
+	return raised
+}
+
+// next returns the payment options and the delay for retrying
+// a payment that failed with the provided category,
+// or false if the payment should not be retried.
+// Retries may raise the fee limit up to maxFeeLimitMsat.
+func (p RetryPolicy) next(retries int, failure lnchat.FailureCategory,
+	opts lnchat.PaymentOptions, maxFeeLimitMsat int64) (
+	lnchat.PaymentOptions, time.Duration, bool) {
+
+	if retries >= p.MaxRetries {
+		return opts, 0, false
+	}
+
+	switch failure {
+	case lnchat.FailureNOROUTE, lnchat.FailureFEETOOLOW:
+		if feeLimit := p.raiseFeeLimit(opts.FeeLimitMsat,
+			maxFeeLimitMsat); feeLimit > opts.FeeLimitMsat {
+
+			opts.FeeLimitMsat = feeLimit
+			return opts, 0, true
+		}
+	case lnchat.FailureTIMEOUT:
+	default:
+		return opts, 0, false
+	}
+
+	// Retry with the same options after the delay.
+	return opts, p.Delay, p.Delay > 0
+}
+
+// classifyPaymentError returns the failure category of a payment error.
+func classifyPaymentError(err error) lnchat.FailureCategory {
+	switch {
+	case errors.Is(err, lnchat.ErrNoRouteFound):
+		return lnchat.FailureNOROUTE
+	case errors.Is(err, lnchat.ErrInsufficientBalance):
+		return lnchat.FailureINSUFFICIENTBALANCE
+	default:
+		return lnchat.FailureUNKNOWN
+	}
+}
+
+// send attempts a payment, retrying it according to the retry policy,
+// and returns the final payment update of the last attempt.
+// The update of a failed payment carries the error
+// corresponding to its failure category.
+// Retries may raise the fee limit up to maxFeeLimitMsat.
+func (app *App) send(ctx context.Context, diag *routeDiagnostics,
+	dest string, amtMsat int64, payReq string,
+	opts lnchat.PaymentOptions, maxFeeLimitMsat int64,
+	tlvs map[uint64][]byte) (lnchat.PaymentUpdate, error) {
+
+	for retries := 0; ; retries++ {
+		update, err := app.sendOnce(ctx, dest, amtMsat, payReq, opts, tlvs)
+
+		var failure lnchat.FailureCategory
+		switch {
+		case err != nil:
+			failure = classifyPaymentError(err)
+		case update.Err != nil:
+			failure = classifyPaymentError(update.Err)
+		default:
+			failure = update.Payment.ClassifyFailure()
+		}
+
+		retryOpts, delay, retry := app.retryPolicy.next(retries,
+			failure, opts, maxFeeLimitMsat)
+		if retry {
+			app.Log.Debugf("Retrying payment to %s after %v failure "+
+				"(fee limit %d msat, delay %v)", dest, failure,
+				retryOpts.FeeLimitMsat, delay)
+
+			select {
+			case <-ctx.Done():
+				retry = false
+			case <-time.After(delay):
+			}
+		}
+		if !retry {
+			if err != nil {
+				return update, diag.attach(ctx, dest, err)
+			}
+			if update.Err == nil && update.Payment != nil &&
+				update.Payment.Status == lnchat.PaymentFAILED {
+
+				update.Err = failure.Err()
+			}
+			// lnd reports the lack of a route as a failed payment,
+			// so diagnostics are attached to no route failures too.
+			update.Err = diag.attach(ctx, dest, update.Err)
+			return update, nil
+		}
+
+		opts = retryOpts
+	}
+}
+
+// sendOnce attempts a payment and returns the final payment update.
+func (app *App) sendOnce(ctx context.Context, dest string, amtMsat int64, payReq string,
+	opts lnchat.PaymentOptions, tlvs map[uint64][]byte) (lnchat.PaymentUpdate, error) {
+
+	var lastUpdate lnchat.PaymentUpdate
+
+	amt := lnchat.NewAmount(amtMsat)
+	updates, err := app.LNManager.SendPayment(ctx, dest, amt, payReq,
+		opts, tlvs, defaultPaymentFilter)
+	if err != nil {
+		return lastUpdate, err
+	}
+
+	for update := range updates {
+		lastUpdate = update
+	}
+
+	return lastUpdate, nil
+}
@@ -0,0 +1,194 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+// paymentUpdates returns a closed channel carrying the provided payment.
+func paymentUpdates(p *lnchat.Payment) <-chan lnchat.PaymentUpdate {
+	ch := make(chan lnchat.PaymentUpdate, 1)
+	ch <- lnchat.PaymentUpdate{Payment: p}
+	close(ch)
+
+	return ch
+}
+
+func TestRetryPolicyNext(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries:     2,
+		FeeLimitFactor: 2,
+		Delay:          time.Second,
+	}
+	opts := lnchat.PaymentOptions{FeeLimitMsat: 1000}
+
+	cases := []struct {
+		name             string
+		retries          int
+		failure          lnchat.FailureCategory
+		maxFeeLimitMsat  int64
+		expectedFeeLimit int64
+		expectedDelay    time.Duration
+		expectedRetry    bool
+	}{
+		{
+			name:             "fee raised",
+			failure:          lnchat.FailureFEETOOLOW,
+			maxFeeLimitMsat:  5000,
+			expectedFeeLimit: 2000,
+			expectedRetry:    true,
+		},
+		{
+			name:             "fee raised up to cap",
+			failure:          lnchat.FailureNOROUTE,
+			maxFeeLimitMsat:  1500,
+			expectedFeeLimit: 1500,
+			expectedRetry:    true,
+		},
+		{
+			name:             "fee at cap",
+			failure:          lnchat.FailureNOROUTE,
+			maxFeeLimitMsat:  1000,
+			expectedFeeLimit: 1000,
+			expectedDelay:    time.Second,
+			expectedRetry:    true,
+		},
+		{
+			name:             "timeout",
+			failure:          lnchat.FailureTIMEOUT,
+			maxFeeLimitMsat:  5000,
+			expectedFeeLimit: 1000,
+			expectedDelay:    time.Second,
+			expectedRetry:    true,
+		},
+		{
+			name:             "insufficient balance",
+			failure:          lnchat.FailureINSUFFICIENTBALANCE,
+			maxFeeLimitMsat:  5000,
+			expectedFeeLimit: 1000,
+		},
+		{
+			name:             "retries exhausted",
+			retries:          2,
+			failure:          lnchat.FailureFEETOOLOW,
+			maxFeeLimitMsat:  5000,
+			expectedFeeLimit: 1000,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			res, delay, retry := policy.next(c.retries, c.failure, opts, c.maxFeeLimitMsat)
+			assert.Equal(t, c.expectedRetry, retry)
+			if retry {
+				assert.Equal(t, c.expectedFeeLimit, res.FeeLimitMsat)
+				assert.Equal(t, c.expectedDelay, delay)
+			}
+		})
+	}
+}
+
+func TestSendRetries(t *testing.T) {
+	dest := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	failed := &lnchat.Payment{
+		Status: lnchat.PaymentFAILED,
+		Htlcs: []lnchat.HTLCAttempt{{
+			Status: lnrpc.HTLCAttempt_FAILED,
+			Failure: &lnchat.HTLCFailure{
+				Code:      lnrpc.Failure_FEE_INSUFFICIENT,
+				NodeIndex: 1,
+			},
+		}},
+	}
+	succeeded := &lnchat.Payment{Status: lnchat.PaymentSUCCEEDED}
+
+	mockLNManager := new(lnmock.LightManager)
+	app, err := New(mockLNManager, new(dbmock.Database),
+		WithRetryPolicy(RetryPolicy{MaxRetries: 3, FeeLimitFactor: 2}))
+	require.NoError(t, err)
+
+	for _, feeLimit := range []int64{1000, 2000} {
+		mockLNManager.On("SendPayment", mock.Anything, dest, lnchat.NewAmount(5000), "",
+			lnchat.PaymentOptions{FeeLimitMsat: feeLimit}, map[uint64][]byte(nil),
+			mock.Anything).Return(paymentUpdates(failed), nil).Once()
+	}
+	mockLNManager.On("SendPayment", mock.Anything, dest, lnchat.NewAmount(5000), "",
+		lnchat.PaymentOptions{FeeLimitMsat: 3000}, map[uint64][]byte(nil),
+		mock.Anything).Return(paymentUpdates(succeeded), nil).Once()
+
+	update, err := app.send(context.Background(), app.newRouteDiagnostics(),
+		dest, 5000, "", lnchat.PaymentOptions{FeeLimitMsat: 1000}, 3000, nil)
+	require.NoError(t, err)
+	require.NoError(t, update.Err)
+	assert.Equal(t, succeeded, update.Payment)
+
+	mockLNManager.AssertExpectations(t)
+}
+
+func TestSendPaymentFailure(t *testing.T) {
+	dest := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	failed := &lnchat.Payment{
+		Status:        lnchat.PaymentFAILED,
+		FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE,
+	}
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	app, err := New(mockLNManager, mockDB,
+		WithRetryPolicy(RetryPolicy{MaxRetries: 3, FeeLimitFactor: 2}))
+	require.NoError(t, err)
+
+	opts := lnchat.PaymentOptions{FeeLimitMsat: 1000}
+	mockLNManager.On("SendPayment", mock.Anything, dest, lnchat.NewAmount(5000), "",
+		opts, map[uint64][]byte(nil), mock.Anything).
+		Return(paymentUpdates(failed), nil).Once()
+	mockDB.On("AddPayments", mock.AnythingOfType("*model.Payment")).Return(nil).Once()
+
+	payment, err := app.SendPayment(context.Background(), dest, 5000, "", opts, nil)
+	require.NotNil(t, payment)
+	assert.Equal(t, lnchat.PaymentFAILED, payment.Status)
+
+	var appErr Error
+	require.ErrorAs(t, err, &appErr)
+	assert.Equal(t, InsufficientBalance, appErr.Kind)
+
+	mockLNManager.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+}
+
+func TestSendMessagePaymentsFailure(t *testing.T) {
+	dest := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	failed := &lnchat.Payment{
+		Status:        lnchat.PaymentFAILED,
+		FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE,
+	}
+
+	mockDB := new(dbmock.Database)
+	app, err := New(new(lnmock.LightManager), mockDB)
+	require.NoError(t, err)
+
+	disc := &model.Discussion{ID: 1, Participants: []string{dest}}
+	msg, err := app.sendMessagePayments(disc, &model.RawMessage{},
+		func(string) (lnchat.PaymentUpdate, error) {
+			return lnchat.PaymentUpdate{Payment: failed}, nil
+		})
+	assert.Nil(t, msg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), lnchat.ErrInsufficientBalance.Error())
+
+	// The message was not delivered, so it is not stored.
+	mockDB.AssertNotCalled(t, "AddRawMessage", mock.Anything)
+}
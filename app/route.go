@@ -12,6 +12,11 @@ import (
 	"github.com/c13n-io/c13n-go/model"
 )
 
+// ErrRouteMismatch indicates that the provided routes
+// do not correspond to the discussion participants.
+var ErrRouteMismatch = fmt.Errorf("routes do not correspond " +
+	"to discussion participants")
+
 // GetRoute attempts to discover a route that can fulfil a payment.
 func (app *App) GetRoute(ctx context.Context, dest string, amtMsat int64,
 	payReq string, opts lnchat.PaymentOptions) (*model.Route, error) {
@@ -19,7 +24,8 @@ func (app *App) GetRoute(ctx context.Context, dest string, amtMsat int64,
 	route, _, err := app.LNManager.GetRoute(ctx,
 		dest, lnchat.NewAmount(amtMsat), payReq, opts, nil)
 	if err != nil {
-		return nil, fmt.Errorf("could not find route: %w", err)
+		return nil, fmt.Errorf("could not find route: %w",
+			app.withRouteDiagnostics(ctx, dest, err))
 	}
 
 	return newRoute(route), nil
@@ -37,6 +43,7 @@ func newRoute(route *lnchat.Route) *model.Route {
 			HopAddress:       hop.NodeID.String(),
 			AmtToForwardMsat: hop.AmtToForward.Msat(),
 			FeeMsat:          hop.Fees.Msat(),
+			Expiry:           hop.Expiry,
 			CustomRecords:    hop.CustomRecords,
 		}
 	}
@@ -53,6 +60,8 @@ func newRoute(route *lnchat.Route) *model.Route {
 // sending a payment (or message) to a discussion.
 // If the discussion contains multiple participants,
 // one route for each participant is calculated and the fees are cumulative.
+// The estimate includes the onion space remaining after the payload
+// across the discovered routes, which is negative if the payload does not fit.
 func (app *App) EstimatePayment(ctx context.Context,
 	payload string, amtMsat int64, discID uint64,
 	opts model.MessageOptions) (*model.Message, error) {
@@ -72,7 +81,7 @@ func (app *App) EstimatePayment(ctx context.Context,
 	}
 
 	// Create a raw message.
-	rawMsg, err := app.createRawMessage(ctx, discussion, payload, !options.Anonymous)
+	rawMsg, err := app.createRawMessage(ctx, discussion, nil, payload, !options.Anonymous)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +90,7 @@ func (app *App) EstimatePayment(ctx context.Context,
 
 	var totalProb = 1.
 	routes := make(map[string]lnchat.Route)
+	diag := app.newRouteDiagnostics()
 	var errs []error
 	for _, recipient := range discussion.Participants {
 		route, prob, err := app.LNManager.GetRoute(ctx, recipient,
@@ -90,7 +100,8 @@ func (app *App) EstimatePayment(ctx context.Context,
 			routes[recipient] = *route
 		default:
 			errs = append(errs, fmt.Errorf("could not "+
-				"find route to %s: %w", recipient, err))
+				"find route to %s: %w", recipient,
+				diag.attach(ctx, recipient, err)))
 		}
 		totalProb *= prob
 	}
@@ -132,6 +143,97 @@ func (app *App) EstimatePayment(ctx context.Context,
 	}
 
 	msg.SuccessProb = totalProb
+	msg.PayloadBudgetBytes = int64(routesPayloadBudget(routes, paymentPayload))
 
 	return msg, nil
 }
+
+// routeFromModel creates an lnchat.Route from a model.Route.
+func routeFromModel(route model.Route) (*lnchat.Route, error) {
+	hops := make([]lnchat.RouteHop, len(route.RouteHops))
+	for i, hop := range route.RouteHops {
+		node, err := lnchat.NewNodeFromString(hop.HopAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hop address %q: %w",
+				hop.HopAddress, err)
+		}
+
+		hops[i] = lnchat.RouteHop{
+			ChannelID:    hop.ChanID,
+			NodeID:       node,
+			AmtToForward: lnchat.NewAmount(hop.AmtToForwardMsat),
+			Fees:         lnchat.NewAmount(hop.FeeMsat),
+			Expiry:       hop.Expiry,
+		}
+	}
+
+	return &lnchat.Route{
+		TimeLock: route.TotalTimeLock,
+		Amt:      lnchat.NewAmount(route.RouteAmtMsat),
+		Fees:     lnchat.NewAmount(route.RouteFeesMsat),
+		Hops:     hops,
+	}, nil
+}
+
+// SendMessageToRoutes sends a message to a discussion along the provided
+// routes, exactly one for each discussion participant.
+// Routes are expected to be the ones returned by EstimatePayment,
+// so that the sent amount and fees match the estimated ones.
+func (app *App) SendMessageToRoutes(ctx context.Context, discID uint64,
+	payload string, routes []model.Route, opts model.MessageOptions) (
+	*model.MessageAggregate, error) {
+
+	disc, err := app.retrieveDiscussion(ctx, discID)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve discussion")
+	}
+
+	// Disallow anonymous messages in group discussions
+	options := overrideOptions(disc.Options, true, opts)
+	if len(disc.Participants) > 1 && options.Anonymous {
+		return nil, ErrDiscAnonymousMessage
+	}
+
+	// Associate each route with the participant it reaches.
+	if len(routes) != len(disc.Participants) {
+		return nil, ErrRouteMismatch
+	}
+	participantRoutes := make(map[string]*lnchat.Route, len(routes))
+	for _, r := range routes {
+		route, err := routeFromModel(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(route.Hops) == 0 {
+			return nil, ErrRouteMismatch
+		}
+		dest := route.Hops[len(route.Hops)-1].NodeID.String()
+		participantRoutes[dest] = route
+	}
+	for _, p := range disc.Participants {
+		if _, ok := participantRoutes[p]; !ok {
+			return nil, ErrRouteMismatch
+		}
+	}
+
+	// Create raw message
+	rawMsg, err := app.createRawMessage(ctx, disc, nil, payload, !options.Anonymous)
+	if err != nil {
+		return nil, err
+	}
+
+	tlvs := marshalPayload(rawMsg)
+	if err := checkPayloadSize(tlvs); err != nil {
+		return nil, err
+	}
+
+	return app.sendMessagePayments(disc, rawMsg,
+		func(dest string) (lnchat.PaymentUpdate, error) {
+			payment, err := app.LNManager.SendToRoute(ctx,
+				participantRoutes[dest], tlvs)
+			if err != nil {
+				return lnchat.PaymentUpdate{}, err
+			}
+			return lnchat.PaymentUpdate{Payment: payment}, nil
+		})
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -20,18 +21,35 @@ func payOptsWithFeeLimit(feeLimit int64) lnchat.PaymentOptions {
 	return DefaultOptions.WithFeeLimit(feeLimit).GetPaymentOptions()
 }
 
-func mustCreatePayload(t *testing.T, participants []string,
-	payload, sender string, signature []byte) map[uint64][]byte {
+// payloadMatching matches raw payloads carrying
+// the provided participants and message.
+func payloadMatching(participants []string, payload string) interface{} {
+	return mock.MatchedBy(func(rawPayload []byte) bool {
+		raw := &model.RawMessage{RawPayload: rawPayload}
+		message, ps, err := raw.UnmarshalPayload()
+		return err == nil && message == payload &&
+			assert.ObjectsAreEqual(participants, ps)
+	})
+}
 
-	rawPayload := mustJSONMarshalMessage(t, participants, payload)
+// payloadRecordsMatching matches payload records carrying the provided
+// participants, message, sender and signature.
+func payloadRecordsMatching(participants []string,
+	payload, sender string, signature []byte) interface{} {
 
-	raw := &model.RawMessage{
-		RawPayload: rawPayload,
-		Sender:     sender,
-		Signature:  signature,
+	senderNode, err := lnchat.NewNodeFromString(sender)
+	if err != nil {
+		panic(err)
 	}
 
-	return marshalPayload(raw)
+	return mock.MatchedBy(func(records map[uint64][]byte) bool {
+		raw := &model.RawMessage{RawPayload: records[PayloadTypeKey]}
+		message, ps, err := raw.UnmarshalPayload()
+		return err == nil && message == payload &&
+			assert.ObjectsAreEqual(participants, ps) &&
+			bytes.Equal(records[SenderTypeKey], senderNode.Bytes()) &&
+			bytes.Equal(records[SignatureTypeKey], signature)
+	})
 }
 
 func TestEstimatePayment(t *testing.T) {
@@ -53,7 +71,7 @@ func TestEstimatePayment(t *testing.T) {
 		amt           int64
 		payReq        string
 		payOpts       lnchat.PaymentOptions
-		payload       map[uint64][]byte
+		payload       interface{}
 		expectedRoute *lnchat.Route
 		expectedProb  float64
 		expectedErr   error
@@ -108,7 +126,7 @@ func TestEstimatePayment(t *testing.T) {
 					amt:       1023,
 					payReq:    "",
 					payOpts:   payOptsWithFeeLimit(3200),
-					payload: mustCreatePayload(t, discussions[0].Participants,
+					payload: payloadRecordsMatching(discussions[0].Participants,
 						testPayload, srcAddress, []byte("dummy signature")),
 					expectedRoute: &lnchat.Route{
 						TimeLock: 321,
@@ -145,6 +163,7 @@ func TestEstimatePayment(t *testing.T) {
 								HopAddress:       destNode.String(),
 								AmtToForwardMsat: 1023,
 								FeeMsat:          0,
+								Expiry:           333,
 							},
 						},
 					},
@@ -152,6 +171,8 @@ func TestEstimatePayment(t *testing.T) {
 				PreimageHash: zeroHash[:],
 				Preimage:     zeroPreimage,
 				SuccessProb:  .75,
+				// 1300 - (3 + 58 + 205 + 32) for a single-hop route.
+				PayloadBudgetBytes: 1002,
 			},
 			expectedErr: nil,
 		},
@@ -195,7 +216,7 @@ func TestEstimatePayment(t *testing.T) {
 					amt:       103,
 					payReq:    "",
 					payOpts:   payOptsWithFeeLimit(3200),
-					payload: mustCreatePayload(t, discussions[0].Participants,
+					payload: payloadRecordsMatching(discussions[0].Participants,
 						"test should fail to find route", srcAddress, []byte("dummy signature")),
 					expectedRoute: nil,
 					expectedProb:  .0,
@@ -217,20 +238,23 @@ func TestEstimatePayment(t *testing.T) {
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(0), nil).Once()
 				mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(0),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
 					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 				mockDB.On("GetDiscussion", c.discID).Return(
 					c.discussion, c.getDiscussionErr).Once()
 
 				if c.getDiscussionErr == nil {
 					if !c.opts.Anonymous {
-						marshalledPayload := mustJSONMarshalMessage(t,
-							c.discussion.Participants, c.payload)
-						mockLNManager.On("SignMessage", mock.Anything, marshalledPayload).Return(
+						mockLNManager.On("SignMessage", mock.Anything,
+							payloadMatching(c.discussion.Participants, c.payload)).Return(
 							c.signature, c.signMessageErr).Once()
 					}
 
@@ -276,3 +300,170 @@ func TestEstimatePayment(t *testing.T) {
 		})
 	}
 }
+
+func TestSendMessageToRoutes(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+	otherAddress := "222222222222222222222222222222222222222222222222222222222222222222"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: srcAddress,
+		},
+	}
+
+	destNode, err := lnchat.NewNodeFromString(destAddress)
+	require.NoError(t, err)
+
+	discussion := &model.Discussion{
+		ID:           42,
+		Participants: []string{destAddress},
+		Options:      DefaultOptions,
+	}
+
+	route := model.Route{
+		TotalTimeLock: 321,
+		RouteAmtMsat:  1023,
+		RouteFeesMsat: 12,
+		RouteHops: []model.Hop{
+			{
+				ChanID:           0x01,
+				HopAddress:       destAddress,
+				AmtToForwardMsat: 1023,
+				FeeMsat:          12,
+				Expiry:           333,
+			},
+		},
+	}
+
+	expectedRoute := &lnchat.Route{
+		TimeLock: 321,
+		Amt:      lnchat.NewAmount(1023),
+		Fees:     lnchat.NewAmount(12),
+		Hops: []lnchat.RouteHop{
+			{
+				ChannelID:    0x01,
+				NodeID:       destNode,
+				AmtToForward: lnchat.NewAmount(1023),
+				Fees:         lnchat.NewAmount(12),
+				Expiry:       333,
+			},
+		},
+	}
+
+	payment := &lnchat.Payment{
+		Hash:         "1111111111111111111111111111111111111111111111111111111111111111",
+		Preimage:     "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Value:        lnchat.NewAmount(1023),
+		Status:       lnchat.PaymentSUCCEEDED,
+		PaymentIndex: 7,
+	}
+
+	payload := "test payload"
+
+	cases := []struct {
+		name           string
+		routes         []model.Route
+		sendToRouteErr error
+		expectedErr    error
+	}{
+		{
+			name:   "Success",
+			routes: []model.Route{route},
+		},
+		{
+			name: "Route to non participant",
+			routes: []model.Route{
+				{
+					RouteHops: []model.Hop{
+						{
+							ChanID:     0x02,
+							HopAddress: otherAddress,
+						},
+					},
+				},
+			},
+			expectedErr: ErrRouteMismatch,
+		},
+		{
+			name:        "Missing route",
+			expectedErr: ErrRouteMismatch,
+		},
+		{
+			name:           "SendToRoute error",
+			routes:         []model.Route{route},
+			sendToRouteErr: fmt.Errorf("some error"),
+			expectedErr: fmt.Errorf("could not initiate payment "+
+				"to recipient %s: some error", destAddress),
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+				mockDB.On("GetLastInvoiceIndex").Return(uint64(0), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(3), nil).Once()
+				mockDB.On("GetPersistentPeers").Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(0),
+					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(3),
+					mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+				mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+				mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
+
+				mockDB.On("GetDiscussion", discussion.ID).Return(discussion, nil).Once()
+
+				mockLNManager.On("SignMessage", mock.Anything, mock.Anything).Return(
+					[]byte("sig"), nil).Once()
+
+				mockLNManager.On("SendToRoute", mock.Anything, expectedRoute,
+					mock.Anything).Return(payment, c.sendToRouteErr).Once()
+
+				mockDB.On("AddPayments", &model.Payment{
+					PayerAddress: srcAddress,
+					PayeeAddress: destAddress,
+					Payment:      *payment,
+				}).Return(nil).Once()
+				mockDB.On("AddRawMessage", mock.AnythingOfType("*model.RawMessage")).Return(
+					nil).Once()
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+			defer cancel()
+
+			msg, err := app.SendMessageToRoutes(ctxt,
+				discussion.ID, payload, c.routes, model.MessageOptions{})
+			if c.expectedErr != nil {
+				assert.Nil(t, msg)
+				assert.EqualError(t, err, c.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, discussion.ID, msg.RawMessage.DiscussionID)
+			assert.Equal(t, []uint64{payment.PaymentIndex}, msg.RawMessage.PaymentIndexes)
+			assert.Len(t, msg.Payments, 1)
+		})
+	}
+}
@@ -3,9 +3,15 @@ package app
 import (
 	"context"
 
+	"github.com/c13n-io/c13n-go/lnchat"
 	"github.com/c13n-io/c13n-go/model"
 )
 
+// GetConnectionState returns the state of the connection to the underlying node.
+func (app *App) GetConnectionState() lnchat.ConnectionState {
+	return app.LNManager.GetConnectionState()
+}
+
 // GetSelfInfo returns the current node's information.
 func (app *App) GetSelfInfo(ctx context.Context) (*model.SelfInfo, error) {
 	info, err := app.LNManager.GetSelfInfo(ctx)
@@ -35,11 +35,15 @@ func TestGetSelfInfoSuccess(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -111,11 +115,15 @@ func TestGetSelfBalanceSuccess(t *testing.T) {
 
 		mockDB.On("GetLastInvoiceIndex").Return(uint64(1), nil).Once()
 		mockDB.On("GetLastPaymentIndex").Return(uint64(42), nil).Once()
+		mockDB.On("GetPersistentPeers").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(1),
 			mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 		mockLNManager.On("SubscribePaymentUpdates", mock.Anything, uint64(42),
 			mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(nil, nil)
+		mockLNManager.On("SubscribeChannelEvents", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribeCustomMessages", mock.Anything).Return(nil, nil)
+		mockLNManager.On("SubscribePeerEvents", mock.Anything).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -144,3 +152,14 @@ func TestGetSelfBalanceSuccess(t *testing.T) {
 	assert.NoError(t, err)
 	assert.EqualValues(t, expectedSelf, self)
 }
+
+func TestGetConnectionState(t *testing.T) {
+	mockLNManager := new(lnmock.LightManager)
+	app, err := New(mockLNManager, new(dbmock.Database))
+	assert.NoError(t, err)
+
+	mockLNManager.On("GetConnectionState").Return(lnchat.ConnectionSYNCING).Once()
+
+	assert.Equal(t, lnchat.ConnectionSYNCING, app.GetConnectionState())
+	mockLNManager.AssertExpectations(t)
+}
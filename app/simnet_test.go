@@ -0,0 +1,212 @@
+package app
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/lnchat/simnet"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+)
+
+// createSimnetApp creates an initialized application
+// backed by a simulated node and an in-memory database.
+func createSimnetApp(t *testing.T, node *simnet.Node) (*App, func()) {
+	return initSimnetApp(t, node, createSimnetDB(t))
+}
+
+// createSimnetDB creates an in-memory database.
+func createSimnetDB(t *testing.T) store.Database {
+	db, err := store.New("", store.WithBadgerOption(
+		func(o badger.Options) badger.Options {
+			o = o.WithInMemory(true)
+			o = o.WithEncryptionKey([]byte("1234567890123456"))
+			o = o.WithIndexCacheSize(1 << 20)
+			return o
+		}),
+	)
+	require.NoError(t, err)
+
+	return db
+}
+
+// initSimnetApp creates an initialized application
+// backed by a simulated node and the provided database.
+func initSimnetApp(t *testing.T, node *simnet.Node, db store.Database,
+	options ...func(*App) error) (*App, func()) {
+
+	app, err := New(node, db, options...)
+	require.NoError(t, err)
+	require.NoError(t, app.Init(context.Background(), 15))
+
+	return app, func() {
+		require.NoError(t, app.Cleanup())
+	}
+}
+
+func TestSimnetSendMessage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	net := simnet.NewNetwork()
+	alice, err := net.AddNode("alice", 10000000)
+	require.NoError(t, err)
+	bob, err := net.AddNode("bob", 10000000)
+	require.NoError(t, err)
+	carol, err := net.AddNode("carol", 10000000)
+	require.NoError(t, err)
+
+	_, err = net.ConnectNodes(alice, bob, 1000000, 0, false)
+	require.NoError(t, err)
+	_, err = net.ConnectNodes(bob, carol, 1000000, 0, false)
+	require.NoError(t, err)
+
+	sender, cleanupSender := createSimnetApp(t, alice)
+	defer cleanupSender()
+	receiver, cleanupReceiver := createSimnetApp(t, carol)
+	defer cleanupReceiver()
+
+	msgs, err := receiver.SubscribeMessages(ctx)
+	require.NoError(t, err)
+
+	disc, err := sender.AddDiscussion(ctx, &model.Discussion{
+		Participants: []string{carol.Address()},
+	})
+	require.NoError(t, err)
+
+	sent, err := sender.SendMessage(ctx, disc.ID, 1000, "",
+		"hello", model.MessageOptions{})
+	require.NoError(t, err)
+	require.Len(t, sent.Payments, 1)
+	assert.Equal(t, lnchat.PaymentSUCCEEDED, sent.Payments[0].Status)
+	assert.Equal(t, int64(1000), sent.Payments[0].Value.Msat())
+
+	select {
+	case msg := <-msgs:
+		require.NotNil(t, msg.RawMessage)
+		payload, participants, err := msg.RawMessage.UnmarshalPayload()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", payload)
+		assert.Equal(t, []string{carol.Address()}, participants)
+		assert.Equal(t, alice.Address(), msg.RawMessage.Sender)
+		assert.True(t, msg.RawMessage.SignatureVerified)
+		require.NotNil(t, msg.Invoice)
+		assert.Equal(t, int64(1000), msg.Invoice.AmtPaid.Msat())
+	case <-ctx.Done():
+		require.FailNow(t, "timed out waiting for received message")
+	}
+
+	// The received message is stored in a discussion with the sender.
+	discs, err := receiver.GetDiscussions(ctx)
+	require.NoError(t, err)
+	require.Len(t, discs, 1)
+	assert.Equal(t, []string{alice.Address()}, discs[0].Participants)
+}
+
+func TestSimnetHoldInvoiceMessage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	net := simnet.NewNetwork()
+	alice, err := net.AddNode("alice", 10000000)
+	require.NoError(t, err)
+	bob, err := net.AddNode("bob", 10000000)
+	require.NoError(t, err)
+	_, err = net.ConnectNodes(alice, bob, 1000000, 0, false)
+	require.NoError(t, err)
+
+	db := createSimnetDB(t)
+	recipient, cleanup := initSimnetApp(t, bob, db)
+	defer cleanup()
+
+	msgs, err := recipient.SubscribeMessages(ctx)
+	require.NoError(t, err)
+
+	sender, err := lnchat.NewNodeFromString(alice.Address())
+	require.NoError(t, err)
+	records := func(text string) map[uint64][]byte {
+		return map[uint64][]byte{
+			KeysendMessageTypeKey: []byte(text),
+			KeysendSenderTypeKey:  sender.Bytes(),
+		}
+	}
+	// A message is received once the invoice subscription is active.
+	require.Eventually(t, func() bool {
+		updates, err := alice.SendPayment(ctx, bob.Address(),
+			lnchat.NewAmount(1000), "", lnchat.PaymentOptions{FeeLimitMsat: 1000},
+			records("hello"), defaultPaymentFilter)
+		require.NoError(t, err)
+		for range updates {
+		}
+		select {
+		case <-msgs:
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var preimage lntypes.Preimage
+	_, err = rand.Read(preimage[:])
+	require.NoError(t, err)
+	inv, err := recipient.CreateHoldInvoice(ctx, "escrow",
+		preimage.Hash().String(), 1000, 3600, false)
+	require.NoError(t, err)
+
+	updates, err := alice.SendPayment(ctx, "", lnchat.NewAmount(0),
+		inv.PaymentRequest, lnchat.PaymentOptions{FeeLimitMsat: 1000},
+		records("escrow message"), defaultPaymentFilter)
+	require.NoError(t, err)
+
+	// The message is published once the payment is accepted.
+	var accepted bool
+	for !accepted {
+		select {
+		case msg := <-msgs:
+			accepted = msg.Invoice != nil && msg.Invoice.Hash == inv.Hash
+			if accepted {
+				assert.Equal(t, lnchat.InvoiceACCEPTED, msg.Invoice.State)
+			}
+		case <-ctx.Done():
+			require.FailNow(t, "timed out waiting for accepted message")
+		}
+	}
+
+	require.NoError(t, recipient.SettleInvoice(ctx, preimage.String()))
+	for u := range updates {
+		require.NoError(t, u.Err)
+		require.Equal(t, lnchat.PaymentSUCCEEDED, u.Payment.Status)
+	}
+
+	// The message is stored once the invoice is settled,
+	// without being published again.
+	require.Eventually(t, func() bool {
+		hold, err := bob.LookupInvoice(ctx, inv.Hash)
+		if err != nil || hold.State != lnchat.InvoiceSETTLED {
+			return false
+		}
+		has, err := db.HasInvoiceMessage(hold.SettleIndex)
+		return err == nil && has
+	}, 5*time.Second, 10*time.Millisecond)
+
+	timeout := time.After(200 * time.Millisecond)
+	for {
+		select {
+		case msg := <-msgs:
+			// Late messages of the subscription check are ignored.
+			if msg.Invoice != nil && msg.Invoice.Hash == inv.Hash {
+				assert.Fail(t, "accepted message published again")
+			}
+		case <-timeout:
+			return
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// NewAddress generates a new address of the underlying node's
+// on-chain wallet, which can be used to deposit funds.
+func (app *App) NewAddress(ctx context.Context, addrType lnchat.AddressType) (string, error) {
+	address, err := app.LNManager.NewAddress(ctx, addrType)
+	if err != nil {
+		return "", newErrorf(err, "NewAddress")
+	}
+
+	return address, nil
+}
+
+// SendCoins sends an on-chain payment from the underlying node's wallet
+// and returns the ID of the published transaction.
+// If sendAll is true, the whole wallet balance is sent.
+func (app *App) SendCoins(ctx context.Context, address string, amtSat int64,
+	sendAll bool, txOptions model.TxFeeOptions) (string, error) {
+
+	txid, err := app.LNManager.SendCoins(ctx, address, amtSat, sendAll,
+		lnchat.TxFeeOptions{
+			SatPerVByte:     txOptions.SatPerVByte,
+			TargetConfBlock: txOptions.TargetConfBlock,
+		},
+	)
+	if err != nil {
+		return "", newErrorf(err, "SendCoins: on-chain payment failed")
+	}
+
+	return txid, nil
+}
+
+// ListUnspent returns the unspent outputs of the underlying node's wallet
+// with at least minConfs and at most maxConfs confirmations.
+func (app *App) ListUnspent(ctx context.Context, minConfs, maxConfs int32) ([]model.Utxo, error) {
+	utxos, err := app.LNManager.ListUnspent(ctx, minConfs, maxConfs)
+	if err != nil {
+		return nil, newErrorf(err, "ListUnspent")
+	}
+
+	res := make([]model.Utxo, len(utxos))
+	for i, u := range utxos {
+		res[i] = model.Utxo{Utxo: u}
+	}
+
+	return res, nil
+}
+
+// ListTransactions returns the on-chain transactions of the underlying
+// node's wallet between startHeight and endHeight (inclusive).
+// An endHeight of 0 or -1 includes unconfirmed transactions.
+func (app *App) ListTransactions(ctx context.Context,
+	startHeight, endHeight int32) ([]model.Transaction, error) {
+
+	txs, err := app.LNManager.ListTransactions(ctx, startHeight, endHeight)
+	if err != nil {
+		return nil, newErrorf(err, "ListTransactions")
+	}
+
+	res := make([]model.Transaction, len(txs))
+	for i, tx := range txs {
+		res[i] = model.Transaction{Transaction: tx}
+	}
+
+	return res, nil
+}
+
+// EstimateFee estimates the fee of an on-chain payment of amtSat
+// to an address, confirming within targetConfBlock blocks.
+func (app *App) EstimateFee(ctx context.Context, address string,
+	amtSat int64, targetConfBlock uint32) (*model.FeeEstimate, error) {
+
+	estimate, err := app.LNManager.EstimateFee(ctx, address, amtSat, targetConfBlock)
+	if err != nil {
+		return nil, newErrorf(err, "EstimateFee")
+	}
+
+	return &model.FeeEstimate{FeeEstimate: *estimate}, nil
+}
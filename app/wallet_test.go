@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestSendCoins(t *testing.T) {
+	const address = "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080"
+	const txid = "9f2c4b6e8a0d1c3e5f7a9b1d3c5e7f9a0b2c4d6e8f0a1b3c5d7e9f1a2b4c6d8e"
+
+	cases := []struct {
+		name  string
+		lnErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:  "insufficient balance",
+			lnErr: lnchat.ErrInsufficientBalance,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockLNManager := new(lnmock.LightManager)
+			app, err := New(mockLNManager, new(dbmock.Database))
+			require.NoError(t, err)
+
+			mockLNManager.On("SendCoins", mock.Anything, address, int64(50000), false,
+				lnchat.TxFeeOptions{SatPerVByte: 2}).Return(txid, c.lnErr).Once()
+
+			res, err := app.SendCoins(context.Background(), address, 50000, false,
+				model.TxFeeOptions{SatPerVByte: 2})
+			if c.lnErr != nil {
+				var appErr Error
+				require.ErrorAs(t, err, &appErr)
+				assert.Equal(t, InsufficientBalance, appErr.Kind)
+				assert.ErrorIs(t, err, c.lnErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, txid, res)
+			}
+			mockLNManager.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListUnspent(t *testing.T) {
+	utxos := []lnchat.Utxo{
+		{
+			Address:       "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080",
+			AmountSat:     2000000,
+			Txid:          "5d3c1f2e4a6b8c0d9e7f5a3b1c2d4e6f8a0b9c7d5e3f1a2b4c6d8e0f9a7b5c3d",
+			Confirmations: 10,
+		},
+	}
+
+	mockLNManager := new(lnmock.LightManager)
+	app, err := New(mockLNManager, new(dbmock.Database))
+	require.NoError(t, err)
+
+	mockLNManager.On("ListUnspent", mock.Anything,
+		int32(1), int32(100)).Return(utxos, nil).Once()
+
+	res, err := app.ListUnspent(context.Background(), 1, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Utxo{{Utxo: utxos[0]}}, res)
+	mockLNManager.AssertExpectations(t)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// bakeMacaroonCmd bakes a macaroon with the permissions required by c13n.
+var bakeMacaroonCmd = &cobra.Command{
+	Use:   "bake-macaroon",
+	Short: "Bake an lnd macaroon permitting only the calls made by c13n",
+	Long: "The configured lnd macaroon must permit macaroon generation (e.g. admin.macaroon).\n" +
+		"The baked macaroon is to be used as the lnd macaroon (lnd.macaroon_path).",
+
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		savePath, err := cmd.Flags().GetString("save-to")
+		if err != nil {
+			return err
+		}
+
+		creds, err := newLNDCredentials()
+		if err != nil {
+			return fmt.Errorf("could not create credentials: %w", err)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		mac, err := lnchat.BakeMacaroon(ctx, creds)
+		if err != nil {
+			return err
+		}
+
+		if savePath == "" {
+			fmt.Println(hex.EncodeToString(mac))
+			return nil
+		}
+		if err := ioutil.WriteFile(savePath, mac, 0600); err != nil {
+			return fmt.Errorf("could not save macaroon: %w", err)
+		}
+		logger.Infof("Macaroon saved to %s", savePath)
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(bakeMacaroonCmd)
+
+	bakeMacaroonFlags := bakeMacaroonCmd.Flags()
+	bakeMacaroonFlags.String("save-to", "",
+		"File to save the baked macaroon to (hex-encoded to stdout if empty)")
+}
@@ -47,6 +47,34 @@ func initCliFlags() {
 		"Default fee limit for discussions in millisatoshi")
 	_ = viper.BindPFlag("app.default_fee_limit_msat",
 		rootFlags.Lookup("default-fee-limit-msat"))
+	rootFlags.Bool("signature-verification-fallback", false,
+		"Verify message signatures through lnd if local verification fails")
+	_ = viper.BindPFlag("app.signature_verification_fallback",
+		rootFlags.Lookup("signature-verification-fallback"))
+	rootFlags.Bool("compact-payloads", false,
+		"Send message payloads in the compact format (unsupported by earlier versions)")
+	_ = viper.BindPFlag("app.compact_payloads",
+		rootFlags.Lookup("compact-payloads"))
+	rootFlags.Bool("reconcile-on-startup", false,
+		"Reconcile stored invoices, payments and messages with lnd on startup")
+	_ = viper.BindPFlag("app.reconciliation.on_startup",
+		rootFlags.Lookup("reconcile-on-startup"))
+	rootFlags.Duration("reconcile-interval", 0,
+		"Interval between reconciliations with lnd (0 disables periodic reconciliation)")
+	_ = viper.BindPFlag("app.reconciliation.interval",
+		rootFlags.Lookup("reconcile-interval"))
+	rootFlags.Int("payment-retries", 0,
+		"Maximum number of retries of failed payments")
+	_ = viper.BindPFlag("app.payment_retry.max_retries",
+		rootFlags.Lookup("payment-retries"))
+	rootFlags.Float64("payment-retry-fee-factor", 0,
+		"Factor the fee limit is raised by on payment retries, up to the discussion maximum")
+	_ = viper.BindPFlag("app.payment_retry.fee_limit_factor",
+		rootFlags.Lookup("payment-retry-fee-factor"))
+	rootFlags.Duration("payment-retry-delay", 0,
+		"Delay before retrying payments that timed out or found no route (0 disables such retries)")
+	_ = viper.BindPFlag("app.payment_retry.delay",
+		rootFlags.Lookup("payment-retry-delay"))
 
 	// RPC flags
 	rootFlags.String("server-address", "localhost:9999",
@@ -73,6 +101,18 @@ func initCliFlags() {
 	_ = viper.BindPFlag("server.graceful_shutdown_timeout",
 		rootFlags.Lookup("graceful-shutdown-timeout"))
 
+	// Lightning backend flags
+	rootFlags.String("backend", "lnd",
+		"Lightning daemon implementation to use (lnd, cln)")
+	_ = viper.BindPFlag("backend", rootFlags.Lookup("backend"))
+	rootFlags.Int("backend-startup-timeout", 60,
+		"Time allowed for the Lightning daemon to become available in seconds")
+	_ = viper.BindPFlag("backend_startup_timeout",
+		rootFlags.Lookup("backend-startup-timeout"))
+	rootFlags.String("cln-rpc-path", "",
+		"Path of the Core Lightning JSON-RPC socket")
+	_ = viper.BindPFlag("cln.rpc_path", rootFlags.Lookup("cln-rpc-path"))
+
 	// LND flags
 	rootFlags.String("lnd-address", "localhost:10009",
 		"Address of the Lightning daemon")
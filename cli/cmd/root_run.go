@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"os/signal"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/dgraph-io/badger/v3"
+	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -67,32 +69,15 @@ func Run(_ *cobra.Command, _ []string) error {
 	}
 
 	// Initialize chat service
-	var creds lnconnect.Credentials
-
-	macConstraints := lnchat.MacaroonConstraints{
-		Timeout: viper.GetInt64("lnd.macaroon_timeout_secs"),
-		IPLock:  viper.GetString("lnd.macaroon_ip"),
-	}
-
-	if viper.GetString("lndconnect") != "" {
-		creds, err = lnchat.NewCredentialsFromURL(
-			viper.GetString("lndconnect"),
-			macConstraints,
-		)
-	} else {
-		creds, err = lnchat.NewCredentials(
-			viper.GetString("lnd.address"),
-			viper.GetString("lnd.tls_path"),
-			viper.GetString("lnd.macaroon_path"),
-			macConstraints,
-		)
-	}
-	if err != nil {
-		logger.WithError(err).Error("Could not create credentials")
-		return err
+	var lnchatMgr lnchat.LightManager
+	switch backend := viper.GetString("backend"); backend {
+	case "", "lnd":
+		lnchatMgr, err = newLNDManager()
+	case "cln":
+		lnchatMgr, err = newCLNManager()
+	default:
+		err = fmt.Errorf("unknown Lightning backend %q", backend)
 	}
-
-	lnchatMgr, err := lnchat.New(creds)
 	if err != nil {
 		logger.WithError(err).Error("Could not initialize lnchat service")
 		return err
@@ -109,6 +94,25 @@ func Run(_ *cobra.Command, _ []string) error {
 	if defaultFeeLimitMsat != 0 {
 		appOpts = append(appOpts, app.WithDefaultFeeLimitMsat(defaultFeeLimitMsat))
 	}
+	if viper.GetBool("app.signature_verification_fallback") {
+		appOpts = append(appOpts, app.WithSignatureVerificationFallback(true))
+	}
+	if viper.GetBool("app.compact_payloads") {
+		appOpts = append(appOpts, app.WithCompactPayloads(true))
+	}
+	reconcileOnStartup := viper.GetBool("app.reconciliation.on_startup")
+	reconcileInterval := viper.GetDuration("app.reconciliation.interval")
+	if reconcileOnStartup || reconcileInterval != 0 {
+		appOpts = append(appOpts, app.WithReconciliation(
+			reconcileOnStartup, reconcileInterval))
+	}
+	if retries := viper.GetInt("app.payment_retry.max_retries"); retries != 0 {
+		appOpts = append(appOpts, app.WithRetryPolicy(app.RetryPolicy{
+			MaxRetries:     retries,
+			FeeLimitFactor: viper.GetFloat64("app.payment_retry.fee_limit_factor"),
+			Delay:          viper.GetDuration("app.payment_retry.delay"),
+		}))
+	}
 	application, err := app.New(lnchatMgr, db, appOpts...)
 	if err != nil {
 		logger.WithError(err).Error("Could not create application")
@@ -189,3 +193,79 @@ func waitForTermination(terminationCh chan<- interface{}, gracePeriodTimeout tim
 
 	close(terminationCh)
 }
+
+// newLNDCredentials creates the lnd credentials from the configuration.
+func newLNDCredentials() (lnconnect.Credentials, error) {
+	macConstraints := lnchat.MacaroonConstraints{
+		Timeout: viper.GetInt64("lnd.macaroon_timeout_secs"),
+		IPLock:  viper.GetString("lnd.macaroon_ip"),
+	}
+
+	if viper.GetString("lndconnect") != "" {
+		return lnchat.NewCredentialsFromURL(
+			viper.GetString("lndconnect"),
+			macConstraints,
+		)
+	}
+
+	return lnchat.NewCredentials(
+		viper.GetString("lnd.address"),
+		viper.GetString("lnd.tls_path"),
+		viper.GetString("lnd.macaroon_path"),
+		macConstraints,
+	)
+}
+
+// newLNDManager creates an lnchat service backed by lnd,
+// reporting the capabilities not permitted by the configured macaroon.
+func newLNDManager() (lnchat.LightManager, error) {
+	creds, err := newLNDCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("could not create credentials: %w", err)
+	}
+
+	logger.Infof("Waiting for lnd at %s", creds.RPCAddress)
+	mgr, err := lnchat.New(creds, lnchat.WithStartupTimeout(backendStartupTimeout()))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	unavailable, err := lnchat.UnavailableCapabilities(ctx, creds)
+	if err != nil {
+		logger.WithError(err).Warn("Could not check macaroon permissions")
+		return mgr, nil
+	}
+	for _, c := range unavailable {
+		logger.Warnf("Macaroon does not permit %s, which will be unavailable", c.Name)
+		if c.Name == lnchat.CapabilityMessageSending {
+			logger.Warn("Operating in read-only mode")
+		}
+	}
+
+	return mgr, nil
+}
+
+// newCLNManager creates an lnchat service backed by Core Lightning.
+func newCLNManager() (lnchat.LightManager, error) {
+	rpcPath, err := homedir.Expand(viper.GetString("cln.rpc_path"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid Core Lightning socket path: %w", err)
+	}
+
+	mgr, err := lnchat.NewCLN(rpcPath, lnchat.WithStartupTimeout(backendStartupTimeout()))
+	if err != nil {
+		return nil, err
+	}
+	logger.Warn("Core Lightning does not expose the custom records of received payments, " +
+		"so incoming messages will not be received")
+
+	return mgr, nil
+}
+
+// backendStartupTimeout returns the configured time allowed
+// for the Lightning daemon to become available.
+func backendStartupTimeout() time.Duration {
+	return time.Duration(viper.GetInt("backend_startup_timeout")) * time.Second
+}
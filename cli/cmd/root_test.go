@@ -48,6 +48,10 @@ func TestInitConfig(t *testing.T) {
 		[]byte("    extra_domains:"),
 		[]byte("      - host_alias"),
 		[]byte(""),
+		[]byte("backend: \"cln\""),
+		[]byte("backend_startup_timeout: 30"),
+		[]byte("cln:"),
+		[]byte("  rpc_path: \"~/.lightning/regtest/lightning-rpc\""),
 		[]byte("lnd:"),
 		[]byte("  address:      \"localhost1:10009\""),
 		[]byte("  tls_path:      \"~/.lnd/tls1.cert\""),
@@ -83,6 +87,10 @@ func TestInitConfig(t *testing.T) {
 		viper.GetStringSlice("server.tls.extra_domains"))
 	assert.Equal(t, 11, viper.GetInt("server.graceful_shutdown_timeout"))
 
+	assert.Equal(t, "cln", viper.GetString("backend"))
+	assert.Equal(t, 30, viper.GetInt("backend_startup_timeout"))
+	assert.Equal(t, "~/.lightning/regtest/lightning-rpc", viper.GetString("cln.rpc_path"))
+
 	assert.Equal(t, "localhost1:10009", viper.GetString("lnd.address"))
 	assert.Equal(t, "~/.lnd/tls1.cert", viper.GetString("lnd.tls_path"))
 	assert.Equal(t, "~/.lnd/data/chain/bitcoin/regtest/admin.macaroon1",
@@ -0,0 +1,251 @@
+package lnchat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// Channel represents an open channel of the underlying node.
+type Channel struct {
+	// The channel point identifying the channel.
+	ChannelPoint ChannelPoint
+	// The ID of the channel (short channel id).
+	ChannelID uint64
+	// The Lightning address of the remote endpoint of the channel.
+	RemoteAddress string
+	// Whether the channel is active (the remote endpoint is online).
+	Active bool
+	// Whether the channel is private (not announced to the network).
+	Private bool
+	// Whether the channel was initiated by the local node.
+	Initiator bool
+	// Capacity is the channel capacity (in millisatoshi).
+	CapacityMsat int64
+	// The distribution of the channel balance.
+	Balance BalanceAllocation
+	// The balance of unsettled HTLCs on the channel (in millisatoshi).
+	UnsettledBalanceMsat int64
+	// The total amount sent over the channel (in millisatoshi).
+	TotalSentMsat int64
+	// The total amount received over the channel (in millisatoshi).
+	TotalReceivedMsat int64
+	// The number of updates to the channel state.
+	NumUpdates uint64
+}
+
+// ChannelFilter restricts the channels returned by ListChannels.
+type ChannelFilter struct {
+	// Return only active channels.
+	ActiveOnly bool
+	// Return only inactive channels.
+	InactiveOnly bool
+	// Return only public channels.
+	PublicOnly bool
+	// Return only private channels.
+	PrivateOnly bool
+	// Return only channels with the node with this Lightning address.
+	Peer string
+}
+
+// PendingChannelState represents the state of a pending channel.
+type PendingChannelState int32
+
+const (
+	// PendingOPEN represents a channel whose funding
+	// transaction has not been confirmed yet.
+	PendingOPEN PendingChannelState = iota
+	// PendingWAITINGCLOSE represents a channel whose closing
+	// transaction has been broadcast but not confirmed yet.
+	PendingWAITINGCLOSE
+	// PendingFORCECLOSING represents a force closed channel
+	// whose funds have not been fully resolved yet.
+	PendingFORCECLOSING
+)
+
+// PendingChannel represents a channel of the underlying node
+// that is in the process of being opened or closed.
+type PendingChannel struct {
+	// The channel point identifying the channel.
+	ChannelPoint ChannelPoint
+	// The Lightning address of the remote endpoint of the channel.
+	RemoteAddress string
+	// The state of the pending channel.
+	State PendingChannelState
+	// Whether the channel was initiated by the local node.
+	Initiator bool
+	// Capacity is the channel capacity (in millisatoshi).
+	CapacityMsat int64
+	// The distribution of the channel balance.
+	Balance BalanceAllocation
+	// The closing transaction ID (empty for channels pending open).
+	ClosingTxid string
+	// The balance awaiting resolution (in millisatoshi).
+	LimboBalanceMsat int64
+	// The height at which funds can be swept (for force closed channels).
+	MaturityHeight uint32
+	// The number of blocks until funds can be swept
+	// (for force closed channels).
+	BlocksTilMaturity int32
+}
+
+// ChannelCloseState represents the state of a channel closing.
+type ChannelCloseState int32
+
+const (
+	// ChannelClosePENDING signifies that the closing transaction
+	// has been broadcast but not confirmed yet.
+	ChannelClosePENDING ChannelCloseState = iota
+	// ChannelCloseCONFIRMED signifies that the closing
+	// transaction has been confirmed.
+	ChannelCloseCONFIRMED
+)
+
+// ChannelCloseStatus represents the progress of a channel closing.
+type ChannelCloseStatus struct {
+	// The state of the channel closing.
+	State ChannelCloseState
+	// The closing transaction ID.
+	ClosingTxid string
+}
+
+// ChannelCloseUpdate represents a channel close update,
+// as returned by CloseChannel.
+type ChannelCloseUpdate struct {
+	Status *ChannelCloseStatus
+	Err    error
+}
+
+// newChannelPointFromString creates a ChannelPoint from its
+// string representation, as returned by lnd (txid:index).
+func newChannelPointFromString(chanPoint string) (ChannelPoint, error) {
+	parts := strings.Split(chanPoint, ":")
+	if len(parts) != 2 {
+		return ChannelPoint{}, fmt.Errorf("invalid channel point %q", chanPoint)
+	}
+
+	fundingTxid, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return ChannelPoint{}, fmt.Errorf("invalid funding transaction: %w", err)
+	}
+	outputIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return ChannelPoint{}, fmt.Errorf("invalid output index: %w", err)
+	}
+
+	return ChannelPoint{
+		FundingTxid: fundingTxid.String(),
+		OutputIndex: uint32(outputIndex),
+	}, nil
+}
+
+// marshalChannelPoint creates an lnrpc.ChannelPoint from a ChannelPoint.
+func marshalChannelPoint(chanPoint ChannelPoint) (*lnrpc.ChannelPoint, error) {
+	fundingTxid, err := chainhash.NewHashFromStr(chanPoint.FundingTxid)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode funding transaction: %w", err)
+	}
+
+	return &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: fundingTxid[:],
+		},
+		OutputIndex: chanPoint.OutputIndex,
+	}, nil
+}
+
+// unmarshalChannel creates an lnchat.Channel from an lnrpc.Channel.
+func unmarshalChannel(c *lnrpc.Channel) (*Channel, error) {
+	if c == nil {
+		return nil, fmt.Errorf("cannot unmarshal nil channel")
+	}
+
+	chanPoint, err := newChannelPointFromString(c.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Channel{
+		ChannelPoint:  chanPoint,
+		ChannelID:     c.ChanId,
+		RemoteAddress: c.RemotePubkey,
+		Active:        c.Active,
+		Private:       c.Private,
+		Initiator:     c.Initiator,
+		CapacityMsat:  c.Capacity * 1000,
+		Balance: BalanceAllocation{
+			LocalMsat:  uint64(c.LocalBalance) * 1000,
+			RemoteMsat: uint64(c.RemoteBalance) * 1000,
+		},
+		UnsettledBalanceMsat: c.UnsettledBalance * 1000,
+		TotalSentMsat:        c.TotalSatoshisSent * 1000,
+		TotalReceivedMsat:    c.TotalSatoshisReceived * 1000,
+		NumUpdates:           c.NumUpdates,
+	}, nil
+}
+
+// unmarshalPendingChannel creates an lnchat.PendingChannel
+// from the common part of the lnd pending channel types.
+func unmarshalPendingChannel(c *lnrpc.PendingChannelsResponse_PendingChannel,
+	state PendingChannelState) (*PendingChannel, error) {
+
+	if c == nil {
+		return nil, fmt.Errorf("cannot unmarshal nil pending channel")
+	}
+
+	chanPoint, err := newChannelPointFromString(c.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PendingChannel{
+		ChannelPoint:  chanPoint,
+		RemoteAddress: c.RemoteNodePub,
+		State:         state,
+		Initiator:     c.Initiator == lnrpc.Initiator_INITIATOR_LOCAL,
+		CapacityMsat:  c.Capacity * 1000,
+		Balance: BalanceAllocation{
+			LocalMsat:  uint64(c.LocalBalance) * 1000,
+			RemoteMsat: uint64(c.RemoteBalance) * 1000,
+		},
+	}, nil
+}
+
+// unmarshalPendingChannels creates a list of lnchat.PendingChannel
+// from an lnrpc.PendingChannelsResponse.
+func unmarshalPendingChannels(resp *lnrpc.PendingChannelsResponse) ([]PendingChannel, error) {
+	var channels []PendingChannel
+
+	for _, c := range resp.GetPendingOpenChannels() {
+		ch, err := unmarshalPendingChannel(c.GetChannel(), PendingOPEN)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, *ch)
+	}
+	for _, c := range resp.GetWaitingCloseChannels() {
+		ch, err := unmarshalPendingChannel(c.GetChannel(), PendingWAITINGCLOSE)
+		if err != nil {
+			return nil, err
+		}
+		ch.ClosingTxid = c.GetClosingTxid()
+		ch.LimboBalanceMsat = c.GetLimboBalance() * 1000
+		channels = append(channels, *ch)
+	}
+	for _, c := range resp.GetPendingForceClosingChannels() {
+		ch, err := unmarshalPendingChannel(c.GetChannel(), PendingFORCECLOSING)
+		if err != nil {
+			return nil, err
+		}
+		ch.ClosingTxid = c.GetClosingTxid()
+		ch.LimboBalanceMsat = c.GetLimboBalance() * 1000
+		ch.MaturityHeight = c.GetMaturityHeight()
+		ch.BlocksTilMaturity = c.GetBlocksTilMaturity()
+		channels = append(channels, *ch)
+	}
+
+	return channels, nil
+}
@@ -0,0 +1,236 @@
+package lnchat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// ChannelEventType represents the type of a channel event.
+type ChannelEventType int32
+
+const (
+	// ChannelEventPENDINGOPEN signifies that the funding transaction
+	// of a channel has been published.
+	ChannelEventPENDINGOPEN ChannelEventType = iota
+	// ChannelEventOPEN signifies that a channel has been opened.
+	ChannelEventOPEN
+	// ChannelEventACTIVE signifies that a channel has become active.
+	ChannelEventACTIVE
+	// ChannelEventINACTIVE signifies that a channel has become inactive.
+	ChannelEventINACTIVE
+	// ChannelEventCLOSED signifies that a channel has been closed.
+	ChannelEventCLOSED
+	// ChannelEventFULLYRESOLVED signifies that all outputs
+	// of a closed channel have been resolved.
+	ChannelEventFULLYRESOLVED
+)
+
+// ChannelCloseType represents the way a channel was closed.
+type ChannelCloseType int32
+
+const (
+	// ChannelCloseCOOPERATIVE represents a cooperative channel closing.
+	ChannelCloseCOOPERATIVE ChannelCloseType = iota
+	// ChannelCloseLOCALFORCE represents a channel closed unilaterally
+	// by the local node.
+	ChannelCloseLOCALFORCE
+	// ChannelCloseREMOTEFORCE represents a channel closed unilaterally
+	// by the remote node.
+	ChannelCloseREMOTEFORCE
+	// ChannelCloseBREACH represents a channel closed
+	// due to a breach by the remote node.
+	ChannelCloseBREACH
+	// ChannelCloseFUNDINGCANCELLED represents a channel whose
+	// funding transaction never confirmed.
+	ChannelCloseFUNDINGCANCELLED
+	// ChannelCloseABANDONED represents a channel abandoned by the local node.
+	ChannelCloseABANDONED
+)
+
+// ClosedChannel contains a summary of a closed channel.
+type ClosedChannel struct {
+	// The ID of the channel (short channel id).
+	ChannelID uint64
+	// The Lightning address of the remote endpoint of the channel.
+	RemoteAddress string
+	// Capacity is the channel capacity (in millisatoshi).
+	CapacityMsat int64
+	// The closing transaction ID.
+	ClosingTxid string
+	// The height at which the closing transaction was confirmed.
+	CloseHeight uint32
+	// The balance settled to the local node (in millisatoshi).
+	SettledBalanceMsat int64
+	// The balance that is time-locked (in millisatoshi).
+	TimeLockedBalanceMsat int64
+	// The way the channel was closed.
+	CloseType ChannelCloseType
+}
+
+// ChannelEvent represents a change in the state
+// of a channel of the underlying node.
+type ChannelEvent struct {
+	// The type of the event.
+	Type ChannelEventType
+	// The channel point of the channel the event refers to.
+	ChannelPoint ChannelPoint
+	// The opened channel (only for ChannelEventOPEN events).
+	Channel *Channel
+	// The closed channel summary (only for ChannelEventCLOSED events).
+	ClosedChannel *ClosedChannel
+}
+
+// ChannelEventUpdate represents a channel event update,
+// as returned by SubscribeChannelEvents.
+type ChannelEventUpdate struct {
+	Event *ChannelEvent
+	Err   error
+}
+
+var channelCloseTypeMap = map[lnrpc.ChannelCloseSummary_ClosureType]ChannelCloseType{
+	lnrpc.ChannelCloseSummary_COOPERATIVE_CLOSE:  ChannelCloseCOOPERATIVE,
+	lnrpc.ChannelCloseSummary_LOCAL_FORCE_CLOSE:  ChannelCloseLOCALFORCE,
+	lnrpc.ChannelCloseSummary_REMOTE_FORCE_CLOSE: ChannelCloseREMOTEFORCE,
+	lnrpc.ChannelCloseSummary_BREACH_CLOSE:       ChannelCloseBREACH,
+	lnrpc.ChannelCloseSummary_FUNDING_CANCELED:   ChannelCloseFUNDINGCANCELLED,
+	lnrpc.ChannelCloseSummary_ABANDONED:          ChannelCloseABANDONED,
+}
+
+// unmarshalRPCChannelPoint creates a ChannelPoint from an lnrpc.ChannelPoint.
+func unmarshalRPCChannelPoint(c *lnrpc.ChannelPoint) (ChannelPoint, error) {
+	if c == nil {
+		return ChannelPoint{}, fmt.Errorf("cannot unmarshal nil channel point")
+	}
+
+	fundingTxid, err := lnrpc.GetChanPointFundingTxid(c)
+	if err != nil {
+		return ChannelPoint{}, err
+	}
+
+	return ChannelPoint{
+		FundingTxid: fundingTxid.String(),
+		OutputIndex: c.OutputIndex,
+	}, nil
+}
+
+// unmarshalClosedChannel creates an lnchat.ClosedChannel
+// from an lnrpc.ChannelCloseSummary.
+func unmarshalClosedChannel(c *lnrpc.ChannelCloseSummary) (ChannelPoint, *ClosedChannel, error) {
+	if c == nil {
+		return ChannelPoint{}, nil, fmt.Errorf("cannot unmarshal nil channel close summary")
+	}
+
+	chanPoint, err := newChannelPointFromString(c.ChannelPoint)
+	if err != nil {
+		return ChannelPoint{}, nil, err
+	}
+
+	return chanPoint, &ClosedChannel{
+		ChannelID:             c.ChanId,
+		RemoteAddress:         c.RemotePubkey,
+		CapacityMsat:          c.Capacity * 1000,
+		ClosingTxid:           c.ClosingTxHash,
+		CloseHeight:           c.CloseHeight,
+		SettledBalanceMsat:    c.SettledBalance * 1000,
+		TimeLockedBalanceMsat: c.TimeLockedBalance * 1000,
+		CloseType:             channelCloseTypeMap[c.CloseType],
+	}, nil
+}
+
+// unmarshalChannelEvent creates an lnchat.ChannelEvent
+// from an lnrpc.ChannelEventUpdate.
+// Events of unknown type (introduced by newer lnd versions)
+// result in a nil event, without an error.
+func unmarshalChannelEvent(e *lnrpc.ChannelEventUpdate) (*ChannelEvent, error) {
+	var err error
+	event := &ChannelEvent{}
+
+	switch e.GetType() {
+	case lnrpc.ChannelEventUpdate_PENDING_OPEN_CHANNEL:
+		event.Type = ChannelEventPENDINGOPEN
+		pending := e.GetPendingOpenChannel()
+		fundingTxid, herr := chainhash.NewHash(pending.GetTxid())
+		if herr != nil {
+			return nil, herr
+		}
+		event.ChannelPoint = ChannelPoint{
+			FundingTxid: fundingTxid.String(),
+			OutputIndex: pending.GetOutputIndex(),
+		}
+	case lnrpc.ChannelEventUpdate_OPEN_CHANNEL:
+		event.Type = ChannelEventOPEN
+		if event.Channel, err = unmarshalChannel(e.GetOpenChannel()); err != nil {
+			return nil, err
+		}
+		event.ChannelPoint = event.Channel.ChannelPoint
+	case lnrpc.ChannelEventUpdate_ACTIVE_CHANNEL:
+		event.Type = ChannelEventACTIVE
+		event.ChannelPoint, err = unmarshalRPCChannelPoint(e.GetActiveChannel())
+	case lnrpc.ChannelEventUpdate_INACTIVE_CHANNEL:
+		event.Type = ChannelEventINACTIVE
+		event.ChannelPoint, err = unmarshalRPCChannelPoint(e.GetInactiveChannel())
+	case lnrpc.ChannelEventUpdate_CLOSED_CHANNEL:
+		event.Type = ChannelEventCLOSED
+		event.ChannelPoint, event.ClosedChannel, err =
+			unmarshalClosedChannel(e.GetClosedChannel())
+	case lnrpc.ChannelEventUpdate_FULLY_RESOLVED_CHANNEL:
+		event.Type = ChannelEventFULLYRESOLVED
+		event.ChannelPoint, err = unmarshalRPCChannelPoint(e.GetFullyResolvedChannel())
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// SubscribeChannelEvents creates and returns a channel over which
+// events concerning the channels of the underlying node are received.
+func (m *manager) SubscribeChannelEvents(ctx context.Context) (<-chan ChannelEventUpdate, error) {
+	req := &lnrpc.ChannelEventSubscription{}
+
+	stream, err := m.lnClient.SubscribeChannelEvents(ctx, req)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	updateCh := make(chan ChannelEventUpdate)
+
+	// Write updates to the returned channel asynchronously.
+	go func() {
+		defer close(updateCh)
+
+		for {
+			rpcEvent, err := stream.Recv()
+			if err != nil {
+				updateCh <- ChannelEventUpdate{nil, translateCommonRPCErrors(err)}
+				return
+			}
+			event, err := unmarshalChannelEvent(rpcEvent)
+			if err != nil {
+				updateCh <- ChannelEventUpdate{nil, err}
+				return
+			}
+			// Unknown events are skipped.
+			if event == nil {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- ChannelEventUpdate{event, nil}:
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
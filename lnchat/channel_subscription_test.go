@@ -0,0 +1,76 @@
+package lnchat
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// testChannelEventStream replays a list of channel events,
+// terminating with io.EOF.
+type testChannelEventStream struct {
+	lnrpc.Lightning_SubscribeChannelEventsClient
+	events []*lnrpc.ChannelEventUpdate
+}
+
+func (s *testChannelEventStream) Recv() (*lnrpc.ChannelEventUpdate, error) {
+	if len(s.events) == 0 {
+		return nil, io.EOF
+	}
+	e := s.events[0]
+	s.events = s.events[1:]
+
+	return e, nil
+}
+
+type testChannelEventClient struct {
+	lnrpc.LightningClient
+	events []*lnrpc.ChannelEventUpdate
+}
+
+func (c *testChannelEventClient) SubscribeChannelEvents(context.Context,
+	*lnrpc.ChannelEventSubscription,
+	...grpc.CallOption) (lnrpc.Lightning_SubscribeChannelEventsClient, error) {
+
+	return &testChannelEventStream{events: c.events}, nil
+}
+
+func TestSubscribeChannelEventsUnknownType(t *testing.T) {
+	chanPoint := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+			FundingTxidBytes: make([]byte, 32),
+		},
+		OutputIndex: 1,
+	}
+
+	mgr := &manager{
+		lnClient: &testChannelEventClient{
+			events: []*lnrpc.ChannelEventUpdate{
+				// An event type unknown to lnchat.
+				{Type: lnrpc.ChannelEventUpdate_UpdateType(42)},
+				{
+					Type: lnrpc.ChannelEventUpdate_ACTIVE_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_ActiveChannel{
+						ActiveChannel: chanPoint,
+					},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, err := mgr.SubscribeChannelEvents(ctx)
+	require.NoError(t, err)
+
+	// The unknown event is skipped.
+	u := <-updates
+	require.NoError(t, u.Err)
+	assert.Equal(t, ChannelEventACTIVE, u.Event.Type)
+	assert.Equal(t, uint32(1), u.Event.ChannelPoint.OutputIndex)
+}
@@ -0,0 +1,542 @@
+package lnchat
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/pkg/errors"
+)
+
+// clnPollInterval is the interval at which Core Lightning is polled
+// for updates that it does not push over JSON-RPC
+// (payments and channel events).
+var clnPollInterval = 2 * time.Second
+
+// clnManager is a LightManager backed by a Core Lightning node,
+// accessed over its JSON-RPC unix socket.
+//
+// Core Lightning (v23.02 or later) offers no hold invoices,
+// custom records on invoice payments, routed spontaneous payments
+// or custom message subscriptions over JSON-RPC (without plugins),
+// so the respective methods fail with ErrNotSupported.
+//
+// Incoming chat messages are not supported: Core Lightning does not expose
+// the custom records (keysend extra TLVs) of received payments
+// over JSON-RPC, so received invoices carry no custom records
+// and no messages can be extracted from them.
+// Only sending messages is supported with Core Lightning.
+type clnManager struct {
+	client *clnClient
+
+	connState      *connectionTracker
+	startupTimeout time.Duration
+	stopSupervise  context.CancelFunc
+	supervisorWg   sync.WaitGroup
+
+	self        SelfInfo
+	chainParams *chaincfg.Params
+}
+
+var _ LightManager = (*clnManager)(nil)
+
+// NewCLN creates a manager connected to a Core Lightning node
+// through the provided JSON-RPC socket path.
+func NewCLN(socketPath string, options ...func(LightManager) error) (LightManager, error) {
+	mgr := &clnManager{
+		client:         &clnClient{socketPath: socketPath},
+		connState:      newConnectionTracker(),
+		startupTimeout: defaultStartupTimeout,
+	}
+
+	for _, option := range options {
+		if err := option(mgr); err != nil {
+			return nil, err
+		}
+	}
+
+	// Wait for the node to become available, then get self info
+	ctx, cancel := context.WithTimeout(context.Background(), mgr.startupTimeout)
+	defer cancel()
+	info, err := mgr.waitInfo(ctx)
+	if err != nil {
+		return nil, startupError(ConnectionCONNECTING, mgr.startupTimeout, err)
+	}
+	mgr.self = info.selfInfo()
+
+	params, ok := clnChainParams[info.Network]
+	if !ok {
+		return nil, newErrorf(ErrInternal, "unknown network %q", info.Network)
+	}
+	mgr.chainParams = params
+	mgr.connState.set(info.connectionState())
+
+	supervisorCtx, cancel := context.WithCancel(context.Background())
+	mgr.stopSupervise = cancel
+	mgr.supervisorWg.Add(1)
+	go func() {
+		defer mgr.supervisorWg.Done()
+		mgr.superviseConnection(supervisorCtx)
+	}()
+
+	return mgr, nil
+}
+
+// waitInfo retrieves the node info, retrying until the node
+// becomes available or the context is done.
+// On failure, the error of the last attempt is returned.
+func (m *clnManager) waitInfo(ctx context.Context) (*clnGetInfo, error) {
+	for {
+		info, err := m.getInfo(ctx)
+		if err == nil {
+			return info, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(clnPollInterval):
+		}
+	}
+}
+
+// Close releases the resources associated with the manager.
+func (m *clnManager) Close() error {
+	m.stopSupervise()
+	m.supervisorWg.Wait()
+
+	return nil
+}
+
+// GetConnectionState returns the current state of the connection
+// to the underlying node.
+func (m *clnManager) GetConnectionState() ConnectionState {
+	return m.connState.get()
+}
+
+// SubscribeConnectionState returns a channel over which the current
+// connection state and any subsequent changes are received.
+func (m *clnManager) SubscribeConnectionState(ctx context.Context) (<-chan ConnectionState, error) {
+	return m.connState.subscribe(ctx), nil
+}
+
+// superviseConnection periodically updates the connection state
+// until the context is done. Core Lightning does not start its
+// JSON-RPC server before its wallet is available, so the wallet
+// is never reported as locked.
+func (m *clnManager) superviseConnection(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(clnPollInterval):
+		}
+
+		info, err := m.getInfo(ctx)
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+			m.connState.setLost(err)
+		default:
+			m.connState.set(info.connectionState())
+		}
+	}
+}
+
+// clnChainParams maps Core Lightning network names to chain parameters.
+var clnChainParams = map[string]*chaincfg.Params{
+	"bitcoin": &chaincfg.MainNetParams,
+	"testnet": &chaincfg.TestNet3Params,
+	"regtest": &chaincfg.RegressionNetParams,
+	"signet":  &chaincfg.SigNetParams,
+}
+
+// clnNetworkNames maps Core Lightning network names
+// to the network names used by lnd.
+var clnNetworkNames = map[string]string{
+	"bitcoin": "mainnet",
+}
+
+type clnGetInfo struct {
+	ID          string `json:"id"`
+	Alias       string `json:"alias"`
+	Network     string `json:"network"`
+	BlockHeight uint32 `json:"blockheight"`
+
+	WarningBitcoindSync   string `json:"warning_bitcoind_sync"`
+	WarningLightningdSync string `json:"warning_lightningd_sync"`
+}
+
+// connectionState returns the connection state of the node,
+// which is syncing while any sync warning is reported.
+func (i *clnGetInfo) connectionState() ConnectionState {
+	if i.WarningBitcoindSync != "" || i.WarningLightningdSync != "" {
+		return ConnectionSYNCING
+	}
+
+	return ConnectionREADY
+}
+
+func (i *clnGetInfo) selfInfo() SelfInfo {
+	network := i.Network
+	if name, ok := clnNetworkNames[network]; ok {
+		network = name
+	}
+
+	return SelfInfo{
+		Node: LightningNode{
+			Alias:   i.Alias,
+			Address: i.ID,
+		},
+		Chains: []Chain{{
+			Chain:   "bitcoin",
+			Network: network,
+		}},
+	}
+}
+
+func (m *clnManager) getInfo(ctx context.Context) (*clnGetInfo, error) {
+	var info clnGetInfo
+	if err := m.client.call(ctx, "getinfo", nil, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// GetSelfInfo returns information about the local node.
+func (m *clnManager) GetSelfInfo(ctx context.Context) (SelfInfo, error) {
+	info, err := m.getInfo(ctx)
+	if err != nil {
+		return SelfInfo{}, err
+	}
+
+	return info.selfInfo(), nil
+}
+
+// GetSelfBalance returns information about the underlying node's balance.
+// Core Lightning does not report the balance of unsettled HTLCs.
+func (m *clnManager) GetSelfBalance(ctx context.Context) (*SelfBalance, error) {
+	var funds struct {
+		Outputs []struct {
+			AmountMsat clnMsat `json:"amount_msat"`
+			Status     string  `json:"status"`
+		} `json:"outputs"`
+		Channels []struct {
+			State         string  `json:"state"`
+			OurAmountMsat clnMsat `json:"our_amount_msat"`
+			AmountMsat    clnMsat `json:"amount_msat"`
+		} `json:"channels"`
+	}
+	if err := m.client.call(ctx, "listfunds", nil, &funds); err != nil {
+		return nil, err
+	}
+
+	balance := &SelfBalance{}
+	for _, o := range funds.Outputs {
+		switch o.Status {
+		case "confirmed":
+			balance.WalletConfirmedBalanceSat += int64(o.AmountMsat) / 1000
+		case "unconfirmed":
+			balance.WalletUnconfirmedBalanceSat += int64(o.AmountMsat) / 1000
+		}
+	}
+	for _, c := range funds.Channels {
+		var alloc *BalanceAllocation
+		switch {
+		case c.State == clnStateNormal:
+			alloc = &balance.ChannelBalance
+		case clnPendingOpenStates[c.State]:
+			alloc = &balance.PendingOpenBalance
+		default:
+			continue
+		}
+		alloc.LocalMsat += uint64(c.OurAmountMsat)
+		alloc.RemoteMsat += uint64(c.AmountMsat - c.OurAmountMsat)
+	}
+
+	return balance, nil
+}
+
+type clnNode struct {
+	NodeID        string `json:"nodeid"`
+	Alias         string `json:"alias"`
+	Color         string `json:"color"`
+	LastTimestamp int64  `json:"last_timestamp"`
+	Features      string `json:"features"`
+	Addresses     []struct {
+		Type    string `json:"type"`
+		Address string `json:"address"`
+		Port    uint16 `json:"port"`
+	} `json:"addresses"`
+}
+
+func (m *clnManager) listNodes(ctx context.Context, id string) ([]clnNode, error) {
+	var params map[string]interface{}
+	if id != "" {
+		params = map[string]interface{}{"id": id}
+	}
+
+	var resp struct {
+		Nodes []clnNode `json:"nodes"`
+	}
+	if err := m.client.call(ctx, "listnodes", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Nodes, nil
+}
+
+// ListNodes returns the nodes present in the network graph.
+func (m *clnManager) ListNodes(ctx context.Context) ([]LightningNode, error) {
+	nodes, err := m.listNodes(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]LightningNode, len(nodes))
+	for i, n := range nodes {
+		res[i] = LightningNode{
+			Alias:   n.Alias,
+			Address: n.NodeID,
+		}
+	}
+
+	return res, nil
+}
+
+// GetNodeInfo returns the details of a node, as advertised to the network graph.
+func (m *clnManager) GetNodeInfo(ctx context.Context, address string) (*NodeInfo, error) {
+	if _, err := addressStrToBytes(address); err != nil {
+		return nil, err
+	}
+
+	nodes, err := m.listNodes(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, newErrorf(ErrNodeNotFound, "%s", address)
+	}
+	node := nodes[0]
+
+	var resp struct {
+		Channels []struct {
+			AmountMsat clnMsat `json:"amount_msat"`
+		} `json:"channels"`
+	}
+	if err := m.client.call(ctx, "listchannels", map[string]interface{}{
+		"source": address,
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	info := &NodeInfo{
+		Node: LightningNode{
+			Alias:   node.Alias,
+			Address: node.NodeID,
+		},
+		Addresses:   make([]NodeAddress, len(node.Addresses)),
+		Features:    parseCLNFeatures(node.Features),
+		Color:       "#" + node.Color,
+		NumChannels: uint32(len(resp.Channels)),
+	}
+	for i, a := range node.Addresses {
+		info.Addresses[i] = NodeAddress{
+			Network: "tcp",
+			Addr:    net.JoinHostPort(a.Address, strconv.Itoa(int(a.Port))),
+		}
+	}
+	if node.LastTimestamp != 0 {
+		info.LastUpdate = time.Unix(node.LastTimestamp, 0)
+	}
+	for _, c := range resp.Channels {
+		info.TotalCapacityMsat += int64(c.AmountMsat)
+	}
+
+	channels, err := m.ListChannels(ctx, ChannelFilter{Peer: address})
+	if err != nil {
+		return nil, err
+	}
+	info.HasChannel = len(channels) > 0
+
+	return info, nil
+}
+
+// parseCLNFeatures parses a hex-encoded feature bitfield,
+// as returned by Core Lightning.
+func parseCLNFeatures(features string) []Feature {
+	b, err := hex.DecodeString(features)
+	if err != nil {
+		return nil
+	}
+
+	fs := make(map[uint32]*lnrpc.Feature)
+	for i := range b {
+		for j := 0; j < 8; j++ {
+			if b[len(b)-1-i]&(1<<j) == 0 {
+				continue
+			}
+
+			bit := uint32(i*8 + j)
+			name, known := lnwire.Features[lnwire.FeatureBit(bit)]
+			fs[bit] = &lnrpc.Feature{
+				Name:       name,
+				IsRequired: bit%2 == 0,
+				IsKnown:    known,
+			}
+		}
+	}
+
+	return unmarshalFeatures(fs)
+}
+
+// ConnectNode creates a peer connection with a node.
+// If hostport is empty, the node addresses are looked up in the network graph.
+func (m *clnManager) ConnectNode(ctx context.Context, address string, hostport string) error {
+	if _, err := addressStrToBytes(address); err != nil {
+		return err
+	}
+
+	params := map[string]interface{}{"id": address}
+	if hostport != "" {
+		host, port, err := net.SplitHostPort(hostport)
+		if err != nil {
+			return withCause(newErrorf(ErrInvalidAddress, "%s", hostport), err)
+		}
+		params["host"] = host
+		params["port"] = port
+	}
+
+	return m.client.call(ctx, "connect", params, nil)
+}
+
+// SubscribePeerEvents is not supported, since Core Lightning
+// does not push peer connection changes over JSON-RPC.
+func (m *clnManager) SubscribePeerEvents(_ context.Context) (<-chan PeerEventUpdate, error) {
+	return nil, newErrorf(ErrNotSupported, "peer event subscriptions")
+}
+
+// ListPeers returns the nodes connected to the underlying node.
+func (m *clnManager) ListPeers(ctx context.Context) ([]Peer, error) {
+	var resp struct {
+		Peers []struct {
+			ID        string   `json:"id"`
+			Connected bool     `json:"connected"`
+			NetAddr   []string `json:"netaddr"`
+			Features  string   `json:"features"`
+		} `json:"peers"`
+	}
+	if err := m.client.call(ctx, "listpeers", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	var peers []Peer
+	for _, p := range resp.Peers {
+		if !p.Connected {
+			continue
+		}
+
+		peer := Peer{
+			Address:  p.ID,
+			Features: parseCLNFeatures(p.Features),
+		}
+		if len(p.NetAddr) > 0 {
+			peer.Host = p.NetAddr[0]
+		}
+		peers = append(peers, peer)
+	}
+
+	return peers, nil
+}
+
+// DisconnectPeer disconnects from a peer.
+func (m *clnManager) DisconnectPeer(ctx context.Context, address string) error {
+	if _, err := addressStrToBytes(address); err != nil {
+		return err
+	}
+
+	return m.client.call(ctx, "disconnect", map[string]interface{}{
+		"id": address,
+	}, nil)
+}
+
+// SignMessage signs a message with the key of the underlying node.
+func (m *clnManager) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
+	var resp struct {
+		ZBase string `json:"zbase"`
+	}
+	if err := m.client.call(ctx, "signmessage", map[string]interface{}{
+		"message": string(message),
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	return signatureStrToBytes(resp.ZBase)
+}
+
+// VerifySignatureExtractPubkey verifies the signature
+// over the message, and returns the extracted pubkey.
+func (m *clnManager) VerifySignatureExtractPubkey(ctx context.Context,
+	message, signature []byte) (string, error) {
+
+	var resp struct {
+		Pubkey   string `json:"pubkey"`
+		Verified bool   `json:"verified"`
+	}
+	if err := m.client.call(ctx, "checkmessage", map[string]interface{}{
+		"message": string(message),
+		"zbase":   signatureBytesToStr(signature),
+	}, &resp); err != nil {
+		return "", err
+	}
+	if !resp.Verified {
+		return "", newError(ErrInvalidSignature)
+	}
+
+	return resp.Pubkey, nil
+}
+
+// SendCustomMessage sends a set of records to a directly connected peer
+// over a custom peer message.
+func (m *clnManager) SendCustomMessage(ctx context.Context,
+	peer string, records map[uint64][]byte) error {
+
+	if _, err := addressStrToBytes(peer); err != nil {
+		return err
+	}
+
+	data, err := encodeCustomRecords(records)
+	if err != nil {
+		return errors.Wrap(err, "could not encode custom message")
+	}
+
+	// The message is prefixed with its (2-byte) type.
+	msg := make([]byte, 2, 2+len(data))
+	binary.BigEndian.PutUint16(msg, uint16(CustomMessageType))
+	msg = append(msg, data...)
+
+	if err := m.client.call(ctx, "sendcustommsg", map[string]interface{}{
+		"node_id": peer,
+		"msg":     hex.EncodeToString(msg),
+	}, nil); err != nil {
+		return errors.Wrap(err, "custom message sending failed")
+	}
+
+	return nil
+}
+
+// SubscribeCustomMessages is not supported, since Core Lightning
+// only delivers custom messages to plugins.
+func (m *clnManager) SubscribeCustomMessages(_ context.Context) (<-chan CustomMessageUpdate, error) {
+	return nil, newErrorf(ErrNotSupported,
+		"custom messages are only delivered to Core Lightning plugins")
+}
@@ -0,0 +1,481 @@
+package lnchat
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// clnStateNormal is the state of open channels.
+const clnStateNormal = "CHANNELD_NORMAL"
+
+// clnPendingOpenStates are the states of channels being opened.
+var clnPendingOpenStates = map[string]bool{
+	"OPENINGD":                  true,
+	"CHANNELD_AWAITING_LOCKIN":  true,
+	"DUALOPEND_OPEN_INIT":       true,
+	"DUALOPEND_AWAITING_LOCKIN": true,
+}
+
+// clnClosingStates are the states of channels being closed,
+// mapped to the respective pending channel state.
+var clnClosingStates = map[string]PendingChannelState{
+	"CHANNELD_SHUTTING_DOWN": PendingWAITINGCLOSE,
+	"CLOSINGD_SIGEXCHANGE":   PendingWAITINGCLOSE,
+	"CLOSINGD_COMPLETE":      PendingWAITINGCLOSE,
+	"AWAITING_UNILATERAL":    PendingFORCECLOSING,
+	"FUNDING_SPEND_SEEN":     PendingFORCECLOSING,
+	"ONCHAIN":                PendingFORCECLOSING,
+}
+
+type clnChannel struct {
+	PeerID           string  `json:"peer_id"`
+	PeerConnected    bool    `json:"peer_connected"`
+	State            string  `json:"state"`
+	ShortChannelID   string  `json:"short_channel_id"`
+	ChannelID        string  `json:"channel_id"`
+	FundingTxid      string  `json:"funding_txid"`
+	FundingOutnum    uint32  `json:"funding_outnum"`
+	Private          bool    `json:"private"`
+	Opener           string  `json:"opener"`
+	Closer           string  `json:"closer"`
+	TotalMsat        clnMsat `json:"total_msat"`
+	ToUsMsat         clnMsat `json:"to_us_msat"`
+	InFulfilledMsat  clnMsat `json:"in_fulfilled_msat"`
+	OutFulfilledMsat clnMsat `json:"out_fulfilled_msat"`
+	Htlcs            []struct {
+		AmountMsat clnMsat `json:"amount_msat"`
+	} `json:"htlcs"`
+	Updates struct {
+		Local clnChannelPolicy `json:"local"`
+	} `json:"updates"`
+}
+
+type clnChannelPolicy struct {
+	HtlcMinimumMsat           clnMsat `json:"htlc_minimum_msat"`
+	HtlcMaximumMsat           clnMsat `json:"htlc_maximum_msat"`
+	CltvExpiryDelta           uint32  `json:"cltv_expiry_delta"`
+	FeeBaseMsat               clnMsat `json:"fee_base_msat"`
+	FeeProportionalMillionths uint32  `json:"fee_proportional_millionths"`
+}
+
+func (c *clnChannel) channelPoint() ChannelPoint {
+	return ChannelPoint{
+		FundingTxid: c.FundingTxid,
+		OutputIndex: c.FundingOutnum,
+	}
+}
+
+func (c *clnChannel) balance() BalanceAllocation {
+	return BalanceAllocation{
+		LocalMsat:  uint64(c.ToUsMsat),
+		RemoteMsat: uint64(c.TotalMsat - c.ToUsMsat),
+	}
+}
+
+// channel creates an lnchat.Channel from an open channel.
+func (c *clnChannel) channel() (*Channel, error) {
+	chanID, err := parseShortChannelID(c.ShortChannelID)
+	if err != nil {
+		return nil, err
+	}
+
+	var unsettled int64
+	for _, h := range c.Htlcs {
+		unsettled += int64(h.AmountMsat)
+	}
+
+	return &Channel{
+		ChannelPoint:         c.channelPoint(),
+		ChannelID:            chanID,
+		RemoteAddress:        c.PeerID,
+		Active:               c.PeerConnected,
+		Private:              c.Private,
+		Initiator:            c.Opener == "local",
+		CapacityMsat:         int64(c.TotalMsat),
+		Balance:              c.balance(),
+		UnsettledBalanceMsat: unsettled,
+		TotalSentMsat:        int64(c.OutFulfilledMsat),
+		TotalReceivedMsat:    int64(c.InFulfilledMsat),
+	}, nil
+}
+
+// closedChannel creates an lnchat.ClosedChannel from a closing channel.
+func (c *clnChannel) closedChannel() *ClosedChannel {
+	closed := &ClosedChannel{
+		RemoteAddress:      c.PeerID,
+		CapacityMsat:       int64(c.TotalMsat),
+		SettledBalanceMsat: int64(c.ToUsMsat),
+	}
+	closed.ChannelID, _ = parseShortChannelID(c.ShortChannelID)
+
+	switch {
+	case clnClosingStates[c.State] == PendingWAITINGCLOSE:
+		closed.CloseType = ChannelCloseCOOPERATIVE
+	case c.Closer == "remote":
+		closed.CloseType = ChannelCloseREMOTEFORCE
+	default:
+		closed.CloseType = ChannelCloseLOCALFORCE
+	}
+
+	return closed
+}
+
+func (m *clnManager) listPeerChannels(ctx context.Context) ([]clnChannel, error) {
+	var resp struct {
+		Channels []clnChannel `json:"channels"`
+	}
+	if err := m.client.call(ctx, "listpeerchannels", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Channels, nil
+}
+
+// findChannel returns the channel with the provided channel point.
+func (m *clnManager) findChannel(ctx context.Context,
+	chanPoint ChannelPoint) (*clnChannel, error) {
+
+	channels, err := m.listPeerChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range channels {
+		if channels[i].channelPoint() == chanPoint {
+			return &channels[i], nil
+		}
+	}
+
+	return nil, newErrorf(ErrUnknown, "channel %s:%d not found",
+		chanPoint.FundingTxid, chanPoint.OutputIndex)
+}
+
+// OpenChannel opens a channel to a peer, funded by the wallet
+// of the underlying node.
+func (m *clnManager) OpenChannel(ctx context.Context, address string,
+	private bool, amtMsat, pushAmtMsat uint64,
+	minOpenConfirmations int32, txOpts TxFeeOptions) (*ChannelPoint, error) {
+
+	if _, err := addressStrToBytes(address); err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"id":       address,
+		"amount":   amtMsat / 1000,
+		"announce": !private,
+	}
+	if pushAmtMsat != 0 {
+		params["push_msat"] = pushAmtMsat
+	}
+	if minOpenConfirmations != 0 {
+		params["minconf"] = minOpenConfirmations
+	}
+	if feerate := clnFeerate(txOpts); feerate != "" {
+		params["feerate"] = feerate
+	}
+
+	var resp struct {
+		Txid   string `json:"txid"`
+		Outnum uint32 `json:"outnum"`
+	}
+	if err := m.client.call(ctx, "fundchannel", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &ChannelPoint{
+		FundingTxid: resp.Txid,
+		OutputIndex: resp.Outnum,
+	}, nil
+}
+
+// clnFeerate returns the Core Lightning fee rate matching the provided
+// fee options, or an empty string if no preference is expressed.
+// A confirmation target is mapped to the closest Core Lightning
+// fee rate estimation (urgent, normal or slow).
+func clnFeerate(txOpts TxFeeOptions) string {
+	switch {
+	case txOpts.SatPerVByte != 0:
+		return fmt.Sprintf("%dperkb", txOpts.SatPerVByte*1000)
+	case txOpts.TargetConfBlock != 0 && txOpts.TargetConfBlock <= 6:
+		return "urgent"
+	case txOpts.TargetConfBlock != 0 && txOpts.TargetConfBlock <= 12:
+		return "normal"
+	case txOpts.TargetConfBlock != 0:
+		return "slow"
+	default:
+		return ""
+	}
+}
+
+// ListChannels returns the open channels of the underlying node
+// that match the provided filter.
+func (m *clnManager) ListChannels(ctx context.Context, filter ChannelFilter) ([]Channel, error) {
+	resp, err := m.listPeerChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []Channel
+	for _, c := range resp {
+		switch {
+		case c.State != clnStateNormal,
+			filter.Peer != "" && c.PeerID != filter.Peer,
+			filter.ActiveOnly && !c.PeerConnected,
+			filter.InactiveOnly && c.PeerConnected,
+			filter.PublicOnly && c.Private,
+			filter.PrivateOnly && !c.Private:
+
+			continue
+		}
+
+		ch, err := c.channel()
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, *ch)
+	}
+
+	return channels, nil
+}
+
+// ListPendingChannels returns the channels of the underlying node
+// that are being opened or closed.
+func (m *clnManager) ListPendingChannels(ctx context.Context) ([]PendingChannel, error) {
+	resp, err := m.listPeerChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var channels []PendingChannel
+	for _, c := range resp {
+		state, closing := clnClosingStates[c.State]
+		if !closing && !clnPendingOpenStates[c.State] {
+			continue
+		}
+		if !closing {
+			state = PendingOPEN
+		}
+
+		ch := PendingChannel{
+			ChannelPoint:  c.channelPoint(),
+			RemoteAddress: c.PeerID,
+			State:         state,
+			Initiator:     c.Opener == "local",
+			CapacityMsat:  int64(c.TotalMsat),
+			Balance:       c.balance(),
+		}
+		if closing {
+			ch.LimboBalanceMsat = int64(c.ToUsMsat)
+		}
+		channels = append(channels, ch)
+	}
+
+	return channels, nil
+}
+
+// CloseChannel closes a channel, returning a channel over which
+// the progress of the closing is received.
+// A forced close is initiated if the peer does not respond
+// to a cooperative close immediately.
+// The closing is considered confirmed once Core Lightning
+// observes the closing transaction on chain.
+func (m *clnManager) CloseChannel(ctx context.Context, chanPoint ChannelPoint,
+	force bool, _ TxFeeOptions) (<-chan ChannelCloseUpdate, error) {
+
+	c, err := m.findChannel(ctx, chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{"id": c.ChannelID}
+	if force {
+		params["unilateraltimeout"] = 1
+	}
+
+	var resp struct {
+		Txid string `json:"txid"`
+	}
+	if err := m.client.call(ctx, "close", params, &resp); err != nil {
+		return nil, err
+	}
+
+	updates := make(chan ChannelCloseUpdate)
+	go func() {
+		defer close(updates)
+
+		send := func(u ChannelCloseUpdate) bool {
+			select {
+			case updates <- u:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if !send(ChannelCloseUpdate{Status: &ChannelCloseStatus{
+			State:       ChannelClosePENDING,
+			ClosingTxid: resp.Txid,
+		}}) {
+			return
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(clnPollInterval):
+			}
+
+			channels, err := m.listPeerChannels(ctx)
+			if err != nil {
+				send(ChannelCloseUpdate{Err: err})
+				return
+			}
+
+			confirmed := true
+			for _, c := range channels {
+				if c.channelPoint() == chanPoint {
+					confirmed = c.State == "ONCHAIN"
+					break
+				}
+			}
+			if confirmed {
+				send(ChannelCloseUpdate{Status: &ChannelCloseStatus{
+					State:       ChannelCloseCONFIRMED,
+					ClosingTxid: resp.Txid,
+				}})
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// SubscribeChannelEvents returns a channel over which channel events
+// are received. Since Core Lightning does not push channel events
+// over JSON-RPC, they are derived by periodically polling the channels.
+func (m *clnManager) SubscribeChannelEvents(ctx context.Context) (<-chan ChannelEventUpdate, error) {
+	channels, err := m.listPeerChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan ChannelEventUpdate)
+	go func() {
+		defer close(updates)
+
+		prev := clnChannelsByPoint(channels)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(clnPollInterval):
+			}
+
+			channels, err := m.listPeerChannels(ctx)
+			if err != nil {
+				select {
+				case updates <- ChannelEventUpdate{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+
+			cur := clnChannelsByPoint(channels)
+			for _, event := range clnChannelEvents(prev, cur) {
+				select {
+				case updates <- ChannelEventUpdate{Event: event}:
+				case <-ctx.Done():
+					return
+				}
+			}
+			prev = cur
+		}
+	}()
+
+	return updates, nil
+}
+
+func clnChannelsByPoint(channels []clnChannel) map[ChannelPoint]clnChannel {
+	res := make(map[ChannelPoint]clnChannel, len(channels))
+	for _, c := range channels {
+		res[c.channelPoint()] = c
+	}
+
+	return res
+}
+
+// clnChannelEvents returns the channel events leading
+// from one channel state snapshot to another.
+func clnChannelEvents(prev, cur map[ChannelPoint]clnChannel) []*ChannelEvent {
+	var events []*ChannelEvent
+
+	for point, c := range cur {
+		p, existed := prev[point]
+		_, closing := clnClosingStates[c.State]
+		_, wasClosing := clnClosingStates[p.State]
+
+		switch {
+		case clnPendingOpenStates[c.State] && !existed:
+			events = append(events, &ChannelEvent{
+				Type:         ChannelEventPENDINGOPEN,
+				ChannelPoint: point,
+			})
+		case c.State == clnStateNormal && p.State != clnStateNormal:
+			ch, err := c.channel()
+			if err != nil {
+				continue
+			}
+			events = append(events, &ChannelEvent{
+				Type:         ChannelEventOPEN,
+				ChannelPoint: point,
+				Channel:      ch,
+			})
+			if c.PeerConnected {
+				events = append(events, &ChannelEvent{
+					Type:         ChannelEventACTIVE,
+					ChannelPoint: point,
+				})
+			}
+		case c.State == clnStateNormal && c.PeerConnected != p.PeerConnected:
+			eventType := ChannelEventINACTIVE
+			if c.PeerConnected {
+				eventType = ChannelEventACTIVE
+			}
+			events = append(events, &ChannelEvent{
+				Type:         eventType,
+				ChannelPoint: point,
+			})
+		case closing && !wasClosing:
+			events = append(events, &ChannelEvent{
+				Type:          ChannelEventCLOSED,
+				ChannelPoint:  point,
+				ClosedChannel: c.closedChannel(),
+			})
+		}
+	}
+
+	// Channels no longer reported have been resolved.
+	for point, p := range prev {
+		if _, ok := cur[point]; ok {
+			continue
+		}
+
+		if _, closing := clnClosingStates[p.State]; !closing {
+			events = append(events, &ChannelEvent{
+				Type:          ChannelEventCLOSED,
+				ChannelPoint:  point,
+				ClosedChannel: p.closedChannel(),
+			})
+		}
+		events = append(events, &ChannelEvent{
+			Type:         ChannelEventFULLYRESOLVED,
+			ChannelPoint: point,
+		})
+	}
+
+	return events
+}
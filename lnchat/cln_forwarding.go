@@ -0,0 +1,125 @@
+package lnchat
+
+import (
+	"context"
+)
+
+// GetChannelPolicy returns the forwarding policy
+// of the underlying node for a channel.
+func (m *clnManager) GetChannelPolicy(ctx context.Context,
+	chanPoint ChannelPoint) (*ChannelPolicy, error) {
+
+	c, err := m.findChannel(ctx, chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := c.Updates.Local
+	return &ChannelPolicy{
+		BaseFeeMsat:   int64(policy.FeeBaseMsat),
+		FeeRatePPM:    policy.FeeProportionalMillionths,
+		TimeLockDelta: policy.CltvExpiryDelta,
+		MinHTLCMsat:   uint64(policy.HtlcMinimumMsat),
+		MaxHTLCMsat:   uint64(policy.HtlcMaximumMsat),
+	}, nil
+}
+
+// UpdateChannelPolicy updates the forwarding policy
+// of the underlying node for a channel, or for all channels
+// if no channel point is provided.
+// A zero minimum or maximum HTLC amount leaves the respective limit unchanged.
+// Since Core Lightning does not support per-channel time-lock deltas,
+// the time-lock delta must not be specified.
+func (m *clnManager) UpdateChannelPolicy(ctx context.Context,
+	chanPoint *ChannelPoint, policy ChannelPolicy) error {
+
+	if policy.TimeLockDelta != 0 {
+		return newErrorf(ErrNotSupported,
+			"time-lock delta is not configurable per channel")
+	}
+
+	params := map[string]interface{}{
+		"id":      "all",
+		"feebase": policy.BaseFeeMsat,
+		"feeppm":  policy.FeeRatePPM,
+	}
+	if chanPoint != nil {
+		c, err := m.findChannel(ctx, *chanPoint)
+		if err != nil {
+			return err
+		}
+		params["id"] = c.ChannelID
+	}
+	if policy.MinHTLCMsat != 0 {
+		params["htlcmin"] = policy.MinHTLCMsat
+	}
+	if policy.MaxHTLCMsat != 0 {
+		params["htlcmax"] = policy.MaxHTLCMsat
+	}
+
+	return m.client.call(ctx, "setchannel", params, nil)
+}
+
+type clnForward struct {
+	InChannel    string  `json:"in_channel"`
+	OutChannel   string  `json:"out_channel"`
+	InMsat       clnMsat `json:"in_msat"`
+	OutMsat      clnMsat `json:"out_msat"`
+	FeeMsat      clnMsat `json:"fee_msat"`
+	ResolvedTime float64 `json:"resolved_time"`
+}
+
+// ForwardingHistory returns up to maxEvents forwarding events
+// settled within the provided time range (in seconds since Unix epoch),
+// starting after the event with index indexOffset.
+// Event indexes are relative to the time range.
+// A zero end time signifies the current time.
+func (m *clnManager) ForwardingHistory(ctx context.Context,
+	startTimeSec, endTimeSec int64, indexOffset uint64,
+	maxEvents uint32) ([]ForwardingEvent, error) {
+
+	var resp struct {
+		Forwards []clnForward `json:"forwards"`
+	}
+	if err := m.client.call(ctx, "listforwards", map[string]interface{}{
+		"status": "settled",
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	var events []ForwardingEvent
+	var index uint64
+	for _, f := range resp.Forwards {
+		resolved := int64(f.ResolvedTime)
+		if resolved < startTimeSec || (endTimeSec != 0 && resolved >= endTimeSec) {
+			continue
+		}
+		if index++; index <= indexOffset {
+			continue
+		}
+		if uint32(len(events)) == maxEvents {
+			break
+		}
+
+		chanIDIn, err := parseShortChannelID(f.InChannel)
+		if err != nil {
+			return nil, withCause(newError(ErrInternal), err)
+		}
+		chanIDOut, err := parseShortChannelID(f.OutChannel)
+		if err != nil {
+			return nil, withCause(newError(ErrInternal), err)
+		}
+
+		events = append(events, ForwardingEvent{
+			Index:       index,
+			TimestampNs: int64(f.ResolvedTime * 1e9),
+			ChanIDIn:    chanIDIn,
+			ChanIDOut:   chanIDOut,
+			AmtInMsat:   uint64(f.InMsat),
+			AmtOutMsat:  uint64(f.OutMsat),
+			FeeMsat:     uint64(f.FeeMsat),
+		})
+	}
+
+	return events, nil
+}
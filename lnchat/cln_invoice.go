@@ -0,0 +1,383 @@
+package lnchat
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// Core Lightning invoice states.
+const (
+	clnInvoiceUnpaid  = "unpaid"
+	clnInvoicePaid    = "paid"
+	clnInvoiceExpired = "expired"
+)
+
+// clnInvoiceLabelPrefix is the prefix of the labels of created invoices,
+// which are required to be unique by Core Lightning.
+const clnInvoiceLabelPrefix = "c13n-"
+
+type clnInvoice struct {
+	Label              string   `json:"label"`
+	Bolt11             string   `json:"bolt11"`
+	PaymentHash        string   `json:"payment_hash"`
+	AmountMsat         *clnMsat `json:"amount_msat"`
+	Status             string   `json:"status"`
+	PayIndex           uint64   `json:"pay_index"`
+	AmountReceivedMsat clnMsat  `json:"amount_received_msat"`
+	PaidAt             int64    `json:"paid_at"`
+	PaymentPreimage    string   `json:"payment_preimage"`
+	Description        string   `json:"description"`
+	ExpiresAt          int64    `json:"expires_at"`
+}
+
+// unmarshalInvoice creates an lnchat.Invoice from a Core Lightning invoice.
+// The creation time, expiry and route hints are decoded
+// from the payment request, if present.
+func (m *clnManager) unmarshalInvoice(i *clnInvoice) (*Invoice, error) {
+	preimage, err := hex.DecodeString(i.PaymentPreimage)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invoice preimage: %w", err)
+	}
+
+	inv := &Invoice{
+		Memo:           i.Description,
+		Hash:           i.PaymentHash,
+		Preimage:       preimage,
+		PaymentRequest: i.Bolt11,
+		AmtPaid:        NewAmount(int64(i.AmountReceivedMsat)),
+		SettleIndex:    i.PayIndex,
+	}
+	if i.AmountMsat != nil {
+		inv.Value = NewAmount(int64(*i.AmountMsat))
+	}
+
+	switch i.Status {
+	case clnInvoiceUnpaid:
+		inv.State = InvoiceOPEN
+	case clnInvoicePaid:
+		inv.State = InvoiceSETTLED
+		inv.SettleTimeSec = i.PaidAt
+		inv.Htlcs = []InvoiceHTLC{{
+			Amount:         inv.AmtPaid,
+			State:          lnrpc.InvoiceHTLCState_SETTLED,
+			AcceptTimeSec:  i.PaidAt,
+			ResolveTimeSec: i.PaidAt,
+		}}
+	case clnInvoiceExpired:
+		inv.State = InvoiceCANCELLED
+	}
+
+	// Spontaneous payments create invoices without payment requests.
+	if i.Bolt11 == "" {
+		inv.CreatedTimeSec = i.PaidAt
+		return inv, nil
+	}
+
+	req, err := zpay32.Decode(i.Bolt11, m.chainParams)
+	if err != nil {
+		return nil, fmt.Errorf("invalid invoice payment request: %w", err)
+	}
+	inv.CreatedTimeSec = req.Timestamp.Unix()
+	inv.Expiry = int64(req.Expiry() / time.Second)
+	inv.CltvExpiry = req.MinFinalCLTVExpiry()
+	for _, hint := range req.RouteHints {
+		routeHint := RouteHint{HopHints: make([]HopHint, len(hint))}
+		for j, h := range hint {
+			routeHint.HopHints[j] = HopHint{
+				NodeID:          NodeID{route.NewVertex(h.NodeID)},
+				ChanID:          h.ChannelID,
+				FeeBaseMsat:     h.FeeBaseMSat,
+				FeeRate:         h.FeeProportionalMillionths,
+				CltvExpiryDelta: uint32(h.CLTVExpiryDelta),
+			}
+		}
+		inv.RouteHints = append(inv.RouteHints, routeHint)
+	}
+	inv.Private = len(inv.RouteHints) > 0
+
+	return inv, nil
+}
+
+// DecodePayReq decodes a payment request string.
+func (m *clnManager) DecodePayReq(ctx context.Context, payReq string) (*PayReq, error) {
+	var resp struct {
+		Payee              string   `json:"payee"`
+		PaymentHash        string   `json:"payment_hash"`
+		AmountMsat         *clnMsat `json:"amount_msat"`
+		CreatedAt          int64    `json:"created_at"`
+		Expiry             int64    `json:"expiry"`
+		MinFinalCltvExpiry uint64   `json:"min_final_cltv_expiry"`
+		DescriptionHash    string   `json:"description_hash"`
+		Routes             [][]struct {
+			Pubkey                    string `json:"pubkey"`
+			ShortChannelID            string `json:"short_channel_id"`
+			FeeBaseMsat               uint32 `json:"fee_base_msat"`
+			FeeProportionalMillionths uint32 `json:"fee_proportional_millionths"`
+			CltvExpiryDelta           uint32 `json:"cltv_expiry_delta"`
+		} `json:"routes"`
+	}
+	if err := m.client.call(ctx, "decodepay", map[string]interface{}{
+		"bolt11": payReq,
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	dest, err := NewNodeFromString(resp.Payee)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &PayReq{
+		Destination:     dest,
+		Hash:            resp.PaymentHash,
+		CreatedTimeSec:  resp.CreatedAt,
+		Expiry:          resp.Expiry,
+		CltvExpiry:      resp.MinFinalCltvExpiry,
+		DescriptionHash: resp.DescriptionHash,
+	}
+	if resp.AmountMsat != nil {
+		req.Amt = NewAmount(int64(*resp.AmountMsat))
+	}
+	for _, r := range resp.Routes {
+		hint := RouteHint{HopHints: make([]HopHint, len(r))}
+		for i, h := range r {
+			nodeID, err := NewNodeFromString(h.Pubkey)
+			if err != nil {
+				return nil, err
+			}
+			chanID, err := parseShortChannelID(h.ShortChannelID)
+			if err != nil {
+				return nil, err
+			}
+			hint.HopHints[i] = HopHint{
+				NodeID:          nodeID,
+				ChanID:          chanID,
+				FeeBaseMsat:     h.FeeBaseMsat,
+				FeeRate:         h.FeeProportionalMillionths,
+				CltvExpiryDelta: h.CltvExpiryDelta,
+			}
+		}
+		req.RouteHints = append(req.RouteHints, hint)
+	}
+
+	return req, nil
+}
+
+// CreateInvoice creates and returns an invoice for the specified amount
+// with the specified memo and expiry time (in seconds).
+// If privateHints is true, the invoice includes hints for private channels.
+func (m *clnManager) CreateInvoice(ctx context.Context, memo string, amt Amount,
+	expiry int64, privateHints bool) (*Invoice, error) {
+
+	preimage, err := generatePreimage()
+	if err != nil {
+		return nil, err
+	}
+	hash := preimage.Hash()
+
+	params := map[string]interface{}{
+		"amount_msat":           "any",
+		"label":                 clnInvoiceLabelPrefix + hash.String(),
+		"description":           memo,
+		"preimage":              preimage.String(),
+		"exposeprivatechannels": privateHints,
+	}
+	if amt.Msat() != 0 {
+		params["amount_msat"] = amt.Msat()
+	}
+	if expiry != 0 {
+		params["expiry"] = expiry
+	}
+
+	var resp struct {
+		PaymentHash string `json:"payment_hash"`
+		Bolt11      string `json:"bolt11"`
+		ExpiresAt   int64  `json:"expires_at"`
+	}
+	if err := m.client.call(ctx, "invoice", params, &resp); err != nil {
+		return nil, err
+	}
+
+	i := &clnInvoice{
+		Label:           params["label"].(string),
+		Bolt11:          resp.Bolt11,
+		PaymentHash:     resp.PaymentHash,
+		Status:          clnInvoiceUnpaid,
+		PaymentPreimage: preimage.String(),
+		Description:     memo,
+		ExpiresAt:       resp.ExpiresAt,
+	}
+	if amt.Msat() != 0 {
+		amtMsat := clnMsat(amt.Msat())
+		i.AmountMsat = &amtMsat
+	}
+
+	inv, err := m.unmarshalInvoice(i)
+	if err != nil {
+		return nil, withCause(newError(ErrInternal), err)
+	}
+	inv.Private = privateHints
+
+	return inv, nil
+}
+
+func (m *clnManager) lookupInvoice(ctx context.Context, payHash string) (*clnInvoice, error) {
+	var resp struct {
+		Invoices []clnInvoice `json:"invoices"`
+	}
+	if err := m.client.call(ctx, "listinvoices", map[string]interface{}{
+		"payment_hash": payHash,
+	}, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Invoices) == 0 {
+		return nil, newErrorf(ErrUnknown,
+			"there are no existing invoices for payment hash %s", payHash)
+	}
+
+	return &resp.Invoices[0], nil
+}
+
+// LookupInvoice retrieves an invoice by its payment hash.
+func (m *clnManager) LookupInvoice(ctx context.Context, payHash string) (*Invoice, error) {
+	i, err := m.lookupInvoice(ctx, payHash)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := m.unmarshalInvoice(i)
+	if err != nil {
+		return nil, withCause(newError(ErrInternal), err)
+	}
+
+	return inv, nil
+}
+
+// ListInvoices returns up to maxInvoices invoices in ascending order,
+// starting after the invoice with add index indexOffset.
+// Since Core Lightning does not index invoice creation,
+// only paid invoices are returned, indexed by their pay index.
+func (m *clnManager) ListInvoices(ctx context.Context,
+	indexOffset, maxInvoices uint64) ([]*Invoice, error) {
+
+	var resp struct {
+		Invoices []clnInvoice `json:"invoices"`
+	}
+	if err := m.client.call(ctx, "listinvoices", nil, &resp); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(resp.Invoices, func(i, j int) bool {
+		return resp.Invoices[i].PayIndex < resp.Invoices[j].PayIndex
+	})
+
+	var invoices []*Invoice
+	for i := range resp.Invoices {
+		if resp.Invoices[i].PayIndex <= indexOffset {
+			continue
+		}
+		if uint64(len(invoices)) == maxInvoices {
+			break
+		}
+
+		inv, err := m.unmarshalInvoice(&resp.Invoices[i])
+		if err != nil {
+			return nil, withCause(newError(ErrInternal), err)
+		}
+		inv.AddIndex = inv.SettleIndex
+		invoices = append(invoices, inv)
+	}
+
+	return invoices, nil
+}
+
+// CreateHoldInvoice is not supported, since Core Lightning
+// offers hold invoices only through plugins.
+func (m *clnManager) CreateHoldInvoice(_ context.Context, _, _ string,
+	_ Amount, _ int64, _ bool) (*Invoice, error) {
+
+	return nil, newErrorf(ErrNotSupported,
+		"hold invoices require a Core Lightning plugin")
+}
+
+// SettleInvoice is not supported, since Core Lightning
+// offers hold invoices only through plugins.
+func (m *clnManager) SettleInvoice(_ context.Context, _ string) error {
+	return newErrorf(ErrNotSupported,
+		"hold invoices require a Core Lightning plugin")
+}
+
+// CancelInvoice cancels an unpaid invoice.
+// Core Lightning deletes cancelled invoices.
+func (m *clnManager) CancelInvoice(ctx context.Context, payHash string) error {
+	i, err := m.lookupInvoice(ctx, payHash)
+	if err != nil {
+		return err
+	}
+
+	return m.client.call(ctx, "delinvoice", map[string]interface{}{
+		"label":  i.Label,
+		"status": i.Status,
+	}, nil)
+}
+
+// SubscribeInvoiceUpdates creates and returns a channel
+// over which invoice updates are received.
+// Core Lightning only reports paid invoices, in payment order,
+// starting after the invoice with the provided pay index
+// (or with the next paid invoice, if startIdx is 0).
+func (m *clnManager) SubscribeInvoiceUpdates(ctx context.Context, startIdx uint64,
+	filter InvoiceUpdateFilter) (<-chan InvoiceUpdate, error) {
+
+	updates := make(chan InvoiceUpdate)
+	go func() {
+		defer close(updates)
+
+		for idx := startIdx; ; {
+			var params map[string]interface{}
+			if idx != 0 {
+				params = map[string]interface{}{"lastpay_index": idx}
+			}
+
+			var i clnInvoice
+			err := m.client.call(ctx, "waitanyinvoice", params, &i)
+			if err != nil && ctx.Err() != nil {
+				return
+			}
+
+			var inv *Invoice
+			if err == nil {
+				idx = i.PayIndex
+				if inv, err = m.unmarshalInvoice(&i); err != nil {
+					err = withCause(newError(ErrInternal), err)
+				}
+			}
+
+			switch {
+			case err != nil:
+				select {
+				case updates <- InvoiceUpdate{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			case !filter(inv):
+				continue
+			}
+
+			select {
+			case updates <- InvoiceUpdate{Inv: inv}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
@@ -0,0 +1,526 @@
+package lnchat
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// Core Lightning payment (sendpay) states.
+const (
+	clnPaymentPending  = "pending"
+	clnPaymentComplete = "complete"
+	clnPaymentFailed   = "failed"
+)
+
+// clnRiskFactor is the risk factor used for route queries,
+// as used by default by Core Lightning for payments.
+const clnRiskFactor = 10
+
+// clnSendPay is a (partial) payment attempt, as returned by listsendpays.
+type clnSendPay struct {
+	ID              uint64  `json:"id"`
+	GroupID         uint64  `json:"groupid"`
+	PaymentHash     string  `json:"payment_hash"`
+	Status          string  `json:"status"`
+	AmountMsat      clnMsat `json:"amount_msat"`
+	AmountSentMsat  clnMsat `json:"amount_sent_msat"`
+	Destination     string  `json:"destination"`
+	CreatedAt       int64   `json:"created_at"`
+	CompletedAt     int64   `json:"completed_at"`
+	Bolt11          string  `json:"bolt11"`
+	PaymentPreimage string  `json:"payment_preimage"`
+}
+
+// clnPayments groups payment attempts by payment hash and group id
+// into payments, ordered by payment index.
+// The payment index of a payment is the smallest id of its attempts.
+// Since Core Lightning does not report the routes of attempts,
+// each attempt is represented by a single-hop route to the destination.
+func clnPayments(attempts []clnSendPay) []*Payment {
+	type groupKey struct {
+		hash  string
+		group uint64
+	}
+
+	groups := make(map[groupKey][]clnSendPay)
+	for _, a := range attempts {
+		key := groupKey{a.PaymentHash, a.GroupID}
+		groups[key] = append(groups[key], a)
+	}
+
+	payments := make([]*Payment, 0, len(groups))
+	for _, parts := range groups {
+		sort.Slice(parts, func(i, j int) bool {
+			return parts[i].ID < parts[j].ID
+		})
+
+		p := &Payment{
+			Hash:           parts[0].PaymentHash,
+			CreationTimeNs: parts[0].CreatedAt * int64(time.Second),
+			PaymentRequest: parts[0].Bolt11,
+			Status:         PaymentFAILED,
+			PaymentIndex:   parts[0].ID,
+			Htlcs:          make([]HTLCAttempt, len(parts)),
+		}
+
+		var amt int64
+		for i, a := range parts {
+			attempt := HTLCAttempt{
+				Route: Route{
+					Amt:  NewAmount(int64(a.AmountMsat)),
+					Fees: NewAmount(int64(a.AmountSentMsat - a.AmountMsat)),
+				},
+				AttemptTimeNs: a.CreatedAt * int64(time.Second),
+				ResolveTimeNs: a.CompletedAt * int64(time.Second),
+			}
+			if dest, err := NewNodeFromString(a.Destination); err == nil {
+				attempt.Route.Hops = []RouteHop{{
+					NodeID:       dest,
+					AmtToForward: attempt.Route.Amt,
+				}}
+			}
+
+			switch a.Status {
+			case clnPaymentComplete:
+				attempt.Status = lnrpc.HTLCAttempt_SUCCEEDED
+				attempt.Preimage, _ = hex.DecodeString(a.PaymentPreimage)
+				p.Status = PaymentSUCCEEDED
+				p.Preimage = a.PaymentPreimage
+				amt += int64(a.AmountMsat)
+			case clnPaymentPending:
+				attempt.Status = lnrpc.HTLCAttempt_IN_FLIGHT
+				if p.Status != PaymentSUCCEEDED {
+					p.Status = PaymentINFLIGHT
+				}
+				amt += int64(a.AmountMsat)
+			default:
+				attempt.Status = lnrpc.HTLCAttempt_FAILED
+			}
+			p.Htlcs[i] = attempt
+		}
+		if p.Status == PaymentFAILED {
+			amt = int64(parts[0].AmountMsat)
+		}
+		p.Value = NewAmount(amt)
+
+		payments = append(payments, p)
+	}
+
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].PaymentIndex < payments[j].PaymentIndex
+	})
+
+	return payments
+}
+
+func (m *clnManager) listPayments(ctx context.Context, payHash string) ([]*Payment, error) {
+	var params map[string]interface{}
+	if payHash != "" {
+		params = map[string]interface{}{"payment_hash": payHash}
+	}
+
+	var resp struct {
+		Payments []clnSendPay `json:"payments"`
+	}
+	if err := m.client.call(ctx, "listsendpays", params, &resp); err != nil {
+		return nil, err
+	}
+
+	return clnPayments(resp.Payments), nil
+}
+
+// SubscribePaymentUpdates creates and returns a channel
+// over which payment updates are received.
+//
+// The returned updates are those for which filter is true.
+// If startIdx is provided (non-zero), only updates for
+// payments after that payment index are returned.
+// Since Core Lightning does not push payment updates over JSON-RPC,
+// payments are periodically polled for changes.
+func (m *clnManager) SubscribePaymentUpdates(ctx context.Context, startIdx uint64,
+	filter PaymentUpdateFilter) (<-chan PaymentUpdate, error) {
+
+	payments, err := m.listPayments(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan PaymentUpdate)
+	go func() {
+		defer close(updates)
+
+		known := make(map[uint64]PaymentStatus)
+		for _, p := range payments {
+			if p.PaymentIndex <= startIdx {
+				known[p.PaymentIndex] = p.Status
+			}
+		}
+
+		for {
+			for _, p := range payments {
+				if status, ok := known[p.PaymentIndex]; ok && status == p.Status {
+					continue
+				}
+				known[p.PaymentIndex] = p.Status
+
+				if !filter(p) {
+					continue
+				}
+				select {
+				case updates <- PaymentUpdate{Payment: p}:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(clnPollInterval):
+			}
+
+			if payments, err = m.listPayments(ctx, ""); err != nil {
+				select {
+				case updates <- PaymentUpdate{Err: err}:
+				case <-ctx.Done():
+				}
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// ListPayments returns up to maxPayments payments in ascending order,
+// including incomplete ones, starting after the payment
+// with payment index indexOffset.
+func (m *clnManager) ListPayments(ctx context.Context,
+	indexOffset, maxPayments uint64) ([]*Payment, error) {
+
+	payments, err := m.listPayments(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*Payment
+	for _, p := range payments {
+		if p.PaymentIndex <= indexOffset {
+			continue
+		}
+		if uint64(len(res)) == maxPayments {
+			break
+		}
+		res = append(res, p)
+	}
+
+	return res, nil
+}
+
+// clnRouteRestrictions returns the route restriction parameters
+// corresponding to the payment options.
+// Outgoing channel and last hop restrictions are not supported,
+// while success probabilities are not estimated,
+// so minimum success probabilities are always met.
+func clnRouteRestrictions(payOpts PaymentOptions) (map[string]interface{}, error) {
+	if len(payOpts.OutgoingChanIDs) != 0 || payOpts.LastHopAddress != "" {
+		return nil, newErrorf(ErrNotSupported,
+			"outgoing channel and last hop restrictions")
+	}
+
+	params := make(map[string]interface{})
+
+	var exclude []string
+	for _, addr := range payOpts.IgnoredNodes {
+		if _, err := addressStrToBytes(addr); err != nil {
+			return nil, err
+		}
+		exclude = append(exclude, addr)
+	}
+	for _, chanID := range payOpts.IgnoredChannels {
+		scid := formatShortChannelID(chanID)
+		exclude = append(exclude, scid+"/0", scid+"/1")
+	}
+	if len(exclude) != 0 {
+		params["exclude"] = exclude
+	}
+	if payOpts.CltvLimit != 0 {
+		params["maxdelay"] = payOpts.CltvLimit
+	}
+
+	return params, nil
+}
+
+// SendPayment sends a payment to the recipient or the payment request,
+// returning a channel over which the payment result is received.
+// The payload is sent along with spontaneous (keysend) payments,
+// while payment request payments cannot carry custom records.
+// The fee limit is enforced as an absolute amount, as with lnd.
+// AMP payments are not supported.
+func (m *clnManager) SendPayment(ctx context.Context,
+	recipient string, amount Amount, payReq string,
+	payOpts PaymentOptions, payload map[uint64][]byte,
+	filter PaymentUpdateFilter) (<-chan PaymentUpdate, error) {
+
+	if payOpts.AMP {
+		return nil, newErrorf(ErrNotSupported, "AMP payments")
+	}
+
+	params, err := clnRouteRestrictions(payOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fees up to exemptfee are always accepted,
+	// so it acts as an absolute fee limit.
+	params["maxfeepercent"] = 0
+	params["exemptfee"] = payOpts.FeeLimitMsat
+	if payOpts.TimeoutSecs != 0 {
+		params["retry_for"] = payOpts.TimeoutSecs
+	}
+
+	method := "keysend"
+	switch payReq {
+	case "":
+		if _, err := addressStrToBytes(recipient); err != nil {
+			return nil, err
+		}
+		if amount.Msat() == 0 {
+			return nil, errors.New("payment amount has not been specified")
+		}
+
+		params["destination"] = recipient
+		params["amount_msat"] = amount.Msat()
+		if len(payload) != 0 {
+			tlvs := make(map[string]string, len(payload))
+			for k, v := range payload {
+				tlvs[strconv.FormatUint(k, 10)] = hex.EncodeToString(v)
+			}
+			params["extratlvs"] = tlvs
+		}
+	default:
+		if len(payload) != 0 {
+			return nil, newErrorf(ErrNotSupported,
+				"custom records on payment request payments")
+		}
+
+		method = "pay"
+		params["bolt11"] = payReq
+		if amount.Msat() != 0 {
+			params["amount_msat"] = amount.Msat()
+		}
+	}
+
+	updates := make(chan PaymentUpdate)
+	go func() {
+		defer close(updates)
+
+		send := func(u PaymentUpdate) {
+			select {
+			case updates <- u:
+			case <-ctx.Done():
+			}
+		}
+
+		var resp struct {
+			PaymentHash string `json:"payment_hash"`
+		}
+		if err := m.client.call(ctx, method, params, &resp); err != nil {
+			// Failed payments are reported as payment updates,
+			// if they are identifiable by their payment hash.
+			if resp.PaymentHash = clnFailedPaymentHash(err); resp.PaymentHash == "" {
+				send(PaymentUpdate{Err: err})
+				return
+			}
+		}
+
+		payments, err := m.listPayments(ctx, resp.PaymentHash)
+		switch {
+		case err != nil:
+			send(PaymentUpdate{Err: err})
+			return
+		case len(payments) == 0:
+			send(PaymentUpdate{Err: newErrorf(ErrInternal,
+				"payment %s not found", resp.PaymentHash)})
+			return
+		}
+
+		// The latest attempt group holds the payment outcome.
+		p := payments[len(payments)-1]
+		if method == "keysend" {
+			preimage, _ := hex.DecodeString(p.Preimage)
+			for i := range p.Htlcs {
+				if hops := p.Htlcs[i].Route.Hops; len(hops) > 0 {
+					hops[len(hops)-1].CustomRecords = copyCustomRecords(payload, preimage)
+				}
+			}
+		}
+
+		if filter(p) {
+			send(PaymentUpdate{Payment: p})
+		}
+	}()
+
+	return updates, nil
+}
+
+// clnFailedPaymentHash returns the payment hash of a failed payment,
+// as reported in the data of payment errors.
+func clnFailedPaymentHash(err error) string {
+	rpcErr := clnRPCErrorOf(err)
+	if rpcErr == nil || len(rpcErr.Data) == 0 {
+		return ""
+	}
+
+	var data struct {
+		PaymentHash string `json:"payment_hash"`
+	}
+	if json.Unmarshal(rpcErr.Data, &data) != nil {
+		return ""
+	}
+
+	return data.PaymentHash
+}
+
+// SendToRoute is not supported, since Core Lightning does not allow
+// spontaneous payments or custom records along explicit routes.
+func (m *clnManager) SendToRoute(_ context.Context, _ *Route,
+	_ map[uint64][]byte) (*Payment, error) {
+
+	return nil, newErrorf(ErrNotSupported,
+		"spontaneous payments along explicit routes")
+}
+
+// GetRoute queries the underlying node for a route that can accomodate
+// a payment of amount to recipient, respecting the provided payment options.
+// Core Lightning does not estimate the success probability of routes,
+// which is reported as 1. Route hints of payment requests are not considered.
+func (m *clnManager) GetRoute(ctx context.Context,
+	recipient string, amount Amount, payReq string, payOpts PaymentOptions,
+	payload map[uint64][]byte) (*Route, float64, error) {
+
+	var decodedPayReq *PayReq
+	if payReq != "" {
+		var err error
+		if decodedPayReq, err = m.DecodePayReq(ctx, payReq); err != nil {
+			return nil, .0, fmt.Errorf("could not decode payment request: %w", err)
+		}
+	}
+	dest, amtMsat, _, err := resolvePaymentTarget(recipient,
+		amount.Msat(), decodedPayReq)
+	if err != nil {
+		return nil, .0, err
+	}
+
+	params, err := clnRouteRestrictions(payOpts)
+	if err != nil {
+		return nil, .0, err
+	}
+	// Route queries do not support a timelock limit,
+	// which is instead checked against the returned route.
+	delete(params, "maxdelay")
+
+	params["id"] = dest
+	params["amount_msat"] = amtMsat
+	params["riskfactor"] = clnRiskFactor
+	if payOpts.FinalCltvDelta != 0 {
+		params["cltv"] = payOpts.FinalCltvDelta
+	}
+
+	var resp struct {
+		Route []struct {
+			ID         string  `json:"id"`
+			Channel    string  `json:"channel"`
+			AmountMsat clnMsat `json:"amount_msat"`
+			Delay      uint32  `json:"delay"`
+		} `json:"route"`
+	}
+	if err := m.client.call(ctx, "getroute", params, &resp); err != nil {
+		return nil, .0, err
+	}
+	if len(resp.Route) == 0 {
+		return nil, .0, ErrNoRouteFound
+	}
+
+	// Route delays are relative to the current block height.
+	info, err := m.getInfo(ctx)
+	if err != nil {
+		return nil, .0, err
+	}
+
+	// Each route entry holds the amount and delay of the HTLC
+	// offered to the respective node, while lnchat hops hold
+	// the amount and expiry of the HTLC forwarded by the node.
+	hops := make([]RouteHop, len(resp.Route))
+	for i, r := range resp.Route {
+		nodeID, err := NewNodeFromString(r.ID)
+		if err != nil {
+			return nil, .0, err
+		}
+		chanID, err := parseShortChannelID(r.Channel)
+		if err != nil {
+			return nil, .0, err
+		}
+
+		next := r
+		if i < len(resp.Route)-1 {
+			next = resp.Route[i+1]
+		}
+		hops[i] = RouteHop{
+			ChannelID:    chanID,
+			NodeID:       nodeID,
+			AmtToForward: NewAmount(int64(next.AmountMsat)),
+			Fees:         NewAmount(int64(r.AmountMsat - next.AmountMsat)),
+			Expiry:       info.BlockHeight + next.Delay,
+		}
+	}
+
+	// As with lnd route queries, the final hop carries
+	// the payload along with a (zero) keysend preimage.
+	var preimage [32]byte
+	hops[len(hops)-1].CustomRecords = copyCustomRecords(payload, preimage[:])
+
+	fees := int64(resp.Route[0].AmountMsat) - amtMsat
+	if payOpts.FeeLimitMsat != 0 && fees > payOpts.FeeLimitMsat {
+		return nil, .0, ErrNoRouteFound
+	}
+	if payOpts.CltvLimit != 0 && resp.Route[0].Delay > payOpts.CltvLimit {
+		return nil, .0, newErrorf(ErrNoRouteFound,
+			"route timelock delta %d exceeds limit", resp.Route[0].Delay)
+	}
+
+	return &Route{
+		TimeLock: info.BlockHeight + resp.Route[0].Delay,
+		Amt:      NewAmount(amtMsat),
+		Fees:     NewAmount(fees),
+		Hops:     hops,
+	}, 1., nil
+}
+
+// QueryMissionControl is not supported, since Core Lightning
+// does not expose the payment attempt history of its router.
+func (m *clnManager) QueryMissionControl(_ context.Context) ([]PairHistory, error) {
+	return nil, newErrorf(ErrNotSupported, "mission control queries")
+}
+
+// QueryProbability is not supported, since Core Lightning
+// does not expose the payment attempt history of its router.
+func (m *clnManager) QueryProbability(_ context.Context,
+	_, _ string, _ Amount) (float64, *PairHistory, error) {
+
+	return 0, nil, newErrorf(ErrNotSupported, "probability queries")
+}
+
+// ResetMissionControl is not supported, since Core Lightning
+// does not expose the payment attempt history of its router.
+func (m *clnManager) ResetMissionControl(_ context.Context) error {
+	return newErrorf(ErrNotSupported, "mission control reset")
+}
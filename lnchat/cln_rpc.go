@@ -0,0 +1,214 @@
+package lnchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Core Lightning JSON-RPC error codes.
+const (
+	clnErrRouteNotFound     = 205
+	clnErrRouteTooExpensive = 206
+	clnErrCannotAfford      = 301
+)
+
+// clnRPCError is an error returned by Core Lightning over JSON-RPC.
+type clnRPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (e *clnRPCError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// clnRequest is a JSON-RPC 2.0 request.
+type clnRequest struct {
+	Version string      `json:"jsonrpc"`
+	ID      uint64      `json:"id"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+}
+
+// clnResponse is a JSON-RPC 2.0 response.
+type clnResponse struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *clnRPCError    `json:"error"`
+}
+
+// clnClient is a JSON-RPC client for the unix socket of a Core Lightning node.
+// Each call uses a separate connection, so that long-running calls
+// (such as waiting for invoices) do not block other calls,
+// and can be cancelled by closing their connection.
+type clnClient struct {
+	socketPath string
+	nextID     uint64
+}
+
+// call performs a JSON-RPC call with named parameters,
+// decoding its result into result (if not nil).
+func (c *clnClient) call(ctx context.Context, method string,
+	params map[string]interface{}, result interface{}) error {
+
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "unix", c.socketPath)
+	if err != nil {
+		if terr := translateCLNContextError(ctx); terr != nil {
+			return withCause(terr, err)
+		}
+		return withCause(newErrorf(ErrNetworkUnavailable,
+			"could not connect to %s", c.socketPath), err)
+	}
+	defer conn.Close()
+
+	// Unblock pending reads and writes once the context is done.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	req := clnRequest{
+		Version: "2.0",
+		ID:      atomic.AddUint64(&c.nextID, 1),
+		Method:  method,
+		Params:  params,
+	}
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return c.connError(ctx, err)
+	}
+
+	var resp clnResponse
+	if err := json.NewDecoder(conn).Decode(&resp); err != nil {
+		return c.connError(ctx, err)
+	}
+
+	switch {
+	case resp.Error != nil:
+		return translateCLNError(resp.Error)
+	case resp.ID != req.ID:
+		return newErrorf(ErrInternal, "%s: response id %d "+
+			"does not match request id %d", method, resp.ID, req.ID)
+	case result == nil:
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Result, result); err != nil {
+		return withCause(newErrorf(ErrInternal,
+			"%s: could not decode response", method), err)
+	}
+
+	return nil
+}
+
+// connError translates an error on the connection of a call.
+func (c *clnClient) connError(ctx context.Context, err error) error {
+	if terr := translateCLNContextError(ctx); terr != nil {
+		return withCause(terr, err)
+	}
+	return withCause(newError(ErrNetworkUnavailable), err)
+}
+
+// translateCLNContextError returns the error corresponding
+// to the termination of a context, or nil if it is not done.
+func translateCLNContextError(ctx context.Context) error {
+	switch ctx.Err() {
+	case context.Canceled:
+		return newError(ErrCancelled)
+	case context.DeadlineExceeded:
+		return newError(ErrDeadlineExceeded)
+	default:
+		return nil
+	}
+}
+
+// translateCLNError translates a Core Lightning error to an lnchat error.
+func translateCLNError(e *clnRPCError) error {
+	switch e.Code {
+	case clnErrRouteNotFound, clnErrRouteTooExpensive:
+		return withCause(newErrorf(ErrNoRouteFound, "%s", e.Message), e)
+	case clnErrCannotAfford:
+		return withCause(newErrorf(ErrInsufficientBalance, "%s", e.Message), e)
+	default:
+		return withCause(newErrorf(ErrUnknown, "%s", e.Message), e)
+	}
+}
+
+// clnRPCErrorOf returns the Core Lightning error underlying an error,
+// or nil if it is not a Core Lightning error.
+func clnRPCErrorOf(err error) *clnRPCError {
+	var e Error
+	if !errors.As(err, &e) {
+		return nil
+	}
+	rpcErr, _ := e.Cause().(*clnRPCError)
+
+	return rpcErr
+}
+
+// clnMsat is a millisatoshi amount, as returned by Core Lightning.
+// Older versions encode amounts as strings with an "msat" suffix.
+type clnMsat int64
+
+// UnmarshalJSON decodes an amount from a number or an "msat" string.
+func (m *clnMsat) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		var n int64
+		if err := json.Unmarshal(b, &n); err != nil {
+			return err
+		}
+		*m = clnMsat(n)
+		return nil
+	}
+
+	n, err := strconv.ParseInt(strings.TrimSuffix(s, "msat"), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid amount %q: %w", s, err)
+	}
+	*m = clnMsat(n)
+
+	return nil
+}
+
+// parseShortChannelID parses a short channel id
+// in its Core Lightning representation (BLOCKxTXxOUT).
+func parseShortChannelID(scid string) (uint64, error) {
+	parts := strings.Split(scid, "x")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid short channel id %q", scid)
+	}
+
+	var fields [3]uint64
+	for i, bits := range []int{24, 24, 16} {
+		n, err := strconv.ParseUint(parts[i], 10, bits)
+		if err != nil {
+			return 0, fmt.Errorf("invalid short channel id %q: %w", scid, err)
+		}
+		fields[i] = n
+	}
+
+	return fields[0]<<40 | fields[1]<<16 | fields[2], nil
+}
+
+// formatShortChannelID formats a short channel id
+// in its Core Lightning representation (BLOCKxTXxOUT).
+func formatShortChannelID(chanID uint64) string {
+	return fmt.Sprintf("%dx%dx%d", chanID>>40, chanID>>16&0xFFFFFF, chanID&0xFFFF)
+}
@@ -0,0 +1,743 @@
+package lnchat
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clnTestSelf = "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"
+	clnTestHop  = "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5"
+	clnTestDest = "02f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9"
+	clnTestHash = "48428bdb7ddd829410d6bbb924fdeb3a3d7e88c2577bffae073b990c6f061d08"
+)
+
+type clnTestRequest struct {
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params"`
+}
+
+// clnTestServer is a stand-in for the JSON-RPC socket of a Core Lightning node.
+// It replays the responses recorded under testdata/cln (one per method),
+// unless overridden, and records the received requests.
+type clnTestServer struct {
+	sync.Mutex
+	overrides map[string]string
+	requests  []clnTestRequest
+}
+
+func newCLNTestServer(t *testing.T, overrides map[string]string) (*clnTestServer, string) {
+	socketPath := filepath.Join(t.TempDir(), "lightning-rpc")
+
+	return listenCLNTestServer(t, socketPath, overrides), socketPath
+}
+
+// listenCLNTestServer serves a clnTestServer on the provided socket path.
+func listenCLNTestServer(t *testing.T, socketPath string,
+	overrides map[string]string) *clnTestServer {
+
+	lis, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+
+	srv := &clnTestServer{overrides: overrides}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go srv.serve(t, conn)
+		}
+	}()
+
+	return srv
+}
+
+func (s *clnTestServer) serve(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+
+	var req struct {
+		clnTestRequest
+		ID uint64 `json:"id"`
+	}
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		return
+	}
+
+	s.Lock()
+	s.requests = append(s.requests, req.clnTestRequest)
+	recorded, ok := s.overrides[req.Method]
+	s.Unlock()
+
+	if !ok {
+		b, err := os.ReadFile(filepath.Join("testdata", "cln", req.Method+".json"))
+		if err != nil {
+			t.Errorf("no recorded response for %s", req.Method)
+			return
+		}
+		recorded = string(b)
+	}
+
+	var resp map[string]interface{}
+	if err := json.Unmarshal([]byte(recorded), &resp); err != nil {
+		t.Errorf("invalid recorded response for %s: %v", req.Method, err)
+		return
+	}
+	resp["jsonrpc"], resp["id"] = "2.0", req.ID
+
+	_ = json.NewEncoder(conn).Encode(resp)
+}
+
+// lastRequest returns the parameters of the last request for method.
+func (s *clnTestServer) lastRequest(method string) map[string]interface{} {
+	s.Lock()
+	defer s.Unlock()
+
+	for i := len(s.requests) - 1; i >= 0; i-- {
+		if s.requests[i].Method == method {
+			return s.requests[i].Params
+		}
+	}
+
+	return nil
+}
+
+func newCLNTestManager(t *testing.T, overrides map[string]string) (*clnTestServer, LightManager) {
+	srv, socketPath := newCLNTestServer(t, overrides)
+
+	mgr, err := NewCLN(socketPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { mgr.Close() })
+
+	return srv, mgr
+}
+
+func TestNewCLN(t *testing.T) {
+	_, mgr := newCLNTestManager(t, nil)
+
+	info, err := mgr.GetSelfInfo(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, SelfInfo{
+		Node: LightningNode{
+			Alias:   "c13n-cln",
+			Address: clnTestSelf,
+		},
+		Chains: []Chain{{
+			Chain:   "bitcoin",
+			Network: "regtest",
+		}},
+	}, info)
+	assert.Equal(t, ConnectionREADY, mgr.GetConnectionState())
+
+	_, err = NewCLN(filepath.Join(t.TempDir(), "missing-rpc"),
+		WithStartupTimeout(50*time.Millisecond))
+	assert.True(t, errors.Is(err, ErrNetworkUnavailable))
+}
+
+func TestNewCLNStartupWait(t *testing.T) {
+	defer func(poll time.Duration) {
+		clnPollInterval = poll
+	}(clnPollInterval)
+	clnPollInterval = 10 * time.Millisecond
+
+	// The node socket becomes available after startup.
+	socketPath := filepath.Join(t.TempDir(), "lightning-rpc")
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		listenCLNTestServer(t, socketPath, nil)
+	}()
+
+	mgr, err := NewCLN(socketPath, WithStartupTimeout(5*time.Second))
+	require.NoError(t, err)
+	defer mgr.Close()
+
+	assert.Equal(t, ConnectionREADY, mgr.GetConnectionState())
+}
+
+func TestCLNSendPayment(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+
+	payload := map[uint64][]byte{
+		0x117C17A7: []byte("payload"),
+	}
+	updates, err := mgr.SendPayment(context.Background(),
+		clnTestDest, NewAmount(1000), "",
+		PaymentOptions{FeeLimitMsat: 3000, TimeoutSecs: 30},
+		payload, func(*Payment) bool { return true })
+	require.NoError(t, err)
+
+	update, ok := <-updates
+	require.True(t, ok)
+	require.NoError(t, update.Err)
+
+	assert.Equal(t, map[string]interface{}{
+		"destination":   clnTestDest,
+		"amount_msat":   1000.,
+		"maxfeepercent": 0.,
+		"exemptfee":     3000.,
+		"retry_for":     30.,
+		"extratlvs": map[string]interface{}{
+			"293345191": hex.EncodeToString([]byte("payload")),
+		},
+	}, srv.lastRequest("keysend"))
+	assert.Equal(t, map[string]interface{}{
+		"payment_hash": clnTestHash,
+	}, srv.lastRequest("listsendpays"))
+
+	p := update.Payment
+	assert.Equal(t, clnTestHash, p.Hash)
+	assert.Equal(t, PaymentSUCCEEDED, p.Status)
+	assert.Equal(t, uint64(4), p.PaymentIndex)
+	assert.Equal(t, int64(1000), p.Value.Msat())
+	require.Len(t, p.Htlcs, 1)
+	assert.Equal(t, int64(2), p.Htlcs[0].Route.Fees.Msat())
+
+	dest, err := p.GetDestination()
+	require.NoError(t, err)
+	assert.Equal(t, clnTestDest, dest.String())
+
+	records := p.Htlcs[0].Route.Hops[0].CustomRecords
+	assert.Equal(t, []byte("payload"), records[0x117C17A7])
+	assert.Len(t, records[record.KeySendType], 32)
+
+	_, ok = <-updates
+	assert.False(t, ok)
+}
+
+func TestCLNSendPaymentErrors(t *testing.T) {
+	cases := []struct {
+		name        string
+		overrides   map[string]string
+		payReq      string
+		opts        PaymentOptions
+		payload     map[uint64][]byte
+		expectedErr error
+		failed      bool
+	}{
+		{
+			name: "no route",
+			overrides: map[string]string{
+				"keysend": `{"error": {"code": 205, "message": "Could not find a route"}}`,
+			},
+			expectedErr: ErrNoRouteFound,
+		},
+		{
+			name: "failed payment",
+			overrides: map[string]string{
+				"keysend": `{"error": {"code": 210, "message": "Ran out of routes to try",` +
+					` "data": {"payment_hash": "` + clnTestHash + `", "status": "failed"}}}`,
+				"listsendpays": `{"result": {"payments": [{"id": 5, "groupid": 1,` +
+					` "payment_hash": "` + clnTestHash + `", "status": "failed",` +
+					` "amount_msat": 1000, "amount_sent_msat": 1002,` +
+					` "destination": "` + clnTestDest + `", "created_at": 1665000000}]}}`,
+			},
+			failed: true,
+		},
+		{
+			name:        "AMP payment",
+			opts:        PaymentOptions{AMP: true},
+			expectedErr: ErrNotSupported,
+		},
+		{
+			name:        "payment request with payload",
+			payReq:      "lnbcrt10n1",
+			payload:     map[uint64][]byte{0x117C17A7: []byte("payload")},
+			expectedErr: ErrNotSupported,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, mgr := newCLNTestManager(t, c.overrides)
+
+			updates, err := mgr.SendPayment(context.Background(),
+				clnTestDest, NewAmount(1000), c.payReq, c.opts, c.payload,
+				func(*Payment) bool { return true })
+			if err == nil {
+				update := <-updates
+				err = update.Err
+				if c.failed {
+					require.NoError(t, err)
+					assert.Equal(t, PaymentFAILED, update.Payment.Status)
+					return
+				}
+			}
+
+			assert.True(t, errors.Is(err, c.expectedErr),
+				"expected %v, got %v", c.expectedErr, err)
+		})
+	}
+}
+
+func TestCLNRouteRestrictions(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+	ctx := context.Background()
+
+	opts := PaymentOptions{
+		FeeLimitMsat:    3000,
+		IgnoredNodes:    []string{clnTestHop},
+		IgnoredChannels: []uint64{103<<40 | 1<<16 | 1},
+		CltvLimit:       100,
+	}
+	updates, err := mgr.SendPayment(ctx, clnTestDest, NewAmount(1000), "",
+		opts, nil, func(*Payment) bool { return true })
+	require.NoError(t, err)
+	update := <-updates
+	require.NoError(t, update.Err)
+
+	assert.Equal(t, map[string]interface{}{
+		"destination":   clnTestDest,
+		"amount_msat":   1000.,
+		"maxfeepercent": 0.,
+		"exemptfee":     3000.,
+		"exclude":       []interface{}{clnTestHop, "103x1x1/0", "103x1x1/1"},
+		"maxdelay":      100.,
+	}, srv.lastRequest("keysend"))
+
+	_, _, err = mgr.GetRoute(ctx, clnTestDest, NewAmount(1000), "", opts, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{clnTestHop, "103x1x1/0", "103x1x1/1"},
+		srv.lastRequest("getroute")["exclude"])
+	assert.NotContains(t, srv.lastRequest("getroute"), "maxdelay")
+
+	// The recorded route exceeds a lower timelock limit.
+	opts.CltvLimit = 40
+	_, _, err = mgr.GetRoute(ctx, clnTestDest, NewAmount(1000), "", opts, nil)
+	assert.True(t, errors.Is(err, ErrNoRouteFound))
+
+	_, err = mgr.SendPayment(ctx, clnTestDest, NewAmount(1000), "",
+		PaymentOptions{OutgoingChanIDs: []uint64{1}}, nil,
+		func(*Payment) bool { return true })
+	assert.True(t, errors.Is(err, ErrNotSupported))
+}
+
+func TestCLNMissionControl(t *testing.T) {
+	_, mgr := newCLNTestManager(t, nil)
+	ctx := context.Background()
+
+	_, err := mgr.QueryMissionControl(ctx)
+	assert.ErrorIs(t, err, ErrNotSupported)
+
+	_, _, err = mgr.QueryProbability(ctx, clnTestHop, clnTestDest, NewAmount(1000))
+	assert.ErrorIs(t, err, ErrNotSupported)
+
+	err = mgr.ResetMissionControl(ctx)
+	assert.ErrorIs(t, err, ErrNotSupported)
+}
+
+func TestCLNSubscribeInvoiceUpdates(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates, err := mgr.SubscribeInvoiceUpdates(ctx, 2,
+		func(*Invoice) bool { return true })
+	require.NoError(t, err)
+
+	update := <-updates
+	require.NoError(t, update.Err)
+	assert.Equal(t, map[string]interface{}{
+		"lastpay_index": 2.,
+	}, srv.lastRequest("waitanyinvoice"))
+
+	inv := update.Inv
+	assert.Equal(t, clnTestHash, inv.Hash)
+	assert.Equal(t, InvoiceSETTLED, inv.State)
+	assert.Equal(t, uint64(3), inv.SettleIndex)
+	assert.Equal(t, int64(1000), inv.AmtPaid.Msat())
+	require.Len(t, inv.Htlcs, 1)
+
+	// Subsequent waits continue after the received invoice.
+	<-updates
+	assert.Equal(t, map[string]interface{}{
+		"lastpay_index": 3.,
+	}, srv.lastRequest("waitanyinvoice"))
+}
+
+func TestCLNListHistory(t *testing.T) {
+	_, mgr := newCLNTestManager(t, nil)
+	ctx := context.Background()
+
+	// Only paid invoices are listed, in pay index order.
+	invoices, err := mgr.ListInvoices(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, invoices, 2)
+	assert.Equal(t, uint64(1), invoices[0].SettleIndex)
+	assert.Equal(t, uint64(1), invoices[0].AddIndex)
+	assert.Equal(t, int64(3000), invoices[0].AmtPaid.Msat())
+	assert.Equal(t, uint64(3), invoices[1].SettleIndex)
+	assert.Equal(t, clnTestHash, invoices[1].Hash)
+
+	invoices, err = mgr.ListInvoices(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, invoices, 1)
+	assert.Equal(t, uint64(3), invoices[0].SettleIndex)
+
+	invoices, err = mgr.ListInvoices(ctx, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, invoices, 1)
+	assert.Equal(t, uint64(1), invoices[0].SettleIndex)
+
+	payments, err := mgr.ListPayments(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, uint64(4), payments[0].PaymentIndex)
+	assert.Equal(t, PaymentSUCCEEDED, payments[0].Status)
+
+	payments, err = mgr.ListPayments(ctx, 4, 10)
+	require.NoError(t, err)
+	assert.Empty(t, payments)
+}
+
+func TestCLNGetRoute(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+
+	payload := map[uint64][]byte{0x117C17A7: []byte("payload")}
+	route, prob, err := mgr.GetRoute(context.Background(),
+		clnTestDest, NewAmount(1000), "", PaymentOptions{FinalCltvDelta: 9}, payload)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"id":          clnTestDest,
+		"amount_msat": 1000.,
+		"riskfactor":  10.,
+		"cltv":        9.,
+	}, srv.lastRequest("getroute"))
+
+	assert.Equal(t, 1., prob)
+	assert.Equal(t, uint32(199), route.TimeLock)
+	assert.Equal(t, int64(1000), route.Amt.Msat())
+	assert.Equal(t, int64(2), route.Fees.Msat())
+	require.Len(t, route.Hops, 2)
+
+	hop := route.Hops[0]
+	assert.Equal(t, clnTestHop, hop.NodeID.String())
+	assert.Equal(t, uint64(103<<40|1<<16), hop.ChannelID)
+	assert.Equal(t, int64(1000), hop.AmtToForward.Msat())
+	assert.Equal(t, int64(2), hop.Fees.Msat())
+	assert.Equal(t, uint32(159), hop.Expiry)
+
+	hop = route.Hops[1]
+	assert.Equal(t, clnTestDest, hop.NodeID.String())
+	assert.Equal(t, uint64(105<<40|2<<16|1), hop.ChannelID)
+	assert.Equal(t, int64(1000), hop.AmtToForward.Msat())
+	assert.Equal(t, int64(0), hop.Fees.Msat())
+	assert.Equal(t, uint32(159), hop.Expiry)
+	assert.Equal(t, []byte("payload"), hop.CustomRecords[0x117C17A7])
+
+	_, _, err = mgr.GetRoute(context.Background(),
+		clnTestDest, NewAmount(1000), "", PaymentOptions{FeeLimitMsat: 1}, nil)
+	assert.True(t, errors.Is(err, ErrNoRouteFound))
+}
+
+func TestCLNSignatures(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+	ctx := context.Background()
+
+	sig, err := mgr.SignMessage(ctx, []byte("message"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"message": "message",
+	}, srv.lastRequest("signmessage"))
+
+	// The recorded signature is valid for the recorded node.
+	signer, err := RecoverSignerAddress([]byte("message"), sig)
+	require.NoError(t, err)
+	assert.Equal(t, clnTestSelf, signer)
+
+	pubkey, err := mgr.VerifySignatureExtractPubkey(ctx, []byte("message"), sig)
+	require.NoError(t, err)
+	assert.Equal(t, clnTestSelf, pubkey)
+	assert.Equal(t, map[string]interface{}{
+		"message": "message",
+		"zbase":   signatureBytesToStr(sig),
+	}, srv.lastRequest("checkmessage"))
+
+	_, mgr = newCLNTestManager(t, map[string]string{
+		"checkmessage": `{"result": {"pubkey": "` + clnTestSelf + `", "verified": false}}`,
+	})
+	_, err = mgr.VerifySignatureExtractPubkey(ctx, []byte("message"), sig)
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+}
+
+func TestCLNOpenChannel(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+
+	point, err := mgr.OpenChannel(context.Background(), clnTestDest,
+		true, 1000000000, 10000, 2, TxFeeOptions{SatPerVByte: 5})
+	require.NoError(t, err)
+
+	assert.Equal(t, &ChannelPoint{
+		FundingTxid: "2b2d6ebb3bff5c2a4b9b2d7c6e1d1f5cbd3a7e4c0d1b8e2f1a6c9d0e3b4a5f60",
+		OutputIndex: 1,
+	}, point)
+	assert.Equal(t, map[string]interface{}{
+		"id":        clnTestDest,
+		"amount":    1000000.,
+		"announce":  false,
+		"push_msat": 10000.,
+		"minconf":   2.,
+		"feerate":   "5000perkb",
+	}, srv.lastRequest("fundchannel"))
+}
+
+func TestCLNWallet(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+	ctx := context.Background()
+
+	address, err := mgr.NewAddress(ctx, AddressWITNESSPUBKEYHASH)
+	require.NoError(t, err)
+	assert.Equal(t, "bcrt1qw508d6qejxtdg4y5r3zarvary0c5xw7kygt080", address)
+	assert.Equal(t, map[string]interface{}{
+		"addresstype": "bech32",
+	}, srv.lastRequest("newaddr"))
+
+	txid, err := mgr.SendCoins(ctx, address, 0, true, TxFeeOptions{TargetConfBlock: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "9f2c4b6e8a0d1c3e5f7a9b1d3c5e7f9a0b2c4d6e8f0a1b3c5d7e9f1a2b4c6d8e", txid)
+	assert.Equal(t, map[string]interface{}{
+		"destination": address,
+		"satoshi":     "all",
+		"feerate":     "urgent",
+	}, srv.lastRequest("withdraw"))
+
+	_, err = mgr.SendCoins(ctx, address, 1000, true, TxFeeOptions{})
+	assert.Error(t, err)
+
+	utxos, err := mgr.ListUnspent(ctx, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, []Utxo{
+		{
+			Address:       address,
+			AmountSat:     2000000,
+			Txid:          "5d3c1f2e4a6b8c0d9e7f5a3b1c2d4e6f8a0b9c7d5e3f1a2b4c6d8e0f9a7b5c3d",
+			OutputIndex:   0,
+			Confirmations: 10,
+		},
+		{
+			Address:       address,
+			AmountSat:     500000,
+			Txid:          txid,
+			OutputIndex:   1,
+			Confirmations: 0,
+		},
+	}, utxos)
+
+	utxos, err = mgr.ListUnspent(ctx, 1, 100)
+	require.NoError(t, err)
+	assert.Len(t, utxos, 1)
+
+	_, err = mgr.ListTransactions(ctx, 0, -1)
+	assert.ErrorIs(t, err, ErrNotSupported)
+
+	estimate, err := mgr.EstimateFee(ctx, address, 100000, 0)
+	require.NoError(t, err)
+	assert.Equal(t, &FeeEstimate{
+		FeeSat:      10 * clnTypicalTxVBytes,
+		SatPerVByte: 10,
+	}, estimate)
+}
+
+func TestCLNChannelPolicy(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+	ctx := context.Background()
+
+	point := ChannelPoint{
+		FundingTxid: "2b2d6ebb3bff5c2a4b9b2d7c6e1d1f5cbd3a7e4c0d1b8e2f1a6c9d0e3b4a5f60",
+		OutputIndex: 1,
+	}
+
+	policy, err := mgr.GetChannelPolicy(ctx, point)
+	require.NoError(t, err)
+	assert.Equal(t, &ChannelPolicy{
+		BaseFeeMsat:   1,
+		FeeRatePPM:    10,
+		TimeLockDelta: 6,
+		MinHTLCMsat:   1000,
+		MaxHTLCMsat:   990000000,
+	}, policy)
+
+	err = mgr.UpdateChannelPolicy(ctx, &point, ChannelPolicy{
+		BaseFeeMsat: 100,
+		FeeRatePPM:  50,
+		MinHTLCMsat: 1000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":      "605f4a3b0e9d6c1a2f8e1b0d4c7e3abd5c1f1d6e7c2d9b4b2a5cff3bbb6e2d2b",
+		"feebase": 100.,
+		"feeppm":  50.,
+		"htlcmin": 1000.,
+	}, srv.lastRequest("setchannel"))
+
+	err = mgr.UpdateChannelPolicy(ctx, nil, ChannelPolicy{FeeRatePPM: 1})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"id":      "all",
+		"feebase": 0.,
+		"feeppm":  1.,
+	}, srv.lastRequest("setchannel"))
+
+	err = mgr.UpdateChannelPolicy(ctx, nil, ChannelPolicy{TimeLockDelta: 40})
+	assert.True(t, errors.Is(err, ErrNotSupported))
+
+	_, err = mgr.GetChannelPolicy(ctx, ChannelPoint{FundingTxid: point.FundingTxid})
+	assert.True(t, errors.Is(err, ErrUnknown))
+}
+
+func TestCLNForwardingHistory(t *testing.T) {
+	srv, mgr := newCLNTestManager(t, nil)
+	ctx := context.Background()
+
+	events, err := mgr.ForwardingHistory(ctx, 0, 0, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"status": "settled",
+	}, srv.lastRequest("listforwards"))
+	require.Len(t, events, 3)
+	assert.Equal(t, ForwardingEvent{
+		Index:       1,
+		TimestampNs: 1665000000500000000,
+		ChanIDIn:    103<<40 | 1<<16 | 1,
+		ChanIDOut:   105<<40 | 2<<16 | 1,
+		AmtInMsat:   10011,
+		AmtOutMsat:  10000,
+		FeeMsat:     11,
+	}, events[0])
+
+	// Indexes are relative to the time range.
+	events, err = mgr.ForwardingHistory(ctx, 1665000100, 0, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(2), events[0].Index)
+	assert.Equal(t, uint64(30031), events[0].AmtInMsat)
+
+	events, err = mgr.ForwardingHistory(ctx, 0, 1665000200, 0, 1)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(10011), events[0].AmtInMsat)
+}
+
+func TestCLNChannelEvents(t *testing.T) {
+	point := ChannelPoint{
+		FundingTxid: "2b2d6ebb3bff5c2a4b9b2d7c6e1d1f5cbd3a7e4c0d1b8e2f1a6c9d0e3b4a5f60",
+		OutputIndex: 1,
+	}
+	channel := func(state string, connected bool) map[ChannelPoint]clnChannel {
+		return map[ChannelPoint]clnChannel{
+			point: {
+				PeerID:         clnTestDest,
+				PeerConnected:  connected,
+				State:          state,
+				ShortChannelID: "103x1x1",
+				FundingTxid:    point.FundingTxid,
+				FundingOutnum:  point.OutputIndex,
+				TotalMsat:      1000000000,
+				ToUsMsat:       990000000,
+			},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		prev     map[ChannelPoint]clnChannel
+		cur      map[ChannelPoint]clnChannel
+		expected []ChannelEventType
+	}{
+		{
+			name:     "pending open",
+			cur:      channel("CHANNELD_AWAITING_LOCKIN", true),
+			expected: []ChannelEventType{ChannelEventPENDINGOPEN},
+		},
+		{
+			name:     "open",
+			prev:     channel("CHANNELD_AWAITING_LOCKIN", true),
+			cur:      channel(clnStateNormal, true),
+			expected: []ChannelEventType{ChannelEventOPEN, ChannelEventACTIVE},
+		},
+		{
+			name:     "inactive",
+			prev:     channel(clnStateNormal, true),
+			cur:      channel(clnStateNormal, false),
+			expected: []ChannelEventType{ChannelEventINACTIVE},
+		},
+		{
+			name:     "unchanged",
+			prev:     channel(clnStateNormal, true),
+			cur:      channel(clnStateNormal, true),
+			expected: nil,
+		},
+		{
+			name:     "closed",
+			prev:     channel(clnStateNormal, true),
+			cur:      channel("CLOSINGD_SIGEXCHANGE", true),
+			expected: []ChannelEventType{ChannelEventCLOSED},
+		},
+		{
+			name:     "resolved",
+			prev:     channel("ONCHAIN", false),
+			expected: []ChannelEventType{ChannelEventFULLYRESOLVED},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var types []ChannelEventType
+			for _, e := range clnChannelEvents(c.prev, c.cur) {
+				assert.Equal(t, point, e.ChannelPoint)
+				types = append(types, e.Type)
+			}
+			assert.Equal(t, c.expected, types)
+		})
+	}
+}
+
+func TestCLNMsat(t *testing.T) {
+	cases := []struct {
+		json     string
+		expected clnMsat
+		err      bool
+	}{
+		{json: `1000`, expected: 1000},
+		{json: `"1000msat"`, expected: 1000},
+		{json: `"1000sat"`, err: true},
+		{json: `true`, err: true},
+	}
+
+	for _, c := range cases {
+		var m clnMsat
+		err := json.Unmarshal([]byte(c.json), &m)
+		if c.err {
+			assert.Error(t, err, c.json)
+			continue
+		}
+		assert.NoError(t, err, c.json)
+		assert.Equal(t, c.expected, m, c.json)
+	}
+}
+
+func TestParseShortChannelID(t *testing.T) {
+	chanID, err := parseShortChannelID("103x1x0")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(113249697726464), chanID)
+
+	for _, scid := range []string{"", "103x1", "103x1x70000", "ax1x0"} {
+		_, err := parseShortChannelID(scid)
+		assert.Error(t, err, scid)
+	}
+}
@@ -0,0 +1,159 @@
+package lnchat
+
+import (
+	"context"
+)
+
+// clnTypicalTxVBytes is the virtual size of a typical wallet transaction
+// (spending one P2WPKH output to two P2WPKH outputs),
+// used to approximate the fee of on-chain payments.
+const clnTypicalTxVBytes = 141
+
+var clnAddressTypes = map[AddressType]string{
+	AddressWITNESSPUBKEYHASH: "bech32",
+	AddressNESTEDPUBKEYHASH:  "p2sh-segwit",
+}
+
+// NewAddress generates a new address of the on-chain wallet.
+func (m *clnManager) NewAddress(ctx context.Context, addrType AddressType) (string, error) {
+	clnAddrType, ok := clnAddressTypes[addrType]
+	if !ok {
+		return "", newErrorf(ErrUnknown, "unknown address type %d", addrType)
+	}
+
+	var resp map[string]string
+	if err := m.client.call(ctx, "newaddr", map[string]interface{}{
+		"addresstype": clnAddrType,
+	}, &resp); err != nil {
+		return "", err
+	}
+
+	return resp[clnAddrType], nil
+}
+
+// SendCoins sends an on-chain payment of amtSat to an address,
+// returning the ID of the transaction.
+// If sendAll is true, the whole wallet balance is sent
+// and amtSat must be zero.
+func (m *clnManager) SendCoins(ctx context.Context, address string, amtSat int64,
+	sendAll bool, txOpts TxFeeOptions) (string, error) {
+
+	var amount interface{} = amtSat
+	if sendAll {
+		if amtSat != 0 {
+			return "", newErrorf(ErrUnknown,
+				"amount must not be specified when sending all funds")
+		}
+		amount = "all"
+	}
+
+	params := map[string]interface{}{
+		"destination": address,
+		"satoshi":     amount,
+	}
+	if feerate := clnFeerate(txOpts); feerate != "" {
+		params["feerate"] = feerate
+	}
+
+	var resp struct {
+		Txid string `json:"txid"`
+	}
+	if err := m.client.call(ctx, "withdraw", params, &resp); err != nil {
+		return "", err
+	}
+
+	return resp.Txid, nil
+}
+
+// ListUnspent returns the unspent outputs of the on-chain wallet
+// with at least minConfs and at most maxConfs confirmations.
+func (m *clnManager) ListUnspent(ctx context.Context, minConfs, maxConfs int32) ([]Utxo, error) {
+	info, err := m.getInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var funds struct {
+		Outputs []struct {
+			Txid        string  `json:"txid"`
+			Output      uint32  `json:"output"`
+			AmountMsat  clnMsat `json:"amount_msat"`
+			Address     string  `json:"address"`
+			Status      string  `json:"status"`
+			BlockHeight int64   `json:"blockheight"`
+		} `json:"outputs"`
+	}
+	if err := m.client.call(ctx, "listfunds", nil, &funds); err != nil {
+		return nil, err
+	}
+
+	var utxos []Utxo
+	for _, o := range funds.Outputs {
+		var confs int64
+		switch o.Status {
+		case "confirmed":
+			confs = int64(info.BlockHeight) - o.BlockHeight + 1
+		case "unconfirmed":
+		default:
+			continue
+		}
+		if confs < int64(minConfs) || confs > int64(maxConfs) {
+			continue
+		}
+
+		utxos = append(utxos, Utxo{
+			Address:       o.Address,
+			AmountSat:     int64(o.AmountMsat) / 1000,
+			Txid:          o.Txid,
+			OutputIndex:   o.Output,
+			Confirmations: confs,
+		})
+	}
+
+	return utxos, nil
+}
+
+// ListTransactions is not supported, since Core Lightning
+// does not report the wallet's share of its transactions.
+func (m *clnManager) ListTransactions(_ context.Context, _, _ int32) ([]Transaction, error) {
+	return nil, newErrorf(ErrNotSupported,
+		"Core Lightning does not report wallet transaction amounts")
+}
+
+// EstimateFee estimates the fee of an on-chain payment of amtSat
+// to an address, confirming within targetConfBlock blocks.
+// The confirmation target is mapped to the closest Core Lightning
+// fee rate estimation, and the fee is approximated
+// for a typical wallet transaction.
+func (m *clnManager) EstimateFee(ctx context.Context, _ string,
+	_ int64, targetConfBlock uint32) (*FeeEstimate, error) {
+
+	var resp struct {
+		PerKB map[string]uint64 `json:"perkb"`
+	}
+	if err := m.client.call(ctx, "feerates", map[string]interface{}{
+		"style": "perkb",
+	}, &resp); err != nil {
+		return nil, err
+	}
+
+	estimation := clnFeerate(TxFeeOptions{TargetConfBlock: targetConfBlock})
+	if estimation == "" {
+		estimation = "normal"
+	}
+	satPerKB, ok := resp.PerKB[estimation]
+	if !ok {
+		return nil, newErrorf(ErrUnknown,
+			"no %s fee rate estimation available", estimation)
+	}
+
+	satPerVByte := satPerKB / 1000
+	if satPerVByte == 0 {
+		satPerVByte = 1
+	}
+
+	return &FeeEstimate{
+		FeeSat:      int64(satPerVByte * clnTypicalTxVBytes),
+		SatPerVByte: satPerVByte,
+	}, nil
+}
@@ -0,0 +1,299 @@
+package lnchat
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+// ConnectionState represents the state of the connection
+// to the underlying node.
+type ConnectionState int32
+
+const (
+	// ConnectionCONNECTING signifies that the underlying node
+	// is unreachable or still starting up.
+	ConnectionCONNECTING ConnectionState = iota
+	// ConnectionWALLETLOCKED signifies that the wallet of the underlying node
+	// is locked (or has not been created yet).
+	ConnectionWALLETLOCKED
+	// ConnectionSYNCING signifies that the underlying node is running,
+	// but has not yet synced to the chain.
+	ConnectionSYNCING
+	// ConnectionREADY signifies that the underlying node is running
+	// and synced to the chain.
+	ConnectionREADY
+)
+
+func (s ConnectionState) String() string {
+	switch s {
+	case ConnectionCONNECTING:
+		return "connecting"
+	case ConnectionWALLETLOCKED:
+		return "wallet-locked"
+	case ConnectionSYNCING:
+		return "syncing"
+	case ConnectionREADY:
+		return "ready"
+	default:
+		return "unknown"
+	}
+}
+
+// connStateRetryInterval is the interval between attempts
+// to watch the state of the underlying node.
+var connStateRetryInterval = 2 * time.Second
+
+// connSyncPollInterval is the interval at which the chain sync status
+// of the underlying node is polled.
+var connSyncPollInterval = 5 * time.Second
+
+// connectionTracker tracks the connection state,
+// logs and broadcasts its changes to subscribers.
+type connectionTracker struct {
+	sync.Mutex
+	state       ConnectionState
+	lastErr     error
+	subscribers map[chan ConnectionState]struct{}
+
+	log *slog.Logger
+}
+
+func newConnectionTracker() *connectionTracker {
+	return &connectionTracker{
+		subscribers: make(map[chan ConnectionState]struct{}),
+		log:         slog.NewLogger("lnchat"),
+	}
+}
+
+func (t *connectionTracker) get() ConnectionState {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.state
+}
+
+// lastError returns the error that caused the connection
+// to be last reported as lost, if it has not been re-established since.
+func (t *connectionTracker) lastError() error {
+	t.Lock()
+	defer t.Unlock()
+
+	return t.lastErr
+}
+
+// set updates the connection state, notifying subscribers on change.
+// Slow subscribers only receive the latest state.
+func (t *connectionTracker) set(state ConnectionState) {
+	t.update(state, nil)
+}
+
+// setLost marks the connection as lost due to the provided error.
+func (t *connectionTracker) setLost(err error) {
+	t.update(ConnectionCONNECTING, err)
+}
+
+func (t *connectionTracker) update(state ConnectionState, err error) {
+	t.Lock()
+	defer t.Unlock()
+
+	prevErr := t.lastErr
+	switch {
+	case err != nil:
+		t.lastErr = err
+	case state != ConnectionCONNECTING:
+		t.lastErr = nil
+	}
+
+	if t.state == state {
+		// Repeated failures are only logged when their cause changes.
+		if err != nil && (prevErr == nil || prevErr.Error() != err.Error()) {
+			t.log.WithError(err).Warn("Connection to the node failed")
+		}
+		return
+	}
+
+	if err != nil {
+		t.log.WithError(err).Warnf("Connection state changed from %v to %v",
+			t.state, state)
+	} else {
+		t.log.Infof("Connection state changed from %v to %v", t.state, state)
+	}
+
+	t.state = state
+
+	for sub := range t.subscribers {
+		select {
+		case <-sub:
+		default:
+		}
+		sub <- state
+	}
+}
+
+// subscribe returns a channel over which the current state
+// and any subsequent state changes are received,
+// until the context is done.
+func (t *connectionTracker) subscribe(ctx context.Context) <-chan ConnectionState {
+	sub := make(chan ConnectionState, 1)
+
+	t.Lock()
+	sub <- t.state
+	t.subscribers[sub] = struct{}{}
+	t.Unlock()
+
+	updates := make(chan ConnectionState)
+	go func() {
+		defer close(updates)
+		defer func() {
+			t.Lock()
+			delete(t.subscribers, sub)
+			t.Unlock()
+		}()
+
+		for {
+			var state ConnectionState
+			select {
+			case state = <-sub:
+			case <-ctx.Done():
+				return
+			}
+
+			select {
+			case updates <- state:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates
+}
+
+// wait blocks until the connection state satisfies cond,
+// or the context is done.
+func (t *connectionTracker) wait(ctx context.Context,
+	cond func(ConnectionState) bool) (ConnectionState, error) {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for state := range t.subscribe(ctx) {
+		if cond(state) {
+			return state, nil
+		}
+	}
+
+	return t.get(), ctx.Err()
+}
+
+// GetConnectionState returns the current state of the connection
+// to the underlying node.
+func (m *manager) GetConnectionState() ConnectionState {
+	return m.connState.get()
+}
+
+// SubscribeConnectionState returns a channel over which the current
+// connection state and any subsequent changes are received.
+func (m *manager) SubscribeConnectionState(ctx context.Context) (<-chan ConnectionState, error) {
+	return m.connState.subscribe(ctx), nil
+}
+
+// superviseConnection tracks the state of the underlying node
+// until the context is done. The wallet state is watched through
+// the lnd state service, which is available even while the wallet is locked,
+// and the node is re-watched whenever the connection is lost.
+func (m *manager) superviseConnection(ctx context.Context) {
+	for {
+		err := m.watchWalletState(ctx)
+
+		if ctx.Err() != nil {
+			return
+		}
+		m.connState.setLost(err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(connStateRetryInterval):
+		}
+	}
+}
+
+// watchWalletState follows the wallet state of the underlying node,
+// polling the chain sync status once the node is fully started.
+// It returns when the state subscription terminates.
+func (m *manager) watchWalletState(ctx context.Context) error {
+	stream, err := m.stateClient.SubscribeState(ctx, &lnrpc.SubscribeStateRequest{})
+	if err != nil {
+		return err
+	}
+
+	// At most one sync state poller runs, while the server is active.
+	var wg sync.WaitGroup
+	var stopPolling context.CancelFunc
+	stop := func() {
+		if stopPolling != nil {
+			stopPolling()
+			wg.Wait()
+			stopPolling = nil
+		}
+	}
+	defer stop()
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch resp.GetState() {
+		case lnrpc.WalletState_NON_EXISTING, lnrpc.WalletState_LOCKED:
+			stop()
+			m.connState.set(ConnectionWALLETLOCKED)
+		case lnrpc.WalletState_SERVER_ACTIVE:
+			if stopPolling != nil {
+				continue
+			}
+			m.connState.set(ConnectionSYNCING)
+
+			pollCtx, cancel := context.WithCancel(ctx)
+			stopPolling = cancel
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				m.pollSyncState(pollCtx)
+			}()
+		default:
+			stop()
+			m.connState.set(ConnectionCONNECTING)
+		}
+	}
+}
+
+// pollSyncState periodically updates the connection state
+// according to the chain sync status of the underlying node.
+func (m *manager) pollSyncState(ctx context.Context) {
+	for {
+		info, err := m.lnClient.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+		switch {
+		case ctx.Err() != nil:
+			return
+		case err != nil:
+		case info.GetSyncedToChain():
+			m.connState.set(ConnectionREADY)
+		default:
+			m.connState.set(ConnectionSYNCING)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(connSyncPollInterval):
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package lnchat
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/c13n-io/c13n-go/lnchat/lnconnect"
+)
+
+type testStateStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	states <-chan lnrpc.WalletState
+}
+
+func (s *testStateStream) Recv() (*lnrpc.SubscribeStateResponse, error) {
+	select {
+	case state, ok := <-s.states:
+		if !ok {
+			return nil, io.EOF
+		}
+		return &lnrpc.SubscribeStateResponse{State: state}, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+// testStateClient serves a state subscription for each provided channel,
+// failing further subscriptions.
+type testStateClient struct {
+	lnrpc.StateClient
+	streams chan chan lnrpc.WalletState
+}
+
+func (c *testStateClient) SubscribeState(ctx context.Context, _ *lnrpc.SubscribeStateRequest,
+	_ ...grpc.CallOption) (lnrpc.State_SubscribeStateClient, error) {
+
+	select {
+	case states := <-c.streams:
+		return &testStateStream{ctx: ctx, states: states}, nil
+	default:
+		return nil, io.ErrUnexpectedEOF
+	}
+}
+
+type testSyncClient struct {
+	lnrpc.LightningClient
+	synced chan bool
+	// The number of pending GetInfo calls.
+	pending int32
+}
+
+func (c *testSyncClient) GetInfo(ctx context.Context, _ *lnrpc.GetInfoRequest,
+	_ ...grpc.CallOption) (*lnrpc.GetInfoResponse, error) {
+
+	atomic.AddInt32(&c.pending, 1)
+	defer atomic.AddInt32(&c.pending, -1)
+
+	select {
+	case synced := <-c.synced:
+		return &lnrpc.GetInfoResponse{SyncedToChain: synced}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func expectConnectionState(t *testing.T, updates <-chan ConnectionState,
+	expected ConnectionState) {
+
+	select {
+	case state := <-updates:
+		assert.Equal(t, expected, state)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected connection state %v", expected)
+	}
+}
+
+func TestConnectionTracker(t *testing.T) {
+	tracker := newConnectionTracker()
+	assert.Equal(t, ConnectionCONNECTING, tracker.get())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := tracker.subscribe(ctx)
+	expectConnectionState(t, updates, ConnectionCONNECTING)
+
+	// Unchanged states are not broadcast.
+	tracker.set(ConnectionCONNECTING)
+	tracker.set(ConnectionWALLETLOCKED)
+	expectConnectionState(t, updates, ConnectionWALLETLOCKED)
+
+	// Slow subscribers skip intermediate states,
+	// but always receive the latest state.
+	tracker.set(ConnectionSYNCING)
+	tracker.set(ConnectionREADY)
+	if state := <-updates; state != ConnectionREADY {
+		assert.Equal(t, ConnectionSYNCING, state)
+		expectConnectionState(t, updates, ConnectionREADY)
+	}
+	assert.Equal(t, ConnectionREADY, tracker.get())
+
+	state, err := tracker.wait(context.Background(), func(s ConnectionState) bool {
+		return s == ConnectionREADY
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, ConnectionREADY, state)
+
+	cancel()
+	_, ok := <-updates
+	assert.False(t, ok)
+
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer waitCancel()
+	_, err = tracker.wait(waitCtx, func(s ConnectionState) bool {
+		return s == ConnectionCONNECTING
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestSuperviseConnection(t *testing.T) {
+	defer func(retry, poll time.Duration) {
+		connStateRetryInterval, connSyncPollInterval = retry, poll
+	}(connStateRetryInterval, connSyncPollInterval)
+	connStateRetryInterval, connSyncPollInterval = time.Millisecond, time.Millisecond
+
+	first, second := make(chan lnrpc.WalletState), make(chan lnrpc.WalletState)
+	streams := make(chan chan lnrpc.WalletState, 2)
+	streams <- first
+	streams <- second
+	synced := make(chan bool)
+
+	mgr := &manager{
+		connState:   newConnectionTracker(),
+		stateClient: &testStateClient{streams: streams},
+		lnClient:    &testSyncClient{synced: synced},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, err := mgr.SubscribeConnectionState(ctx)
+	require.NoError(t, err)
+	expectConnectionState(t, updates, ConnectionCONNECTING)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		mgr.superviseConnection(ctx)
+	}()
+
+	first <- lnrpc.WalletState_LOCKED
+	expectConnectionState(t, updates, ConnectionWALLETLOCKED)
+	first <- lnrpc.WalletState_RPC_ACTIVE
+	expectConnectionState(t, updates, ConnectionCONNECTING)
+	first <- lnrpc.WalletState_SERVER_ACTIVE
+	expectConnectionState(t, updates, ConnectionSYNCING)
+	synced <- true
+	expectConnectionState(t, updates, ConnectionREADY)
+
+	// A terminated subscription signifies a lost connection,
+	// after which the state is watched anew.
+	close(first)
+	expectConnectionState(t, updates, ConnectionCONNECTING)
+	second <- lnrpc.WalletState_SERVER_ACTIVE
+	expectConnectionState(t, updates, ConnectionSYNCING)
+	synced <- false
+	synced <- true
+	expectConnectionState(t, updates, ConnectionREADY)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection supervision did not terminate")
+	}
+}
+
+func TestWatchWalletStateSinglePoller(t *testing.T) {
+	defer func(poll time.Duration) {
+		connSyncPollInterval = poll
+	}(connSyncPollInterval)
+	connSyncPollInterval = time.Millisecond
+
+	states := make(chan lnrpc.WalletState)
+	streams := make(chan chan lnrpc.WalletState, 1)
+	streams <- states
+	client := &testSyncClient{synced: make(chan bool)}
+
+	mgr := &manager{
+		connState:   newConnectionTracker(),
+		stateClient: &testStateClient{streams: streams},
+		lnClient:    client,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = mgr.watchWalletState(ctx)
+	}()
+
+	// Repeated active states do not start more pollers.
+	for i := 0; i < 3; i++ {
+		states <- lnrpc.WalletState_SERVER_ACTIVE
+	}
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&client.pending) == 1
+	}, 5*time.Second, time.Millisecond)
+	client.synced <- true
+	require.Eventually(t, func() bool {
+		return mgr.connState.get() == ConnectionREADY &&
+			atomic.LoadInt32(&client.pending) == 1
+	}, 5*time.Second, time.Millisecond)
+
+	// Nor do they reset the synced state.
+	states <- lnrpc.WalletState_SERVER_ACTIVE
+	assert.Equal(t, ConnectionREADY, mgr.connState.get())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&client.pending))
+
+	// The poller stops once the wallet is locked.
+	states <- lnrpc.WalletState_LOCKED
+	require.Eventually(t, func() bool {
+		return mgr.connState.get() == ConnectionWALLETLOCKED
+	}, 5*time.Second, time.Millisecond)
+	assert.Zero(t, atomic.LoadInt32(&client.pending))
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("wallet state watch did not terminate")
+	}
+}
+
+func TestNewStartupTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	// Connections to a closed listener are refused.
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	creds := lnconnect.Credentials{
+		RPCAddress: address,
+		TLSCreds:   credentials.NewTLS(&tls.Config{}),
+	}
+
+	start := time.Now()
+	_, err = New(creds, WithStartupTimeout(200*time.Millisecond))
+	assert.ErrorIs(t, err, ErrNetworkUnavailable)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	var lnchatErr Error
+	require.ErrorAs(t, err, &lnchatErr)
+	assert.Error(t, lnchatErr.Cause())
+
+	_, err = New(creds, WithStartupTimeout(0))
+	assert.Error(t, err)
+}
@@ -0,0 +1,152 @@
+package lnchat
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/pkg/errors"
+)
+
+// CustomMessageType is the peer message type used for c13n payloads.
+// It lies in the custom message range (>= 32768) and is odd,
+// so that peers unaware of it ignore the message instead of disconnecting.
+const CustomMessageType uint32 = 0xC13B
+
+// CustomMessage represents a payload exchanged
+// with a directly connected peer over a custom peer message.
+type CustomMessage struct {
+	// The Lightning address of the peer.
+	Peer string
+	// The payload records.
+	Records map[uint64][]byte
+}
+
+// CustomMessageUpdate represents an update of a custom message subscription.
+type CustomMessageUpdate struct {
+	Msg *CustomMessage
+	Err error
+}
+
+// encodeCustomRecords encodes a set of records as a TLV stream.
+func encodeCustomRecords(records map[uint64][]byte) ([]byte, error) {
+	stream, err := tlv.NewStream(tlv.MapToRecords(records)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeCustomRecords decodes a TLV stream into a set of records.
+func decodeCustomRecords(data []byte) (map[uint64][]byte, error) {
+	stream, err := tlv.NewStream()
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := stream.DecodeWithParsedTypes(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[uint64][]byte, len(parsed))
+	for t, v := range parsed {
+		records[uint64(t)] = v
+	}
+
+	return records, nil
+}
+
+// SendCustomMessage sends a set of records to a directly connected peer
+// over a custom peer message. No payment is involved in the exchange.
+func (m *manager) SendCustomMessage(ctx context.Context,
+	peer string, records map[uint64][]byte) error {
+
+	peerBytes, err := addressStrToBytes(peer)
+	if err != nil {
+		return err
+	}
+
+	data, err := encodeCustomRecords(records)
+	if err != nil {
+		return errors.Wrap(err, "could not encode custom message")
+	}
+
+	req := &lnrpc.SendCustomMessageRequest{
+		Peer: peerBytes,
+		Type: CustomMessageType,
+		Data: data,
+	}
+	if _, err := m.lnClient.SendCustomMessage(ctx, req); err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"custom message sending failed")
+	}
+
+	return nil
+}
+
+// SubscribeCustomMessages creates and returns a channel over which
+// custom messages carrying c13n payloads are received.
+// Custom messages of other types, as well as messages
+// that cannot be decoded, are ignored.
+func (m *manager) SubscribeCustomMessages(ctx context.Context) (<-chan CustomMessageUpdate, error) {
+	req := &lnrpc.SubscribeCustomMessagesRequest{}
+
+	stream, err := m.lnClient.SubscribeCustomMessages(ctx, req)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	updateCh := make(chan CustomMessageUpdate)
+
+	// Write updates to the returned channel asynchronously.
+	go func() {
+		defer close(updateCh)
+
+		for {
+			rpcMsg, err := stream.Recv()
+			if err != nil {
+				updateCh <- CustomMessageUpdate{nil, translateCommonRPCErrors(err)}
+				return
+			}
+			if rpcMsg.GetType() != CustomMessageType {
+				continue
+			}
+
+			peer, err := addressBytesToStr(rpcMsg.GetPeer())
+			if err != nil {
+				continue
+			}
+			records, err := decodeCustomRecords(rpcMsg.GetData())
+			if err != nil {
+				continue
+			}
+
+			msg := &CustomMessage{
+				Peer:    peer,
+				Records: records,
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- CustomMessageUpdate{msg, nil}:
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
@@ -0,0 +1,26 @@
+package lnchat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomRecordsEncoding(t *testing.T) {
+	records := map[uint64][]byte{
+		0x117C17A7: []byte("payload"),
+		0x117C17A9: []byte("sender"),
+		0x117C17AB: []byte("signature"),
+	}
+
+	data, err := encodeCustomRecords(records)
+	require.NoError(t, err)
+
+	decoded, err := decodeCustomRecords(data)
+	assert.NoError(t, err)
+	assert.Equal(t, records, decoded)
+
+	_, err = decodeCustomRecords(data[:len(data)-1])
+	assert.Error(t, err)
+}
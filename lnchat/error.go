@@ -15,9 +15,18 @@ var (
 	// ErrInvalidAddress signifies that the provided
 	// address is invalid.
 	ErrInvalidAddress = fmt.Errorf("Invalid address")
+	// ErrNodeNotFound signifies that the requested node
+	// is not present in the network graph.
+	ErrNodeNotFound = fmt.Errorf("Node not found")
 	// ErrInsufficientBalance is returned when a payment fails
 	// due to insufficient balance.
 	ErrInsufficientBalance = fmt.Errorf("Insufficient balance")
+	// ErrIncorrectPaymentDetails is returned when the recipient
+	// of a payment rejects the payment details.
+	ErrIncorrectPaymentDetails = fmt.Errorf("Incorrect payment details")
+	// ErrInvalidSignature signifies that a signature
+	// could not be verified.
+	ErrInvalidSignature = fmt.Errorf("Invalid signature")
 
 	// ErrCancelled is returned when a grpc call returns
 	// with code Canceled.
@@ -39,6 +48,9 @@ var (
 	// ErrPermissionDenied is returned when a grpc call returns
 	// with code PermissionDenied.
 	ErrPermissionDenied = fmt.Errorf("Permission denied")
+	// ErrNotSupported is returned when an operation
+	// is not supported by the underlying node implementation.
+	ErrNotSupported = fmt.Errorf("Operation not supported")
 )
 
 // Error represents an error of the lnchat package.
@@ -0,0 +1,140 @@
+package lnchat
+
+import (
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// FailureCategory represents an actionable category of payment failures.
+type FailureCategory int32
+
+const (
+	// FailureUNKNOWN signifies that the failure category could not
+	// be determined, or that the payment has not failed.
+	FailureUNKNOWN FailureCategory = iota
+	// FailureINSUFFICIENTBALANCE signifies that the underlying node
+	// lacks the outbound balance required for the payment.
+	FailureINSUFFICIENTBALANCE
+	// FailureNOROUTE signifies that no route could carry the payment.
+	FailureNOROUTE
+	// FailureINCORRECTDETAILS signifies that the recipient rejected
+	// the payment details (e.g. an unknown payment hash or a wrong amount).
+	FailureINCORRECTDETAILS
+	// FailureFEETOOLOW signifies that a node along the route
+	// required a higher fee than the one offered.
+	FailureFEETOOLOW
+	// FailureTIMEOUT signifies that the payment was not completed in time.
+	FailureTIMEOUT
+)
+
+// String returns the name of a failure category.
+func (c FailureCategory) String() string {
+	switch c {
+	case FailureINSUFFICIENTBALANCE:
+		return "insufficient balance"
+	case FailureNOROUTE:
+		return "no route"
+	case FailureINCORRECTDETAILS:
+		return "incorrect payment details"
+	case FailureFEETOOLOW:
+		return "fee too low"
+	case FailureTIMEOUT:
+		return "timeout"
+	default:
+		return "unknown"
+	}
+}
+
+// Err returns the lnchat error corresponding to a failure category.
+func (c FailureCategory) Err() error {
+	switch c {
+	case FailureINSUFFICIENTBALANCE:
+		return newError(ErrInsufficientBalance)
+	case FailureNOROUTE:
+		return newError(ErrNoRouteFound)
+	case FailureINCORRECTDETAILS:
+		return newError(ErrIncorrectPaymentDetails)
+	case FailureFEETOOLOW:
+		return newErrorf(ErrNoRouteFound, "fee too low")
+	case FailureTIMEOUT:
+		return newErrorf(ErrDeadlineExceeded, "payment timed out")
+	default:
+		return newErrorf(ErrUnknown, "payment failed: %v", c)
+	}
+}
+
+// classifyFailureCode returns the failure category of a BOLT #4 failure code
+// generated by the node at the provided route position.
+func classifyFailureCode(code lnrpc.Failure_FailureCode,
+	nodeIndex uint32) FailureCategory {
+
+	switch code {
+	case lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS,
+		lnrpc.Failure_INCORRECT_PAYMENT_AMOUNT,
+		lnrpc.Failure_FINAL_INCORRECT_CLTV_EXPIRY,
+		lnrpc.Failure_FINAL_INCORRECT_HTLC_AMOUNT,
+		lnrpc.Failure_FINAL_EXPIRY_TOO_SOON:
+
+		return FailureINCORRECTDETAILS
+	case lnrpc.Failure_FEE_INSUFFICIENT:
+		return FailureFEETOOLOW
+	case lnrpc.Failure_MPP_TIMEOUT:
+		return FailureTIMEOUT
+	// A temporary failure of the sending node signifies
+	// that its channel lacks the required balance.
+	case lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE:
+		if nodeIndex == 0 {
+			return FailureINSUFFICIENTBALANCE
+		}
+		return FailureNOROUTE
+	case lnrpc.Failure_UNKNOWN_NEXT_PEER,
+		lnrpc.Failure_CHANNEL_DISABLED,
+		lnrpc.Failure_TEMPORARY_NODE_FAILURE,
+		lnrpc.Failure_PERMANENT_NODE_FAILURE,
+		lnrpc.Failure_PERMANENT_CHANNEL_FAILURE,
+		lnrpc.Failure_AMOUNT_BELOW_MINIMUM,
+		lnrpc.Failure_EXPIRY_TOO_SOON,
+		lnrpc.Failure_EXPIRY_TOO_FAR,
+		lnrpc.Failure_INCORRECT_CLTV_EXPIRY,
+		lnrpc.Failure_REQUIRED_NODE_FEATURE_MISSING,
+		lnrpc.Failure_REQUIRED_CHANNEL_FEATURE_MISSING:
+
+		return FailureNOROUTE
+	default:
+		return FailureUNKNOWN
+	}
+}
+
+// ClassifyFailure returns the category of a failed payment,
+// based on the payment failure reason and the failures of its HTLCs.
+// Failure reasons identifying the category take precedence,
+// followed by the failure of the most recent classifiable HTLC.
+func (p *Payment) ClassifyFailure() FailureCategory {
+	if p == nil || p.Status != PaymentFAILED {
+		return FailureUNKNOWN
+	}
+
+	switch p.FailureReason {
+	case lnrpc.PaymentFailureReason_FAILURE_REASON_TIMEOUT:
+		return FailureTIMEOUT
+	case lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE:
+		return FailureINSUFFICIENTBALANCE
+	case lnrpc.PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS:
+		return FailureINCORRECTDETAILS
+	}
+
+	for i := len(p.Htlcs) - 1; i >= 0; i-- {
+		f := p.Htlcs[i].Failure
+		if f == nil {
+			continue
+		}
+		if c := classifyFailureCode(f.Code, f.NodeIndex); c != FailureUNKNOWN {
+			return c
+		}
+	}
+
+	if p.FailureReason == lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE {
+		return FailureNOROUTE
+	}
+
+	return FailureUNKNOWN
+}
@@ -0,0 +1,118 @@
+package lnchat
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyFailure(t *testing.T) {
+	failedHTLC := func(code lnrpc.Failure_FailureCode, nodeIndex uint32) HTLCAttempt {
+		return HTLCAttempt{
+			Status:  lnrpc.HTLCAttempt_FAILED,
+			Failure: &HTLCFailure{Code: code, NodeIndex: nodeIndex},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		payment  *Payment
+		expected FailureCategory
+	}{
+		{
+			name:     "succeeded payment",
+			payment:  &Payment{Status: PaymentSUCCEEDED},
+			expected: FailureUNKNOWN,
+		},
+		{
+			name: "timeout reason",
+			payment: &Payment{
+				Status:        PaymentFAILED,
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_TIMEOUT,
+				Htlcs: []HTLCAttempt{
+					failedHTLC(lnrpc.Failure_FEE_INSUFFICIENT, 1),
+				},
+			},
+			expected: FailureTIMEOUT,
+		},
+		{
+			name: "insufficient balance reason",
+			payment: &Payment{
+				Status:        PaymentFAILED,
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE,
+			},
+			expected: FailureINSUFFICIENTBALANCE,
+		},
+		{
+			name: "local temporary channel failure",
+			payment: &Payment{
+				Status:        PaymentFAILED,
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE,
+				Htlcs: []HTLCAttempt{
+					failedHTLC(lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE, 0),
+				},
+			},
+			expected: FailureINSUFFICIENTBALANCE,
+		},
+		{
+			name: "remote temporary channel failure",
+			payment: &Payment{
+				Status: PaymentFAILED,
+				Htlcs: []HTLCAttempt{
+					failedHTLC(lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE, 2),
+				},
+			},
+			expected: FailureNOROUTE,
+		},
+		{
+			name: "latest classifiable HTLC failure",
+			payment: &Payment{
+				Status:        PaymentFAILED,
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE,
+				Htlcs: []HTLCAttempt{
+					failedHTLC(lnrpc.Failure_UNKNOWN_NEXT_PEER, 1),
+					failedHTLC(lnrpc.Failure_FEE_INSUFFICIENT, 1),
+					failedHTLC(lnrpc.Failure_UNREADABLE_FAILURE, 2),
+				},
+			},
+			expected: FailureFEETOOLOW,
+		},
+		{
+			name: "incorrect details",
+			payment: &Payment{
+				Status: PaymentFAILED,
+				Htlcs: []HTLCAttempt{
+					failedHTLC(lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS, 2),
+				},
+			},
+			expected: FailureINCORRECTDETAILS,
+		},
+		{
+			name: "no route reason",
+			payment: &Payment{
+				Status:        PaymentFAILED,
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE,
+			},
+			expected: FailureNOROUTE,
+		},
+		{
+			name: "error reason",
+			payment: &Payment{
+				Status:        PaymentFAILED,
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_ERROR,
+			},
+			expected: FailureUNKNOWN,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, c.payment.ClassifyFailure())
+		})
+	}
+
+	assert.ErrorIs(t, FailureINSUFFICIENTBALANCE.Err(), ErrInsufficientBalance)
+	assert.ErrorIs(t, FailureINCORRECTDETAILS.Err(), ErrIncorrectPaymentDetails)
+	assert.ErrorIs(t, FailureFEETOOLOW.Err(), ErrNoRouteFound)
+}
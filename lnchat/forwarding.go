@@ -0,0 +1,186 @@
+package lnchat
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/pkg/errors"
+)
+
+// ChannelPolicy represents the terms under which
+// the underlying node forwards HTLCs over a channel.
+type ChannelPolicy struct {
+	// The base fee charged for forwarding an HTLC (in millisatoshi).
+	BaseFeeMsat int64
+	// The proportional fee charged for forwarding an HTLC
+	// (in millionths of the forwarded amount).
+	FeeRatePPM uint32
+	// The time-lock delta required for forwarding an HTLC (in blocks).
+	TimeLockDelta uint32
+	// The minimum amount of forwarded HTLCs (in millisatoshi).
+	MinHTLCMsat uint64
+	// The maximum amount of forwarded HTLCs (in millisatoshi).
+	MaxHTLCMsat uint64
+}
+
+// ForwardingEvent represents an HTLC forwarded by the underlying node.
+type ForwardingEvent struct {
+	// The index of the event in the forwarding history.
+	Index uint64
+	// The time the forwarded HTLC was settled
+	// (in nanoseconds since Unix epoch).
+	TimestampNs int64
+	// The ID of the channel the HTLC arrived on.
+	ChanIDIn uint64
+	// The ID of the channel the HTLC was forwarded on.
+	ChanIDOut uint64
+	// The amount of the incoming HTLC (in millisatoshi).
+	AmtInMsat uint64
+	// The amount of the outgoing HTLC (in millisatoshi).
+	AmtOutMsat uint64
+	// The fee earned by the forward (in millisatoshi).
+	FeeMsat uint64
+}
+
+// findChannel returns the open channel with the provided channel point.
+func (m *manager) findChannel(ctx context.Context,
+	chanPoint ChannelPoint) (*lnrpc.Channel, error) {
+
+	resp, err := m.lnClient.ListChannels(ctx, &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"channel retrieval failed")
+	}
+
+	for _, c := range resp.GetChannels() {
+		cp, err := newChannelPointFromString(c.GetChannelPoint())
+		if err == nil && cp == chanPoint {
+			return c, nil
+		}
+	}
+
+	return nil, newErrorf(ErrUnknown, "channel %s:%d not found",
+		chanPoint.FundingTxid, chanPoint.OutputIndex)
+}
+
+// GetChannelPolicy returns the forwarding policy
+// of the underlying node for a channel.
+func (m *manager) GetChannelPolicy(ctx context.Context,
+	chanPoint ChannelPoint) (*ChannelPolicy, error) {
+
+	c, err := m.findChannel(ctx, chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	edge, err := m.lnClient.GetChanInfo(ctx, &lnrpc.ChanInfoRequest{
+		ChanId: c.GetChanId(),
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"channel policy retrieval failed")
+	}
+
+	// The local policy is the one of the endpoint
+	// that is not the remote node.
+	policy := edge.GetNode1Policy()
+	if edge.GetNode1Pub() == c.GetRemotePubkey() {
+		policy = edge.GetNode2Policy()
+	}
+	if policy == nil {
+		return nil, newErrorf(ErrUnknown, "channel policy not announced")
+	}
+
+	return &ChannelPolicy{
+		BaseFeeMsat:   policy.GetFeeBaseMsat(),
+		FeeRatePPM:    uint32(policy.GetFeeRateMilliMsat()),
+		TimeLockDelta: policy.GetTimeLockDelta(),
+		MinHTLCMsat:   uint64(policy.GetMinHtlc()),
+		MaxHTLCMsat:   policy.GetMaxHtlcMsat(),
+	}, nil
+}
+
+// UpdateChannelPolicy updates the forwarding policy
+// of the underlying node for a channel, or for all channels
+// if no channel point is provided.
+// A zero minimum or maximum HTLC amount leaves the respective limit unchanged.
+func (m *manager) UpdateChannelPolicy(ctx context.Context,
+	chanPoint *ChannelPoint, policy ChannelPolicy) error {
+
+	req := &lnrpc.PolicyUpdateRequest{
+		Scope:                &lnrpc.PolicyUpdateRequest_Global{Global: true},
+		BaseFeeMsat:          policy.BaseFeeMsat,
+		FeeRatePpm:           policy.FeeRatePPM,
+		TimeLockDelta:        policy.TimeLockDelta,
+		MinHtlcMsat:          policy.MinHTLCMsat,
+		MinHtlcMsatSpecified: policy.MinHTLCMsat != 0,
+		MaxHtlcMsat:          policy.MaxHTLCMsat,
+	}
+	if chanPoint != nil {
+		rpcChanPoint, err := marshalChannelPoint(*chanPoint)
+		if err != nil {
+			return err
+		}
+		req.Scope = &lnrpc.PolicyUpdateRequest_ChanPoint{ChanPoint: rpcChanPoint}
+	}
+
+	resp, err := m.lnClient.UpdateChannelPolicy(ctx, req)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"channel policy update failed")
+	}
+	if failed := resp.GetFailedUpdates(); len(failed) > 0 {
+		return newErrorf(ErrUnknown, "channel policy update failed: %s",
+			failed[0].GetUpdateError())
+	}
+
+	return nil
+}
+
+// ForwardingHistory returns up to maxEvents forwarding events
+// settled within the provided time range (in seconds since Unix epoch),
+// starting after the event with index indexOffset.
+// Event indexes are relative to the time range.
+// A zero end time signifies the current time.
+func (m *manager) ForwardingHistory(ctx context.Context,
+	startTimeSec, endTimeSec int64, indexOffset uint64,
+	maxEvents uint32) ([]ForwardingEvent, error) {
+
+	resp, err := m.lnClient.ForwardingHistory(ctx, &lnrpc.ForwardingHistoryRequest{
+		StartTime:    uint64(startTimeSec),
+		EndTime:      uint64(endTimeSec),
+		IndexOffset:  uint32(indexOffset),
+		NumMaxEvents: maxEvents,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"forwarding history retrieval failed")
+	}
+
+	events := make([]ForwardingEvent, len(resp.GetForwardingEvents()))
+	for i, e := range resp.GetForwardingEvents() {
+		events[i] = ForwardingEvent{
+			Index:       indexOffset + uint64(i) + 1,
+			TimestampNs: int64(e.GetTimestampNs()),
+			ChanIDIn:    e.GetChanIdIn(),
+			ChanIDOut:   e.GetChanIdOut(),
+			AmtInMsat:   e.GetAmtInMsat(),
+			AmtOutMsat:  e.GetAmtOutMsat(),
+			FeeMsat:     e.GetFeeMsat(),
+		}
+	}
+
+	return events, nil
+}
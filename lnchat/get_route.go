@@ -3,6 +3,7 @@ package lnchat
 import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/pkg/errors"
 )
 
 func createQueryRoutesRequest(dest string, amtMsat int64,
@@ -32,6 +33,37 @@ func createQueryRoutesRequest(dest string, amtMsat int64,
 		DestCustomRecords: records,
 		UseMissionControl: true,
 		FeeLimit:          feeLimit,
+		CltvLimit:         options.CltvLimit,
+	}
+
+	// Route queries are restricted to a single outgoing channel.
+	switch len(options.OutgoingChanIDs) {
+	case 0:
+	case 1:
+		request.OutgoingChanId = options.OutgoingChanIDs[0]
+	default:
+		return nil, newErrorf(ErrNotSupported,
+			"multiple outgoing channels in route queries")
+	}
+	if options.LastHopAddress != "" {
+		lastHop, err := addressStrToBytes(options.LastHopAddress)
+		if err != nil {
+			return nil, err
+		}
+		request.LastHopPubkey = lastHop
+	}
+	for _, addr := range options.IgnoredNodes {
+		node, err := addressStrToBytes(addr)
+		if err != nil {
+			return nil, err
+		}
+		request.IgnoredNodes = append(request.IgnoredNodes, node)
+	}
+	for _, chanID := range options.IgnoredChannels {
+		request.IgnoredEdges = append(request.IgnoredEdges,
+			&lnrpc.EdgeLocator{ChannelId: chanID},
+			&lnrpc.EdgeLocator{ChannelId: chanID, DirectionReverse: true},
+		)
 	}
 
 	return request, nil
@@ -61,3 +93,41 @@ func marshalRouteHints(hints []RouteHint) []*lnrpc.RouteHint {
 
 	return lnrpcHints
 }
+
+// resolvePaymentTarget resolves the destination and amount of a payment
+// from the provided recipient and amount, and the (decoded) payment request,
+// returning them along with the payment request route hints (if any).
+func resolvePaymentTarget(destAddr string, amtMsat int64,
+	payReq *PayReq) (string, int64, []RouteHint, error) {
+
+	var reqAmtMsat, reqDest = int64(0), ""
+	var hints []RouteHint
+	if payReq != nil {
+		reqAmtMsat = payReq.Amt.Msat()
+		reqDest = payReq.Destination.String()
+		hints = payReq.RouteHints
+	}
+
+	switch {
+	case reqAmtMsat == 0 && amtMsat == 0:
+		return "", 0, nil, errors.New("payment amount " +
+			"has not been specified")
+	case reqAmtMsat != 0 && amtMsat != 0 && reqAmtMsat != amtMsat:
+		return "", 0, nil, errors.New("payment request amount " +
+			"non-zero but specified amount difers")
+	case reqAmtMsat != 0:
+		amtMsat = reqAmtMsat
+	}
+	switch {
+	case reqDest == "" && destAddr == "":
+		return "", 0, nil, errors.New("destination " +
+			"has not been specified")
+	case reqDest != "" && destAddr != "" && reqDest != destAddr:
+		return "", 0, nil, errors.New("specified destination " +
+			"and payment request destination differ")
+	case reqDest != "":
+		destAddr = reqDest
+	}
+
+	return destAddr, amtMsat, hints, nil
+}
@@ -0,0 +1,234 @@
+package lnchat
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// graphCache is an in-memory view of the network graph
+// of the underlying node.
+//
+// The cache is seeded with the graph description on first use,
+// and is kept current through the channel graph topology subscription.
+// If the subscription terminates, the cache is invalidated
+// and seeded again on next use.
+type graphCache struct {
+	// syncMtx serializes cache seeding.
+	syncMtx sync.Mutex
+
+	mtx    sync.RWMutex
+	synced bool
+	nodes  map[string]LightningNode
+	// channels maps channel ids to their endpoints,
+	// so that nodes left without channels can be pruned.
+	channels map[uint64][2]string
+	// nodeChans counts the channels of each node.
+	nodeChans map[string]int
+	// epoch identifies the current seeding of the cache.
+	epoch uint64
+
+	// cancel terminates the topology subscription, if active.
+	cancel context.CancelFunc
+}
+
+func newGraphCache() *graphCache {
+	return &graphCache{
+		nodes:     make(map[string]LightningNode),
+		channels:  make(map[uint64][2]string),
+		nodeChans: make(map[string]int),
+	}
+}
+
+// isSynced returns whether the cache is seeded and kept current.
+func (g *graphCache) isSynced() bool {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+
+	return g.synced
+}
+
+// seed replaces the cache contents with the provided graph description,
+// and returns the epoch of the seeded cache.
+func (g *graphCache) seed(graph *lnrpc.ChannelGraph) uint64 {
+	nodes := make(map[string]LightningNode, len(graph.GetNodes()))
+	for _, n := range graph.GetNodes() {
+		nodes[n.GetPubKey()] = LightningNode{
+			Alias:   n.GetAlias(),
+			Address: n.GetPubKey(),
+		}
+	}
+
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	g.nodes = nodes
+	g.channels = make(map[uint64][2]string, len(graph.GetEdges()))
+	g.nodeChans = make(map[string]int, len(nodes))
+	for _, e := range graph.GetEdges() {
+		g.addChannel(e.GetChannelId(), e.GetNode1Pub(), e.GetNode2Pub())
+	}
+	g.synced = true
+	g.epoch++
+
+	return g.epoch
+}
+
+// apply updates the cache contents with a topology update.
+// Nodes left without channels after a channel closure are pruned,
+// as they are from the graph of the underlying node.
+func (g *graphCache) apply(update *lnrpc.GraphTopologyUpdate) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	for _, n := range update.GetNodeUpdates() {
+		g.nodes[n.GetIdentityKey()] = LightningNode{
+			Alias:   n.GetAlias(),
+			Address: n.GetIdentityKey(),
+		}
+	}
+	for _, c := range update.GetChannelUpdates() {
+		g.addChannel(c.GetChanId(), c.GetAdvertisingNode(), c.GetConnectingNode())
+	}
+	for _, c := range update.GetClosedChans() {
+		endpoints, ok := g.channels[c.GetChanId()]
+		if !ok {
+			continue
+		}
+		delete(g.channels, c.GetChanId())
+
+		for _, addr := range endpoints {
+			if g.nodeChans[addr]--; g.nodeChans[addr] <= 0 {
+				delete(g.nodeChans, addr)
+				delete(g.nodes, addr)
+			}
+		}
+	}
+}
+
+// addChannel records a channel between two nodes.
+// Channel endpoints are known to the graph
+// even if they have not announced themselves.
+// The cache mutex must be held by the caller.
+func (g *graphCache) addChannel(chanID uint64, node1, node2 string) {
+	if _, ok := g.channels[chanID]; ok || node1 == "" || node2 == "" {
+		return
+	}
+	g.channels[chanID] = [2]string{node1, node2}
+
+	for _, addr := range []string{node1, node2} {
+		if _, ok := g.nodes[addr]; !ok {
+			g.nodes[addr] = LightningNode{Address: addr}
+		}
+		g.nodeChans[addr]++
+	}
+}
+
+// invalidate marks the cache as stale,
+// if it has not been seeded again since the provided epoch.
+func (g *graphCache) invalidate(epoch uint64) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+
+	if g.epoch == epoch {
+		g.synced = false
+	}
+}
+
+// listNodes returns the cached nodes, ordered by address.
+func (g *graphCache) listNodes() []LightningNode {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+
+	nodes := make([]LightningNode, 0, len(g.nodes))
+	for _, n := range g.nodes {
+		nodes = append(nodes, n)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Address < nodes[j].Address
+	})
+
+	return nodes
+}
+
+// hasNode returns whether a node with the provided address is cached.
+func (g *graphCache) hasNode(address string) bool {
+	g.mtx.RLock()
+	defer g.mtx.RUnlock()
+
+	_, ok := g.nodes[address]
+	return ok
+}
+
+// stop terminates the topology subscription.
+func (g *graphCache) stop() {
+	g.syncMtx.Lock()
+	defer g.syncMtx.Unlock()
+
+	if g.cancel != nil {
+		g.cancel()
+		g.cancel = nil
+	}
+
+	g.mtx.Lock()
+	g.synced = false
+	g.mtx.Unlock()
+}
+
+// syncGraph seeds the graph cache, if not already synced,
+// and starts the topology subscription keeping it current.
+func (m *manager) syncGraph(ctx context.Context) error {
+	g := m.graph
+
+	g.syncMtx.Lock()
+	defer g.syncMtx.Unlock()
+
+	if g.isSynced() {
+		return nil
+	}
+	if g.cancel != nil {
+		g.cancel()
+	}
+
+	// Subscribe before retrieving the graph, so that no updates are missed.
+	subCtx, cancel := context.WithCancel(context.Background())
+	stream, err := m.lnClient.SubscribeChannelGraph(subCtx,
+		&lnrpc.GraphTopologySubscription{})
+	if err != nil {
+		cancel()
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return interceptRPCError(err, ErrUnknown)
+	}
+
+	graph, err := m.lnClient.DescribeGraph(ctx, &lnrpc.ChannelGraphRequest{})
+	if err != nil {
+		cancel()
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return interceptRPCError(err, ErrUnknown)
+	}
+
+	epoch := g.seed(graph)
+	g.cancel = cancel
+
+	// Apply topology updates asynchronously,
+	// invalidating the cache upon subscription termination.
+	go func() {
+		defer g.invalidate(epoch)
+
+		for {
+			update, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			g.apply(update)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package lnchat
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestGraphCache(t *testing.T) {
+	g := newGraphCache()
+	assert.False(t, g.isSynced())
+
+	epoch := g.seed(&lnrpc.ChannelGraph{
+		Nodes: []*lnrpc.LightningNode{
+			{
+				PubKey: "address_1",
+				Alias:  "alias_1",
+			},
+		},
+	})
+	assert.True(t, g.isSynced())
+	assert.True(t, g.hasNode("address_1"))
+	assert.False(t, g.hasNode("address_2"))
+
+	g.apply(&lnrpc.GraphTopologyUpdate{
+		NodeUpdates: []*lnrpc.NodeUpdate{
+			{
+				IdentityKey: "address_1",
+				Alias:       "alias_1_updated",
+			},
+		},
+		ChannelUpdates: []*lnrpc.ChannelEdgeUpdate{
+			{
+				ChanId:          1,
+				AdvertisingNode: "address_1",
+				ConnectingNode:  "address_2",
+			},
+		},
+	})
+	assert.Equal(t, []LightningNode{
+		{
+			Alias:   "alias_1_updated",
+			Address: "address_1",
+		},
+		{
+			Address: "address_2",
+		},
+	}, g.listNodes())
+
+	// Nodes left without channels are pruned on channel closure.
+	g.apply(&lnrpc.GraphTopologyUpdate{
+		ChannelUpdates: []*lnrpc.ChannelEdgeUpdate{
+			{
+				ChanId:          2,
+				AdvertisingNode: "address_3",
+				ConnectingNode:  "address_1",
+			},
+		},
+		ClosedChans: []*lnrpc.ClosedChannelUpdate{
+			{
+				ChanId: 1,
+			},
+		},
+	})
+	assert.Equal(t, []LightningNode{
+		{
+			Alias:   "alias_1_updated",
+			Address: "address_1",
+		},
+		{
+			Address: "address_3",
+		},
+	}, g.listNodes())
+
+	g.apply(&lnrpc.GraphTopologyUpdate{
+		ClosedChans: []*lnrpc.ClosedChannelUpdate{
+			{
+				ChanId: 2,
+			},
+		},
+	})
+	assert.Empty(t, g.listNodes())
+
+	// Invalidation of a stale epoch leaves the cache synced.
+	g.seed(&lnrpc.ChannelGraph{})
+	g.invalidate(epoch)
+	assert.True(t, g.isSynced())
+	assert.Empty(t, g.listNodes())
+
+	g.invalidate(epoch + 1)
+	assert.False(t, g.isSynced())
+}
+
+type testGraphStream struct {
+	lnrpc.Lightning_SubscribeChannelGraphClient
+	ctx context.Context
+}
+
+func (s *testGraphStream) Recv() (*lnrpc.GraphTopologyUpdate, error) {
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+type testGraphClient struct {
+	lnrpc.LightningClient
+	graph    *lnrpc.ChannelGraph
+	channels []*lnrpc.Channel
+}
+
+func (c *testGraphClient) SubscribeChannelGraph(ctx context.Context,
+	_ *lnrpc.GraphTopologySubscription,
+	_ ...grpc.CallOption) (lnrpc.Lightning_SubscribeChannelGraphClient, error) {
+
+	return &testGraphStream{ctx: ctx}, nil
+}
+
+func (c *testGraphClient) DescribeGraph(context.Context, *lnrpc.ChannelGraphRequest,
+	...grpc.CallOption) (*lnrpc.ChannelGraph, error) {
+
+	return c.graph, nil
+}
+
+func (c *testGraphClient) ListChannels(_ context.Context, req *lnrpc.ListChannelsRequest,
+	_ ...grpc.CallOption) (*lnrpc.ListChannelsResponse, error) {
+
+	var channels []*lnrpc.Channel
+	for _, ch := range c.channels {
+		if ch.GetRemotePubkey() == hex.EncodeToString(req.GetPeer()) {
+			channels = append(channels, ch)
+		}
+	}
+
+	return &lnrpc.ListChannelsResponse{Channels: channels}, nil
+}
+
+func TestIsUnreachable(t *testing.T) {
+	public := "02" + strings.Repeat("11", 32)
+	private := "02" + strings.Repeat("22", 32)
+	unknown := "02" + strings.Repeat("33", 32)
+
+	mgr := &manager{
+		graph: newGraphCache(),
+		lnClient: &testGraphClient{
+			graph: &lnrpc.ChannelGraph{
+				Nodes: []*lnrpc.LightningNode{
+					{
+						PubKey: public,
+					},
+				},
+			},
+			channels: []*lnrpc.Channel{
+				{
+					RemotePubkey: private,
+					Private:      true,
+				},
+			},
+		},
+	}
+	defer mgr.graph.stop()
+
+	ctx := context.Background()
+	assert.False(t, mgr.isUnreachable(ctx, public))
+	// Peers over private channels are absent from the graph.
+	assert.False(t, mgr.isUnreachable(ctx, private))
+	assert.True(t, mgr.isUnreachable(ctx, unknown))
+}
@@ -0,0 +1,63 @@
+package lnchat
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// ListInvoices returns up to maxInvoices invoices in ascending order,
+// starting after the invoice with add index indexOffset.
+func (m *manager) ListInvoices(ctx context.Context,
+	indexOffset, maxInvoices uint64) ([]*Invoice, error) {
+
+	resp, err := m.lnClient.ListInvoices(ctx, &lnrpc.ListInvoiceRequest{
+		IndexOffset:    indexOffset,
+		NumMaxInvoices: maxInvoices,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	invoices := make([]*Invoice, len(resp.GetInvoices()))
+	for i, inv := range resp.GetInvoices() {
+		if invoices[i], err = unmarshalInvoice(inv); err != nil {
+			return nil, withCause(newErrorf(ErrInternal,
+				"could not unmarshal invoice"), err)
+		}
+	}
+
+	return invoices, nil
+}
+
+// ListPayments returns up to maxPayments payments in ascending order,
+// including incomplete ones, starting after the payment
+// with payment index indexOffset.
+func (m *manager) ListPayments(ctx context.Context,
+	indexOffset, maxPayments uint64) ([]*Payment, error) {
+
+	resp, err := m.lnClient.ListPayments(ctx, &lnrpc.ListPaymentsRequest{
+		IncludeIncomplete: true,
+		IndexOffset:       indexOffset,
+		MaxPayments:       maxPayments,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	payments := make([]*Payment, len(resp.GetPayments()))
+	for i, p := range resp.GetPayments() {
+		if payments[i], err = unmarshalPayment(p); err != nil {
+			return nil, withCause(newErrorf(ErrInternal,
+				"could not unmarshal payment"), err)
+		}
+	}
+
+	return payments, nil
+}
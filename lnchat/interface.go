@@ -8,34 +8,73 @@ import (
 
 // LightManager is the API for the lnchat service.
 type LightManager interface {
+	GetConnectionState() ConnectionState
+	SubscribeConnectionState(ctx context.Context) (<-chan ConnectionState, error)
+
 	GetSelfInfo(ctx context.Context) (SelfInfo, error)
 	ListNodes(ctx context.Context) ([]LightningNode, error)
+	GetNodeInfo(ctx context.Context, address string) (*NodeInfo, error)
 	GetSelfBalance(ctx context.Context) (*SelfBalance, error)
 
 	ConnectNode(ctx context.Context, address string, hostport string) error
+	ListPeers(ctx context.Context) ([]Peer, error)
+	DisconnectPeer(ctx context.Context, address string) error
+	SubscribePeerEvents(ctx context.Context) (<-chan PeerEventUpdate, error)
 	OpenChannel(ctx context.Context, address string,
 		private bool, amtMsat, pushAmtMsat uint64,
 		minOpenConfirmations int32, txOpts TxFeeOptions) (*ChannelPoint, error)
+	ListChannels(ctx context.Context, filter ChannelFilter) ([]Channel, error)
+	ListPendingChannels(ctx context.Context) ([]PendingChannel, error)
+	CloseChannel(ctx context.Context, chanPoint ChannelPoint,
+		force bool, txOpts TxFeeOptions) (<-chan ChannelCloseUpdate, error)
+	SubscribeChannelEvents(ctx context.Context) (<-chan ChannelEventUpdate, error)
+	GetChannelPolicy(ctx context.Context, chanPoint ChannelPoint) (*ChannelPolicy, error)
+	UpdateChannelPolicy(ctx context.Context, chanPoint *ChannelPoint, policy ChannelPolicy) error
+	ForwardingHistory(ctx context.Context, startTimeSec, endTimeSec int64,
+		indexOffset uint64, maxEvents uint32) ([]ForwardingEvent, error)
+
+	NewAddress(ctx context.Context, addrType AddressType) (string, error)
+	SendCoins(ctx context.Context, address string, amtSat int64,
+		sendAll bool, txOpts TxFeeOptions) (string, error)
+	ListUnspent(ctx context.Context, minConfs, maxConfs int32) ([]Utxo, error)
+	ListTransactions(ctx context.Context, startHeight, endHeight int32) ([]Transaction, error)
+	EstimateFee(ctx context.Context, address string,
+		amtSat int64, targetConfBlock uint32) (*FeeEstimate, error)
 
 	VerifySignatureExtractPubkey(ctx context.Context, message, signature []byte) (string, error)
 	SignMessage(ctx context.Context, message []byte) ([]byte, error)
 
+	SendCustomMessage(ctx context.Context, peer string, records map[uint64][]byte) error
+	SubscribeCustomMessages(ctx context.Context) (<-chan CustomMessageUpdate, error)
+
 	SubscribeInvoiceUpdates(ctx context.Context, startIdx uint64,
 		filter InvoiceUpdateFilter) (<-chan InvoiceUpdate, error)
 	SubscribePaymentUpdates(ctx context.Context, startIdx uint64,
 		filter PaymentUpdateFilter) (<-chan PaymentUpdate, error)
+	ListPayments(ctx context.Context, indexOffset, maxPayments uint64) ([]*Payment, error)
 	SendPayment(ctx context.Context, recipient string, amt Amount, payReq string,
 		payOpts PaymentOptions, payload map[uint64][]byte,
 		filter PaymentUpdateFilter) (<-chan PaymentUpdate, error)
+	SendToRoute(ctx context.Context, route *Route,
+		payload map[uint64][]byte) (*Payment, error)
 
 	DecodePayReq(ctx context.Context, payReq string) (*PayReq, error)
 	CreateInvoice(ctx context.Context, memo string, amt Amount,
 		expiry int64, privateHints bool) (*Invoice, error)
 	LookupInvoice(ctx context.Context, payHash string) (*Invoice, error)
+	ListInvoices(ctx context.Context, indexOffset, maxInvoices uint64) ([]*Invoice, error)
+	CreateHoldInvoice(ctx context.Context, memo, payHash string,
+		amt Amount, expiry int64, privateHints bool) (*Invoice, error)
+	SettleInvoice(ctx context.Context, preimage string) error
+	CancelInvoice(ctx context.Context, payHash string) error
 
 	GetRoute(ctx context.Context, recipient string, amt Amount, payReq string,
 		payOpts PaymentOptions, payload map[uint64][]byte) (
 		route *Route, prob float64, err error)
+	QueryMissionControl(ctx context.Context) ([]PairHistory, error)
+	QueryProbability(ctx context.Context, from, to string,
+		amt Amount) (float64, *PairHistory, error)
+	ResetMissionControl(ctx context.Context) error
 
 	Close() error
 }
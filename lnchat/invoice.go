@@ -71,9 +71,14 @@ type InvoiceHTLC struct {
 }
 
 // GetCustomRecords returns the custom records transferred via the invoice HTLCs.
+// Records of cancelled HTLCs are omitted, since a payment split
+// into multiple HTLCs may have partial attempts failing.
 func (inv Invoice) GetCustomRecords() []map[uint64][]byte {
 	var records []map[uint64][]byte
 	for _, htlc := range inv.Htlcs {
+		if htlc.State == lnrpc.InvoiceHTLCState_CANCELED {
+			continue
+		}
 		if len(htlc.CustomRecords) > 0 {
 			records = append(records, htlc.CustomRecords)
 		}
@@ -1,6 +1,8 @@
 package lnchat
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -52,9 +54,37 @@ func unmarshalInvoice(i *lnrpc.Invoice) (*Invoice, error) {
 		}
 	}
 
-	invHtlcs := make([]InvoiceHTLC, len(i.Htlcs))
-	for i, htlc := range i.Htlcs {
-		invHtlcs[i] = InvoiceHTLC{
+	// An AMP invoice is paid (and settled) separately by each
+	// HTLC set, so it is presented as the most recently settled set.
+	var ampSetID []byte
+	if i.IsAmp {
+		setID, ampState := latestSettledAMPSet(i.AmpInvoiceState)
+		if ampState != nil {
+			if ampSetID, err = hex.DecodeString(setID); err != nil {
+				return nil, fmt.Errorf("invalid AMP set id: %w", err)
+			}
+			inv.State = InvoiceSETTLED
+			inv.SettleIndex = ampState.SettleIndex
+			inv.SettleTimeSec = ampState.SettleTime
+			inv.AmtPaid = NewAmount(ampState.AmtPaidMsat)
+		}
+	}
+
+	invHtlcs := make([]InvoiceHTLC, 0, len(i.Htlcs))
+	for _, htlc := range i.Htlcs {
+		if ampSetID != nil {
+			amp := htlc.GetAmp()
+			if !bytes.Equal(amp.GetSetId(), ampSetID) {
+				continue
+			}
+			// The preimage of the first HTLC in the set
+			// uniquely identifies the settled set.
+			if amp.GetChildIndex() == 0 {
+				inv.Preimage = amp.GetPreimage()
+			}
+		}
+
+		invHtlcs = append(invHtlcs, InvoiceHTLC{
 			ChanID:         htlc.ChanId,
 			Amount:         NewAmount(int64(htlc.AmtMsat)),
 			ExpiryHeight:   htlc.ExpiryHeight,
@@ -62,15 +92,34 @@ func unmarshalInvoice(i *lnrpc.Invoice) (*Invoice, error) {
 			AcceptTimeSec:  htlc.AcceptTime,
 			ResolveTimeSec: htlc.ResolveTime,
 			CustomRecords:  htlc.CustomRecords,
-		}
+		})
 	}
-	if len(i.Htlcs) != 0 {
+	if len(invHtlcs) != 0 {
 		inv.Htlcs = invHtlcs
 	}
 
 	return inv, nil
 }
 
+// latestSettledAMPSet returns the set id and state
+// of the most recently settled HTLC set of an AMP invoice.
+func latestSettledAMPSet(states map[string]*lnrpc.AMPInvoiceState) (
+	string, *lnrpc.AMPInvoiceState) {
+
+	var setID string
+	var latest *lnrpc.AMPInvoiceState
+	for id, state := range states {
+		if state.GetState() != lnrpc.InvoiceHTLCState_SETTLED {
+			continue
+		}
+		if latest == nil || state.GetSettleIndex() > latest.GetSettleIndex() {
+			setID, latest = id, state
+		}
+	}
+
+	return setID, latest
+}
+
 // unmarshalRouteHints creates an lnchat.RouteHint list
 // from the corresponding lnrpc type.
 func unmarshalRouteHints(r []*lnrpc.RouteHint) ([]RouteHint, error) {
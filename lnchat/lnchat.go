@@ -3,13 +3,18 @@ package lnchat
 import (
 	"context"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/c13n-io/c13n-go/lnchat/lnconnect"
 )
@@ -17,22 +22,67 @@ import (
 // defaultConnectTimeout is the default timeout used for peer connections (in seconds).
 const defaultConnectTimeout = 15
 
+// defaultStartupTimeout is the default time allowed for the underlying node
+// to become available when creating a manager.
+const defaultStartupTimeout = 60 * time.Second
+
+// defaultRoutePaymentTimeout is the default time allowed for retrying
+// payments sent along queried routes, if no payment timeout is set.
+const defaultRoutePaymentTimeout = 60 * time.Second
+
+// WithStartupTimeout sets the time allowed for the underlying node
+// to become available (and its wallet unlocked) when creating a manager,
+// after which manager creation fails.
+func WithStartupTimeout(timeout time.Duration) func(LightManager) error {
+	return func(mgr LightManager) error {
+		if timeout <= 0 {
+			return newErrorf(ErrInternal, "invalid startup timeout %v", timeout)
+		}
+
+		switch m := mgr.(type) {
+		case *manager:
+			m.startupTimeout = timeout
+		case *clnManager:
+			m.startupTimeout = timeout
+		default:
+			return newErrorf(ErrNotSupported, "startup timeout option")
+		}
+		return nil
+	}
+}
+
 type manager struct {
 	conn *grpc.ClientConn
 
-	lnClient    lnrpc.LightningClient
-	routeClient routerrpc.RouterClient
-	creds       lnconnect.Credentials
+	connState      *connectionTracker
+	startupTimeout time.Duration
+	stopSupervise  context.CancelFunc
+	supervisorWg   sync.WaitGroup
+
+	lnClient       lnrpc.LightningClient
+	stateClient    lnrpc.StateClient
+	routeClient    routerrpc.RouterClient
+	invoicesClient invoicesrpc.InvoicesClient
+	creds          lnconnect.Credentials
 
 	self SelfInfo
+
+	graph *graphCache
 }
 
 var _ LightManager = (*manager)(nil)
 
 // New creates a manager connected to the node daemon with the provided credentials.
+//
+// The connection to the daemon is supervised for the lifetime of the manager,
+// and New waits until the daemon is available and its wallet unlocked,
+// failing if that does not happen within the startup timeout.
 func New(creds lnconnect.Credentials, options ...func(LightManager) error) (LightManager, error) {
 	mgr := &manager{
-		creds: creds,
+		creds:          creds,
+		graph:          newGraphCache(),
+		connState:      newConnectionTracker(),
+		startupTimeout: defaultStartupTimeout,
 	}
 
 	for _, option := range options {
@@ -54,20 +104,58 @@ func New(creds lnconnect.Credentials, options ...func(LightManager) error) (Ligh
 
 	mgr.conn = conn
 	mgr.lnClient = lnrpc.NewLightningClient(conn)
+	mgr.stateClient = lnrpc.NewStateClient(conn)
 	mgr.routeClient = routerrpc.NewRouterClient(conn)
+	mgr.invoicesClient = invoicesrpc.NewInvoicesClient(conn)
+
+	supervisorCtx, cancel := context.WithCancel(context.Background())
+	mgr.stopSupervise = cancel
+	mgr.supervisorWg.Add(1)
+	go func() {
+		defer mgr.supervisorWg.Done()
+		mgr.superviseConnection(supervisorCtx)
+	}()
+
+	// Wait for the daemon to start, then get self info
+	ctx, cancel := context.WithTimeout(context.Background(), mgr.startupTimeout)
+	defer cancel()
+	state, err := mgr.connState.wait(ctx, func(s ConnectionState) bool {
+		return s == ConnectionSYNCING || s == ConnectionREADY
+	})
+	if err != nil {
+		cause := mgr.connState.lastError()
+		mgr.Close()
+		return nil, startupError(state, mgr.startupTimeout, cause)
+	}
 
-	// Get self info during initialization
-	ctx := context.Background()
 	mgr.self, err = mgr.GetSelfInfo(ctx)
 	if err != nil {
+		mgr.Close()
 		return nil, err
 	}
 
 	return mgr, nil
 }
 
+// startupError returns the error reported when the underlying node
+// did not become available within the startup timeout.
+func startupError(state ConnectionState, timeout time.Duration, cause error) error {
+	if cause == nil {
+		return newErrorf(ErrNetworkUnavailable,
+			"node not available after %v (connection state %v)", timeout, state)
+	}
+
+	return withCause(newErrorf(ErrNetworkUnavailable,
+		"node not available after %v (connection state %v): %v",
+		timeout, state, cause), cause)
+}
+
 // Close closes the underlying connection and releases the associated resources.
 func (m *manager) Close() error {
+	m.stopSupervise()
+	m.supervisorWg.Wait()
+	m.graph.stop()
+
 	return m.conn.Close()
 }
 
@@ -148,65 +236,103 @@ func (m *manager) GetSelfBalance(ctx context.Context) (*SelfBalance, error) {
 // ListNodes returns a list of the current nodes in the network.
 // The list contains only nodes visible from the underlying lightning daemon,
 // including ones with whom he has open private channels.
+//
+// The nodes are retrieved from the graph cache, which is seeded
+// on first use and kept current through graph topology updates.
 func (m *manager) ListNodes(ctx context.Context) ([]LightningNode, error) {
-	var nodes []LightningNode
+	if err := m.syncGraph(ctx); err != nil {
+		return nil, err
+	}
 
-	req := &lnrpc.ChannelGraphRequest{}
+	return m.graph.listNodes(), nil
+}
 
-	graph, err := m.lnClient.DescribeGraph(ctx, req)
+// GetNodeInfo returns the details of a node, as advertised
+// to the network graph of the underlying node.
+func (m *manager) GetNodeInfo(ctx context.Context, address string) (*NodeInfo, error) {
+	req := &lnrpc.NodeInfoRequest{
+		PubKey: address,
+	}
+	resp, err := m.lnClient.GetNodeInfo(ctx, req)
 	if err != nil {
 		if terr := translateCommonRPCErrors(err); terr != err {
-			return nodes, terr
+			return nil, terr
+		}
+		if status.Code(err) == codes.NotFound {
+			return nil, withCause(newError(ErrNodeNotFound), err)
 		}
-		return nodes, interceptRPCError(err, ErrUnknown)
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"node info retrieval failed")
 	}
 
-	// `lnd/lnrpc/rpc.pb.go:type LightningNode struct`
-	lnNodeList := graph.GetNodes()
-	nodes = make([]LightningNode, len(lnNodeList))
-	for i, n := range lnNodeList {
-		nodes[i] = LightningNode{
-			Alias:   n.GetAlias(),
-			Address: n.GetPubKey(),
-		}
+	info := unmarshalNodeInfo(resp)
+
+	channels, err := m.ListChannels(ctx, ChannelFilter{Peer: address})
+	if err != nil {
+		return nil, err
 	}
+	info.HasChannel = len(channels) > 0
 
-	return nodes, nil
+	return &info, nil
 }
 
 // ConnectNode creates a peer connection with a node
 // if one does not already exist.
 func (m *manager) ConnectNode(ctx context.Context, pubkey string, hostport string) error {
-	// Check if a peer connection exists.
-	peers, err := m.lnClient.ListPeers(ctx, &lnrpc.ListPeersRequest{})
-	if err != nil {
+	connReq := &lnrpc.ConnectPeerRequest{
+		Addr: &lnrpc.LightningAddress{
+			Pubkey: pubkey,
+			Host:   hostport,
+		},
+		Timeout: defaultConnectTimeout,
+	}
+	if _, err := m.lnClient.ConnectPeer(ctx, connReq); err != nil {
+		// An existing peer connection is not an error.
+		if isAlreadyConnectedError(err) {
+			return nil
+		}
 		if terr := translateCommonRPCErrors(err); terr != err {
 			return terr
 		}
 		return errors.Wrap(interceptRPCError(err, ErrUnknown),
-			"peer retrieval failed")
+			"creation of node connection failed")
 	}
 
-	for _, p := range peers.GetPeers() {
-		if p.PubKey == pubkey {
-			return nil
+	return nil
+}
+
+// ListPeers returns the nodes currently connected to the underlying node.
+func (m *manager) ListPeers(ctx context.Context) ([]Peer, error) {
+	resp, err := m.lnClient.ListPeers(ctx, &lnrpc.ListPeersRequest{})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
 		}
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"peer retrieval failed")
 	}
 
-	// Attempt to create peer connection.
-	connReq := &lnrpc.ConnectPeerRequest{
-		Addr: &lnrpc.LightningAddress{
-			Pubkey: pubkey,
-			Host:   hostport,
-		},
-		Timeout: defaultConnectTimeout,
+	peers := make([]Peer, len(resp.GetPeers()))
+	for i, p := range resp.GetPeers() {
+		peers[i] = unmarshalPeer(p)
 	}
-	if _, err := m.lnClient.ConnectPeer(ctx, connReq); err != nil {
+
+	return peers, nil
+}
+
+// DisconnectPeer terminates the peer connection with a node.
+// A peer with which the underlying node has active or pending channels
+// cannot be disconnected.
+func (m *manager) DisconnectPeer(ctx context.Context, pubkey string) error {
+	req := &lnrpc.DisconnectPeerRequest{
+		PubKey: pubkey,
+	}
+	if _, err := m.lnClient.DisconnectPeer(ctx, req); err != nil {
 		if terr := translateCommonRPCErrors(err); terr != err {
 			return terr
 		}
 		return errors.Wrap(interceptRPCError(err, ErrUnknown),
-			"creation of node connection failed")
+			"peer disconnection failed")
 	}
 
 	return nil
@@ -280,56 +406,166 @@ func (m *manager) OpenChannel(ctx context.Context, address string,
 	}
 }
 
-// CloseChannel closes the specified channel.
+// ListChannels returns the open channels of the underlying node
+// that match the provided filter.
+func (m *manager) ListChannels(ctx context.Context,
+	filter ChannelFilter) ([]Channel, error) {
+
+	req := &lnrpc.ListChannelsRequest{
+		ActiveOnly:   filter.ActiveOnly,
+		InactiveOnly: filter.InactiveOnly,
+		PublicOnly:   filter.PublicOnly,
+		PrivateOnly:  filter.PrivateOnly,
+	}
+	if filter.Peer != "" {
+		peer, err := addressStrToBytes(filter.Peer)
+		if err != nil {
+			return nil, err
+		}
+		req.Peer = peer
+	}
+
+	resp, err := m.lnClient.ListChannels(ctx, req)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"channel retrieval failed")
+	}
+
+	channels := make([]Channel, len(resp.GetChannels()))
+	for i, c := range resp.GetChannels() {
+		ch, err := unmarshalChannel(c)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode channel")
+		}
+		channels[i] = *ch
+	}
+
+	return channels, nil
+}
+
+// ListPendingChannels returns the channels of the underlying node
+// that are in the process of being opened or closed.
+func (m *manager) ListPendingChannels(ctx context.Context) ([]PendingChannel, error) {
+	req := &lnrpc.PendingChannelsRequest{}
+
+	resp, err := m.lnClient.PendingChannels(ctx, req)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"pending channel retrieval failed")
+	}
+
+	channels, err := unmarshalPendingChannels(resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode pending channel")
+	}
+
+	return channels, nil
+}
+
+// CloseChannel closes the specified channel, either cooperatively
+// or unilaterally (if force is set), and returns a channel
+// over which close updates are received.
+// The update channel is closed when the closing
+// transaction is confirmed.
 func (m *manager) CloseChannel(ctx context.Context, chanPoint ChannelPoint,
-	force bool, txOpts TxFeeOptions) (string, error) {
+	force bool, txOpts TxFeeOptions) (<-chan ChannelCloseUpdate, error) {
 
-	fundingTxid, err := chainhash.NewHashFromStr(chanPoint.FundingTxid)
+	rpcChanPoint, err := marshalChannelPoint(chanPoint)
 	if err != nil {
-		return "", errors.Wrap(err, "could not decode funding transaction")
+		return nil, err
 	}
 
 	closeChanReq := &lnrpc.CloseChannelRequest{
-		ChannelPoint: &lnrpc.ChannelPoint{
-			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
-				FundingTxidBytes: fundingTxid[:],
-			},
-			OutputIndex: chanPoint.OutputIndex,
-		},
-		TargetConf: int32(txOpts.TargetConfBlock),
-		Force:      force,
+		ChannelPoint: rpcChanPoint,
+		Force:        force,
+		TargetConf:   int32(txOpts.TargetConfBlock),
+		SatPerVbyte:  txOpts.SatPerVByte,
 	}
 	closeStatusStream, err := m.lnClient.CloseChannel(ctx, closeChanReq)
 	if err != nil {
 		if terr := translateCommonRPCErrors(err); terr != err {
-			return "", terr
+			return nil, terr
 		}
-		return "", errors.Wrap(interceptRPCError(err, ErrUnknown),
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
 			"channel closing failed")
 	}
-	for {
-		update, err := closeStatusStream.Recv()
-		if err != nil {
-			return "", errors.Wrap(err, "failed to receive channel status update")
-		}
 
-		switch update.Update.(type) {
-		case *lnrpc.CloseStatusUpdate_ClosePending:
-			continue
-		case *lnrpc.CloseStatusUpdate_ChanClose:
-			chanClosingTxid, err := chainhash.NewHash(
-				update.GetChanClose().GetClosingTxid(),
-			)
+	updateCh := make(chan ChannelCloseUpdate)
+
+	// Write updates to the returned channel asynchronously.
+	go func() {
+		defer close(updateCh)
+
+		for {
+			update, err := closeStatusStream.Recv()
+			switch {
+			case err == io.EOF:
+				return
+			case err != nil:
+				if terr := translateCommonRPCErrors(err); terr != err {
+					err = terr
+				} else {
+					err = interceptRPCError(err, ErrUnknown)
+				}
+				select {
+				case <-ctx.Done():
+				case updateCh <- ChannelCloseUpdate{nil, err}:
+				}
+				return
+			}
+
+			var status *ChannelCloseStatus
+			switch update.Update.(type) {
+			case *lnrpc.CloseStatusUpdate_ClosePending:
+				status, err = newChannelCloseStatus(ChannelClosePENDING,
+					update.GetClosePending().GetTxid())
+			case *lnrpc.CloseStatusUpdate_ChanClose:
+				status, err = newChannelCloseStatus(ChannelCloseCONFIRMED,
+					update.GetChanClose().GetClosingTxid())
+			default:
+				err = errors.New("unknown channel close update type")
+			}
 			if err != nil {
-				return "", errors.Wrap(err, "could not encode closing transaction")
+				select {
+				case <-ctx.Done():
+				case updateCh <- ChannelCloseUpdate{nil, err}:
+				}
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- ChannelCloseUpdate{status, nil}:
+			}
+
+			if status.State == ChannelCloseCONFIRMED {
+				return
 			}
-			return chanClosingTxid.String(), nil
-		default:
-			return "", errors.New("unknown channel close update type")
 		}
+	}()
+
+	return updateCh, nil
+}
+
+func newChannelCloseStatus(state ChannelCloseState,
+	closingTxid []byte) (*ChannelCloseStatus, error) {
+
+	txid, err := chainhash.NewHash(closingTxid)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not encode closing transaction")
 	}
 
-	return "", nil
+	return &ChannelCloseStatus{
+		State:       state,
+		ClosingTxid: txid.String(),
+	}, nil
 }
 
 func (m *manager) signMessage(ctx context.Context, msg []byte) ([]byte, error) {
@@ -378,6 +614,20 @@ func (m *manager) VerifySignatureExtractPubkey(ctx context.Context, message, sig
 	return m.verifyMessage(ctx, message, signature)
 }
 
+// isUnreachable returns whether a destination is known to be unreachable
+// without route hints, namely when it is absent from the (synced) graph cache
+// and the underlying node has no channel with it.
+// Errors while checking are left for the route query to surface.
+func (m *manager) isUnreachable(ctx context.Context, dest string) bool {
+	if m.syncGraph(ctx) != nil || m.graph.hasNode(dest) {
+		return false
+	}
+
+	channels, err := m.ListChannels(ctx, ChannelFilter{Peer: dest})
+
+	return err == nil && len(channels) == 0
+}
+
 // GetRoute queries the underlying daemon for a route that can accomodate
 // a payment of amount to recipient, respecting the provided payment options.
 // If a route was found, it is returned along with a probability of success
@@ -386,50 +636,67 @@ func (m *manager) GetRoute(ctx context.Context,
 	recipient string, amount Amount, payReq string, payOpts PaymentOptions,
 	payload map[uint64][]byte) (*Route, float64, error) {
 
-	dest, amtMsat, hints, err := func(destAddr string,
-		amtMsat int64, req string) (string, int64, []RouteHint, error) {
-
-		var reqAmtMsat, reqDest = int64(0), ""
-		var hints []RouteHint
-		if req != "" {
-			decodedPayReq, err := m.DecodePayReq(ctx, req)
-			if err != nil {
-				return "", 0, nil, errors.Wrap(err,
-					"could not decode payment request")
-			}
-			reqAmtMsat = decodedPayReq.Amt.Msat()
-			reqDest = decodedPayReq.Destination.String()
-			hints = decodedPayReq.RouteHints
+	var decodedPayReq *PayReq
+	if payReq != "" {
+		var err error
+		if decodedPayReq, err = m.DecodePayReq(ctx, payReq); err != nil {
+			return nil, .0, errors.Wrap(err, "could not decode payment request")
 		}
+	}
+	dest, amtMsat, hints, err := resolvePaymentTarget(recipient,
+		amount.Msat(), decodedPayReq)
+	if err != nil {
+		return nil, .0, err
+	}
 
-		switch {
-		case reqAmtMsat == 0 && amtMsat == 0:
-			return "", 0, nil, errors.New("payment amount " +
-				"has not been specified")
-		case reqAmtMsat != 0 && amtMsat != 0 && reqAmtMsat != amtMsat:
-			return "", 0, nil, errors.New("payment request amount " +
-				"non-zero but specified amount difers")
-		case reqAmtMsat != 0:
-			amtMsat = reqAmtMsat
+	// Destinations absent from the network graph can only be reached
+	// over a direct channel or through route hints,
+	// so the daemon is not queried for them otherwise.
+	if len(hints) == 0 && m.isUnreachable(ctx, dest) {
+		return nil, .0, ErrNoRouteFound
+	}
+
+	// Route queries are restricted to a single outgoing channel,
+	// so each allowed outgoing channel is queried separately
+	// and the most probable route is returned.
+	chanSets := [][]uint64{payOpts.OutgoingChanIDs}
+	if len(payOpts.OutgoingChanIDs) > 1 {
+		chanSets = make([][]uint64, len(payOpts.OutgoingChanIDs))
+		for i, chanID := range payOpts.OutgoingChanIDs {
+			chanSets[i] = []uint64{chanID}
 		}
+	}
+
+	var best *Route
+	var bestProb float64
+	for _, chans := range chanSets {
+		opts := payOpts
+		opts.OutgoingChanIDs = chans
+
+		route, prob, queryErr := m.queryRoute(ctx, dest, amtMsat, hints, opts, payload)
 		switch {
-		case reqDest == "" && destAddr == "":
-			return "", 0, nil, errors.New("destination " +
-				"has not been specified")
-		case reqDest != "" && destAddr != "" && reqDest != destAddr:
-			return "", 0, nil, errors.New("specified destination " +
-				"and payment request destination differ")
-		case reqDest != "":
-			destAddr = reqDest
-		}
+		case queryErr != nil:
+			err = queryErr
+		case best == nil || prob > bestProb ||
+			(prob == bestProb && route.Fees.Msat() < best.Fees.Msat()):
 
-		return destAddr, amtMsat, hints, nil
-	}(recipient, amount.Msat(), payReq)
-	if err != nil {
+			best, bestProb = route, prob
+		}
+	}
+	if best == nil {
 		return nil, .0, err
 	}
 
-	// Create route request
+	return best, bestProb, nil
+}
+
+// queryRoute queries the underlying daemon for a route to dest
+// respecting the provided payment options,
+// and returns it along with its probability of success.
+func (m *manager) queryRoute(ctx context.Context, dest string, amtMsat int64,
+	hints []RouteHint, payOpts PaymentOptions,
+	payload map[uint64][]byte) (*Route, float64, error) {
+
 	req, err := createQueryRoutesRequest(dest, amtMsat, hints, payOpts, payload)
 	if err != nil {
 		return nil, .0, err
@@ -448,6 +715,10 @@ func (m *manager) GetRoute(ctx context.Context,
 	if len(routes) == 0 {
 		return nil, .0, ErrNoRouteFound
 	}
+	if prob < payOpts.MinSuccessProb {
+		return nil, .0, newErrorf(ErrNoRouteFound,
+			"route success probability %.3f below minimum", prob)
+	}
 	route, err := unmarshalRoute(routes[0])
 	if err != nil {
 		return nil, .0, err
@@ -484,11 +755,19 @@ type PaymentUpdate struct {
 // SendPayment attempts to send a payment to a receiver,
 // returning a channel over which payment updates are received.
 // The update channel is closed when the payment succeeds.
+// Payments restricted by ignored nodes or channels or by a minimum
+// success probability are sent along queried routes instead,
+// as described in sendPaymentAlongRoute.
 func (m *manager) SendPayment(ctx context.Context,
 	recipient string, amount Amount, payReq string,
 	payOpts PaymentOptions, payload map[uint64][]byte,
 	filter PaymentUpdateFilter) (<-chan PaymentUpdate, error) {
 
+	if payOpts.requiresRouteQuery() {
+		return m.sendPaymentAlongRoute(ctx, recipient, amount,
+			payReq, payOpts, payload, filter)
+	}
+
 	// Validate request, destination and amount.
 	dest, amtMsat, err := func(destAddr string,
 		amtMsat int64, req string) ([]byte, int64, error) {
@@ -592,6 +871,108 @@ func (m *manager) SendPayment(ctx context.Context,
 	return updateCh, nil
 }
 
+// sendPaymentAlongRoute sends a spontaneous payment along routes
+// returned by route queries respecting the payment options,
+// since payment requests cannot express all route restrictions.
+// Failed attempts are retried along a new route until the payment
+// timeout expires. The daemon's mission control records the result
+// of each attempt, and the channel an attempt failed at is also
+// ignored by later route queries of the payment.
+// Each attempt is a distinct payment sent along a single path,
+// so such payments are never split.
+// The final payment update is received over the returned channel.
+func (m *manager) sendPaymentAlongRoute(ctx context.Context,
+	recipient string, amount Amount, payReq string,
+	payOpts PaymentOptions, payload map[uint64][]byte,
+	filter PaymentUpdateFilter) (<-chan PaymentUpdate, error) {
+
+	if payReq != "" || payOpts.AMP {
+		return nil, newErrorf(ErrNotSupported, "ignored nodes, ignored channels "+
+			"and success probability limits on payment request or AMP payments")
+	}
+
+	route, _, err := m.GetRoute(ctx, recipient, amount, "", payOpts, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultRoutePaymentTimeout
+	if payOpts.TimeoutSecs > 0 {
+		timeout = time.Duration(payOpts.TimeoutSecs) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+
+	updateCh := make(chan PaymentUpdate)
+	go func() {
+		defer close(updateCh)
+
+		payment, err := m.sendAlongRoutes(ctx, deadline, route,
+			recipient, amount, payOpts, payload)
+		if err == nil && !filter(payment) {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+		case updateCh <- PaymentUpdate{payment, err}:
+		}
+	}()
+
+	return updateCh, nil
+}
+
+// sendAlongRoutes attempts a payment along the provided route,
+// retrying failed attempts along newly queried routes
+// until an attempt succeeds, no route is found or the deadline passes.
+// The last attempted payment is returned.
+func (m *manager) sendAlongRoutes(ctx context.Context, deadline time.Time,
+	route *Route, recipient string, amount Amount, payOpts PaymentOptions,
+	payload map[uint64][]byte) (*Payment, error) {
+
+	payOpts.IgnoredChannels = append([]uint64(nil), payOpts.IgnoredChannels...)
+	for {
+		payment, err := m.SendToRoute(ctx, route, payload)
+		if err != nil || payment.Status != PaymentFAILED {
+			return payment, err
+		}
+
+		chanID, ok := failedChannel(payment)
+		if !ok || ctx.Err() != nil || time.Now().After(deadline) {
+			return payment, nil
+		}
+		payOpts.IgnoredChannels = append(payOpts.IgnoredChannels, chanID)
+
+		queryCtx, cancel := context.WithDeadline(ctx, deadline)
+		next, _, err := m.GetRoute(queryCtx, recipient, amount, "", payOpts, payload)
+		cancel()
+		if err != nil {
+			return payment, nil
+		}
+		route = next
+	}
+}
+
+// failedChannel returns the channel the last attempt of a failed payment
+// failed to be forwarded over, or false if the attempt failed
+// at the recipient (in which case other routes would fail as well).
+func failedChannel(payment *Payment) (uint64, bool) {
+	if len(payment.Htlcs) == 0 {
+		return 0, false
+	}
+
+	attempt := payment.Htlcs[len(payment.Htlcs)-1]
+	if attempt.Failure == nil {
+		return 0, false
+	}
+
+	idx, hops := int(attempt.Failure.NodeIndex), attempt.Route.Hops
+	if idx >= len(hops) {
+		return 0, false
+	}
+
+	return hops[idx].ChannelID, true
+}
+
 // InvoiceUpdateFilter allows filtering of invoice updates of interest
 // to be returned from InvoiceSubscription
 type InvoiceUpdateFilter = func(*Invoice) bool
@@ -698,6 +1079,84 @@ func (m *manager) LookupInvoice(ctx context.Context, hashStr string) (*Invoice,
 	return m.lookupInvoice(ctx, hash[:])
 }
 
+// CreateHoldInvoice creates a hold invoice for the provided payment hash
+// with the specified amount and memo, and returns it.
+// A hold invoice is not settled upon receiving payment,
+// but remains accepted until it is settled with the preimage
+// corresponding to the payment hash, or cancelled.
+// If expiry is set, it sets the invoice expiry (in seconds),
+// and privateHints controls inclusion of private channel hints.
+func (m *manager) CreateHoldInvoice(ctx context.Context, memo, hashStr string,
+	amt Amount, expiry int64, privateHints bool) (*Invoice, error) {
+
+	hash, err := lntypes.MakeHashFromStr(hashStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &invoicesrpc.AddHoldInvoiceRequest{
+		Memo:      memo,
+		Hash:      hash[:],
+		ValueMsat: amt.Msat(),
+		Expiry:    expiry,
+		Private:   privateHints,
+	}
+
+	if _, err := m.invoicesClient.AddHoldInvoice(ctx, req); err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	return m.lookupInvoice(ctx, hash[:])
+}
+
+// SettleInvoice settles an accepted hold invoice
+// with the preimage corresponding to its payment hash.
+func (m *manager) SettleInvoice(ctx context.Context, preimageStr string) error {
+	preimage, err := lntypes.MakePreimageFromStr(preimageStr)
+	if err != nil {
+		return err
+	}
+
+	req := &invoicesrpc.SettleInvoiceMsg{
+		Preimage: preimage[:],
+	}
+
+	if _, err := m.invoicesClient.SettleInvoice(ctx, req); err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return interceptRPCError(err, ErrUnknown)
+	}
+
+	return nil
+}
+
+// CancelInvoice cancels an open or accepted invoice,
+// identified by its payment hash.
+// Any HTLCs held for an accepted invoice are failed back.
+func (m *manager) CancelInvoice(ctx context.Context, hashStr string) error {
+	hash, err := lntypes.MakeHashFromStr(hashStr)
+	if err != nil {
+		return err
+	}
+
+	req := &invoicesrpc.CancelInvoiceMsg{
+		PaymentHash: hash[:],
+	}
+
+	if _, err := m.invoicesClient.CancelInvoice(ctx, req); err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return interceptRPCError(err, ErrUnknown)
+	}
+
+	return nil
+}
+
 func (m *manager) lookupInvoice(ctx context.Context, hash []byte) (*Invoice, error) {
 	req := &lnrpc.PaymentHash{
 		RHash: hash,
@@ -1,11 +1,11 @@
 package lnconnect
 
 import (
-	"context"
 	"fmt"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials"
 )
 
@@ -22,28 +22,34 @@ type Credentials struct {
 
 var (
 	dialTimeoutSeconds = 10
+	// maxReconnectDelay bounds the backoff between reconnection attempts,
+	// so that a restarted daemon is picked up promptly.
+	maxReconnectDelay = 10 * time.Second
 
 	maxMsgRecvSize = grpc.MaxCallRecvMsgSize(1 * 1024 * 1024 * 50)
 )
 
-// InitializeConnection establishes a connection with a Lightning daemon.
+// InitializeConnection creates a connection with a Lightning daemon.
+// The connection is established in the background, and is re-established
+// whenever it is lost, so the daemon need not be available.
 func InitializeConnection(cfg Credentials) (*grpc.ClientConn, error) {
+	reconnectBackoff := backoff.DefaultConfig
+	reconnectBackoff.MaxDelay = maxReconnectDelay
+
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(cfg.TLSCreds),
-		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(maxMsgRecvSize),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           reconnectBackoff,
+			MinConnectTimeout: time.Duration(dialTimeoutSeconds) * time.Second,
+		}),
 	}
 
 	if cfg.RPCCreds != nil {
 		opts = append(opts, grpc.WithPerRPCCredentials(cfg.RPCCreds))
 	}
 
-	// Set a timeout for the blocking connection call
-	dialCtx, cancel := context.WithTimeout(context.Background(),
-		time.Duration(dialTimeoutSeconds)*time.Second)
-	defer cancel()
-
-	conn, err := grpc.DialContext(dialCtx, cfg.RPCAddress, opts...)
+	conn, err := grpc.Dial(cfg.RPCAddress, opts...)
 	if err != nil {
 		return nil, err
 	}
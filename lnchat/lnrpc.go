@@ -17,7 +17,6 @@ func addressStrToBytes(addr string) ([]byte, error) {
 	return addrV[:], nil
 }
 
-//nolint:deadcode // Useful for rpc calls expecting pubkey bytes.
 func addressBytesToStr(addr []byte) (string, error) {
 	addrV, err := route.NewVertexFromBytes(addr)
 	if err != nil {
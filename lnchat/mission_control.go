@@ -0,0 +1,126 @@
+package lnchat
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/pkg/errors"
+)
+
+// PairHistory represents the outcomes of past payment attempts
+// between a pair of nodes, as recorded by the underlying node.
+type PairHistory struct {
+	// The address of the node the pair starts from.
+	NodeFrom string
+	// The address of the node the pair ends at.
+	NodeTo string
+	// The time of the last failure (in seconds since Unix epoch).
+	// A value of 0 signifies that no failure was recorded.
+	FailTimeSec int64
+	// The smallest amount that failed to be forwarded
+	// at the time of the last failure (in millisatoshi).
+	FailAmtMsat int64
+	// The time of the last success (in seconds since Unix epoch).
+	// A value of 0 signifies that no success was recorded.
+	SuccessTimeSec int64
+	// The largest amount successfully forwarded
+	// at the time of the last success (in millisatoshi).
+	SuccessAmtMsat int64
+}
+
+func newPairHistory(from, to []byte, data *routerrpc.PairData) (PairHistory, error) {
+	nodeFrom, err := addressBytesToStr(from)
+	if err != nil {
+		return PairHistory{}, err
+	}
+	nodeTo, err := addressBytesToStr(to)
+	if err != nil {
+		return PairHistory{}, err
+	}
+
+	return PairHistory{
+		NodeFrom:       nodeFrom,
+		NodeTo:         nodeTo,
+		FailTimeSec:    data.GetFailTime(),
+		FailAmtMsat:    data.GetFailAmtMsat(),
+		SuccessTimeSec: data.GetSuccessTime(),
+		SuccessAmtMsat: data.GetSuccessAmtMsat(),
+	}, nil
+}
+
+// QueryMissionControl returns the payment attempt history
+// recorded by the underlying node for all node pairs.
+func (m *manager) QueryMissionControl(ctx context.Context) ([]PairHistory, error) {
+	resp, err := m.routeClient.QueryMissionControl(ctx,
+		&routerrpc.QueryMissionControlRequest{})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"mission control query failed")
+	}
+
+	pairs := make([]PairHistory, len(resp.GetPairs()))
+	for i, p := range resp.GetPairs() {
+		if pairs[i], err = newPairHistory(p.GetNodeFrom(),
+			p.GetNodeTo(), p.GetHistory()); err != nil {
+
+			return nil, err
+		}
+	}
+
+	return pairs, nil
+}
+
+// QueryProbability returns the estimated probability that the underlying node
+// can forward the provided amount from a node to another,
+// along with the payment attempt history of the pair.
+func (m *manager) QueryProbability(ctx context.Context,
+	from, to string, amt Amount) (float64, *PairHistory, error) {
+
+	fromNode, err := addressStrToBytes(from)
+	if err != nil {
+		return 0, nil, err
+	}
+	toNode, err := addressStrToBytes(to)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := m.routeClient.QueryProbability(ctx, &routerrpc.QueryProbabilityRequest{
+		FromNode: fromNode,
+		ToNode:   toNode,
+		AmtMsat:  amt.Msat(),
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return 0, nil, terr
+		}
+		return 0, nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"probability query failed")
+	}
+
+	history, err := newPairHistory(fromNode, toNode, resp.GetHistory())
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return resp.GetProbability(), &history, nil
+}
+
+// ResetMissionControl clears the payment attempt history
+// recorded by the underlying node.
+func (m *manager) ResetMissionControl(ctx context.Context) error {
+	if _, err := m.routeClient.ResetMissionControl(ctx,
+		&routerrpc.ResetMissionControlRequest{}); err != nil {
+
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"mission control reset failed")
+	}
+
+	return nil
+}
@@ -15,6 +15,20 @@ type LightManager struct {
 	mock.Mock
 }
 
+// CancelInvoice provides a mock function with given fields: ctx, payHash
+func (_m *LightManager) CancelInvoice(ctx context.Context, payHash string) error {
+	ret := _m.Called(ctx, payHash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, payHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *LightManager) Close() error {
 	ret := _m.Called()
@@ -29,6 +43,29 @@ func (_m *LightManager) Close() error {
 	return r0
 }
 
+// CloseChannel provides a mock function with given fields: ctx, chanPoint, force, txOpts
+func (_m *LightManager) CloseChannel(ctx context.Context, chanPoint lnchat.ChannelPoint, force bool, txOpts lnchat.TxFeeOptions) (<-chan lnchat.ChannelCloseUpdate, error) {
+	ret := _m.Called(ctx, chanPoint, force, txOpts)
+
+	var r0 <-chan lnchat.ChannelCloseUpdate
+	if rf, ok := ret.Get(0).(func(context.Context, lnchat.ChannelPoint, bool, lnchat.TxFeeOptions) <-chan lnchat.ChannelCloseUpdate); ok {
+		r0 = rf(ctx, chanPoint, force, txOpts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.ChannelCloseUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, lnchat.ChannelPoint, bool, lnchat.TxFeeOptions) error); ok {
+		r1 = rf(ctx, chanPoint, force, txOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConnectNode provides a mock function with given fields: ctx, address, hostport
 func (_m *LightManager) ConnectNode(ctx context.Context, address string, hostport string) error {
 	ret := _m.Called(ctx, address, hostport)
@@ -43,6 +80,29 @@ func (_m *LightManager) ConnectNode(ctx context.Context, address string, hostpor
 	return r0
 }
 
+// CreateHoldInvoice provides a mock function with given fields: ctx, memo, payHash, amt, expiry, privateHints
+func (_m *LightManager) CreateHoldInvoice(ctx context.Context, memo string, payHash string, amt lnchat.Amount, expiry int64, privateHints bool) (*lnchat.Invoice, error) {
+	ret := _m.Called(ctx, memo, payHash, amt, expiry, privateHints)
+
+	var r0 *lnchat.Invoice
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, lnchat.Amount, int64, bool) *lnchat.Invoice); ok {
+		r0 = rf(ctx, memo, payHash, amt, expiry, privateHints)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lnchat.Invoice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, lnchat.Amount, int64, bool) error); ok {
+		r1 = rf(ctx, memo, payHash, amt, expiry, privateHints)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateInvoice provides a mock function with given fields: ctx, memo, amt, expiry, privateHints
 func (_m *LightManager) CreateInvoice(ctx context.Context, memo string, amt lnchat.Amount, expiry int64, privateHints bool) (*lnchat.Invoice, error) {
 	ret := _m.Called(ctx, memo, amt, expiry, privateHints)
@@ -89,6 +149,126 @@ func (_m *LightManager) DecodePayReq(ctx context.Context, payReq string) (*lncha
 	return r0, r1
 }
 
+// DisconnectPeer provides a mock function with given fields: ctx, address
+func (_m *LightManager) DisconnectPeer(ctx context.Context, address string) error {
+	ret := _m.Called(ctx, address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EstimateFee provides a mock function with given fields: ctx, address, amtSat, targetConfBlock
+func (_m *LightManager) EstimateFee(ctx context.Context, address string, amtSat int64, targetConfBlock uint32) (*lnchat.FeeEstimate, error) {
+	ret := _m.Called(ctx, address, amtSat, targetConfBlock)
+
+	var r0 *lnchat.FeeEstimate
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, uint32) *lnchat.FeeEstimate); ok {
+		r0 = rf(ctx, address, amtSat, targetConfBlock)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lnchat.FeeEstimate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, uint32) error); ok {
+		r1 = rf(ctx, address, amtSat, targetConfBlock)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForwardingHistory provides a mock function with given fields: ctx, startTimeSec, endTimeSec, indexOffset, maxEvents
+func (_m *LightManager) ForwardingHistory(ctx context.Context, startTimeSec int64, endTimeSec int64, indexOffset uint64, maxEvents uint32) ([]lnchat.ForwardingEvent, error) {
+	ret := _m.Called(ctx, startTimeSec, endTimeSec, indexOffset, maxEvents)
+
+	var r0 []lnchat.ForwardingEvent
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, uint64, uint32) []lnchat.ForwardingEvent); ok {
+		r0 = rf(ctx, startTimeSec, endTimeSec, indexOffset, maxEvents)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.ForwardingEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, uint64, uint32) error); ok {
+		r1 = rf(ctx, startTimeSec, endTimeSec, indexOffset, maxEvents)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChannelPolicy provides a mock function with given fields: ctx, chanPoint
+func (_m *LightManager) GetChannelPolicy(ctx context.Context, chanPoint lnchat.ChannelPoint) (*lnchat.ChannelPolicy, error) {
+	ret := _m.Called(ctx, chanPoint)
+
+	var r0 *lnchat.ChannelPolicy
+	if rf, ok := ret.Get(0).(func(context.Context, lnchat.ChannelPoint) *lnchat.ChannelPolicy); ok {
+		r0 = rf(ctx, chanPoint)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lnchat.ChannelPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, lnchat.ChannelPoint) error); ok {
+		r1 = rf(ctx, chanPoint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConnectionState provides a mock function with given fields:
+func (_m *LightManager) GetConnectionState() lnchat.ConnectionState {
+	ret := _m.Called()
+
+	var r0 lnchat.ConnectionState
+	if rf, ok := ret.Get(0).(func() lnchat.ConnectionState); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(lnchat.ConnectionState)
+	}
+
+	return r0
+}
+
+// GetNodeInfo provides a mock function with given fields: ctx, address
+func (_m *LightManager) GetNodeInfo(ctx context.Context, address string) (*lnchat.NodeInfo, error) {
+	ret := _m.Called(ctx, address)
+
+	var r0 *lnchat.NodeInfo
+	if rf, ok := ret.Get(0).(func(context.Context, string) *lnchat.NodeInfo); ok {
+		r0 = rf(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lnchat.NodeInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRoute provides a mock function with given fields: ctx, recipient, amt, payReq, payOpts, payload
 func (_m *LightManager) GetRoute(ctx context.Context, recipient string, amt lnchat.Amount, payReq string, payOpts lnchat.PaymentOptions, payload map[uint64][]byte) (*lnchat.Route, float64, error) {
 	ret := _m.Called(ctx, recipient, amt, payReq, payOpts, payload)
@@ -163,6 +343,52 @@ func (_m *LightManager) GetSelfInfo(ctx context.Context) (lnchat.SelfInfo, error
 	return r0, r1
 }
 
+// ListChannels provides a mock function with given fields: ctx, filter
+func (_m *LightManager) ListChannels(ctx context.Context, filter lnchat.ChannelFilter) ([]lnchat.Channel, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []lnchat.Channel
+	if rf, ok := ret.Get(0).(func(context.Context, lnchat.ChannelFilter) []lnchat.Channel); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.Channel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, lnchat.ChannelFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInvoices provides a mock function with given fields: ctx, indexOffset, maxInvoices
+func (_m *LightManager) ListInvoices(ctx context.Context, indexOffset uint64, maxInvoices uint64) ([]*lnchat.Invoice, error) {
+	ret := _m.Called(ctx, indexOffset, maxInvoices)
+
+	var r0 []*lnchat.Invoice
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []*lnchat.Invoice); ok {
+		r0 = rf(ctx, indexOffset, maxInvoices)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*lnchat.Invoice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, indexOffset, maxInvoices)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListNodes provides a mock function with given fields: ctx
 func (_m *LightManager) ListNodes(ctx context.Context) ([]lnchat.LightningNode, error) {
 	ret := _m.Called(ctx)
@@ -186,6 +412,121 @@ func (_m *LightManager) ListNodes(ctx context.Context) ([]lnchat.LightningNode,
 	return r0, r1
 }
 
+// ListPayments provides a mock function with given fields: ctx, indexOffset, maxPayments
+func (_m *LightManager) ListPayments(ctx context.Context, indexOffset uint64, maxPayments uint64) ([]*lnchat.Payment, error) {
+	ret := _m.Called(ctx, indexOffset, maxPayments)
+
+	var r0 []*lnchat.Payment
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []*lnchat.Payment); ok {
+		r0 = rf(ctx, indexOffset, maxPayments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*lnchat.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, indexOffset, maxPayments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPeers provides a mock function with given fields: ctx
+func (_m *LightManager) ListPeers(ctx context.Context) ([]lnchat.Peer, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []lnchat.Peer
+	if rf, ok := ret.Get(0).(func(context.Context) []lnchat.Peer); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.Peer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPendingChannels provides a mock function with given fields: ctx
+func (_m *LightManager) ListPendingChannels(ctx context.Context) ([]lnchat.PendingChannel, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []lnchat.PendingChannel
+	if rf, ok := ret.Get(0).(func(context.Context) []lnchat.PendingChannel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.PendingChannel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTransactions provides a mock function with given fields: ctx, startHeight, endHeight
+func (_m *LightManager) ListTransactions(ctx context.Context, startHeight int32, endHeight int32) ([]lnchat.Transaction, error) {
+	ret := _m.Called(ctx, startHeight, endHeight)
+
+	var r0 []lnchat.Transaction
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) []lnchat.Transaction); ok {
+		r0 = rf(ctx, startHeight, endHeight)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.Transaction)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
+		r1 = rf(ctx, startHeight, endHeight)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListUnspent provides a mock function with given fields: ctx, minConfs, maxConfs
+func (_m *LightManager) ListUnspent(ctx context.Context, minConfs int32, maxConfs int32) ([]lnchat.Utxo, error) {
+	ret := _m.Called(ctx, minConfs, maxConfs)
+
+	var r0 []lnchat.Utxo
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int32) []lnchat.Utxo); ok {
+		r0 = rf(ctx, minConfs, maxConfs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.Utxo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int32) error); ok {
+		r1 = rf(ctx, minConfs, maxConfs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LookupInvoice provides a mock function with given fields: ctx, payHash
 func (_m *LightManager) LookupInvoice(ctx context.Context, payHash string) (*lnchat.Invoice, error) {
 	ret := _m.Called(ctx, payHash)
@@ -209,6 +550,27 @@ func (_m *LightManager) LookupInvoice(ctx context.Context, payHash string) (*lnc
 	return r0, r1
 }
 
+// NewAddress provides a mock function with given fields: ctx, addrType
+func (_m *LightManager) NewAddress(ctx context.Context, addrType lnchat.AddressType) (string, error) {
+	ret := _m.Called(ctx, addrType)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, lnchat.AddressType) string); ok {
+		r0 = rf(ctx, addrType)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, lnchat.AddressType) error); ok {
+		r1 = rf(ctx, addrType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // OpenChannel provides a mock function with given fields: ctx, address, private, amtMsat, pushAmtMsat, minOpenConfirmations, txOpts
 func (_m *LightManager) OpenChannel(ctx context.Context, address string, private bool, amtMsat uint64, pushAmtMsat uint64, minOpenConfirmations int32, txOpts lnchat.TxFeeOptions) (*lnchat.ChannelPoint, error) {
 	ret := _m.Called(ctx, address, private, amtMsat, pushAmtMsat, minOpenConfirmations, txOpts)
@@ -232,6 +594,108 @@ func (_m *LightManager) OpenChannel(ctx context.Context, address string, private
 	return r0, r1
 }
 
+// QueryMissionControl provides a mock function with given fields: ctx
+func (_m *LightManager) QueryMissionControl(ctx context.Context) ([]lnchat.PairHistory, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []lnchat.PairHistory
+	if rf, ok := ret.Get(0).(func(context.Context) []lnchat.PairHistory); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.PairHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryProbability provides a mock function with given fields: ctx, from, to, amt
+func (_m *LightManager) QueryProbability(ctx context.Context, from string, to string, amt lnchat.Amount) (float64, *lnchat.PairHistory, error) {
+	ret := _m.Called(ctx, from, to, amt)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, lnchat.Amount) float64); ok {
+		r0 = rf(ctx, from, to, amt)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 *lnchat.PairHistory
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, lnchat.Amount) *lnchat.PairHistory); ok {
+		r1 = rf(ctx, from, to, amt)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*lnchat.PairHistory)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, lnchat.Amount) error); ok {
+		r2 = rf(ctx, from, to, amt)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ResetMissionControl provides a mock function with given fields: ctx
+func (_m *LightManager) ResetMissionControl(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendCoins provides a mock function with given fields: ctx, address, amtSat, sendAll, txOpts
+func (_m *LightManager) SendCoins(ctx context.Context, address string, amtSat int64, sendAll bool, txOpts lnchat.TxFeeOptions) (string, error) {
+	ret := _m.Called(ctx, address, amtSat, sendAll, txOpts)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, bool, lnchat.TxFeeOptions) string); ok {
+		r0 = rf(ctx, address, amtSat, sendAll, txOpts)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, bool, lnchat.TxFeeOptions) error); ok {
+		r1 = rf(ctx, address, amtSat, sendAll, txOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendCustomMessage provides a mock function with given fields: ctx, peer, records
+func (_m *LightManager) SendCustomMessage(ctx context.Context, peer string, records map[uint64][]byte) error {
+	ret := _m.Called(ctx, peer, records)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[uint64][]byte) error); ok {
+		r0 = rf(ctx, peer, records)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendPayment provides a mock function with given fields: ctx, recipient, amt, payReq, payOpts, payload, filter
 func (_m *LightManager) SendPayment(ctx context.Context, recipient string, amt lnchat.Amount, payReq string, payOpts lnchat.PaymentOptions, payload map[uint64][]byte, filter func(*lnchat.Payment) bool) (<-chan lnchat.PaymentUpdate, error) {
 	ret := _m.Called(ctx, recipient, amt, payReq, payOpts, payload, filter)
@@ -255,6 +719,43 @@ func (_m *LightManager) SendPayment(ctx context.Context, recipient string, amt l
 	return r0, r1
 }
 
+// SendToRoute provides a mock function with given fields: ctx, route, payload
+func (_m *LightManager) SendToRoute(ctx context.Context, route *lnchat.Route, payload map[uint64][]byte) (*lnchat.Payment, error) {
+	ret := _m.Called(ctx, route, payload)
+
+	var r0 *lnchat.Payment
+	if rf, ok := ret.Get(0).(func(context.Context, *lnchat.Route, map[uint64][]byte) *lnchat.Payment); ok {
+		r0 = rf(ctx, route, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lnchat.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *lnchat.Route, map[uint64][]byte) error); ok {
+		r1 = rf(ctx, route, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SettleInvoice provides a mock function with given fields: ctx, preimage
+func (_m *LightManager) SettleInvoice(ctx context.Context, preimage string) error {
+	ret := _m.Called(ctx, preimage)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, preimage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SignMessage provides a mock function with given fields: ctx, message
 func (_m *LightManager) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
 	ret := _m.Called(ctx, message)
@@ -278,6 +779,75 @@ func (_m *LightManager) SignMessage(ctx context.Context, message []byte) ([]byte
 	return r0, r1
 }
 
+// SubscribeChannelEvents provides a mock function with given fields: ctx
+func (_m *LightManager) SubscribeChannelEvents(ctx context.Context) (<-chan lnchat.ChannelEventUpdate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan lnchat.ChannelEventUpdate
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan lnchat.ChannelEventUpdate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.ChannelEventUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeConnectionState provides a mock function with given fields: ctx
+func (_m *LightManager) SubscribeConnectionState(ctx context.Context) (<-chan lnchat.ConnectionState, error) {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan lnchat.ConnectionState
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan lnchat.ConnectionState); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.ConnectionState)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscribeCustomMessages provides a mock function with given fields: ctx
+func (_m *LightManager) SubscribeCustomMessages(ctx context.Context) (<-chan lnchat.CustomMessageUpdate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan lnchat.CustomMessageUpdate
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan lnchat.CustomMessageUpdate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.CustomMessageUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubscribeInvoiceUpdates provides a mock function with given fields: ctx, startIdx, filter
 func (_m *LightManager) SubscribeInvoiceUpdates(ctx context.Context, startIdx uint64, filter func(*lnchat.Invoice) bool) (<-chan lnchat.InvoiceUpdate, error) {
 	ret := _m.Called(ctx, startIdx, filter)
@@ -324,6 +894,43 @@ func (_m *LightManager) SubscribePaymentUpdates(ctx context.Context, startIdx ui
 	return r0, r1
 }
 
+// SubscribePeerEvents provides a mock function with given fields: ctx
+func (_m *LightManager) SubscribePeerEvents(ctx context.Context) (<-chan lnchat.PeerEventUpdate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan lnchat.PeerEventUpdate
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan lnchat.PeerEventUpdate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.PeerEventUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateChannelPolicy provides a mock function with given fields: ctx, chanPoint, policy
+func (_m *LightManager) UpdateChannelPolicy(ctx context.Context, chanPoint *lnchat.ChannelPoint, policy lnchat.ChannelPolicy) error {
+	ret := _m.Called(ctx, chanPoint, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *lnchat.ChannelPoint, lnchat.ChannelPolicy) error); ok {
+		r0 = rf(ctx, chanPoint, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // VerifySignatureExtractPubkey provides a mock function with given fields: ctx, message, signature
 func (_m *LightManager) VerifySignatureExtractPubkey(ctx context.Context, message []byte, signature []byte) (string, error) {
 	ret := _m.Called(ctx, message, signature)
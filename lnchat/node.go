@@ -0,0 +1,98 @@
+package lnchat
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// NodeInfo represents the details of a Lightning network node,
+// as advertised to the network graph.
+type NodeInfo struct {
+	// The node alias and address.
+	Node LightningNode
+	// The network addresses advertised by the node.
+	Addresses []NodeAddress
+	// The features advertised by the node, ordered by feature bit.
+	Features []Feature
+	// The time of the last node announcement.
+	LastUpdate time.Time
+	// The color advertised by the node, in hex format.
+	Color string
+	// The number of channels of the node.
+	NumChannels uint32
+	// The total capacity of the node channels (in millisatoshi).
+	TotalCapacityMsat int64
+	// Whether the underlying node has an open channel with the node.
+	HasChannel bool
+}
+
+// NodeAddress represents a network address of a node.
+type NodeAddress struct {
+	// The network of the address (e.g. tcp).
+	Network string
+	// The address (host:port).
+	Addr string
+}
+
+// Feature represents a feature advertised by a node.
+type Feature struct {
+	// The feature bit.
+	Bit uint32
+	// The feature name.
+	Name string
+	// Whether the feature is required.
+	IsRequired bool
+	// Whether the feature is known by the underlying node.
+	IsKnown bool
+}
+
+// unmarshalFeatures creates a list of features ordered by feature bit.
+func unmarshalFeatures(fs map[uint32]*lnrpc.Feature) []Feature {
+	features := make([]Feature, 0, len(fs))
+	for bit, f := range fs {
+		features = append(features, Feature{
+			Bit:        bit,
+			Name:       f.GetName(),
+			IsRequired: f.GetIsRequired(),
+			IsKnown:    f.GetIsKnown(),
+		})
+	}
+	sort.Slice(features, func(i, j int) bool {
+		return features[i].Bit < features[j].Bit
+	})
+
+	return features
+}
+
+// unmarshalNodeInfo creates an lnchat.NodeInfo from an lnrpc.NodeInfo.
+func unmarshalNodeInfo(info *lnrpc.NodeInfo) NodeInfo {
+	node := info.GetNode()
+
+	addrs := make([]NodeAddress, len(node.GetAddresses()))
+	for i, a := range node.GetAddresses() {
+		addrs[i] = NodeAddress{
+			Network: a.GetNetwork(),
+			Addr:    a.GetAddr(),
+		}
+	}
+
+	var lastUpdate time.Time
+	if node.GetLastUpdate() != 0 {
+		lastUpdate = time.Unix(int64(node.GetLastUpdate()), 0)
+	}
+
+	return NodeInfo{
+		Node: LightningNode{
+			Alias:   node.GetAlias(),
+			Address: node.GetPubKey(),
+		},
+		Addresses:         addrs,
+		Features:          unmarshalFeatures(node.GetFeatures()),
+		LastUpdate:        lastUpdate,
+		Color:             node.GetColor(),
+		NumChannels:       info.GetNumChannels(),
+		TotalCapacityMsat: info.GetTotalCapacity() * 1000,
+	}
+}
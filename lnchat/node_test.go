@@ -0,0 +1,49 @@
+package lnchat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalNodeInfo(t *testing.T) {
+	rpcInfo := &lnrpc.NodeInfo{
+		Node: &lnrpc.LightningNode{
+			LastUpdate: 1600000000,
+			PubKey:     "000000000000000000000000000000000000000000000000000000000000000000",
+			Alias:      "alias",
+			Addresses: []*lnrpc.NodeAddress{
+				{Network: "tcp", Addr: "127.0.0.1:9735"},
+			},
+			Color: "#3399ff",
+			Features: map[uint32]*lnrpc.Feature{
+				9: {Name: "tlv-onion", IsKnown: true},
+				0: {Name: "data-loss-protect", IsRequired: true, IsKnown: true},
+			},
+		},
+		NumChannels:   2,
+		TotalCapacity: 300000,
+	}
+
+	expected := NodeInfo{
+		Node: LightningNode{
+			Alias:   "alias",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+		Addresses: []NodeAddress{
+			{Network: "tcp", Addr: "127.0.0.1:9735"},
+		},
+		Features: []Feature{
+			{Bit: 0, Name: "data-loss-protect", IsRequired: true, IsKnown: true},
+			{Bit: 9, Name: "tlv-onion", IsKnown: true},
+		},
+		LastUpdate:        time.Unix(1600000000, 0),
+		Color:             "#3399ff",
+		NumChannels:       2,
+		TotalCapacityMsat: 300000000,
+	}
+
+	assert.Equal(t, expected, unmarshalNodeInfo(rpcInfo))
+}
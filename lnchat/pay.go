@@ -27,12 +27,31 @@ func createSendPaymentRequest(dest []byte, amtMsat int64, payReq string,
 	customRecords map[uint64][]byte, options PaymentOptions) (
 	*routerrpc.SendPaymentRequest, error) {
 
+	if options.requiresRouteQuery() {
+		return nil, newErrorf(ErrNotSupported, "ignored nodes, ignored channels "+
+			"and success probability limits in payment requests")
+	}
+
 	var finalCltvDelta int32
 	var preimage, paymentHash []byte
 
+	destFeatures := []lnrpc.FeatureBit{
+		lnrpc.FeatureBit_TLV_ONION_OPT,
+	}
+
 	// If a payment request is not provided, the preimage must
-	// be set in the custom records (spontaneous payment).
-	if payReq == "" {
+	// be set in the custom records (spontaneous payment),
+	// unless the payment is an AMP payment, in which case
+	// the preimage is derived by the recipient.
+	switch {
+	case payReq == "" && options.AMP:
+		destFeatures = append(destFeatures,
+			lnrpc.FeatureBit_PAYMENT_ADDR_OPT,
+			lnrpc.FeatureBit_AMP_OPT,
+		)
+
+		finalCltvDelta = options.FinalCltvDelta
+	case payReq == "":
 		preimg, err := generatePreimage()
 		if err != nil {
 			return nil, err
@@ -46,16 +65,26 @@ func createSendPaymentRequest(dest []byte, amtMsat int64, payReq string,
 	}
 
 	request := &routerrpc.SendPaymentRequest{
-		Dest:           dest,
-		AmtMsat:        amtMsat,
-		PaymentRequest: payReq,
-		PaymentHash:    paymentHash,
-		FinalCltvDelta: finalCltvDelta,
-		FeeLimitMsat:   options.FeeLimitMsat,
-		TimeoutSeconds: options.TimeoutSecs,
-		DestFeatures: []lnrpc.FeatureBit{
-			lnrpc.FeatureBit_TLV_ONION_OPT,
-		},
+		Dest:             dest,
+		AmtMsat:          amtMsat,
+		PaymentRequest:   payReq,
+		PaymentHash:      paymentHash,
+		FinalCltvDelta:   finalCltvDelta,
+		FeeLimitMsat:     options.FeeLimitMsat,
+		TimeoutSeconds:   options.TimeoutSecs,
+		MaxParts:         options.MaxShards,
+		MaxShardSizeMsat: options.MaxShardSizeMsat,
+		Amp:              payReq == "" && options.AMP,
+		DestFeatures:     destFeatures,
+		OutgoingChanIds:  options.OutgoingChanIDs,
+		CltvLimit:        int32(options.CltvLimit),
+	}
+	if options.LastHopAddress != "" {
+		lastHop, err := addressStrToBytes(options.LastHopAddress)
+		if err != nil {
+			return nil, err
+		}
+		request.LastHopPubkey = lastHop
 	}
 
 	records := copyCustomRecords(customRecords, preimage)
@@ -0,0 +1,454 @@
+package lnchat
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const testLastHop = "02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5"
+
+func TestCreateSendPaymentRequest(t *testing.T) {
+	dest := make([]byte, 33)
+	records := map[uint64][]byte{
+		65537: []byte("payload"),
+	}
+
+	opts := PaymentOptions{
+		FeeLimitMsat:     1000,
+		FinalCltvDelta:   20,
+		TimeoutSecs:      30,
+		MaxShards:        8,
+		MaxShardSizeMsat: 50000,
+	}
+
+	t.Run("Keysend", func(t *testing.T) {
+		req, err := createSendPaymentRequest(dest, 100000, "", records, opts)
+		require.NoError(t, err)
+
+		assert.False(t, req.Amp)
+		assert.Len(t, req.PaymentHash, 32)
+		assert.Contains(t, req.DestCustomRecords, uint64(record.KeySendType))
+		assert.Equal(t, []byte("payload"), req.DestCustomRecords[65537])
+		assert.Equal(t, uint32(8), req.MaxParts)
+		assert.Equal(t, uint64(50000), req.MaxShardSizeMsat)
+		assert.Equal(t, []lnrpc.FeatureBit{
+			lnrpc.FeatureBit_TLV_ONION_OPT,
+		}, req.DestFeatures)
+	})
+
+	t.Run("AMP", func(t *testing.T) {
+		ampOpts := opts
+		ampOpts.AMP = true
+
+		req, err := createSendPaymentRequest(dest, 100000, "", records, ampOpts)
+		require.NoError(t, err)
+
+		assert.True(t, req.Amp)
+		assert.Empty(t, req.PaymentHash)
+		assert.NotContains(t, req.DestCustomRecords, uint64(record.KeySendType))
+		assert.Equal(t, []byte("payload"), req.DestCustomRecords[65537])
+		assert.Equal(t, int32(20), req.FinalCltvDelta)
+		assert.Equal(t, []lnrpc.FeatureBit{
+			lnrpc.FeatureBit_TLV_ONION_OPT,
+			lnrpc.FeatureBit_PAYMENT_ADDR_OPT,
+			lnrpc.FeatureBit_AMP_OPT,
+		}, req.DestFeatures)
+	})
+
+	t.Run("Payment request", func(t *testing.T) {
+		ampOpts := opts
+		ampOpts.AMP = true
+
+		req, err := createSendPaymentRequest(dest, 0, "lnbc1", records, ampOpts)
+		require.NoError(t, err)
+
+		assert.False(t, req.Amp)
+		assert.Empty(t, req.PaymentHash)
+		assert.Zero(t, req.FinalCltvDelta)
+		assert.Equal(t, "lnbc1", req.PaymentRequest)
+		assert.Equal(t, uint32(8), req.MaxParts)
+	})
+
+	t.Run("Route restrictions", func(t *testing.T) {
+		restrictedOpts := opts
+		restrictedOpts.OutgoingChanIDs = []uint64{1, 2}
+		restrictedOpts.LastHopAddress = testLastHop
+		restrictedOpts.CltvLimit = 200
+
+		req, err := createSendPaymentRequest(dest, 100000, "", records, restrictedOpts)
+		require.NoError(t, err)
+
+		assert.Equal(t, []uint64{1, 2}, req.OutgoingChanIds)
+		assert.Equal(t, testLastHop, hex.EncodeToString(req.LastHopPubkey))
+		assert.Equal(t, int32(200), req.CltvLimit)
+
+		restrictedOpts.IgnoredNodes = []string{testLastHop}
+		_, err = createSendPaymentRequest(dest, 100000, "", records, restrictedOpts)
+		assert.ErrorIs(t, err, ErrNotSupported)
+	})
+}
+
+func TestCreateQueryRoutesRequest(t *testing.T) {
+	dest := "000000000000000000000000000000000000000000000000000000000000000000"
+
+	opts := PaymentOptions{
+		FeeLimitMsat:    1000,
+		FinalCltvDelta:  20,
+		OutgoingChanIDs: []uint64{1},
+		LastHopAddress:  testLastHop,
+		IgnoredNodes:    []string{testLastHop},
+		IgnoredChannels: []uint64{2},
+		CltvLimit:       200,
+	}
+
+	req, err := createQueryRoutesRequest(dest, 100000, nil, opts, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(1), req.OutgoingChanId)
+	assert.Equal(t, testLastHop, hex.EncodeToString(req.LastHopPubkey))
+	require.Len(t, req.IgnoredNodes, 1)
+	assert.Equal(t, testLastHop, hex.EncodeToString(req.IgnoredNodes[0]))
+	assert.Equal(t, []*lnrpc.EdgeLocator{
+		{ChannelId: 2},
+		{ChannelId: 2, DirectionReverse: true},
+	}, req.IgnoredEdges)
+	assert.Equal(t, uint32(200), req.CltvLimit)
+
+	opts.OutgoingChanIDs = []uint64{1, 3}
+	_, err = createQueryRoutesRequest(dest, 100000, nil, opts, nil)
+	assert.ErrorIs(t, err, ErrNotSupported)
+
+	opts.OutgoingChanIDs = nil
+	opts.IgnoredNodes = []string{"invalid"}
+	_, err = createQueryRoutesRequest(dest, 100000, nil, opts, nil)
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+}
+
+func TestUnmarshalAMPInvoice(t *testing.T) {
+	setA, setB := make([]byte, 32), make([]byte, 32)
+	setA[0], setB[0] = 0x0a, 0x0b
+
+	rpcInv := &lnrpc.Invoice{
+		RHash: make([]byte, 32),
+		State: lnrpc.Invoice_SETTLED,
+		IsAmp: true,
+		AmpInvoiceState: map[string]*lnrpc.AMPInvoiceState{
+			"0a00000000000000000000000000000000000000000000000000000000000000": {
+				State:       lnrpc.InvoiceHTLCState_SETTLED,
+				SettleIndex: 4,
+				SettleTime:  1600000000,
+				AmtPaidMsat: 3000,
+			},
+			"0b00000000000000000000000000000000000000000000000000000000000000": {
+				State:       lnrpc.InvoiceHTLCState_SETTLED,
+				SettleIndex: 7,
+				SettleTime:  1600000100,
+				AmtPaidMsat: 5000,
+			},
+		},
+		Htlcs: []*lnrpc.InvoiceHTLC{
+			{
+				AmtMsat: 3000,
+				State:   lnrpc.InvoiceHTLCState_SETTLED,
+				Amp: &lnrpc.AMP{
+					SetId:    setA,
+					Preimage: []byte("preimage a"),
+				},
+			},
+			{
+				AmtMsat: 2000,
+				State:   lnrpc.InvoiceHTLCState_SETTLED,
+				Amp: &lnrpc.AMP{
+					SetId:      setB,
+					ChildIndex: 1,
+					Preimage:   []byte("preimage b1"),
+				},
+			},
+			{
+				AmtMsat: 3000,
+				State:   lnrpc.InvoiceHTLCState_SETTLED,
+				Amp: &lnrpc.AMP{
+					SetId:    setB,
+					Preimage: []byte("preimage b0"),
+				},
+			},
+		},
+	}
+
+	inv, err := unmarshalInvoice(rpcInv)
+	require.NoError(t, err)
+
+	assert.Equal(t, InvoiceSETTLED, inv.State)
+	assert.Equal(t, uint64(7), inv.SettleIndex)
+	assert.Equal(t, int64(1600000100), inv.SettleTimeSec)
+	assert.Equal(t, int64(5000), inv.AmtPaid.Msat())
+	assert.Equal(t, []byte("preimage b0"), inv.Preimage)
+	assert.Len(t, inv.Htlcs, 2)
+}
+
+func TestMarshalRoute(t *testing.T) {
+	hop1, err := NewNodeFromString(
+		"111111111111111111111111111111111111111111111111111111111111111111")
+	require.NoError(t, err)
+	hop2, err := NewNodeFromString(
+		"222222222222222222222222222222222222222222222222222222222222222222")
+	require.NoError(t, err)
+
+	route := &Route{
+		TimeLock: 340,
+		Amt:      NewAmount(1000),
+		Fees:     NewAmount(2),
+		Hops: []RouteHop{
+			{
+				ChannelID:    1,
+				NodeID:       hop1,
+				AmtToForward: NewAmount(1000),
+				Fees:         NewAmount(2),
+				Expiry:       320,
+			},
+			{
+				ChannelID:    2,
+				NodeID:       hop2,
+				AmtToForward: NewAmount(1000),
+				Expiry:       320,
+			},
+		},
+	}
+	records := map[uint64][]byte{
+		65537: []byte("payload"),
+	}
+
+	rpcRoute, err := marshalRoute(route, records)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint32(340), rpcRoute.TotalTimeLock)
+	assert.Equal(t, int64(1002), rpcRoute.TotalAmtMsat)
+	assert.Equal(t, int64(2), rpcRoute.TotalFeesMsat)
+	require.Len(t, rpcRoute.Hops, 2)
+	assert.Equal(t, hop1.String(), rpcRoute.Hops[0].PubKey)
+	assert.Empty(t, rpcRoute.Hops[0].CustomRecords)
+	assert.Equal(t, records, rpcRoute.Hops[1].CustomRecords)
+
+	// The route can be recovered from its marshalled form.
+	unmarshalled, err := unmarshalRoute(rpcRoute)
+	require.NoError(t, err)
+	assert.Equal(t, route.Amt, unmarshalled.Amt)
+	assert.Equal(t, route.Fees, unmarshalled.Fees)
+
+	_, err = marshalRoute(&Route{}, records)
+	assert.Error(t, err)
+}
+
+type testRouteQueryClient struct {
+	testGraphClient
+	queries []*lnrpc.QueryRoutesRequest
+	query   func(*lnrpc.QueryRoutesRequest) *lnrpc.QueryRoutesResponse
+}
+
+func (c *testRouteQueryClient) QueryRoutes(_ context.Context, req *lnrpc.QueryRoutesRequest,
+	_ ...grpc.CallOption) (*lnrpc.QueryRoutesResponse, error) {
+
+	c.queries = append(c.queries, req)
+	return c.query(req), nil
+}
+
+type testTrackStream struct {
+	routerrpc.Router_TrackPaymentV2Client
+	payment *lnrpc.Payment
+}
+
+func (s *testTrackStream) Recv() (*lnrpc.Payment, error) {
+	return s.payment, nil
+}
+
+type testSendToRouteClient struct {
+	routerrpc.RouterClient
+	routes   []*lnrpc.Route
+	payments []*lnrpc.Payment
+}
+
+func (c *testSendToRouteClient) SendToRouteV2(_ context.Context, req *routerrpc.SendToRouteRequest,
+	_ ...grpc.CallOption) (*lnrpc.HTLCAttempt, error) {
+
+	c.routes = append(c.routes, req.GetRoute())
+	return &lnrpc.HTLCAttempt{}, nil
+}
+
+func (c *testSendToRouteClient) TrackPaymentV2(context.Context, *routerrpc.TrackPaymentRequest,
+	...grpc.CallOption) (routerrpc.Router_TrackPaymentV2Client, error) {
+
+	payment := c.payments[0]
+	c.payments = c.payments[1:]
+
+	return &testTrackStream{payment: payment}, nil
+}
+
+func testRoute(chanIDs ...uint64) *lnrpc.Route {
+	hops := make([]*lnrpc.Hop, len(chanIDs))
+	for i, chanID := range chanIDs {
+		hops[i] = &lnrpc.Hop{
+			ChanId: chanID,
+			PubKey: "02" + strings.Repeat(hex.EncodeToString([]byte{byte(i)}), 32),
+		}
+	}
+
+	return &lnrpc.Route{
+		TotalAmtMsat:  1000 + int64(len(chanIDs)),
+		TotalFeesMsat: int64(len(chanIDs)),
+		Hops:          hops,
+	}
+}
+
+func newTestRouteManager(dest string,
+	query func(*lnrpc.QueryRoutesRequest) *lnrpc.QueryRoutesResponse) (
+	*manager, *testRouteQueryClient) {
+
+	client := &testRouteQueryClient{
+		testGraphClient: testGraphClient{
+			graph: &lnrpc.ChannelGraph{
+				Nodes: []*lnrpc.LightningNode{
+					{
+						PubKey: dest,
+					},
+				},
+			},
+		},
+		query: query,
+	}
+
+	return &manager{
+		graph:    newGraphCache(),
+		lnClient: client,
+	}, client
+}
+
+func TestGetRouteOutgoingChannels(t *testing.T) {
+	dest := "02" + strings.Repeat("11", 32)
+
+	probs := map[uint64]float64{1: 0.5, 2: 0.9, 3: 0.9}
+	mgr, client := newTestRouteManager(dest,
+		func(req *lnrpc.QueryRoutesRequest) *lnrpc.QueryRoutesResponse {
+			chanID := req.GetOutgoingChanId()
+			// Channel 3 routes are costlier than channel 2 routes.
+			route := testRoute(chanID, 10)
+			if chanID == 3 {
+				route = testRoute(chanID, 10, 11)
+			}
+			return &lnrpc.QueryRoutesResponse{
+				Routes:      []*lnrpc.Route{route},
+				SuccessProb: probs[chanID],
+			}
+		})
+	defer mgr.graph.stop()
+
+	// Each outgoing channel is queried, and the most probable
+	// (then cheapest) route is returned.
+	route, prob, err := mgr.GetRoute(context.Background(), dest, NewAmount(1000), "",
+		PaymentOptions{OutgoingChanIDs: []uint64{1, 2, 3}}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.9, prob)
+	assert.Equal(t, uint64(2), route.Hops[0].ChannelID)
+	assert.Len(t, client.queries, 3)
+}
+
+func TestSendPaymentAlongRoutes(t *testing.T) {
+	dest := "02" + strings.Repeat("11", 32)
+
+	routes := []*lnrpc.Route{testRoute(1, 2), testRoute(3, 4)}
+	mgr, client := newTestRouteManager(dest,
+		func(*lnrpc.QueryRoutesRequest) *lnrpc.QueryRoutesResponse {
+			route := routes[0]
+			routes = routes[1:]
+			return &lnrpc.QueryRoutesResponse{
+				Routes:      []*lnrpc.Route{route},
+				SuccessProb: 0.8,
+			}
+		})
+	defer mgr.graph.stop()
+
+	routeClient := &testSendToRouteClient{
+		payments: []*lnrpc.Payment{
+			{
+				Status: lnrpc.Payment_FAILED,
+				Htlcs: []*lnrpc.HTLCAttempt{
+					{
+						Route: testRoute(1, 2),
+						Failure: &lnrpc.Failure{
+							Code:               lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+							FailureSourceIndex: 1,
+						},
+					},
+				},
+			},
+			{
+				Status: lnrpc.Payment_SUCCEEDED,
+			},
+		},
+	}
+	mgr.routeClient = routeClient
+
+	opts := PaymentOptions{
+		TimeoutSecs:     10,
+		IgnoredChannels: []uint64{9},
+	}
+	updates, err := mgr.SendPayment(context.Background(), dest, NewAmount(1000), "",
+		opts, nil, func(*Payment) bool { return true })
+	require.NoError(t, err)
+
+	update := <-updates
+	require.NoError(t, update.Err)
+	assert.Equal(t, PaymentSUCCEEDED, update.Payment.Status)
+
+	// The failed attempt is retried along a route avoiding the failed channel.
+	require.Len(t, routeClient.routes, 2)
+	assert.Equal(t, uint64(3), routeClient.routes[1].GetHops()[0].GetChanId())
+	require.Len(t, client.queries, 2)
+	assert.Equal(t, []*lnrpc.EdgeLocator{
+		{ChannelId: 9},
+		{ChannelId: 9, DirectionReverse: true},
+		{ChannelId: 2},
+		{ChannelId: 2, DirectionReverse: true},
+	}, client.queries[1].GetIgnoredEdges())
+	assert.Equal(t, []uint64{9}, opts.IgnoredChannels)
+}
+
+func TestFailedChannel(t *testing.T) {
+	route, err := unmarshalRoute(testRoute(1, 2))
+	require.NoError(t, err)
+
+	attempt := func(nodeIndex uint32) *Payment {
+		return &Payment{
+			Status: PaymentFAILED,
+			Htlcs: []HTLCAttempt{
+				{
+					Route:   *route,
+					Failure: &HTLCFailure{NodeIndex: nodeIndex},
+				},
+			},
+		}
+	}
+
+	chanID, ok := failedChannel(attempt(0))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(1), chanID)
+
+	chanID, ok = failedChannel(attempt(1))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), chanID)
+
+	// Failures at the recipient are not retried.
+	_, ok = failedChannel(attempt(2))
+	assert.False(t, ok)
+
+	_, ok = failedChannel(&Payment{Status: PaymentFAILED})
+	assert.False(t, ok)
+}
@@ -35,6 +35,9 @@ type PayReq struct {
 	CltvExpiry uint64
 	// Route hints that can be used for payment.
 	RouteHints []RouteHint
+	// The hash of the invoice description (hex-encoded),
+	// if the invoice commits to one instead of a description.
+	DescriptionHash string
 }
 
 func unmarshalPaymentRequest(payReq *lnrpc.PayReq) (*PayReq, error) {
@@ -52,13 +55,14 @@ func unmarshalPaymentRequest(payReq *lnrpc.PayReq) (*PayReq, error) {
 	}
 
 	return &PayReq{
-		Destination:    node,
-		Hash:           payReq.PaymentHash,
-		Amt:            NewAmount(payReq.NumMsat),
-		CreatedTimeSec: payReq.Timestamp,
-		Expiry:         payReq.Expiry,
-		CltvExpiry:     uint64(payReq.CltvExpiry),
-		RouteHints:     hints,
+		Destination:     node,
+		Hash:            payReq.PaymentHash,
+		Amt:             NewAmount(payReq.NumMsat),
+		CreatedTimeSec:  payReq.Timestamp,
+		Expiry:          payReq.Expiry,
+		CltvExpiry:      uint64(payReq.CltvExpiry),
+		RouteHints:      hints,
+		DescriptionHash: payReq.DescriptionHash,
 	}, nil
 }
 
@@ -98,6 +102,8 @@ type Payment struct {
 	PaymentRequest string
 	// The status of the payment.
 	Status PaymentStatus
+	// The reason of payment failure, set only for failed payments.
+	FailureReason lnrpc.PaymentFailureReason
 	// The payment index of the payment.
 	PaymentIndex uint64
 	// The HTLC attempts made to settle the payment.
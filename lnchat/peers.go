@@ -1,6 +1,147 @@
 package lnchat
 
-import "strings"
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// Peer represents a node connected to the underlying node.
+type Peer struct {
+	// The Lightning address of the peer.
+	Address string
+	// The network address (host:port) of the peer connection.
+	Host string
+	// Whether the connection was initiated by the peer.
+	Inbound bool
+	// The ping time to the peer (in microseconds).
+	PingTimeMicros int64
+	// The number of bytes sent to the peer.
+	BytesSent uint64
+	// The number of bytes received from the peer.
+	BytesReceived uint64
+	// The features advertised by the peer, ordered by feature bit.
+	Features []Feature
+}
+
+// unmarshalPeer creates an lnchat.Peer from an lnrpc.Peer.
+func unmarshalPeer(p *lnrpc.Peer) Peer {
+	return Peer{
+		Address:        p.PubKey,
+		Host:           p.Address,
+		Inbound:        p.Inbound,
+		PingTimeMicros: p.PingTime,
+		BytesSent:      p.BytesSent,
+		BytesReceived:  p.BytesRecv,
+		Features:       unmarshalFeatures(p.Features),
+	}
+}
+
+// PeerEventType is the type of a peer event.
+type PeerEventType int
+
+const (
+	// PeerONLINE signifies that a peer connection was established.
+	PeerONLINE PeerEventType = iota
+	// PeerOFFLINE signifies that a peer connection was lost.
+	PeerOFFLINE
+)
+
+func (t PeerEventType) String() string {
+	switch t {
+	case PeerONLINE:
+		return "ONLINE"
+	case PeerOFFLINE:
+		return "OFFLINE"
+	default:
+		return fmt.Sprintf("%d", t)
+	}
+}
+
+// PeerEvent represents a change in the peer connections of the underlying node.
+type PeerEvent struct {
+	// The Lightning address of the peer.
+	Address string
+	// The type of the event.
+	Type PeerEventType
+}
+
+// PeerEventUpdate represents a peer event update,
+// as returned by SubscribePeerEvents.
+type PeerEventUpdate struct {
+	Event *PeerEvent
+	Err   error
+}
+
+// unmarshalPeerEvent creates an lnchat.PeerEvent from an lnrpc.PeerEvent.
+func unmarshalPeerEvent(e *lnrpc.PeerEvent) (*PeerEvent, error) {
+	event := &PeerEvent{
+		Address: e.GetPubKey(),
+	}
+
+	switch e.GetType() {
+	case lnrpc.PeerEvent_PEER_ONLINE:
+		event.Type = PeerONLINE
+	case lnrpc.PeerEvent_PEER_OFFLINE:
+		event.Type = PeerOFFLINE
+	default:
+		return nil, fmt.Errorf("unknown peer event type %v", e.GetType())
+	}
+
+	return event, nil
+}
+
+// SubscribePeerEvents creates and returns a channel over which
+// the peer connection changes of the underlying node are received.
+func (m *manager) SubscribePeerEvents(ctx context.Context) (<-chan PeerEventUpdate, error) {
+	stream, err := m.lnClient.SubscribePeerEvents(ctx, &lnrpc.PeerEventSubscription{})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	updateCh := make(chan PeerEventUpdate)
+
+	// Write updates to the returned channel asynchronously.
+	go func() {
+		defer close(updateCh)
+
+		for {
+			var update PeerEventUpdate
+
+			rpcEvent, err := stream.Recv()
+			switch {
+			case err != nil:
+				update.Err = translateCommonRPCErrors(err)
+			default:
+				update.Event, update.Err = unmarshalPeerEvent(rpcEvent)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- update:
+			}
+			if update.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
+
+// isAlreadyConnectedError returns whether the error returned
+// by a peer connection attempt signifies an existing connection.
+// It matches the message of the error lnd returns in that case
+// (errPeerAlreadyConnected in lnd's server.go).
+func isAlreadyConnectedError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "already connected to peer")
+}
 
 // ResolveAlias returns all nodes whose alias contains the provided substring.
 // It returns a list of nodes, since aliases are not guaranteed to be unique or may not exist at all.
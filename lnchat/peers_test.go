@@ -3,7 +3,10 @@ package lnchat
 import (
 	"testing"
 
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestResolveAlias(t *testing.T) {
@@ -233,3 +236,47 @@ func TestResolveAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalPeer(t *testing.T) {
+	rpcPeer := &lnrpc.Peer{
+		PubKey:    "000000000000000000000000000000000000000000000000000000000000000000",
+		Address:   "127.0.0.1:9735",
+		BytesSent: 1024,
+		BytesRecv: 2048,
+		Inbound:   true,
+		PingTime:  1200,
+		Features: map[uint32]*lnrpc.Feature{
+			17: {Name: "multi-path-payments", IsKnown: true},
+			1:  {Name: "data-loss-protect", IsKnown: true},
+			8:  {Name: "tlv-onion", IsRequired: true, IsKnown: true},
+		},
+	}
+
+	expected := Peer{
+		Address:        "000000000000000000000000000000000000000000000000000000000000000000",
+		Host:           "127.0.0.1:9735",
+		Inbound:        true,
+		PingTimeMicros: 1200,
+		BytesSent:      1024,
+		BytesReceived:  2048,
+		Features: []Feature{
+			{Bit: 1, Name: "data-loss-protect", IsKnown: true},
+			{Bit: 8, Name: "tlv-onion", IsRequired: true, IsKnown: true},
+			{Bit: 17, Name: "multi-path-payments", IsKnown: true},
+		},
+	}
+
+	assert.Equal(t, expected, unmarshalPeer(rpcPeer))
+}
+
+func TestIsAlreadyConnectedError(t *testing.T) {
+	// The error returned by lnd (v0.14) when connecting to an existing peer,
+	// as formatted by errPeerAlreadyConnected in lnd's server.go.
+	lndErr := status.Error(codes.Unknown, "already connected to peer: "+
+		"02c6047f9441ed7d6d3045406e95c07cd85c778e4b8cef3ca7abac09b95c709ee5@127.0.0.1:9735")
+	assert.True(t, isAlreadyConnectedError(lndErr))
+
+	assert.False(t, isAlreadyConnectedError(nil))
+	assert.False(t, isAlreadyConnectedError(status.Error(codes.Unknown,
+		"dial tcp 127.0.0.1:9735: connect: connection refused")))
+}
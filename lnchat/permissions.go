@@ -0,0 +1,292 @@
+package lnchat
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	macaroon "gopkg.in/macaroon.v2"
+
+	"github.com/c13n-io/c13n-go/lnchat/lnconnect"
+)
+
+// macaroonIDVersion is the version of lnd macaroon identifiers
+// (the latest macaroon bakery version).
+const macaroonIDVersion = 3
+
+// macaroonURIEntity is the permission entity of lnd macaroons
+// that permits a single RPC method by its URI.
+const macaroonURIEntity = "uri"
+
+// Capability is a group of functionality that requires
+// a set of lnd RPC methods to be permitted.
+type Capability struct {
+	// The name of the capability.
+	Name string
+	// The URIs of the RPC methods the capability requires.
+	URIs []string
+}
+
+// CapabilityMessageSending is the name of the capability of sending
+// messages, without which lnchat can only receive messages.
+const CapabilityMessageSending = "message sending"
+
+// Capabilities lists the functionality offered over lnd,
+// along with the RPC methods each capability requires.
+var Capabilities = []Capability{
+	{
+		Name: "node and network information",
+		URIs: []string{
+			"/lnrpc.Lightning/GetInfo",
+			"/lnrpc.Lightning/WalletBalance",
+			"/lnrpc.Lightning/ChannelBalance",
+			"/lnrpc.Lightning/GetNodeInfo",
+			"/lnrpc.Lightning/DescribeGraph",
+			"/lnrpc.Lightning/SubscribeChannelGraph",
+			"/lnrpc.Lightning/ListPeers",
+			"/lnrpc.Lightning/ListChannels",
+			"/lnrpc.Lightning/PendingChannels",
+			"/lnrpc.Lightning/SubscribeChannelEvents",
+			"/lnrpc.Lightning/ListPermissions",
+		},
+	},
+	{
+		Name: "message reception",
+		URIs: []string{
+			"/lnrpc.Lightning/SubscribeInvoices",
+			"/lnrpc.Lightning/SubscribeCustomMessages",
+			"/lnrpc.Lightning/VerifyMessage",
+		},
+	},
+	{
+		Name: CapabilityMessageSending,
+		URIs: []string{
+			"/lnrpc.Lightning/SignMessage",
+			"/lnrpc.Lightning/DecodePayReq",
+			"/lnrpc.Lightning/QueryRoutes",
+			"/lnrpc.Lightning/ListPayments",
+			"/lnrpc.Lightning/SendCustomMessage",
+			"/routerrpc.Router/SendPaymentV2",
+			"/routerrpc.Router/SendToRouteV2",
+			"/routerrpc.Router/TrackPaymentV2",
+			"/routerrpc.Router/SubscribeHtlcEvents",
+		},
+	},
+	{
+		Name: "invoices",
+		URIs: []string{
+			"/lnrpc.Lightning/AddInvoice",
+			"/lnrpc.Lightning/LookupInvoice",
+			"/lnrpc.Lightning/ListInvoices",
+			"/invoicesrpc.Invoices/AddHoldInvoice",
+			"/invoicesrpc.Invoices/SettleInvoice",
+			"/invoicesrpc.Invoices/CancelInvoice",
+		},
+	},
+	{
+		Name: "peer management",
+		URIs: []string{
+			"/lnrpc.Lightning/ConnectPeer",
+			"/lnrpc.Lightning/DisconnectPeer",
+			"/lnrpc.Lightning/SubscribePeerEvents",
+		},
+	},
+	{
+		Name: "channel management",
+		URIs: []string{
+			"/lnrpc.Lightning/OpenChannel",
+			"/lnrpc.Lightning/CloseChannel",
+		},
+	},
+	{
+		Name: "routing policy and forwarding history",
+		URIs: []string{
+			"/lnrpc.Lightning/GetChanInfo",
+			"/lnrpc.Lightning/UpdateChannelPolicy",
+			"/lnrpc.Lightning/ForwardingHistory",
+		},
+	},
+	{
+		Name: "routing diagnostics",
+		URIs: []string{
+			"/routerrpc.Router/QueryMissionControl",
+			"/routerrpc.Router/QueryProbability",
+			"/routerrpc.Router/ResetMissionControl",
+		},
+	},
+	{
+		Name: "on-chain wallet",
+		URIs: []string{
+			"/lnrpc.Lightning/NewAddress",
+			"/lnrpc.Lightning/SendCoins",
+			"/lnrpc.Lightning/ListUnspent",
+			"/lnrpc.Lightning/GetTransactions",
+			"/lnrpc.Lightning/EstimateFee",
+		},
+	},
+}
+
+// RequiredURIs returns the URIs of all lnd RPC methods used by lnchat.
+func RequiredURIs() []string {
+	var uris []string
+	for _, c := range Capabilities {
+		uris = append(uris, c.URIs...)
+	}
+	sort.Strings(uris)
+
+	return uris
+}
+
+// BakeMacaroon bakes a macaroon permitting exactly
+// the lnd RPC methods used by lnchat, and returns it serialized.
+// The provided credentials must permit macaroon generation.
+func BakeMacaroon(ctx context.Context, creds lnconnect.Credentials) ([]byte, error) {
+	uris := RequiredURIs()
+	perms := make([]*lnrpc.MacaroonPermission, len(uris))
+	for i, uri := range uris {
+		perms[i] = &lnrpc.MacaroonPermission{
+			Entity: macaroonURIEntity,
+			Action: uri,
+		}
+	}
+
+	client, closeConn, err := dialLightningClient(creds)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+
+	resp, err := client.BakeMacaroon(ctx, &lnrpc.BakeMacaroonRequest{
+		Permissions: perms,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"macaroon baking failed")
+	}
+
+	macBytes, err := hex.DecodeString(resp.GetMacaroon())
+	if err != nil {
+		return nil, withCause(newErrorf(ErrInternal,
+			"could not decode baked macaroon"), err)
+	}
+
+	return macBytes, nil
+}
+
+// UnavailableCapabilities returns the capabilities that are not permitted
+// by the macaroon of the provided credentials.
+// Credentials without a macaroon are assumed to permit every capability.
+func UnavailableCapabilities(ctx context.Context, creds lnconnect.Credentials) ([]Capability, error) {
+	macCreds, ok := creds.RPCCreds.(macaroonCredentials)
+	if !ok {
+		return nil, nil
+	}
+
+	ops, err := macaroonOps(macCreds.Macaroon)
+	if err != nil {
+		return nil, withCause(newErrorf(ErrCredentials,
+			"could not decode macaroon permissions"), err)
+	}
+
+	client, closeConn, err := dialLightningClient(creds)
+	if err != nil {
+		return nil, err
+	}
+	defer closeConn()
+
+	resp, err := client.ListPermissions(ctx, &lnrpc.ListPermissionsRequest{})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	return unavailableCapabilities(ops, resp.GetMethodPermissions()), nil
+}
+
+// unavailableCapabilities returns the capabilities not permitted by a set of
+// macaroon operations (entity:action), given the permissions of each method.
+// A method is permitted either by its URI or by all its required permissions.
+func unavailableCapabilities(ops map[string]bool,
+	methodPerms map[string]*lnrpc.MacaroonPermissionList) []Capability {
+
+	permitted := func(uri string) bool {
+		if ops[macaroonURIEntity+":"+uri] {
+			return true
+		}
+
+		perms := methodPerms[uri].GetPermissions()
+		if len(perms) == 0 {
+			return false
+		}
+		for _, p := range perms {
+			if !ops[p.GetEntity()+":"+p.GetAction()] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	var unavailable []Capability
+	for _, c := range Capabilities {
+		for _, uri := range c.URIs {
+			if !permitted(uri) {
+				unavailable = append(unavailable, c)
+				break
+			}
+		}
+	}
+
+	return unavailable
+}
+
+// macaroonOps returns the operations (entity:action) permitted by
+// an lnd macaroon, as encoded in its identifier.
+func macaroonOps(mac *macaroon.Macaroon) (map[string]bool, error) {
+	rawID := mac.Id()
+	if len(rawID) == 0 || rawID[0] != macaroonIDVersion {
+		return nil, errors.New("invalid macaroon identifier version")
+	}
+
+	id := &lnrpc.MacaroonId{}
+	if err := proto.Unmarshal(rawID[1:], id); err != nil {
+		return nil, errors.Wrap(err, "invalid macaroon identifier")
+	}
+
+	ops := make(map[string]bool)
+	for _, op := range id.GetOps() {
+		for _, action := range op.GetActions() {
+			ops[op.GetEntity()+":"+action] = true
+		}
+	}
+
+	return ops, nil
+}
+
+// dialLightningClient creates a client over a dedicated connection
+// with the provided credentials, returning it along with
+// a function that closes the connection.
+func dialLightningClient(creds lnconnect.Credentials) (
+	lnrpc.LightningClient, func() error, error) {
+
+	conn, err := lnconnect.InitializeConnection(creds)
+	if err != nil {
+		switch {
+		case errors.Is(err, lnconnect.ErrCredentials):
+			return nil, nil, withCause(newError(ErrCredentials), err)
+		default:
+			return nil, nil, withCause(newErrorf(ErrUnknown,
+				"could not establish connection to grpc server"), err)
+		}
+	}
+
+	return lnrpc.NewLightningClient(conn), conn.Close, nil
+}
@@ -0,0 +1,197 @@
+package lnchat
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+func newTestMacaroon(t *testing.T, ops ...*lnrpc.Op) *macaroon.Macaroon {
+	id, err := proto.Marshal(&lnrpc.MacaroonId{
+		Nonce:     []byte("nonce"),
+		StorageId: []byte("0"),
+		Ops:       ops,
+	})
+	require.NoError(t, err)
+
+	mac, err := macaroon.New([]byte("root key"), append([]byte{macaroonIDVersion}, id...),
+		"lnd", macaroon.LatestVersion)
+	require.NoError(t, err)
+
+	return mac
+}
+
+func TestRequiredURIs(t *testing.T) {
+	uris := RequiredURIs()
+
+	assert.IsIncreasing(t, uris)
+	assert.Contains(t, uris, "/lnrpc.Lightning/GetInfo")
+	assert.Contains(t, uris, "/routerrpc.Router/SendPaymentV2")
+}
+
+// lnchatRPCServices maps the client fields of the manager
+// to the lnd services they call.
+// The State service does not require a macaroon.
+var lnchatRPCServices = map[string]string{
+	"lnClient":       "/lnrpc.Lightning/",
+	"routeClient":    "/routerrpc.Router/",
+	"invoicesClient": "/invoicesrpc.Invoices/",
+}
+
+func TestRequiredURIsCoverRPCCalls(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	required := make(map[string]bool)
+	for _, uri := range RequiredURIs() {
+		required[uri] = true
+	}
+
+	var calls int
+	ast.Inspect(pkgs["lnchat"], func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		method, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		client, ok := method.X.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if service, ok := lnchatRPCServices[client.Sel.Name]; ok {
+			calls++
+			uri := service + method.Sel.Name
+			assert.True(t, required[uri], "%s is called at %v "+
+				"but not required by any capability",
+				uri, fset.Position(call.Pos()))
+		}
+		return true
+	})
+	assert.NotZero(t, calls)
+}
+
+func TestMacaroonOps(t *testing.T) {
+	mac := newTestMacaroon(t,
+		&lnrpc.Op{Entity: "info", Actions: []string{"read"}},
+		&lnrpc.Op{Entity: "offchain", Actions: []string{"read", "write"}},
+	)
+
+	ops, err := macaroonOps(mac)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"info:read":      true,
+		"offchain:read":  true,
+		"offchain:write": true,
+	}, ops)
+
+	mac, err = macaroon.New([]byte("root key"), []byte{2, 0},
+		"lnd", macaroon.LatestVersion)
+	require.NoError(t, err)
+	_, err = macaroonOps(mac)
+	assert.Error(t, err)
+}
+
+func TestUnavailableCapabilities(t *testing.T) {
+	// Every method requires a permission named after its URI,
+	// except for peer connection, which requires two.
+	methodPerms := make(map[string]*lnrpc.MacaroonPermissionList)
+	for _, uri := range RequiredURIs() {
+		methodPerms[uri] = &lnrpc.MacaroonPermissionList{
+			Permissions: []*lnrpc.MacaroonPermission{
+				{Entity: "method", Action: uri},
+			},
+		}
+	}
+	methodPerms["/lnrpc.Lightning/ConnectPeer"].Permissions = append(
+		methodPerms["/lnrpc.Lightning/ConnectPeer"].Permissions,
+		&lnrpc.MacaroonPermission{Entity: "peers", Action: "write"},
+	)
+
+	capabilityNames := func(capabilities []Capability) []string {
+		var names []string
+		for _, c := range capabilities {
+			names = append(names, c.Name)
+		}
+		return names
+	}
+
+	allOps := func() map[string]bool {
+		ops := map[string]bool{"peers:write": true}
+		for _, uri := range RequiredURIs() {
+			ops["method:"+uri] = true
+		}
+		return ops
+	}
+
+	cases := []struct {
+		name     string
+		ops      func() map[string]bool
+		expected []string
+	}{
+		{
+			name:     "all permissions",
+			ops:      allOps,
+			expected: nil,
+		},
+		{
+			name: "all URIs",
+			ops: func() map[string]bool {
+				ops := make(map[string]bool)
+				for _, uri := range RequiredURIs() {
+					ops[macaroonURIEntity+":"+uri] = true
+				}
+				return ops
+			},
+			expected: nil,
+		},
+		{
+			name: "missing method permission",
+			ops: func() map[string]bool {
+				ops := allOps()
+				delete(ops, "method:/routerrpc.Router/SendPaymentV2")
+				return ops
+			},
+			expected: []string{CapabilityMessageSending},
+		},
+		{
+			name: "partial method permissions",
+			ops: func() map[string]bool {
+				ops := allOps()
+				delete(ops, "peers:write")
+				return ops
+			},
+			expected: []string{"peer management"},
+		},
+		{
+			name:     "no permissions",
+			ops:      func() map[string]bool { return nil },
+			expected: capabilityNames(Capabilities),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			unavailable := unavailableCapabilities(c.ops(), methodPerms)
+			assert.Equal(t, c.expected, capabilityNames(unavailable))
+		})
+	}
+
+	// Methods unknown to the daemon are only permitted by URI.
+	unavailable := unavailableCapabilities(allOps(), nil)
+	assert.Equal(t, capabilityNames(Capabilities), capabilityNames(unavailable))
+}
@@ -0,0 +1,98 @@
+package lnchat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/pkg/errors"
+)
+
+// marshalRoute creates an lnrpc.Route from an lnchat.Route,
+// placing the provided custom records on the final hop.
+func marshalRoute(route *Route, customRecords map[uint64][]byte) (*lnrpc.Route, error) {
+	if route == nil || len(route.Hops) == 0 {
+		return nil, fmt.Errorf("cannot marshal empty route")
+	}
+
+	hops := make([]*lnrpc.Hop, len(route.Hops))
+	for i, hop := range route.Hops {
+		hops[i] = &lnrpc.Hop{
+			ChanId:           hop.ChannelID,
+			PubKey:           hop.NodeID.String(),
+			AmtToForwardMsat: hop.AmtToForward.Msat(),
+			FeeMsat:          hop.Fees.Msat(),
+			Expiry:           hop.Expiry,
+			TlvPayload:       true,
+		}
+	}
+	if len(customRecords) > 0 {
+		hops[len(hops)-1].CustomRecords = customRecords
+	}
+
+	return &lnrpc.Route{
+		TotalTimeLock: route.TimeLock,
+		TotalAmtMsat:  route.Amt.Msat() + route.Fees.Msat(),
+		TotalFeesMsat: route.Fees.Msat(),
+		Hops:          hops,
+	}, nil
+}
+
+// SendToRoute sends a spontaneous payment along the provided route
+// and returns the resulting payment.
+// The payload is placed on the final hop of the route.
+// The route is used as-is, so the fees paid are exactly the route fees.
+func (m *manager) SendToRoute(ctx context.Context, route *Route,
+	payload map[uint64][]byte) (*Payment, error) {
+
+	preimage, err := generatePreimage()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate preimage")
+	}
+	hash := preimage.Hash()
+
+	rpcRoute, err := marshalRoute(route, copyCustomRecords(payload, preimage[:]))
+	if err != nil {
+		return nil, err
+	}
+
+	req := &routerrpc.SendToRouteRequest{
+		PaymentHash: hash[:],
+		Route:       rpcRoute,
+	}
+
+	if _, err := m.routeClient.SendToRouteV2(ctx, req); err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	// Retrieve the payment created for the attempt.
+	trackCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	trackReq := &routerrpc.TrackPaymentRequest{
+		PaymentHash:       hash[:],
+		NoInflightUpdates: true,
+	}
+
+	stream, err := m.routeClient.TrackPaymentV2(trackCtx, trackReq)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	rpcPayment, err := stream.Recv()
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	return unmarshalPayment(rpcPayment)
+}
@@ -0,0 +1,79 @@
+package lnchat
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// signedMsgPrefix is the prefix lnd prepends to messages before signing.
+var signedMsgPrefix = []byte("Lightning Signed Message:")
+
+// RecoverSignerAddress verifies an lnd-style signature over the message
+// and returns the address of the signing node.
+//
+// The signature is a recoverable compact ECDSA signature over the
+// double-SHA256 digest of the prefixed message, as produced by lnd SignMessage.
+// Verification does not involve the underlying node, so signatures
+// of nodes absent from its graph (e.g. private nodes) can be verified.
+func RecoverSignerAddress(message, signature []byte) (string, error) {
+	if len(message) == 0 || len(signature) == 0 {
+		return "", newErrorf(ErrInvalidSignature,
+			"message and signature are required")
+	}
+
+	digest := SignedMessageDigest(message)
+
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), signature, digest)
+	if err != nil {
+		return "", withCause(newError(ErrInvalidSignature), err)
+	}
+
+	return addressBytesToStr(pubKey.SerializeCompressed())
+}
+
+// SignedMessageDigest returns the digest over which lnd signs a message,
+// which is the double-SHA256 hash of the prefixed message.
+func SignedMessageDigest(message []byte) []byte {
+	msg := make([]byte, 0, len(signedMsgPrefix)+len(message))
+	msg = append(msg, signedMsgPrefix...)
+	msg = append(msg, message...)
+
+	return chainhash.DoubleHashB(msg)
+}
+
+// VerifyWireSignature verifies a signature over the message
+// against the address of the signing node.
+//
+// The signature is an ECDSA signature over the SHA256 digest
+// of the unprefixed message, as produced by the lnd signer SignMessage call
+// (used by keysend messaging applications), either in the fixed-size
+// (64 byte) LN wire format or DER encoded (as returned by earlier lnd versions).
+func VerifyWireSignature(message, signature []byte, address string) (bool, error) {
+	if len(message) == 0 || len(signature) == 0 {
+		return false, newErrorf(ErrInvalidSignature,
+			"message and signature are required")
+	}
+
+	addr, err := addressStrToBytes(address)
+	if err != nil {
+		return false, err
+	}
+	pubKey, err := btcec.ParsePubKey(addr, btcec.S256())
+	if err != nil {
+		return false, withCause(newErrorf(ErrInvalidAddress, "%s", address), err)
+	}
+
+	var wireSig lnwire.Sig
+	if len(signature) == len(wireSig) {
+		copy(wireSig[:], signature)
+	} else if wireSig, err = lnwire.NewSigFromRawSignature(signature); err != nil {
+		return false, withCause(newError(ErrInvalidSignature), err)
+	}
+	sig, err := wireSig.ToSignature()
+	if err != nil {
+		return false, withCause(newError(ErrInvalidSignature), err)
+	}
+
+	return sig.Verify(chainhash.HashB(message), pubKey), nil
+}
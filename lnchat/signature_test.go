@@ -0,0 +1,122 @@
+package lnchat
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecoverSignerAddress(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	address := hex.EncodeToString(key.PubKey().SerializeCompressed())
+
+	msg := []byte("message")
+	digest := chainhash.DoubleHashB(append(signedMsgPrefix, msg...))
+	sig, err := btcec.SignCompact(btcec.S256(), key, digest, true)
+	require.NoError(t, err)
+
+	// The signature round-trips through its zbase32 encoding.
+	sig, err = signatureStrToBytes(signatureBytesToStr(sig))
+	require.NoError(t, err)
+
+	cases := []struct {
+		name            string
+		msg             []byte
+		sig             []byte
+		expectedAddress string
+		expectedErr     error
+	}{
+		{
+			name:            "valid signature",
+			msg:             msg,
+			sig:             sig,
+			expectedAddress: address,
+		},
+		{
+			name: "different message",
+			msg:  []byte("other message"),
+			sig:  sig,
+		},
+		{
+			name:        "malformed signature",
+			msg:         msg,
+			sig:         []byte("signature"),
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name:        "missing signature",
+			msg:         msg,
+			expectedErr: ErrInvalidSignature,
+		},
+		{
+			name:        "missing message",
+			sig:         sig,
+			expectedErr: ErrInvalidSignature,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			addr, err := RecoverSignerAddress(c.msg, c.sig)
+			if c.expectedErr != nil {
+				assert.ErrorIs(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if c.expectedAddress != "" {
+				assert.Equal(t, c.expectedAddress, addr)
+			} else {
+				// A signature over a different message
+				// recovers a different key.
+				assert.NotEqual(t, address, addr)
+			}
+		})
+	}
+}
+
+func TestVerifyWireSignature(t *testing.T) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	address := hex.EncodeToString(key.PubKey().SerializeCompressed())
+
+	other, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	otherAddress := hex.EncodeToString(other.PubKey().SerializeCompressed())
+
+	msg := []byte("message")
+	ecdsaSig, err := key.Sign(chainhash.HashB(msg))
+	require.NoError(t, err)
+	wireSig, err := lnwire.NewSigFromSignature(ecdsaSig)
+	require.NoError(t, err)
+	sig := wireSig[:]
+
+	valid, err := VerifyWireSignature(msg, sig, address)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// DER encoded signatures are accepted as well.
+	valid, err = VerifyWireSignature(msg, ecdsaSig.Serialize(), address)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	valid, err = VerifyWireSignature([]byte("other message"), sig, address)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	valid, err = VerifyWireSignature(msg, sig, otherAddress)
+	require.NoError(t, err)
+	assert.False(t, valid)
+
+	_, err = VerifyWireSignature(msg, sig[:63], address)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+	_, err = VerifyWireSignature(msg, sig, "address")
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+}
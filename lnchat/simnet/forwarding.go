@@ -0,0 +1,142 @@
+package simnet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// recordForward appends a settled forward to the node history.
+// Must be called with the network lock held.
+func (n *Node) recordForward(in, out leg) {
+	n.forwards = append(n.forwards, lnchat.ForwardingEvent{
+		TimestampNs: time.Now().UnixNano(),
+		ChanIDIn:    in.c.id,
+		ChanIDOut:   out.c.id,
+		AmtInMsat:   uint64(in.amt),
+		AmtOutMsat:  uint64(out.amt),
+		FeeMsat:     uint64(in.amt - out.amt),
+	})
+}
+
+// localChannel returns the channel of the node with the provided
+// channel point, along with the endpoint index of the node.
+// Must be called with the network lock held.
+func (n *Node) localChannel(chanPoint lnchat.ChannelPoint) (*channel, int, error) {
+	c := n.net.channelByPoint(chanPoint)
+	if c == nil || c.side(n) < 0 {
+		return nil, 0, fmt.Errorf("%w: %s:%d", ErrChannelNotFound,
+			chanPoint.FundingTxid, chanPoint.OutputIndex)
+	}
+
+	return c, c.side(n), nil
+}
+
+// GetChannelPolicy returns the forwarding policy of the node for a channel.
+// Channels without a maximum HTLC amount report their capacity instead.
+func (n *Node) GetChannelPolicy(_ context.Context,
+	chanPoint lnchat.ChannelPoint) (*lnchat.ChannelPolicy, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	c, side, err := n.localChannel(chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	p := c.policy[side]
+	maxHTLCMsat := p.maxHTLCMsat
+	if maxHTLCMsat == 0 {
+		maxHTLCMsat = c.capacity
+	}
+
+	return &lnchat.ChannelPolicy{
+		BaseFeeMsat:   p.baseFeeMsat,
+		FeeRatePPM:    uint32(p.feeRatePPM),
+		TimeLockDelta: p.timeLockDelta,
+		MinHTLCMsat:   uint64(p.minHTLCMsat),
+		MaxHTLCMsat:   uint64(maxHTLCMsat),
+	}, nil
+}
+
+// UpdateChannelPolicy updates the forwarding policy of the node
+// for a channel, or for all its channels if no channel point is provided.
+// A zero minimum or maximum HTLC amount leaves the respective limit unchanged.
+func (n *Node) UpdateChannelPolicy(_ context.Context,
+	chanPoint *lnchat.ChannelPoint, policy lnchat.ChannelPolicy) error {
+
+	if err := n.lock(); err != nil {
+		return err
+	}
+	defer n.unlock()
+
+	update := func(p *routingPolicy) {
+		p.baseFeeMsat = policy.BaseFeeMsat
+		p.feeRatePPM = int64(policy.FeeRatePPM)
+		p.timeLockDelta = policy.TimeLockDelta
+		if policy.MinHTLCMsat != 0 {
+			p.minHTLCMsat = int64(policy.MinHTLCMsat)
+		}
+		if policy.MaxHTLCMsat != 0 {
+			p.maxHTLCMsat = int64(policy.MaxHTLCMsat)
+		}
+	}
+
+	if chanPoint != nil {
+		c, side, err := n.localChannel(*chanPoint)
+		if err != nil {
+			return err
+		}
+		update(&c.policy[side])
+
+		return nil
+	}
+
+	for _, c := range n.net.channels {
+		if side := c.side(n); side >= 0 {
+			update(&c.policy[side])
+		}
+	}
+
+	return nil
+}
+
+// ForwardingHistory returns up to maxEvents forwarding events
+// settled within the provided time range (in seconds since Unix epoch),
+// starting after the event with index indexOffset.
+// Event indexes are relative to the time range.
+// A zero end time signifies the current time.
+func (n *Node) ForwardingHistory(_ context.Context,
+	startTimeSec, endTimeSec int64, indexOffset uint64,
+	maxEvents uint32) ([]lnchat.ForwardingEvent, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	var events []lnchat.ForwardingEvent
+	var index uint64
+	for _, e := range n.forwards {
+		ts := e.TimestampNs / int64(time.Second)
+		if ts < startTimeSec || (endTimeSec != 0 && ts >= endTimeSec) {
+			continue
+		}
+		if index++; index <= indexOffset {
+			continue
+		}
+		if uint32(len(events)) == maxEvents {
+			break
+		}
+
+		e.Index = index
+		events = append(events, e)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,470 @@
+package simnet
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// invoice is an invoice of a node.
+type invoice struct {
+	inv lnchat.Invoice
+
+	// hold signifies a hold invoice, which is not settled
+	// upon payment but remains accepted until settled or cancelled.
+	hold bool
+	// htlc is the in-flight HTLC paying an accepted hold invoice.
+	htlc *htlc
+}
+
+// snapshot returns a copy of the invoice.
+func (i *invoice) snapshot() *lnchat.Invoice {
+	inv := i.inv
+	inv.Preimage = append([]byte(nil), i.inv.Preimage...)
+	inv.RouteHints = append([]lnchat.RouteHint(nil), i.inv.RouteHints...)
+	inv.Htlcs = make([]lnchat.InvoiceHTLC, len(i.inv.Htlcs))
+	for j, h := range i.inv.Htlcs {
+		h.CustomRecords = copyRecords(h.CustomRecords)
+		inv.Htlcs[j] = h
+	}
+	if len(inv.Htlcs) == 0 {
+		inv.Htlcs = nil
+	}
+
+	return &inv
+}
+
+// notifyInvoice notifies the invoice subscribers of an invoice update.
+// Must be called with the network lock held.
+func (n *Node) notifyInvoice(i *invoice) {
+	notifyAll(n.invoiceSubs, i.snapshot())
+}
+
+// SubscribeInvoiceUpdates returns a channel over which invoice updates
+// matching the filter are received.
+// If startIdx is non-zero, the invoices settled after that
+// settle index are delivered first.
+func (n *Node) SubscribeInvoiceUpdates(ctx context.Context, startIdx uint64,
+	filter lnchat.InvoiceUpdateFilter) (<-chan lnchat.InvoiceUpdate, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+
+	sub := n.subscribe(ctx, n.invoiceSubs)
+	if startIdx != 0 {
+		var settled []*invoice
+		for _, i := range n.invoices {
+			if i.inv.State == lnchat.InvoiceSETTLED && i.inv.SettleIndex > startIdx {
+				settled = append(settled, i)
+			}
+		}
+		sort.Slice(settled, func(a, b int) bool {
+			return settled[a].inv.SettleIndex < settled[b].inv.SettleIndex
+		})
+		for _, i := range settled {
+			sub.notify(i.snapshot())
+		}
+	}
+	n.unlock()
+
+	updates := make(chan lnchat.InvoiceUpdate)
+	go func() {
+		defer close(updates)
+
+		sub.forward(ctx, func(u interface{}) bool {
+			inv := u.(*lnchat.Invoice)
+			if !filter(inv) {
+				return true
+			}
+
+			select {
+			case <-ctx.Done():
+				return false
+			case <-sub.quit:
+				return false
+			case updates <- lnchat.InvoiceUpdate{Inv: inv}:
+				return true
+			}
+		})
+	}()
+
+	return updates, nil
+}
+
+// DecodePayReq decodes a payment request created by a simulated node.
+func (n *Node) DecodePayReq(_ context.Context, payReq string) (*lnchat.PayReq, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	return decodePayReq(payReq)
+}
+
+func decodePayReq(payReq string) (*lnchat.PayReq, error) {
+	req, err := zpay32.Decode(payReq, chainParams)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment request: %w", err)
+	}
+
+	dest, err := lnchat.NewNodeFromBytes(req.Destination.SerializeCompressed())
+	if err != nil {
+		return nil, err
+	}
+
+	var amt int64
+	if req.MilliSat != nil {
+		amt = int64(*req.MilliSat)
+	}
+
+	var descHash string
+	if req.DescriptionHash != nil {
+		descHash = hex.EncodeToString(req.DescriptionHash[:])
+	}
+
+	var hints []lnchat.RouteHint
+	for _, r := range req.RouteHints {
+		hint := lnchat.RouteHint{
+			HopHints: make([]lnchat.HopHint, len(r)),
+		}
+		for i, h := range r {
+			hint.HopHints[i] = lnchat.HopHint{
+				NodeID:          lnchat.NodeID{Vertex: route.NewVertex(h.NodeID)},
+				ChanID:          h.ChannelID,
+				FeeBaseMsat:     h.FeeBaseMSat,
+				FeeRate:         h.FeeProportionalMillionths,
+				CltvExpiryDelta: uint32(h.CLTVExpiryDelta),
+			}
+		}
+		hints = append(hints, hint)
+	}
+
+	return &lnchat.PayReq{
+		Destination:     dest,
+		Hash:            lntypes.Hash(*req.PaymentHash).String(),
+		Amt:             lnchat.NewAmount(amt),
+		CreatedTimeSec:  req.Timestamp.Unix(),
+		Expiry:          int64(req.Expiry() / time.Second),
+		CltvExpiry:      req.MinFinalCLTVExpiry(),
+		RouteHints:      hints,
+		DescriptionHash: descHash,
+	}, nil
+}
+
+// encodePayReq creates a payment request for an invoice,
+// signed by the node key.
+// Must be called with the network lock held.
+func (n *Node) encodePayReq(inv *lnchat.Invoice, hash lntypes.Hash) (string, error) {
+	opts := []func(*zpay32.Invoice){
+		zpay32.Description(inv.Memo),
+		zpay32.Expiry(time.Duration(inv.Expiry) * time.Second),
+		zpay32.CLTVExpiry(inv.CltvExpiry),
+	}
+	if inv.Value != 0 {
+		opts = append(opts, zpay32.Amount(lnwire.MilliSatoshi(inv.Value.Msat())))
+	}
+	for _, hint := range inv.RouteHints {
+		hopHints := make([]zpay32.HopHint, len(hint.HopHints))
+		for i, h := range hint.HopHints {
+			pubKey, err := btcec.ParsePubKey(h.NodeID.Bytes(), btcec.S256())
+			if err != nil {
+				return "", err
+			}
+			hopHints[i] = zpay32.HopHint{
+				NodeID:                    pubKey,
+				ChannelID:                 h.ChanID,
+				FeeBaseMSat:               h.FeeBaseMsat,
+				FeeProportionalMillionths: h.FeeRate,
+				CLTVExpiryDelta:           uint16(h.CltvExpiryDelta),
+			}
+		}
+		opts = append(opts, zpay32.RouteHint(hopHints))
+	}
+
+	req, err := zpay32.NewInvoice(chainParams, [32]byte(hash),
+		time.Unix(inv.CreatedTimeSec, 0), opts...)
+	if err != nil {
+		return "", err
+	}
+
+	return req.Encode(zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			return btcec.SignCompact(btcec.S256(), n.key,
+				chainhash.HashB(msg), true)
+		},
+	})
+}
+
+// privateRouteHints returns route hints for the private channels of the node.
+// Must be called with the network lock held.
+func (n *Node) privateRouteHints() []lnchat.RouteHint {
+	var hints []lnchat.RouteHint
+	for _, c := range n.net.channels {
+		local := c.side(n)
+		if local < 0 || !c.private {
+			continue
+		}
+		remote := 1 - local
+
+		policy := c.policy[remote]
+		hints = append(hints, lnchat.RouteHint{
+			HopHints: []lnchat.HopHint{
+				{
+					NodeID:          lnchat.NodeID{Vertex: route.NewVertex(c.nodes[remote].key.PubKey())},
+					ChanID:          c.id,
+					FeeBaseMsat:     uint32(policy.baseFeeMsat),
+					FeeRate:         uint32(policy.feeRatePPM),
+					CltvExpiryDelta: policy.timeLockDelta,
+				},
+			},
+		})
+	}
+	sort.Slice(hints, func(i, j int) bool {
+		return hints[i].HopHints[0].ChanID < hints[j].HopHints[0].ChanID
+	})
+
+	return hints
+}
+
+// addInvoice creates an open invoice for the provided payment hash,
+// along with its payment request if withPayReq is set.
+// The preimage of hold invoices is not known in advance.
+// Must be called with the network lock held.
+func (n *Node) addInvoice(memo string, hash lntypes.Hash, preimage *lntypes.Preimage,
+	amt lnchat.Amount, expiry int64, privateHints, withPayReq bool) (*invoice, error) {
+
+	if _, ok := n.invoices[hash]; ok {
+		return nil, ErrInvoiceExists
+	}
+	if expiry == 0 {
+		expiry = defaultInvoiceExpirySecs
+	}
+
+	n.numInvoices++
+	i := &invoice{
+		inv: lnchat.Invoice{
+			Memo:           memo,
+			Hash:           hash.String(),
+			Value:          amt,
+			CreatedTimeSec: time.Now().Unix(),
+			Expiry:         expiry,
+			CltvExpiry:     defaultFinalCltvDelta,
+			State:          lnchat.InvoiceOPEN,
+			AddIndex:       n.numInvoices,
+			Private:        privateHints,
+		},
+		hold: preimage == nil,
+	}
+	if preimage != nil {
+		i.inv.Preimage = append([]byte(nil), preimage[:]...)
+	}
+	if privateHints {
+		i.inv.RouteHints = n.privateRouteHints()
+	}
+
+	if withPayReq {
+		payReq, err := n.encodePayReq(&i.inv, hash)
+		if err != nil {
+			return nil, fmt.Errorf("could not create payment request: %w", err)
+		}
+		i.inv.PaymentRequest = payReq
+	}
+
+	n.invoices[hash] = i
+	n.notifyInvoice(i)
+
+	return i, nil
+}
+
+// CreateInvoice creates an invoice for the provided amount.
+func (n *Node) CreateInvoice(_ context.Context, memo string,
+	amt lnchat.Amount, expiry int64, privateHints bool) (*lnchat.Invoice, error) {
+
+	preimage, err := newPreimage()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	i, err := n.addInvoice(memo, preimage.Hash(), &preimage, amt, expiry, privateHints, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.snapshot(), nil
+}
+
+// LookupInvoice returns the invoice with the provided payment hash.
+func (n *Node) LookupInvoice(_ context.Context, payHash string) (*lnchat.Invoice, error) {
+	hash, err := lntypes.MakeHashFromStr(payHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	i, ok := n.invoices[hash]
+	if !ok {
+		return nil, ErrInvoiceNotFound
+	}
+
+	return i.snapshot(), nil
+}
+
+// ListInvoices returns up to maxInvoices invoices in ascending order,
+// starting after the invoice with add index indexOffset.
+func (n *Node) ListInvoices(_ context.Context,
+	indexOffset, maxInvoices uint64) ([]*lnchat.Invoice, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	var invoices []*invoice
+	for _, i := range n.invoices {
+		if i.inv.AddIndex > indexOffset {
+			invoices = append(invoices, i)
+		}
+	}
+	sort.Slice(invoices, func(a, b int) bool {
+		return invoices[a].inv.AddIndex < invoices[b].inv.AddIndex
+	})
+	if uint64(len(invoices)) > maxInvoices {
+		invoices = invoices[:maxInvoices]
+	}
+
+	res := make([]*lnchat.Invoice, len(invoices))
+	for j, i := range invoices {
+		res[j] = i.snapshot()
+	}
+
+	return res, nil
+}
+
+// CreateHoldInvoice creates a hold invoice for the provided payment hash.
+func (n *Node) CreateHoldInvoice(_ context.Context, memo, payHash string,
+	amt lnchat.Amount, expiry int64, privateHints bool) (*lnchat.Invoice, error) {
+
+	hash, err := lntypes.MakeHashFromStr(payHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	i, err := n.addInvoice(memo, hash, nil, amt, expiry, privateHints, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.snapshot(), nil
+}
+
+// SettleInvoice settles an accepted hold invoice
+// with the preimage of its payment hash.
+func (n *Node) SettleInvoice(_ context.Context, preimageStr string) error {
+	preimage, err := lntypes.MakePreimageFromStr(preimageStr)
+	if err != nil {
+		return err
+	}
+
+	if err := n.lock(); err != nil {
+		return err
+	}
+	defer n.unlock()
+
+	i, ok := n.invoices[preimage.Hash()]
+	switch {
+	case !ok:
+		return ErrInvoiceNotFound
+	case !i.hold || i.inv.State != lnchat.InvoiceACCEPTED:
+		return fmt.Errorf("%w: cannot settle invoice in state %d",
+			ErrInvalidInvoiceState, i.inv.State)
+	}
+
+	i.inv.Preimage = append([]byte(nil), preimage[:]...)
+	n.settleInvoice(i)
+	i.htlc.settle(preimage)
+	i.htlc = nil
+
+	return nil
+}
+
+// CancelInvoice cancels an open or accepted invoice,
+// failing back any HTLCs held for it.
+func (n *Node) CancelInvoice(_ context.Context, payHash string) error {
+	hash, err := lntypes.MakeHashFromStr(payHash)
+	if err != nil {
+		return err
+	}
+
+	if err := n.lock(); err != nil {
+		return err
+	}
+	defer n.unlock()
+
+	i, ok := n.invoices[hash]
+	switch {
+	case !ok:
+		return ErrInvoiceNotFound
+	case i.inv.State == lnchat.InvoiceSETTLED:
+		return fmt.Errorf("%w: cannot cancel settled invoice",
+			ErrInvalidInvoiceState)
+	}
+
+	i.inv.State = lnchat.InvoiceCANCELLED
+	now := time.Now().Unix()
+	for j := range i.inv.Htlcs {
+		i.inv.Htlcs[j].State = lnrpc.InvoiceHTLCState_CANCELED
+		i.inv.Htlcs[j].ResolveTimeSec = now
+	}
+	i.inv.AmtPaid = 0
+	if i.htlc != nil {
+		i.htlc.fail(lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS)
+		i.htlc = nil
+	}
+	n.notifyInvoice(i)
+
+	return nil
+}
+
+// settleInvoice marks an invoice and its HTLCs as settled.
+// Must be called with the network lock held.
+func (n *Node) settleInvoice(i *invoice) {
+	now := time.Now().Unix()
+
+	n.settleIndex++
+	i.inv.State = lnchat.InvoiceSETTLED
+	i.inv.SettleIndex = n.settleIndex
+	i.inv.SettleTimeSec = now
+	for j := range i.inv.Htlcs {
+		i.inv.Htlcs[j].State = lnrpc.InvoiceHTLCState_SETTLED
+		i.inv.Htlcs[j].ResolveTimeSec = now
+	}
+
+	n.notifyInvoice(i)
+}
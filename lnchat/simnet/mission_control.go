@@ -0,0 +1,124 @@
+package simnet
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// nodePair identifies a directed pair of nodes by their addresses.
+type nodePair struct {
+	from, to string
+}
+
+// pairHistory returns the payment attempt history of the node for a pair,
+// creating it if absent.
+// Must be called with the network lock held.
+func (n *Node) pairHistory(from, to string) *lnchat.PairHistory {
+	key := nodePair{from: from, to: to}
+	h, ok := n.pairs[key]
+	if !ok {
+		h = &lnchat.PairHistory{NodeFrom: from, NodeTo: to}
+		n.pairs[key] = h
+	}
+
+	return h
+}
+
+// recordAttempt records the outcome of an HTLC attempt of the node.
+// Pairs preceding the failing one are recorded as successful,
+// while failures at the final node do not concern any pair.
+// Must be called with the network lock held.
+func (n *Node) recordAttempt(attempt *lnchat.HTLCAttempt) {
+	now := time.Now().Unix()
+	hops := attempt.Route.Hops
+
+	from := n.address
+	for i, hop := range hops {
+		to := hop.NodeID.String()
+		amt := hop.AmtToForward.Msat() + hop.Fees.Msat()
+		h := n.pairHistory(from, to)
+
+		if attempt.Status == lnrpc.HTLCAttempt_FAILED &&
+			attempt.Failure != nil && int(attempt.Failure.NodeIndex) == i {
+
+			h.FailTimeSec, h.FailAmtMsat = now, amt
+			if h.SuccessAmtMsat >= amt {
+				h.SuccessAmtMsat = amt - 1
+			}
+			return
+		}
+
+		h.SuccessTimeSec = now
+		if amt > h.SuccessAmtMsat {
+			h.SuccessAmtMsat = amt
+		}
+		if h.FailTimeSec != 0 && h.FailAmtMsat <= amt {
+			h.FailTimeSec, h.FailAmtMsat = 0, 0
+		}
+		from = to
+	}
+}
+
+// QueryMissionControl returns the payment attempt history
+// of the node for all node pairs, sorted by pair.
+func (n *Node) QueryMissionControl(_ context.Context) ([]lnchat.PairHistory, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	pairs := make([]lnchat.PairHistory, 0, len(n.pairs))
+	for _, h := range n.pairs {
+		pairs = append(pairs, *h)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].NodeFrom != pairs[j].NodeFrom {
+			return pairs[i].NodeFrom < pairs[j].NodeFrom
+		}
+		return pairs[i].NodeTo < pairs[j].NodeTo
+	})
+
+	return pairs, nil
+}
+
+// QueryProbability returns the probability that the node can forward
+// the provided amount from a node to another, along with
+// the payment attempt history of the pair.
+// Since the network state is fully known, the probability is 0
+// if an attempt of at most the amount has failed, and 1 otherwise.
+func (n *Node) QueryProbability(_ context.Context, from, to string,
+	amt lnchat.Amount) (float64, *lnchat.PairHistory, error) {
+
+	if err := n.lock(); err != nil {
+		return .0, nil, err
+	}
+	defer n.unlock()
+
+	h := lnchat.PairHistory{NodeFrom: from, NodeTo: to}
+	if ph, ok := n.pairs[nodePair{from: from, to: to}]; ok {
+		h = *ph
+	}
+
+	if h.FailTimeSec != 0 && h.FailAmtMsat <= amt.Msat() {
+		return .0, &h, nil
+	}
+
+	return 1., &h, nil
+}
+
+// ResetMissionControl clears the payment attempt history of the node.
+func (n *Node) ResetMissionControl(_ context.Context) error {
+	if err := n.lock(); err != nil {
+		return err
+	}
+	defer n.unlock()
+
+	n.pairs = make(map[nodePair]*lnchat.PairHistory)
+
+	return nil
+}
@@ -0,0 +1,235 @@
+// Package simnet provides an in-memory simulated Lightning network,
+// whose nodes implement lnchat.LightManager.
+//
+// Simulated nodes create and pay invoices, send spontaneous payments
+// carrying custom records, route payments over multiple hops
+// according to configurable forwarding policies, sign and verify messages,
+// exchange custom peer messages and send on-chain payments
+// between node wallets, without any external daemon.
+// Channels are usable as soon as they are opened,
+// and payments are resolved synchronously, unless paying a hold invoice.
+package simnet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+const (
+	// defaultBaseFeeMsat is the base fee charged for forwarding payments.
+	defaultBaseFeeMsat = 1000
+	// defaultFeeRatePPM is the proportional fee (in millionths)
+	// charged for forwarding payments.
+	defaultFeeRatePPM = 1
+	// defaultTimeLockDelta is the timelock delta
+	// required for forwarding payments.
+	defaultTimeLockDelta = 40
+	// defaultFinalCltvDelta is the final hop timelock delta,
+	// used if not specified.
+	defaultFinalCltvDelta = 40
+	// defaultInvoiceExpirySecs is the invoice expiry,
+	// used if not specified.
+	defaultInvoiceExpirySecs = 3600
+
+	// startHeight is the (constant) block height of the network.
+	startHeight = 100
+)
+
+var (
+	// ErrNodeClosed is returned by the methods of a closed node.
+	ErrNodeClosed = fmt.Errorf("%w: node is closed", lnchat.ErrNetworkUnavailable)
+	// ErrNotConnected is returned when an operation
+	// requires a peer connection that does not exist.
+	ErrNotConnected = fmt.Errorf("peer is not connected")
+	// ErrChannelNotFound is returned when a channel does not exist.
+	ErrChannelNotFound = fmt.Errorf("channel not found")
+	// ErrInsufficientFunds is returned when the wallet balance
+	// cannot fund a channel.
+	ErrInsufficientFunds = fmt.Errorf("insufficient wallet balance")
+	// ErrInvoiceNotFound is returned when an invoice does not exist.
+	ErrInvoiceNotFound = fmt.Errorf("invoice not found")
+	// ErrInvoiceExists is returned when creating an invoice
+	// for an existing payment hash.
+	ErrInvoiceExists = fmt.Errorf("invoice with payment hash already exists")
+	// ErrInvalidInvoiceState is returned when an invoice
+	// cannot transition to the requested state.
+	ErrInvalidInvoiceState = fmt.Errorf("invalid invoice state")
+	// ErrAlreadyPaid is returned when attempting a payment
+	// for a payment hash that is already paid or in flight.
+	ErrAlreadyPaid = fmt.Errorf("payment is already paid or in flight")
+)
+
+// chainParams are the chain parameters used for payment requests.
+var chainParams = &chaincfg.SimNetParams
+
+// Network is an in-memory Lightning network of simulated nodes.
+// All network state is guarded by a single lock,
+// so that operations are applied atomically across nodes.
+type Network struct {
+	mtx sync.Mutex
+
+	nodes    map[string]*Node
+	channels map[uint64]*channel
+	// addresses maps on-chain addresses to the node owning them.
+	addresses map[string]*Node
+
+	// numChannels is the number of channels ever opened,
+	// used for channel identifier generation.
+	numChannels uint32
+	// numTxs is the number of on-chain transactions,
+	// used for transaction identifier generation.
+	numTxs uint32
+}
+
+// NewNetwork creates an empty simulated network.
+func NewNetwork() *Network {
+	return &Network{
+		nodes:     make(map[string]*Node),
+		channels:  make(map[uint64]*channel),
+		addresses: make(map[string]*Node),
+	}
+}
+
+// AddNode creates a node with a random identity key
+// and the provided alias and wallet balance (in satoshi),
+// and adds it to the network.
+func (net *Network) AddNode(alias string, walletBalanceSat int64) (*Node, error) {
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, fmt.Errorf("could not generate node key: %w", err)
+	}
+
+	node := newNode(net, key, alias, walletBalanceSat)
+
+	net.mtx.Lock()
+	defer net.mtx.Unlock()
+
+	net.nodes[node.address] = node
+
+	return node, nil
+}
+
+// ConnectNodes creates a peer connection between two nodes
+// and opens a channel of the provided capacity (in satoshi)
+// funded by the first node, pushing pushSat to the second node.
+func (net *Network) ConnectNodes(from, to *Node,
+	capacitySat, pushSat int64, private bool) (lnchat.ChannelPoint, error) {
+
+	if err := from.connect(to, ""); err != nil {
+		return lnchat.ChannelPoint{}, err
+	}
+
+	return from.openChannel(to, private, capacitySat*1000, pushSat*1000)
+}
+
+// routingPolicy describes the terms under which
+// a node forwards payments over a channel.
+type routingPolicy struct {
+	baseFeeMsat   int64
+	feeRatePPM    int64
+	timeLockDelta uint32
+	// minHTLCMsat and maxHTLCMsat limit the forwarded amount
+	// (a zero maximum imposes no limit).
+	minHTLCMsat int64
+	maxHTLCMsat int64
+}
+
+func defaultRoutingPolicy() routingPolicy {
+	return routingPolicy{
+		baseFeeMsat:   defaultBaseFeeMsat,
+		feeRatePPM:    defaultFeeRatePPM,
+		timeLockDelta: defaultTimeLockDelta,
+	}
+}
+
+// fee returns the fee charged for forwarding amtMsat.
+func (p routingPolicy) fee(amtMsat int64) int64 {
+	return p.baseFeeMsat + amtMsat*p.feeRatePPM/1000000
+}
+
+// allows returns whether amtMsat is within the forwarding limits.
+func (p routingPolicy) allows(amtMsat int64) bool {
+	return amtMsat >= p.minHTLCMsat &&
+		(p.maxHTLCMsat == 0 || amtMsat <= p.maxHTLCMsat)
+}
+
+// channel is a channel between two nodes.
+// The first endpoint is the channel initiator.
+type channel struct {
+	id       uint64
+	point    lnchat.ChannelPoint
+	nodes    [2]*Node
+	private  bool
+	capacity int64
+
+	// balance and policy are indexed by endpoint.
+	balance [2]int64
+	policy  [2]routingPolicy
+	// unsettled is the amount locked in in-flight HTLCs,
+	// offered by each endpoint.
+	unsettled [2]int64
+	sent      [2]int64
+
+	numUpdates uint64
+}
+
+// side returns the endpoint index of a node,
+// or -1 if the node is not an endpoint of the channel.
+func (c *channel) side(n *Node) int {
+	switch n {
+	case c.nodes[0]:
+		return 0
+	case c.nodes[1]:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// newChannelID returns a short channel identifier for the next channel,
+// along with the channel point of its (simulated) funding transaction.
+// Must be called with the network lock held.
+func (net *Network) newChannelID() (uint64, lnchat.ChannelPoint) {
+	net.numChannels++
+
+	id := uint64(startHeight)<<40 | uint64(net.numChannels)<<16
+
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], id)
+	txid := chainhash.HashH(b[:])
+
+	return id, lnchat.ChannelPoint{
+		FundingTxid: txid.String(),
+		OutputIndex: 0,
+	}
+}
+
+// channelByPoint returns the channel with the provided channel point.
+// Must be called with the network lock held.
+func (net *Network) channelByPoint(point lnchat.ChannelPoint) *channel {
+	for _, c := range net.channels {
+		if c.point == point {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// nodeByAddress returns the node with the provided address.
+// Must be called with the network lock held.
+func (net *Network) nodeByAddress(address string) (*Node, error) {
+	node, ok := net.nodes[address]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", lnchat.ErrNodeNotFound, address)
+	}
+
+	return node, nil
+}
@@ -0,0 +1,758 @@
+package simnet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// Node is a simulated Lightning node, implementing lnchat.LightManager.
+type Node struct {
+	net *Network
+
+	key     *btcec.PrivateKey
+	alias   string
+	address string
+
+	// The following fields are guarded by the network lock.
+
+	closed    bool
+	walletSat int64
+	peers     map[string]*peerConn
+
+	invoices    map[lntypes.Hash]*invoice
+	numInvoices uint64
+	settleIndex uint64
+
+	payments    map[lntypes.Hash]*lnchat.Payment
+	numPayments uint64
+
+	numAddresses uint64
+	transactions []lnchat.Transaction
+
+	forwards []lnchat.ForwardingEvent
+	pairs    map[nodePair]*lnchat.PairHistory
+
+	invoiceSubs   map[*subscriber]struct{}
+	paymentSubs   map[*subscriber]struct{}
+	channelSubs   map[*subscriber]struct{}
+	customMsgSubs map[*subscriber]struct{}
+	peerSubs      map[*subscriber]struct{}
+}
+
+var _ lnchat.LightManager = (*Node)(nil)
+
+// peerConn is a peer connection of a node.
+type peerConn struct {
+	host          string
+	inbound       bool
+	bytesSent     uint64
+	bytesReceived uint64
+}
+
+func newNode(net *Network, key *btcec.PrivateKey, alias string, walletSat int64) *Node {
+	return &Node{
+		net:     net,
+		key:     key,
+		alias:   alias,
+		address: route.NewVertex(key.PubKey()).String(),
+
+		walletSat: walletSat,
+		peers:     make(map[string]*peerConn),
+		invoices:  make(map[lntypes.Hash]*invoice),
+		payments:  make(map[lntypes.Hash]*lnchat.Payment),
+		pairs:     make(map[nodePair]*lnchat.PairHistory),
+
+		invoiceSubs:   make(map[*subscriber]struct{}),
+		paymentSubs:   make(map[*subscriber]struct{}),
+		channelSubs:   make(map[*subscriber]struct{}),
+		customMsgSubs: make(map[*subscriber]struct{}),
+		peerSubs:      make(map[*subscriber]struct{}),
+	}
+}
+
+// Address returns the Lightning address of the node.
+func (n *Node) Address() string {
+	return n.address
+}
+
+// Alias returns the alias of the node.
+func (n *Node) Alias() string {
+	return n.alias
+}
+
+// lock acquires the network lock, failing if the node is closed.
+func (n *Node) lock() error {
+	n.net.mtx.Lock()
+	if n.closed {
+		n.net.mtx.Unlock()
+		return ErrNodeClosed
+	}
+
+	return nil
+}
+
+func (n *Node) unlock() {
+	n.net.mtx.Unlock()
+}
+
+// notifyAll queues an update for all provided subscribers.
+func notifyAll(subs map[*subscriber]struct{}, update interface{}) {
+	for s := range subs {
+		s.notify(update)
+	}
+}
+
+// subscribe registers a subscriber, and unregisters it
+// once the context is done.
+// Must be called with the network lock held.
+func (n *Node) subscribe(ctx context.Context, subs map[*subscriber]struct{}) *subscriber {
+	sub := newSubscriber()
+	subs[sub] = struct{}{}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-sub.quit:
+		}
+
+		sub.stop()
+
+		n.net.mtx.Lock()
+		delete(subs, sub)
+		n.net.mtx.Unlock()
+	}()
+
+	return sub
+}
+
+// GetSelfInfo returns the node identity.
+func (n *Node) GetSelfInfo(_ context.Context) (lnchat.SelfInfo, error) {
+	if err := n.lock(); err != nil {
+		return lnchat.SelfInfo{}, err
+	}
+	defer n.unlock()
+
+	return lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   n.alias,
+			Address: n.address,
+		},
+		Chains: []lnchat.Chain{
+			{
+				Chain:   "bitcoin",
+				Network: chainParams.Name,
+			},
+		},
+	}, nil
+}
+
+// GetConnectionState returns the connection state of the node,
+// which is ready until the node is closed.
+func (n *Node) GetConnectionState() lnchat.ConnectionState {
+	if err := n.lock(); err != nil {
+		return lnchat.ConnectionCONNECTING
+	}
+	defer n.unlock()
+
+	return lnchat.ConnectionREADY
+}
+
+// SubscribeConnectionState returns a channel over which the
+// connection state of the node is received. Simulated nodes
+// do not change state, so only the current state is sent.
+func (n *Node) SubscribeConnectionState(ctx context.Context) (<-chan lnchat.ConnectionState, error) {
+	state := n.GetConnectionState()
+
+	updates := make(chan lnchat.ConnectionState)
+	go func() {
+		defer close(updates)
+
+		select {
+		case updates <- state:
+		case <-ctx.Done():
+			return
+		}
+		<-ctx.Done()
+	}()
+
+	return updates, nil
+}
+
+// ListNodes returns all nodes of the network, ordered by address.
+func (n *Node) ListNodes(_ context.Context) ([]lnchat.LightningNode, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	nodes := make([]lnchat.LightningNode, 0, len(n.net.nodes))
+	for _, node := range n.net.nodes {
+		nodes = append(nodes, lnchat.LightningNode{
+			Alias:   node.alias,
+			Address: node.address,
+		})
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Address < nodes[j].Address
+	})
+
+	return nodes, nil
+}
+
+// GetNodeInfo returns the details of a network node.
+// Only public channels are accounted for in the node channel statistics.
+func (n *Node) GetNodeInfo(_ context.Context, address string) (*lnchat.NodeInfo, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	node, err := n.net.nodeByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &lnchat.NodeInfo{
+		Node: lnchat.LightningNode{
+			Alias:   node.alias,
+			Address: node.address,
+		},
+	}
+	for _, c := range n.net.channels {
+		if c.side(node) < 0 {
+			continue
+		}
+		if !c.private {
+			info.NumChannels++
+			info.TotalCapacityMsat += c.capacity
+		}
+		if c.side(n) >= 0 && node != n {
+			info.HasChannel = true
+		}
+	}
+
+	return info, nil
+}
+
+// GetSelfBalance returns the wallet and channel balances of the node.
+func (n *Node) GetSelfBalance(_ context.Context) (*lnchat.SelfBalance, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	balance := &lnchat.SelfBalance{
+		WalletConfirmedBalanceSat: n.walletSat,
+	}
+	for _, c := range n.net.channels {
+		local := c.side(n)
+		if local < 0 {
+			continue
+		}
+		remote := 1 - local
+
+		balance.ChannelBalance.LocalMsat += uint64(c.balance[local])
+		balance.ChannelBalance.RemoteMsat += uint64(c.balance[remote])
+		balance.UnsettledBalance.LocalMsat += uint64(c.unsettled[local])
+		balance.UnsettledBalance.RemoteMsat += uint64(c.unsettled[remote])
+	}
+
+	return balance, nil
+}
+
+// ConnectNode creates a peer connection with a node,
+// if one does not already exist.
+func (n *Node) ConnectNode(_ context.Context, address string, hostport string) error {
+	n.net.mtx.Lock()
+	peer, err := n.net.nodeByAddress(address)
+	n.net.mtx.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return n.connect(peer, hostport)
+}
+
+// connect creates a peer connection between two nodes.
+func (n *Node) connect(peer *Node, hostport string) error {
+	if err := n.lock(); err != nil {
+		return err
+	}
+	defer n.unlock()
+
+	switch {
+	case peer == n:
+		return fmt.Errorf("cannot connect to self")
+	case peer.closed:
+		return fmt.Errorf("%w: could not connect to %s",
+			lnchat.ErrNetworkUnavailable, peer.address)
+	}
+	if _, ok := n.peers[peer.address]; ok {
+		return nil
+	}
+
+	n.peers[peer.address] = &peerConn{host: hostport}
+	peer.peers[n.address] = &peerConn{inbound: true}
+
+	notifyAll(n.peerSubs, &lnchat.PeerEvent{Address: peer.address, Type: lnchat.PeerONLINE})
+	notifyAll(peer.peerSubs, &lnchat.PeerEvent{Address: n.address, Type: lnchat.PeerONLINE})
+
+	return nil
+}
+
+// ListPeers returns the nodes currently connected to the node,
+// ordered by address.
+func (n *Node) ListPeers(_ context.Context) ([]lnchat.Peer, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	peers := make([]lnchat.Peer, 0, len(n.peers))
+	for addr, p := range n.peers {
+		peers = append(peers, lnchat.Peer{
+			Address:       addr,
+			Host:          p.host,
+			Inbound:       p.inbound,
+			BytesSent:     p.bytesSent,
+			BytesReceived: p.bytesReceived,
+		})
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].Address < peers[j].Address
+	})
+
+	return peers, nil
+}
+
+// DisconnectPeer terminates the peer connection with a node.
+// A peer with which the node has channels cannot be disconnected.
+func (n *Node) DisconnectPeer(_ context.Context, address string) error {
+	if err := n.lock(); err != nil {
+		return err
+	}
+	defer n.unlock()
+
+	if _, ok := n.peers[address]; !ok {
+		return fmt.Errorf("%w: %s", ErrNotConnected, address)
+	}
+	for _, c := range n.net.channels {
+		if local := c.side(n); local >= 0 && c.nodes[1-local].address == address {
+			return fmt.Errorf("cannot disconnect peer %s with open channels", address)
+		}
+	}
+
+	delete(n.peers, address)
+	notifyAll(n.peerSubs, &lnchat.PeerEvent{Address: address, Type: lnchat.PeerOFFLINE})
+	if peer, ok := n.net.nodes[address]; ok {
+		delete(peer.peers, n.address)
+		notifyAll(peer.peerSubs, &lnchat.PeerEvent{Address: n.address, Type: lnchat.PeerOFFLINE})
+	}
+
+	return nil
+}
+
+// SubscribePeerEvents returns a channel over which
+// the peer connection changes of the node are received.
+func (n *Node) SubscribePeerEvents(ctx context.Context) (
+	<-chan lnchat.PeerEventUpdate, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	sub := n.subscribe(ctx, n.peerSubs)
+	n.unlock()
+
+	updates := make(chan lnchat.PeerEventUpdate)
+	go func() {
+		defer close(updates)
+
+		sub.forward(ctx, func(u interface{}) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-sub.quit:
+				return false
+			case updates <- lnchat.PeerEventUpdate{Event: u.(*lnchat.PeerEvent)}:
+				return true
+			}
+		})
+	}()
+
+	return updates, nil
+}
+
+// OpenChannel opens a channel with a peer, funded from the node wallet.
+// The channel is usable as soon as it is opened.
+func (n *Node) OpenChannel(_ context.Context, address string,
+	private bool, amtMsat, pushAmtMsat uint64,
+	_ int32, _ lnchat.TxFeeOptions) (*lnchat.ChannelPoint, error) {
+
+	n.net.mtx.Lock()
+	peer, err := n.net.nodeByAddress(address)
+	n.net.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	point, err := n.openChannel(peer, private, int64(amtMsat), int64(pushAmtMsat))
+	if err != nil {
+		return nil, err
+	}
+
+	return &point, nil
+}
+
+func (n *Node) openChannel(peer *Node, private bool,
+	amtMsat, pushAmtMsat int64) (lnchat.ChannelPoint, error) {
+
+	if err := n.lock(); err != nil {
+		return lnchat.ChannelPoint{}, err
+	}
+	defer n.unlock()
+
+	switch {
+	case n.peers[peer.address] == nil:
+		return lnchat.ChannelPoint{}, fmt.Errorf("%w: %s",
+			ErrNotConnected, peer.address)
+	case amtMsat <= 0 || pushAmtMsat < 0 || pushAmtMsat > amtMsat:
+		return lnchat.ChannelPoint{}, fmt.Errorf("invalid channel amounts")
+	case amtMsat/1000 > n.walletSat:
+		return lnchat.ChannelPoint{}, ErrInsufficientFunds
+	}
+
+	id, point := n.net.newChannelID()
+	c := &channel{
+		id:       id,
+		point:    point,
+		nodes:    [2]*Node{n, peer},
+		private:  private,
+		capacity: amtMsat,
+		balance:  [2]int64{amtMsat - pushAmtMsat, pushAmtMsat},
+		policy:   [2]routingPolicy{defaultRoutingPolicy(), defaultRoutingPolicy()},
+	}
+	n.net.channels[id] = c
+	n.walletSat -= amtMsat / 1000
+
+	for _, node := range c.nodes {
+		notifyAll(node.channelSubs, &lnchat.ChannelEvent{
+			Type:         lnchat.ChannelEventPENDINGOPEN,
+			ChannelPoint: point,
+		})
+		ch := node.channelView(c)
+		notifyAll(node.channelSubs, &lnchat.ChannelEvent{
+			Type:         lnchat.ChannelEventOPEN,
+			ChannelPoint: point,
+			Channel:      &ch,
+		})
+	}
+
+	return point, nil
+}
+
+// channelView returns a channel as seen from the node.
+// Must be called with the network lock held.
+func (n *Node) channelView(c *channel) lnchat.Channel {
+	local := c.side(n)
+	remote := 1 - local
+
+	return lnchat.Channel{
+		ChannelPoint:  c.point,
+		ChannelID:     c.id,
+		RemoteAddress: c.nodes[remote].address,
+		Active:        !c.nodes[remote].closed,
+		Private:       c.private,
+		Initiator:     local == 0,
+		CapacityMsat:  c.capacity,
+		Balance: lnchat.BalanceAllocation{
+			LocalMsat:  uint64(c.balance[local]),
+			RemoteMsat: uint64(c.balance[remote]),
+		},
+		UnsettledBalanceMsat: c.unsettled[local] + c.unsettled[remote],
+		TotalSentMsat:        c.sent[local],
+		TotalReceivedMsat:    c.sent[remote],
+		NumUpdates:           c.numUpdates,
+	}
+}
+
+// ListChannels returns the channels of the node matching the filter,
+// ordered by channel identifier.
+func (n *Node) ListChannels(_ context.Context,
+	filter lnchat.ChannelFilter) ([]lnchat.Channel, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	var channels []lnchat.Channel
+	for _, c := range n.net.channels {
+		if c.side(n) < 0 {
+			continue
+		}
+
+		ch := n.channelView(c)
+		switch {
+		case filter.ActiveOnly && !ch.Active,
+			filter.InactiveOnly && ch.Active,
+			filter.PublicOnly && ch.Private,
+			filter.PrivateOnly && !ch.Private,
+			filter.Peer != "" && filter.Peer != ch.RemoteAddress:
+			continue
+		}
+		channels = append(channels, ch)
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].ChannelID < channels[j].ChannelID
+	})
+
+	return channels, nil
+}
+
+// ListPendingChannels returns the pending channels of the node.
+// Since channels are opened and closed immediately,
+// there are never any pending channels.
+func (n *Node) ListPendingChannels(_ context.Context) ([]lnchat.PendingChannel, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	return nil, nil
+}
+
+// CloseChannel closes a channel of the node, returning
+// the channel balances to the wallets of the endpoints.
+// Channels with in-flight HTLCs cannot be closed.
+func (n *Node) CloseChannel(_ context.Context, chanPoint lnchat.ChannelPoint,
+	force bool, _ lnchat.TxFeeOptions) (<-chan lnchat.ChannelCloseUpdate, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	c := n.net.channelByPoint(chanPoint)
+	if c == nil || c.side(n) < 0 {
+		return nil, fmt.Errorf("%w: %s:%d", ErrChannelNotFound,
+			chanPoint.FundingTxid, chanPoint.OutputIndex)
+	}
+	if c.unsettled[0] != 0 || c.unsettled[1] != 0 {
+		return nil, fmt.Errorf("cannot close channel with in-flight HTLCs")
+	}
+
+	delete(n.net.channels, c.id)
+
+	closingTxid := chainhash.HashH([]byte(c.point.FundingTxid)).String()
+	for i, node := range c.nodes {
+		node.walletSat += c.balance[i] / 1000
+
+		closeType := lnchat.ChannelCloseCOOPERATIVE
+		switch {
+		case force && node == n:
+			closeType = lnchat.ChannelCloseLOCALFORCE
+		case force:
+			closeType = lnchat.ChannelCloseREMOTEFORCE
+		}
+		notifyAll(node.channelSubs, &lnchat.ChannelEvent{
+			Type:         lnchat.ChannelEventCLOSED,
+			ChannelPoint: c.point,
+			ClosedChannel: &lnchat.ClosedChannel{
+				ChannelID:          c.id,
+				RemoteAddress:      c.nodes[1-i].address,
+				CapacityMsat:       c.capacity,
+				ClosingTxid:        closingTxid,
+				CloseHeight:        startHeight,
+				SettledBalanceMsat: c.balance[i],
+				CloseType:          closeType,
+			},
+		})
+	}
+
+	updates := make(chan lnchat.ChannelCloseUpdate, 2)
+	for _, state := range []lnchat.ChannelCloseState{
+		lnchat.ChannelClosePENDING, lnchat.ChannelCloseCONFIRMED,
+	} {
+		updates <- lnchat.ChannelCloseUpdate{
+			Status: &lnchat.ChannelCloseStatus{
+				State:       state,
+				ClosingTxid: closingTxid,
+			},
+		}
+	}
+	close(updates)
+
+	return updates, nil
+}
+
+// SubscribeChannelEvents returns a channel over which
+// channel events of the node are received.
+func (n *Node) SubscribeChannelEvents(ctx context.Context) (
+	<-chan lnchat.ChannelEventUpdate, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	sub := n.subscribe(ctx, n.channelSubs)
+	n.unlock()
+
+	updates := make(chan lnchat.ChannelEventUpdate)
+	go func() {
+		defer close(updates)
+
+		sub.forward(ctx, func(u interface{}) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-sub.quit:
+				return false
+			case updates <- lnchat.ChannelEventUpdate{Event: u.(*lnchat.ChannelEvent)}:
+				return true
+			}
+		})
+	}()
+
+	return updates, nil
+}
+
+// SignMessage signs a message with the node key, as lnd does.
+func (n *Node) SignMessage(_ context.Context, message []byte) ([]byte, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	return btcec.SignCompact(btcec.S256(), n.key,
+		lnchat.SignedMessageDigest(message), true)
+}
+
+// VerifySignatureExtractPubkey verifies the signature over the message,
+// and returns the address of the signing node.
+// An invalid signature results in an empty address.
+func (n *Node) VerifySignatureExtractPubkey(_ context.Context,
+	message, signature []byte) (string, error) {
+
+	if err := n.lock(); err != nil {
+		return "", err
+	}
+	defer n.unlock()
+
+	if len(message) == 0 {
+		return "", fmt.Errorf("need a message to verify")
+	}
+
+	addr, err := lnchat.RecoverSignerAddress(message, signature)
+	if err != nil {
+		return "", nil
+	}
+
+	return addr, nil
+}
+
+// SendCustomMessage sends a custom message carrying
+// the provided records to a peer.
+func (n *Node) SendCustomMessage(_ context.Context, peer string,
+	records map[uint64][]byte) error {
+
+	if err := n.lock(); err != nil {
+		return err
+	}
+	defer n.unlock()
+
+	conn, ok := n.peers[peer]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotConnected, peer)
+	}
+	recipient, err := n.net.nodeByAddress(peer)
+	if err != nil {
+		return err
+	}
+
+	size := uint64(0)
+	for _, v := range records {
+		size += uint64(len(v))
+	}
+	conn.bytesSent += size
+	recipient.peers[n.address].bytesReceived += size
+
+	notifyAll(recipient.customMsgSubs, &lnchat.CustomMessage{
+		Peer:    n.address,
+		Records: copyRecords(records),
+	})
+
+	return nil
+}
+
+// SubscribeCustomMessages returns a channel over which
+// custom messages received from peers are delivered.
+func (n *Node) SubscribeCustomMessages(ctx context.Context) (
+	<-chan lnchat.CustomMessageUpdate, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	sub := n.subscribe(ctx, n.customMsgSubs)
+	n.unlock()
+
+	updates := make(chan lnchat.CustomMessageUpdate)
+	go func() {
+		defer close(updates)
+
+		sub.forward(ctx, func(u interface{}) bool {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-sub.quit:
+				return false
+			case updates <- lnchat.CustomMessageUpdate{Msg: u.(*lnchat.CustomMessage)}:
+				return true
+			}
+		})
+	}()
+
+	return updates, nil
+}
+
+// Close shuts the node down, terminating all its subscriptions.
+// Channels with a closed node are inactive and cannot route payments.
+func (n *Node) Close() error {
+	n.net.mtx.Lock()
+	defer n.net.mtx.Unlock()
+
+	if n.closed {
+		return nil
+	}
+	n.closed = true
+
+	for _, subs := range []map[*subscriber]struct{}{
+		n.invoiceSubs, n.paymentSubs, n.channelSubs, n.customMsgSubs, n.peerSubs,
+	} {
+		for s := range subs {
+			s.stop()
+		}
+	}
+
+	return nil
+}
+
+// copyRecords returns a deep copy of a custom record set.
+func copyRecords(records map[uint64][]byte) map[uint64][]byte {
+	if records == nil {
+		return nil
+	}
+
+	res := make(map[uint64][]byte, len(records))
+	for k, v := range records {
+		res[k] = append([]byte(nil), v...)
+	}
+
+	return res
+}
@@ -0,0 +1,843 @@
+package simnet
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+func newPreimage() (lntypes.Preimage, error) {
+	var preimage lntypes.Preimage
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return lntypes.Preimage{}, fmt.Errorf("could not generate preimage: %w", err)
+	}
+
+	return preimage, nil
+}
+
+// leg is the transfer of an HTLC over a channel.
+type leg struct {
+	c    *channel
+	from int
+	amt  int64
+}
+
+// htlc is an HTLC sent by a node along a route.
+type htlc struct {
+	sender *Node
+	hash   lntypes.Hash
+	legs   []leg
+}
+
+// lock locks the HTLC amounts on the route channels.
+// Must be called with the network lock held.
+func (h *htlc) lock() {
+	for _, l := range h.legs {
+		l.c.balance[l.from] -= l.amt
+		l.c.unsettled[l.from] += l.amt
+	}
+}
+
+// settle transfers the HTLC amounts over the route channels,
+// records the forwards of the intermediate nodes
+// and marks the sender payment as succeeded.
+// Must be called with the network lock held.
+func (h *htlc) settle(preimage lntypes.Preimage) {
+	for i, l := range h.legs {
+		l.c.unsettled[l.from] -= l.amt
+		l.c.balance[1-l.from] += l.amt
+		l.c.sent[l.from] += l.amt
+		l.c.numUpdates++
+
+		if i > 0 {
+			l.c.nodes[l.from].recordForward(h.legs[i-1], l)
+		}
+	}
+
+	h.sender.resolvePayment(h.hash, &preimage, nil)
+}
+
+// fail returns the HTLC amounts to the offering channel endpoints,
+// and marks the sender payment as failed by the final node.
+// Must be called with the network lock held.
+func (h *htlc) fail(code lnrpc.Failure_FailureCode) {
+	for _, l := range h.legs {
+		l.c.unsettled[l.from] -= l.amt
+		l.c.balance[l.from] += l.amt
+		l.c.numUpdates++
+	}
+
+	h.sender.resolvePayment(h.hash, nil, &lnchat.HTLCFailure{
+		Code:      code,
+		NodeIndex: uint32(len(h.legs)),
+	})
+}
+
+// copyPayment returns a deep copy of a payment.
+func copyPayment(p *lnchat.Payment) *lnchat.Payment {
+	res := *p
+	if p.Htlcs == nil {
+		return &res
+	}
+
+	res.Htlcs = make([]lnchat.HTLCAttempt, len(p.Htlcs))
+	for i, h := range p.Htlcs {
+		h.Route.Hops = append([]lnchat.RouteHop(nil), h.Route.Hops...)
+		for j := range h.Route.Hops {
+			h.Route.Hops[j].CustomRecords = copyRecords(h.Route.Hops[j].CustomRecords)
+		}
+		h.Preimage = append([]byte(nil), h.Preimage...)
+		if h.Failure != nil {
+			failure := *h.Failure
+			h.Failure = &failure
+		}
+		res.Htlcs[i] = h
+	}
+
+	return &res
+}
+
+// notifyPayment notifies the payment subscribers of a payment update.
+// Must be called with the network lock held.
+func (n *Node) notifyPayment(p *lnchat.Payment) {
+	notifyAll(n.paymentSubs, copyPayment(p))
+}
+
+// checkPayable fails if a payment for the payment hash
+// has succeeded or is in flight.
+// Must be called with the network lock held.
+func (n *Node) checkPayable(hash lntypes.Hash) error {
+	if p, ok := n.payments[hash]; ok && p.Status != lnchat.PaymentFAILED {
+		return fmt.Errorf("%w: %s", ErrAlreadyPaid, hash)
+	}
+
+	return nil
+}
+
+// newPayment records a new payment for the payment hash,
+// replacing any previously failed payment.
+// Must be called with the network lock held.
+func (n *Node) newPayment(hash lntypes.Hash, payReq string, amtMsat int64) *lnchat.Payment {
+	n.numPayments++
+	p := &lnchat.Payment{
+		Hash:           hash.String(),
+		Value:          lnchat.NewAmount(amtMsat),
+		CreationTimeNs: time.Now().UnixNano(),
+		PaymentRequest: payReq,
+		Status:         lnchat.PaymentINFLIGHT,
+		PaymentIndex:   n.numPayments,
+	}
+	n.payments[hash] = p
+
+	return p
+}
+
+// localBalance returns the total local balance
+// of the node channels (in millisatoshi).
+// Must be called with the network lock held.
+func (n *Node) localBalance() int64 {
+	var balance int64
+	for _, c := range n.net.channels {
+		if side := c.side(n); side >= 0 {
+			balance += c.balance[side]
+		}
+	}
+
+	return balance
+}
+
+// resolvePayment resolves the last HTLC attempt of a payment,
+// either with the preimage or with a failure,
+// and records its outcome in the payment attempt history of the node.
+// Must be called with the network lock held.
+func (n *Node) resolvePayment(hash lntypes.Hash, preimage *lntypes.Preimage,
+	failure *lnchat.HTLCFailure) {
+
+	p, ok := n.payments[hash]
+	if !ok || len(p.Htlcs) == 0 {
+		return
+	}
+
+	attempt := &p.Htlcs[len(p.Htlcs)-1]
+	attempt.ResolveTimeNs = time.Now().UnixNano()
+	switch {
+	case preimage != nil:
+		attempt.Status = lnrpc.HTLCAttempt_SUCCEEDED
+		attempt.Preimage = append([]byte(nil), preimage[:]...)
+		p.Status = lnchat.PaymentSUCCEEDED
+		p.Preimage = preimage.String()
+	default:
+		attempt.Status = lnrpc.HTLCAttempt_FAILED
+		attempt.Failure = failure
+		p.Status = lnchat.PaymentFAILED
+		// Payments are not retried over other routes, so failures
+		// of intermediate nodes leave the payment without a route.
+		p.FailureReason = lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE
+		if failure != nil && int(failure.NodeIndex) == len(attempt.Route.Hops) {
+			p.FailureReason = lnrpc.PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS
+		}
+	}
+	n.recordAttempt(attempt)
+
+	n.notifyPayment(p)
+}
+
+// routeLegs validates that a route starting at the node can carry an HTLC,
+// and returns the channel transfers of the HTLC.
+// On failure, the failure code and the index of the failing node are returned.
+// Must be called with the network lock held.
+func (n *Node) routeLegs(rt *lnchat.Route) ([]leg, *lnchat.HTLCFailure) {
+	legs := make([]leg, len(rt.Hops))
+
+	from := n
+	for i, hop := range rt.Hops {
+		failure := func(code lnrpc.Failure_FailureCode) *lnchat.HTLCFailure {
+			return &lnchat.HTLCFailure{Code: code, NodeIndex: uint32(i)}
+		}
+
+		c, ok := n.net.channels[hop.ChannelID]
+		if !ok {
+			return nil, failure(lnrpc.Failure_UNKNOWN_NEXT_PEER)
+		}
+		side := c.side(from)
+		if side < 0 || c.nodes[1-side].address != hop.NodeID.String() {
+			return nil, failure(lnrpc.Failure_UNKNOWN_NEXT_PEER)
+		}
+		to := c.nodes[1-side]
+
+		// The HTLC offered to a hop carries the amount it forwards
+		// along with its forwarding fee, which the previous hop
+		// must have received and charged according to its policy.
+		amt := hop.AmtToForward.Msat() + hop.Fees.Msat()
+		switch {
+		case to.closed || from.peers[to.address] == nil:
+			return nil, failure(lnrpc.Failure_UNKNOWN_NEXT_PEER)
+		case c.balance[side] < amt:
+			return nil, failure(lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE)
+		case i > 0 && rt.Hops[i-1].AmtToForward.Msat() != amt:
+			return nil, failure(lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS)
+		case i > 0 && rt.Hops[i-1].Fees.Msat() < c.policy[side].fee(amt):
+			return nil, failure(lnrpc.Failure_FEE_INSUFFICIENT)
+		case i > 0 && amt < c.policy[side].minHTLCMsat:
+			return nil, failure(lnrpc.Failure_AMOUNT_BELOW_MINIMUM)
+		case i > 0 && !c.policy[side].allows(amt):
+			return nil, failure(lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE)
+		}
+
+		legs[i] = leg{c: c, from: side, amt: amt}
+		from = to
+	}
+
+	return legs, nil
+}
+
+// sendHTLC sends an HTLC for the payment hash along the route,
+// recording the attempt in the payment.
+// The records are placed on the final hop of the route.
+// Must be called with the network lock held.
+func (n *Node) sendHTLC(p *lnchat.Payment, hash lntypes.Hash, rt *lnchat.Route,
+	records map[uint64][]byte, ampPreimage *lntypes.Preimage) {
+
+	attemptRoute := *rt
+	attemptRoute.Hops = append([]lnchat.RouteHop(nil), rt.Hops...)
+	attemptRoute.Hops[len(rt.Hops)-1].CustomRecords = copyRecords(records)
+
+	p.Htlcs = append(p.Htlcs, lnchat.HTLCAttempt{
+		Route:         attemptRoute,
+		AttemptTimeNs: time.Now().UnixNano(),
+		Status:        lnrpc.HTLCAttempt_IN_FLIGHT,
+	})
+	n.notifyPayment(p)
+
+	legs, failure := n.routeLegs(rt)
+	if failure != nil {
+		n.resolvePayment(hash, nil, failure)
+		return
+	}
+
+	h := &htlc{sender: n, hash: hash, legs: legs}
+	h.lock()
+
+	finalHop := rt.Hops[len(rt.Hops)-1]
+	dest := n.net.nodes[finalHop.NodeID.String()]
+	dest.receiveHTLC(h, finalHop, records, ampPreimage)
+}
+
+// receiveHTLC handles an HTLC arriving at its final node.
+// HTLCs carrying their preimage (keysend and AMP) are settled
+// into a newly created invoice, while other HTLCs must pay
+// an open invoice for the payment hash.
+// Must be called with the network lock held.
+func (n *Node) receiveHTLC(h *htlc, hop lnchat.RouteHop,
+	records map[uint64][]byte, ampPreimage *lntypes.Preimage) {
+
+	now := time.Now().Unix()
+	invHTLC := lnchat.InvoiceHTLC{
+		ChanID:        hop.ChannelID,
+		Amount:        hop.AmtToForward,
+		ExpiryHeight:  int32(hop.Expiry),
+		State:         lnrpc.InvoiceHTLCState_ACCEPTED,
+		AcceptTimeSec: now,
+		CustomRecords: copyRecords(records),
+	}
+
+	preimage := ampPreimage
+	if keysend, ok := records[record.KeySendType]; ok {
+		p, err := lntypes.MakePreimage(keysend)
+		if err != nil {
+			h.fail(lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS)
+			return
+		}
+		preimage = &p
+	}
+
+	// Spontaneous payment.
+	if preimage != nil {
+		if preimage.Hash() != h.hash {
+			h.fail(lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS)
+			return
+		}
+
+		i, err := n.addInvoice("", h.hash, preimage, hop.AmtToForward, 0, false, false)
+		if err != nil {
+			h.fail(lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS)
+			return
+		}
+		i.inv.Htlcs = []lnchat.InvoiceHTLC{invHTLC}
+		i.inv.AmtPaid = hop.AmtToForward
+		n.settleInvoice(i)
+		h.settle(*preimage)
+		return
+	}
+
+	i, ok := n.invoices[h.hash]
+	switch {
+	case !ok, i.inv.State != lnchat.InvoiceOPEN,
+		i.inv.CreatedTimeSec+i.inv.Expiry < now,
+		hop.AmtToForward < i.inv.Value:
+
+		h.fail(lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS)
+		return
+	}
+
+	i.inv.Htlcs = append(i.inv.Htlcs, invHTLC)
+	i.inv.AmtPaid = hop.AmtToForward
+
+	if i.hold {
+		i.inv.State = lnchat.InvoiceACCEPTED
+		i.htlc = h
+		n.notifyInvoice(i)
+		return
+	}
+
+	preimg, err := lntypes.MakePreimage(i.inv.Preimage)
+	if err != nil {
+		h.fail(lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS)
+		return
+	}
+	n.settleInvoice(i)
+	h.settle(preimg)
+}
+
+// resolveRecipient resolves the destination and amount of a payment
+// from the provided recipient, amount and payment request.
+// Must be called with the network lock held.
+func (n *Node) resolveRecipient(recipient string, amount lnchat.Amount,
+	payReq string) (*Node, int64, *lnchat.PayReq, error) {
+
+	var req *lnchat.PayReq
+	amtMsat, reqAmtMsat, reqDest := amount.Msat(), int64(0), ""
+	if payReq != "" {
+		var err error
+		if req, err = decodePayReq(payReq); err != nil {
+			return nil, 0, nil, err
+		}
+		reqAmtMsat, reqDest = req.Amt.Msat(), req.Destination.String()
+	}
+
+	switch {
+	case reqAmtMsat == 0 && amtMsat == 0:
+		return nil, 0, nil, fmt.Errorf("payment amount has not been specified")
+	case reqAmtMsat != 0 && amtMsat != 0 && reqAmtMsat != amtMsat:
+		return nil, 0, nil, fmt.Errorf("payment request amount " +
+			"non-zero but specified amount differs")
+	case reqAmtMsat != 0:
+		amtMsat = reqAmtMsat
+	}
+	switch {
+	case reqDest == "" && recipient == "":
+		return nil, 0, nil, fmt.Errorf("destination has not been specified")
+	case reqDest != "" && recipient != "" && reqDest != recipient:
+		return nil, 0, nil, fmt.Errorf("specified destination " +
+			"and payment request destination differ")
+	case reqDest != "":
+		recipient = reqDest
+	}
+
+	dest, err := n.net.nodeByAddress(recipient)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return dest, amtMsat, req, nil
+}
+
+// routeState is the best known way for a node
+// to reach the destination of a route.
+type routeState struct {
+	// amtIn is the amount the node must receive
+	// (or send, for the source) to deliver the payment.
+	amtIn int64
+	next  *Node
+	via   *channel
+	hops  int
+}
+
+// routeRestrictions are the restrictions of payment options
+// on the channels and nodes of a route.
+type routeRestrictions struct {
+	outgoingChans map[uint64]bool
+	lastHop       string
+	ignoredNodes  map[string]bool
+	ignoredChans  map[uint64]bool
+	cltvLimit     uint32
+}
+
+func newRouteRestrictions(payOpts lnchat.PaymentOptions) routeRestrictions {
+	r := routeRestrictions{
+		outgoingChans: make(map[uint64]bool),
+		lastHop:       payOpts.LastHopAddress,
+		ignoredNodes:  make(map[string]bool),
+		ignoredChans:  make(map[uint64]bool),
+		cltvLimit:     payOpts.CltvLimit,
+	}
+	for _, id := range payOpts.OutgoingChanIDs {
+		r.outgoingChans[id] = true
+	}
+	for _, addr := range payOpts.IgnoredNodes {
+		r.ignoredNodes[addr] = true
+	}
+	for _, id := range payOpts.IgnoredChannels {
+		r.ignoredChans[id] = true
+	}
+
+	return r
+}
+
+// excludes returns whether a route cannot reach to from over c.
+func (r routeRestrictions) excludes(c *channel, from, to, src, dst *Node) bool {
+	switch {
+	case r.ignoredChans[c.id]:
+		return true
+	case from != src && r.ignoredNodes[from.address]:
+		return true
+	case from == src && len(r.outgoingChans) != 0 && !r.outgoingChans[c.id]:
+		return true
+	case to == dst && r.lastHop != "" && from.address != r.lastHop:
+		return true
+	}
+
+	return false
+}
+
+// findRoute finds the cheapest route from src to dst able to carry amtMsat,
+// respecting the provided restrictions.
+// Private channels are only used as first or last hop of a route.
+// A negative maxFeeMsat does not restrict the route fees.
+// Must be called with the network lock held.
+func (net *Network) findRoute(src, dst *Node, amtMsat int64,
+	finalCltvDelta uint32, maxFeeMsat int64,
+	restrict routeRestrictions) (*lnchat.Route, error) {
+
+	if src == dst {
+		return nil, fmt.Errorf("%w: cannot route payments to self",
+			lnchat.ErrNoRouteFound)
+	}
+
+	// Search backwards from the destination, since the amount
+	// a node forwards depends on the fees of the following hops.
+	best := map[*Node]*routeState{dst: {amtIn: amtMsat}}
+	visited := make(map[*Node]bool)
+	for {
+		var cur *Node
+		for node, s := range best {
+			if visited[node] {
+				continue
+			}
+			if cur == nil || s.amtIn < best[cur].amtIn ||
+				s.amtIn == best[cur].amtIn && s.hops < best[cur].hops ||
+				s.amtIn == best[cur].amtIn && s.hops == best[cur].hops &&
+					node.address < cur.address {
+
+				cur = node
+			}
+		}
+		if cur == nil {
+			return nil, lnchat.ErrNoRouteFound
+		}
+		if cur == src {
+			break
+		}
+		visited[cur] = true
+
+		state := best[cur]
+		for _, c := range net.channels {
+			side := c.side(cur)
+			if side < 0 {
+				continue
+			}
+			prevSide := 1 - side
+			prev := c.nodes[prevSide]
+
+			switch {
+			case visited[prev], prev.closed, cur.closed,
+				prev.peers[cur.address] == nil,
+				c.private && prev != src && cur != dst,
+				c.balance[prevSide] < state.amtIn,
+				prev != src && !c.policy[prevSide].allows(state.amtIn),
+				restrict.excludes(c, prev, cur, src, dst):
+
+				continue
+			}
+
+			amtIn := state.amtIn
+			if prev != src {
+				amtIn += c.policy[prevSide].fee(state.amtIn)
+			}
+			if s, ok := best[prev]; ok && s.amtIn <= amtIn {
+				continue
+			}
+			best[prev] = &routeState{
+				amtIn: amtIn,
+				next:  cur,
+				via:   c,
+				hops:  state.hops + 1,
+			}
+		}
+	}
+
+	fees := best[src].amtIn - amtMsat
+	if maxFeeMsat >= 0 && fees > maxFeeMsat {
+		return nil, fmt.Errorf("%w: route fees %d msat exceed fee limit",
+			lnchat.ErrNoRouteFound, fees)
+	}
+
+	// Walk the route forwards, collecting the node states.
+	var path []*routeState
+	for node := src; node != dst; node = best[node].next {
+		path = append(path, best[node])
+	}
+
+	// Create the hops backwards, since each timelock
+	// depends on the timelocks of the following hops.
+	hops := make([]lnchat.RouteHop, len(path))
+	timeLock := uint32(startHeight) + finalCltvDelta
+	for i := len(path) - 1; i >= 0; i-- {
+		node := path[i].next
+
+		hop := lnchat.RouteHop{
+			ChannelID:    path[i].via.id,
+			NodeID:       lnchat.NodeID{Vertex: route.NewVertex(node.key.PubKey())},
+			AmtToForward: lnchat.NewAmount(amtMsat),
+			Expiry:       timeLock,
+		}
+		if i < len(path)-1 {
+			out := path[i+1]
+			hop.AmtToForward = lnchat.NewAmount(best[out.next].amtIn)
+			hop.Fees = lnchat.NewAmount(out.amtIn - best[out.next].amtIn)
+
+			// The HTLC arriving at a forwarding node must expire
+			// later than the one it offers, by its timelock delta.
+			timeLock += out.via.policy[out.via.side(node)].timeLockDelta
+		}
+		hops[i] = hop
+	}
+	if restrict.cltvLimit != 0 && timeLock-startHeight > restrict.cltvLimit {
+		return nil, fmt.Errorf("%w: route timelock delta %d exceeds limit",
+			lnchat.ErrNoRouteFound, timeLock-startHeight)
+	}
+
+	return &lnchat.Route{
+		TimeLock: timeLock,
+		Amt:      lnchat.NewAmount(amtMsat),
+		Fees:     lnchat.NewAmount(fees),
+		Hops:     hops,
+	}, nil
+}
+
+// SubscribePaymentUpdates returns a channel over which payment updates
+// matching the filter are received.
+// The current state of payments after startIdx is delivered first.
+func (n *Node) SubscribePaymentUpdates(ctx context.Context, startIdx uint64,
+	filter lnchat.PaymentUpdateFilter) (<-chan lnchat.PaymentUpdate, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+
+	sub := n.subscribe(ctx, n.paymentSubs)
+	var payments []*lnchat.Payment
+	for _, p := range n.payments {
+		if p.PaymentIndex > startIdx {
+			payments = append(payments, p)
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].PaymentIndex < payments[j].PaymentIndex
+	})
+	for _, p := range payments {
+		sub.notify(copyPayment(p))
+	}
+	n.unlock()
+
+	updates := make(chan lnchat.PaymentUpdate)
+	go func() {
+		defer close(updates)
+
+		sub.forward(ctx, func(u interface{}) bool {
+			p := u.(*lnchat.Payment)
+			if !filter(p) {
+				return true
+			}
+
+			select {
+			case <-ctx.Done():
+				return false
+			case <-sub.quit:
+				return false
+			case updates <- lnchat.PaymentUpdate{Payment: p}:
+				return true
+			}
+		})
+	}()
+
+	return updates, nil
+}
+
+// ListPayments returns up to maxPayments payments in ascending order,
+// starting after the payment with payment index indexOffset.
+func (n *Node) ListPayments(_ context.Context,
+	indexOffset, maxPayments uint64) ([]*lnchat.Payment, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	var payments []*lnchat.Payment
+	for _, p := range n.payments {
+		if p.PaymentIndex > indexOffset {
+			payments = append(payments, p)
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].PaymentIndex < payments[j].PaymentIndex
+	})
+	if uint64(len(payments)) > maxPayments {
+		payments = payments[:maxPayments]
+	}
+
+	res := make([]*lnchat.Payment, len(payments))
+	for i, p := range payments {
+		res[i] = copyPayment(p)
+	}
+
+	return res, nil
+}
+
+// SendPayment sends a payment to the recipient or the payment request,
+// returning a channel over which payment updates matching the filter
+// are received. The channel is closed once the payment is resolved.
+// Payments are routed over the cheapest route able to carry them,
+// and, as in lnd, a zero fee limit allows no fees.
+// Payments are not split into multiple parts.
+func (n *Node) SendPayment(ctx context.Context, recipient string,
+	amount lnchat.Amount, payReq string, payOpts lnchat.PaymentOptions,
+	payload map[uint64][]byte, filter lnchat.PaymentUpdateFilter) (
+	<-chan lnchat.PaymentUpdate, error) {
+
+	// Spontaneous payments carry their preimage, in the custom records
+	// for keysend payments, or implicitly for AMP payments.
+	preimage, err := newPreimage()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	dest, amtMsat, req, err := n.resolveRecipient(recipient, amount, payReq)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, records := preimage.Hash(), copyRecords(payload)
+	if records == nil {
+		records = make(map[uint64][]byte)
+	}
+	finalCltvDelta := uint32(payOpts.FinalCltvDelta)
+	var ampPreimage *lntypes.Preimage
+	switch {
+	case req != nil:
+		if hash, err = lntypes.MakeHashFromStr(req.Hash); err != nil {
+			return nil, err
+		}
+		finalCltvDelta = uint32(req.CltvExpiry)
+	case payOpts.AMP:
+		ampPreimage = &preimage
+	default:
+		records[record.KeySendType] = preimage[:]
+	}
+	if finalCltvDelta == 0 {
+		finalCltvDelta = defaultFinalCltvDelta
+	}
+
+	if err := n.checkPayable(hash); err != nil {
+		return nil, err
+	}
+
+	sub := n.subscribe(ctx, n.paymentSubs)
+
+	p := n.newPayment(hash, payReq, amtMsat)
+	n.notifyPayment(p)
+	switch rt, err := n.net.findRoute(n, dest, amtMsat, finalCltvDelta,
+		payOpts.FeeLimitMsat, newRouteRestrictions(payOpts)); err {
+	case nil:
+		n.sendHTLC(p, hash, rt, records, ampPreimage)
+	default:
+		p.Status = lnchat.PaymentFAILED
+		p.FailureReason = lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE
+		if n.localBalance() < amtMsat {
+			p.FailureReason = lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE
+		}
+		n.notifyPayment(p)
+	}
+
+	updates := make(chan lnchat.PaymentUpdate)
+	go func() {
+		defer close(updates)
+		defer sub.stop()
+
+		sub.forward(ctx, func(u interface{}) bool {
+			p := u.(*lnchat.Payment)
+			if p.Hash != hash.String() {
+				return true
+			}
+			final := p.Status == lnchat.PaymentSUCCEEDED ||
+				p.Status == lnchat.PaymentFAILED
+			if !filter(p) {
+				return !final
+			}
+
+			select {
+			case <-ctx.Done():
+				return false
+			case <-sub.quit:
+				return false
+			case updates <- lnchat.PaymentUpdate{Payment: p}:
+				return !final
+			}
+		})
+	}()
+
+	return updates, nil
+}
+
+// SendToRoute sends a spontaneous payment along the provided route
+// and returns the resulting payment.
+// The payload is placed on the final hop of the route.
+func (n *Node) SendToRoute(_ context.Context, rt *lnchat.Route,
+	payload map[uint64][]byte) (*lnchat.Payment, error) {
+
+	if rt == nil || len(rt.Hops) == 0 {
+		return nil, fmt.Errorf("cannot send to empty route")
+	}
+
+	preimage, err := newPreimage()
+	if err != nil {
+		return nil, err
+	}
+	hash := preimage.Hash()
+
+	records := copyRecords(payload)
+	if records == nil {
+		records = make(map[uint64][]byte)
+	}
+	records[record.KeySendType] = preimage[:]
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	if _, err := n.net.nodeByAddress(rt.Hops[len(rt.Hops)-1].NodeID.String()); err != nil {
+		return nil, err
+	}
+
+	p := n.newPayment(hash, "", rt.Amt.Msat())
+	n.notifyPayment(p)
+	n.sendHTLC(p, hash, rt, records, nil)
+
+	return copyPayment(p), nil
+}
+
+// GetRoute returns the cheapest route able to carry a payment
+// to the recipient or the payment request, respecting the route
+// restrictions of the payment options.
+// A zero fee limit does not restrict the route fees.
+// Since the network state is fully known, the success probability is 1,
+// which meets any minimum success probability.
+func (n *Node) GetRoute(_ context.Context, recipient string, amount lnchat.Amount,
+	payReq string, payOpts lnchat.PaymentOptions, payload map[uint64][]byte) (
+	*lnchat.Route, float64, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, .0, err
+	}
+	defer n.unlock()
+
+	dest, amtMsat, req, err := n.resolveRecipient(recipient, amount, payReq)
+	if err != nil {
+		return nil, .0, err
+	}
+
+	finalCltvDelta := uint32(payOpts.FinalCltvDelta)
+	if req != nil {
+		finalCltvDelta = uint32(req.CltvExpiry)
+	}
+	if finalCltvDelta == 0 {
+		finalCltvDelta = defaultFinalCltvDelta
+	}
+	maxFeeMsat := payOpts.FeeLimitMsat
+	if maxFeeMsat == 0 {
+		maxFeeMsat = -1
+	}
+
+	rt, err := n.net.findRoute(n, dest, amtMsat, finalCltvDelta,
+		maxFeeMsat, newRouteRestrictions(payOpts))
+	if err != nil {
+		return nil, .0, err
+	}
+
+	// As with lnd route queries, the final hop carries
+	// the payload along with a (zero) keysend preimage.
+	records := copyRecords(payload)
+	if records == nil {
+		records = make(map[uint64][]byte)
+	}
+	records[record.KeySendType] = make([]byte, lntypes.PreimageSize)
+	rt.Hops[len(rt.Hops)-1].CustomRecords = records
+
+	return rt, 1., nil
+}
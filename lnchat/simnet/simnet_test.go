@@ -0,0 +1,650 @@
+package simnet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+const (
+	testTimeout = 5 * time.Second
+	// testRecordType is a custom record type used in tests.
+	testRecordType = 0x117C17A7
+)
+
+// createLineNetwork creates a network of three nodes,
+// with channels from the first to the second
+// and from the second to the third node.
+func createLineNetwork(t *testing.T) (*Node, *Node, *Node) {
+	net := NewNetwork()
+
+	alice, err := net.AddNode("alice", 10000000)
+	require.NoError(t, err)
+	bob, err := net.AddNode("bob", 10000000)
+	require.NoError(t, err)
+	carol, err := net.AddNode("carol", 10000000)
+	require.NoError(t, err)
+
+	_, err = net.ConnectNodes(alice, bob, 1000000, 0, false)
+	require.NoError(t, err)
+	_, err = net.ConnectNodes(bob, carol, 1000000, 0, false)
+	require.NoError(t, err)
+
+	return alice, bob, carol
+}
+
+func allPayments(*lnchat.Payment) bool { return true }
+
+func allInvoices(*lnchat.Invoice) bool { return true }
+
+// waitPayment returns the final payment update of a payment.
+func waitPayment(t *testing.T, updates <-chan lnchat.PaymentUpdate) *lnchat.Payment {
+	var last *lnchat.Payment
+	for {
+		select {
+		case u, ok := <-updates:
+			if !ok {
+				require.NotNil(t, last)
+				return last
+			}
+			require.NoError(t, u.Err)
+			last = u.Payment
+		case <-time.After(testTimeout):
+			require.FailNow(t, "timed out waiting for payment updates")
+		}
+	}
+}
+
+// waitInvoice returns the first invoice update in the provided state.
+func waitInvoice(t *testing.T, updates <-chan lnchat.InvoiceUpdate,
+	state lnchat.InvoiceState) *lnchat.Invoice {
+
+	for {
+		select {
+		case u, ok := <-updates:
+			require.True(t, ok, "invoice subscription terminated")
+			require.NoError(t, u.Err)
+			if u.Inv.State == state {
+				return u.Inv
+			}
+		case <-time.After(testTimeout):
+			require.FailNow(t, "timed out waiting for invoice updates")
+		}
+	}
+}
+
+func TestSendPaymentKeysend(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, bob, carol := createLineNetwork(t)
+
+	invUpdates, err := carol.SubscribeInvoiceUpdates(ctx, 0, allInvoices)
+	require.NoError(t, err)
+
+	payload := map[uint64][]byte{
+		testRecordType: []byte("payload"),
+	}
+	amt := lnchat.NewAmount(10000)
+	updates, err := alice.SendPayment(ctx, carol.Address(), amt, "",
+		lnchat.PaymentOptions{FeeLimitMsat: 5000}, payload, allPayments)
+	require.NoError(t, err)
+
+	payment := waitPayment(t, updates)
+	require.Equal(t, lnchat.PaymentSUCCEEDED, payment.Status)
+	require.Len(t, payment.Htlcs, 1)
+
+	// Alice pays the forwarding fee of Bob.
+	rt := payment.Htlcs[0].Route
+	require.Len(t, rt.Hops, 2)
+	assert.Equal(t, bob.Address(), rt.Hops[0].NodeID.String())
+	assert.Equal(t, carol.Address(), rt.Hops[1].NodeID.String())
+	assert.Equal(t, int64(10000), rt.Hops[1].AmtToForward.Msat())
+	assert.Equal(t, int64(1000), rt.Fees.Msat())
+
+	preimage, err := lntypes.MakePreimageFromStr(payment.Preimage)
+	require.NoError(t, err)
+	assert.Equal(t, payment.Hash, preimage.Hash().String())
+
+	inv := waitInvoice(t, invUpdates, lnchat.InvoiceSETTLED)
+	assert.Equal(t, payment.Hash, inv.Hash)
+	assert.Equal(t, int64(10000), inv.AmtPaid.Msat())
+	require.Len(t, inv.Htlcs, 1)
+	assert.Equal(t, []byte("payload"),
+		inv.Htlcs[0].CustomRecords[testRecordType])
+	assert.Equal(t, preimage[:],
+		inv.Htlcs[0].CustomRecords[record.KeySendType])
+
+	balance, err := carol.GetSelfBalance(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10000), balance.ChannelBalance.LocalMsat)
+}
+
+func TestSendPaymentFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, _, carol := createLineNetwork(t)
+
+	cases := []struct {
+		name    string
+		amtMsat int64
+		payOpts lnchat.PaymentOptions
+	}{
+		{
+			name:    "fee limit",
+			amtMsat: 10000,
+			payOpts: lnchat.PaymentOptions{FeeLimitMsat: 999},
+		},
+		{
+			name:    "insufficient liquidity",
+			amtMsat: 2000000000,
+			payOpts: lnchat.PaymentOptions{FeeLimitMsat: 1000000},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			updates, err := alice.SendPayment(ctx, carol.Address(),
+				lnchat.NewAmount(c.amtMsat), "", c.payOpts, nil, allPayments)
+			require.NoError(t, err)
+
+			payment := waitPayment(t, updates)
+			assert.Equal(t, lnchat.PaymentFAILED, payment.Status)
+		})
+	}
+}
+
+func TestForwarding(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, bob, carol := createLineNetwork(t)
+
+	inChannels, err := bob.ListChannels(ctx, lnchat.ChannelFilter{Peer: alice.Address()})
+	require.NoError(t, err)
+	require.Len(t, inChannels, 1)
+	outChannels, err := bob.ListChannels(ctx, lnchat.ChannelFilter{Peer: carol.Address()})
+	require.NoError(t, err)
+	require.Len(t, outChannels, 1)
+	chanPoint := outChannels[0].ChannelPoint
+
+	err = bob.UpdateChannelPolicy(ctx, &chanPoint, lnchat.ChannelPolicy{
+		BaseFeeMsat:   2000,
+		FeeRatePPM:    100000,
+		TimeLockDelta: 80,
+		MinHTLCMsat:   5000,
+		MaxHTLCMsat:   50000,
+	})
+	require.NoError(t, err)
+
+	policy, err := bob.GetChannelPolicy(ctx, chanPoint)
+	require.NoError(t, err)
+	assert.Equal(t, &lnchat.ChannelPolicy{
+		BaseFeeMsat:   2000,
+		FeeRatePPM:    100000,
+		TimeLockDelta: 80,
+		MinHTLCMsat:   5000,
+		MaxHTLCMsat:   50000,
+	}, policy)
+
+	pay := func(amtMsat int64) *lnchat.Payment {
+		updates, err := alice.SendPayment(ctx, carol.Address(),
+			lnchat.NewAmount(amtMsat), "",
+			lnchat.PaymentOptions{FeeLimitMsat: 10000}, nil, allPayments)
+		require.NoError(t, err)
+
+		return waitPayment(t, updates)
+	}
+
+	// Payments outside the forwarding limits cannot be routed.
+	assert.Equal(t, lnchat.PaymentFAILED, pay(1000).Status)
+	assert.Equal(t, lnchat.PaymentFAILED, pay(60000).Status)
+
+	payment := pay(10000)
+	require.Equal(t, lnchat.PaymentSUCCEEDED, payment.Status)
+	assert.Equal(t, int64(3000), payment.Htlcs[0].Route.Fees.Msat())
+
+	events, err := bob.ForwardingHistory(ctx, 0, 0, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, uint64(1), events[0].Index)
+	assert.Equal(t, inChannels[0].ChannelID, events[0].ChanIDIn)
+	assert.Equal(t, outChannels[0].ChannelID, events[0].ChanIDOut)
+	assert.Equal(t, uint64(13000), events[0].AmtInMsat)
+	assert.Equal(t, uint64(10000), events[0].AmtOutMsat)
+	assert.Equal(t, uint64(3000), events[0].FeeMsat)
+
+	events, err = bob.ForwardingHistory(ctx, 0, 0, 1, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	events, err = alice.ForwardingHistory(ctx, 0, 0, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	_, err = alice.GetChannelPolicy(ctx, chanPoint)
+	assert.ErrorIs(t, err, ErrChannelNotFound)
+}
+
+func TestRouteRestrictions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	// Alice reaches Dave through either Bob or Carol.
+	net := NewNetwork()
+	nodes := make(map[string]*Node)
+	for _, name := range []string{"alice", "bob", "carol", "dave"} {
+		node, err := net.AddNode(name, 10000000)
+		require.NoError(t, err)
+		nodes[name] = node
+	}
+	for _, pair := range [][2]string{
+		{"alice", "bob"}, {"alice", "carol"}, {"bob", "dave"}, {"carol", "dave"},
+	} {
+		_, err := net.ConnectNodes(nodes[pair[0]], nodes[pair[1]], 1000000, 0, false)
+		require.NoError(t, err)
+	}
+	alice, dave := nodes["alice"], nodes["dave"]
+
+	chanID := func(from, to string) uint64 {
+		channels, err := nodes[from].ListChannels(ctx,
+			lnchat.ChannelFilter{Peer: nodes[to].Address()})
+		require.NoError(t, err)
+		require.Len(t, channels, 1)
+		return channels[0].ChannelID
+	}
+
+	cases := []struct {
+		name    string
+		payOpts lnchat.PaymentOptions
+		via     string
+	}{
+		{
+			name:    "ignored node",
+			payOpts: lnchat.PaymentOptions{IgnoredNodes: []string{nodes["bob"].Address()}},
+			via:     "carol",
+		},
+		{
+			name:    "ignored channel",
+			payOpts: lnchat.PaymentOptions{IgnoredChannels: []uint64{chanID("carol", "dave")}},
+			via:     "bob",
+		},
+		{
+			name:    "outgoing channel",
+			payOpts: lnchat.PaymentOptions{OutgoingChanIDs: []uint64{chanID("alice", "carol")}},
+			via:     "carol",
+		},
+		{
+			name:    "last hop",
+			payOpts: lnchat.PaymentOptions{LastHopAddress: nodes["bob"].Address()},
+			via:     "bob",
+		},
+		{
+			name: "ignored last hop",
+			payOpts: lnchat.PaymentOptions{
+				LastHopAddress: nodes["bob"].Address(),
+				IgnoredNodes:   []string{nodes["bob"].Address()},
+			},
+		},
+		{
+			name:    "timelock limit",
+			payOpts: lnchat.PaymentOptions{CltvLimit: defaultFinalCltvDelta},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rt, _, err := alice.GetRoute(ctx, dave.Address(),
+				lnchat.NewAmount(10000), "", c.payOpts, nil)
+			if c.via == "" {
+				assert.ErrorIs(t, err, lnchat.ErrNoRouteFound)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, rt.Hops, 2)
+			assert.Equal(t, nodes[c.via].Address(), rt.Hops[0].NodeID.String())
+
+			payOpts := c.payOpts
+			payOpts.FeeLimitMsat = 5000
+			updates, err := alice.SendPayment(ctx, dave.Address(),
+				lnchat.NewAmount(10000), "", payOpts, nil, allPayments)
+			require.NoError(t, err)
+			payment := waitPayment(t, updates)
+			require.Equal(t, lnchat.PaymentSUCCEEDED, payment.Status)
+			assert.Equal(t, nodes[c.via].Address(),
+				payment.Htlcs[0].Route.Hops[0].NodeID.String())
+		})
+	}
+}
+
+func TestPayInvoice(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, _, carol := createLineNetwork(t)
+
+	inv, err := carol.CreateInvoice(ctx, "memo", lnchat.NewAmount(5000), 0, false)
+	require.NoError(t, err)
+
+	payReq, err := alice.DecodePayReq(ctx, inv.PaymentRequest)
+	require.NoError(t, err)
+	assert.Equal(t, carol.Address(), payReq.Destination.String())
+	assert.Equal(t, inv.Hash, payReq.Hash)
+	assert.Equal(t, int64(5000), payReq.Amt.Msat())
+
+	updates, err := alice.SendPayment(ctx, "", lnchat.NewAmount(0),
+		inv.PaymentRequest, lnchat.PaymentOptions{FeeLimitMsat: 5000},
+		nil, allPayments)
+	require.NoError(t, err)
+	payment := waitPayment(t, updates)
+	require.Equal(t, lnchat.PaymentSUCCEEDED, payment.Status)
+
+	inv, err = carol.LookupInvoice(ctx, inv.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, lnchat.InvoiceSETTLED, inv.State)
+	assert.Equal(t, payment.Preimage, lntypes.Preimage(*(*[32]byte)(inv.Preimage)).String())
+
+	// The invoice cannot be paid twice.
+	_, err = alice.SendPayment(ctx, "", lnchat.NewAmount(0),
+		inv.PaymentRequest, lnchat.PaymentOptions{FeeLimitMsat: 5000},
+		nil, allPayments)
+	assert.ErrorIs(t, err, ErrAlreadyPaid)
+}
+
+func TestListHistory(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, _, carol := createLineNetwork(t)
+
+	for i := 0; i < 3; i++ {
+		updates, err := alice.SendPayment(ctx, carol.Address(),
+			lnchat.NewAmount(1000), "", lnchat.PaymentOptions{FeeLimitMsat: 5000},
+			nil, allPayments)
+		require.NoError(t, err)
+		require.Equal(t, lnchat.PaymentSUCCEEDED, waitPayment(t, updates).Status)
+	}
+
+	payments, err := alice.ListPayments(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, payments, 2)
+	assert.Less(t, payments[0].PaymentIndex, payments[1].PaymentIndex)
+
+	payments, err = alice.ListPayments(ctx, payments[1].PaymentIndex, 2)
+	require.NoError(t, err)
+	require.Len(t, payments, 1)
+
+	invoices, err := carol.ListInvoices(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, invoices, 3)
+	for _, inv := range invoices {
+		assert.Equal(t, lnchat.InvoiceSETTLED, inv.State)
+	}
+
+	invoices, err = carol.ListInvoices(ctx, invoices[2].AddIndex, 10)
+	require.NoError(t, err)
+	assert.Empty(t, invoices)
+}
+
+func TestHoldInvoice(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, bob, _ := createLineNetwork(t)
+
+	preimage, err := newPreimage()
+	require.NoError(t, err)
+
+	inv, err := bob.CreateHoldInvoice(ctx, "hold", preimage.Hash().String(),
+		lnchat.NewAmount(3000), 0, false)
+	require.NoError(t, err)
+
+	invUpdates, err := bob.SubscribeInvoiceUpdates(ctx, 0, allInvoices)
+	require.NoError(t, err)
+
+	updates, err := alice.SendPayment(ctx, "", lnchat.NewAmount(0),
+		inv.PaymentRequest, lnchat.PaymentOptions{}, nil, allPayments)
+	require.NoError(t, err)
+
+	accepted := waitInvoice(t, invUpdates, lnchat.InvoiceACCEPTED)
+	require.Len(t, accepted.Htlcs, 1)
+	assert.Equal(t, lnrpc.InvoiceHTLCState_ACCEPTED, accepted.Htlcs[0].State)
+
+	require.NoError(t, bob.SettleInvoice(ctx, preimage.String()))
+
+	payment := waitPayment(t, updates)
+	assert.Equal(t, lnchat.PaymentSUCCEEDED, payment.Status)
+	assert.Equal(t, preimage.String(), payment.Preimage)
+
+	settled := waitInvoice(t, invUpdates, lnchat.InvoiceSETTLED)
+	assert.Equal(t, int64(3000), settled.AmtPaid.Msat())
+}
+
+func TestSendToRoute(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, _, carol := createLineNetwork(t)
+
+	rt, prob, err := alice.GetRoute(ctx, carol.Address(), lnchat.NewAmount(2000),
+		"", lnchat.PaymentOptions{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1., prob)
+	require.Len(t, rt.Hops, 2)
+
+	payment, err := alice.SendToRoute(ctx, rt, map[uint64][]byte{
+		testRecordType: []byte("routed"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, lnchat.PaymentSUCCEEDED, payment.Status)
+
+	inv, err := carol.LookupInvoice(ctx, payment.Hash)
+	require.NoError(t, err)
+	require.Len(t, inv.Htlcs, 1)
+	assert.Equal(t, []byte("routed"),
+		inv.Htlcs[0].CustomRecords[testRecordType])
+}
+
+func TestMissionControl(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, bob, carol := createLineNetwork(t)
+
+	rt, _, err := alice.GetRoute(ctx, carol.Address(), lnchat.NewAmount(2000),
+		"", lnchat.PaymentOptions{}, nil)
+	require.NoError(t, err)
+
+	// Raising the fee of Bob invalidates the route at the second hop.
+	require.NoError(t, bob.UpdateChannelPolicy(ctx, nil, lnchat.ChannelPolicy{
+		BaseFeeMsat:   5000,
+		TimeLockDelta: defaultTimeLockDelta,
+	}))
+
+	payment, err := alice.SendToRoute(ctx, rt, nil)
+	require.NoError(t, err)
+	require.Equal(t, lnchat.PaymentFAILED, payment.Status)
+
+	pairs, err := alice.QueryMissionControl(ctx)
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+	for _, p := range pairs {
+		switch p.NodeFrom {
+		case alice.Address():
+			assert.Equal(t, bob.Address(), p.NodeTo)
+			assert.NotZero(t, p.SuccessTimeSec)
+			assert.Zero(t, p.FailTimeSec)
+		case bob.Address():
+			assert.Equal(t, carol.Address(), p.NodeTo)
+			assert.NotZero(t, p.FailTimeSec)
+			assert.Equal(t, int64(2000), p.FailAmtMsat)
+		default:
+			t.Fatalf("unexpected pair from %s", p.NodeFrom)
+		}
+	}
+
+	prob, history, err := alice.QueryProbability(ctx,
+		bob.Address(), carol.Address(), lnchat.NewAmount(3000))
+	require.NoError(t, err)
+	assert.Equal(t, 0., prob)
+	assert.NotZero(t, history.FailTimeSec)
+
+	prob, _, err = alice.QueryProbability(ctx,
+		bob.Address(), carol.Address(), lnchat.NewAmount(1000))
+	require.NoError(t, err)
+	assert.Equal(t, 1., prob)
+
+	require.NoError(t, alice.ResetMissionControl(ctx))
+
+	pairs, err = alice.QueryMissionControl(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pairs)
+
+	prob, history, err = alice.QueryProbability(ctx,
+		bob.Address(), carol.Address(), lnchat.NewAmount(3000))
+	require.NoError(t, err)
+	assert.Equal(t, 1., prob)
+	assert.Zero(t, history.FailTimeSec)
+}
+
+func TestSignMessage(t *testing.T) {
+	ctx := context.Background()
+
+	alice, bob, _ := createLineNetwork(t)
+
+	msg := []byte("message")
+	sig, err := alice.SignMessage(ctx, msg)
+	require.NoError(t, err)
+
+	address, err := bob.VerifySignatureExtractPubkey(ctx, msg, sig)
+	require.NoError(t, err)
+	assert.Equal(t, alice.Address(), address)
+
+	address, err = lnchat.RecoverSignerAddress(msg, sig)
+	require.NoError(t, err)
+	assert.Equal(t, alice.Address(), address)
+}
+
+func TestCustomMessages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, bob, carol := createLineNetwork(t)
+
+	msgs, err := bob.SubscribeCustomMessages(ctx)
+	require.NoError(t, err)
+
+	records := map[uint64][]byte{testRecordType: []byte("hello")}
+	require.NoError(t, alice.SendCustomMessage(ctx, bob.Address(), records))
+
+	select {
+	case u := <-msgs:
+		require.NoError(t, u.Err)
+		assert.Equal(t, alice.Address(), u.Msg.Peer)
+		assert.Equal(t, records, u.Msg.Records)
+	case <-time.After(testTimeout):
+		require.FailNow(t, "timed out waiting for custom message")
+	}
+
+	// Alice and Carol are not peers.
+	err = alice.SendCustomMessage(ctx, carol.Address(), records)
+	assert.ErrorIs(t, err, ErrNotConnected)
+}
+
+func TestPeerEvents(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	alice, _, carol := createLineNetwork(t)
+
+	events, err := carol.SubscribePeerEvents(ctx)
+	require.NoError(t, err)
+
+	expectEvent := func(typ lnchat.PeerEventType) {
+		select {
+		case u := <-events:
+			require.NoError(t, u.Err)
+			assert.Equal(t, alice.Address(), u.Event.Address)
+			assert.Equal(t, typ, u.Event.Type)
+		case <-time.After(testTimeout):
+			require.FailNowf(t, "timed out waiting for peer event", "%v", typ)
+		}
+	}
+
+	require.NoError(t, alice.ConnectNode(ctx, carol.Address(), "127.0.0.1:9735"))
+	expectEvent(lnchat.PeerONLINE)
+
+	require.NoError(t, alice.DisconnectPeer(ctx, carol.Address()))
+	expectEvent(lnchat.PeerOFFLINE)
+}
+
+func TestSendCoins(t *testing.T) {
+	ctx := context.Background()
+
+	alice, _, carol := createLineNetwork(t)
+
+	address, err := carol.NewAddress(ctx, lnchat.AddressNESTEDPUBKEYHASH)
+	require.NoError(t, err)
+
+	estimate, err := alice.EstimateFee(ctx, address, 100000, 6)
+	require.NoError(t, err)
+
+	txid, err := alice.SendCoins(ctx, address, 100000, false, lnchat.TxFeeOptions{})
+	require.NoError(t, err)
+
+	balance, err := alice.GetSelfBalance(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9000000-100000)-estimate.FeeSat, balance.WalletConfirmedBalanceSat)
+	balance, err = carol.GetSelfBalance(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000000+100000), balance.WalletConfirmedBalanceSat)
+
+	txs, err := carol.ListTransactions(ctx, 0, -1)
+	require.NoError(t, err)
+	require.Len(t, txs, 1)
+	assert.Equal(t, txid, txs[0].Txid)
+	assert.Equal(t, int64(100000), txs[0].AmountSat)
+	assert.Equal(t, []string{address}, txs[0].DestAddresses)
+
+	utxos, err := carol.ListUnspent(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+	assert.Equal(t, int64(10100000), utxos[0].AmountSat)
+
+	// Carol sweeps her wallet to an address outside the network.
+	dave, err := NewNetwork().AddNode("dave", 0)
+	require.NoError(t, err)
+	address, err = dave.NewAddress(ctx, lnchat.AddressWITNESSPUBKEYHASH)
+	require.NoError(t, err)
+	_, err = carol.SendCoins(ctx, address, 0, true, lnchat.TxFeeOptions{SatPerVByte: 1})
+	require.NoError(t, err)
+	utxos, err = carol.ListUnspent(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, utxos)
+
+	_, err = alice.SendCoins(ctx, address, 100000000, false, lnchat.TxFeeOptions{})
+	assert.ErrorIs(t, err, ErrInsufficientFunds)
+	_, err = alice.SendCoins(ctx, "invalid", 1000, false, lnchat.TxFeeOptions{})
+	assert.ErrorIs(t, err, lnchat.ErrInvalidAddress)
+}
+
+func TestClose(t *testing.T) {
+	ctx := context.Background()
+
+	alice, _, _ := createLineNetwork(t)
+
+	require.NoError(t, alice.Close())
+
+	_, err := alice.GetSelfInfo(ctx)
+	assert.ErrorIs(t, err, ErrNodeClosed)
+	assert.ErrorIs(t, err, lnchat.ErrNetworkUnavailable)
+}
@@ -0,0 +1,69 @@
+package simnet
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriber queues the updates of a subscription,
+// so that notifying it never blocks on a slow consumer.
+type subscriber struct {
+	mtx   sync.Mutex
+	queue []interface{}
+
+	signal chan struct{}
+	quit   chan struct{}
+	once   sync.Once
+}
+
+func newSubscriber() *subscriber {
+	return &subscriber{
+		signal: make(chan struct{}, 1),
+		quit:   make(chan struct{}),
+	}
+}
+
+// notify queues an update for delivery.
+func (s *subscriber) notify(update interface{}) {
+	s.mtx.Lock()
+	s.queue = append(s.queue, update)
+	s.mtx.Unlock()
+
+	select {
+	case s.signal <- struct{}{}:
+	default:
+	}
+}
+
+// stop terminates update delivery.
+func (s *subscriber) stop() {
+	s.once.Do(func() {
+		close(s.quit)
+	})
+}
+
+// forward delivers the queued updates in order through deliver,
+// until the context is done, the subscriber is stopped
+// or deliver returns false.
+func (s *subscriber) forward(ctx context.Context, deliver func(interface{}) bool) {
+	for {
+		s.mtx.Lock()
+		updates := s.queue
+		s.queue = nil
+		s.mtx.Unlock()
+
+		for _, u := range updates {
+			if !deliver(u) {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.quit:
+			return
+		case <-s.signal:
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package simnet
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+const (
+	// defaultFeeRateSatPerVByte is the fee rate of on-chain payments,
+	// used if not specified.
+	defaultFeeRateSatPerVByte = 10
+	// txVBytes is the (constant) virtual size of simulated transactions.
+	txVBytes = 141
+)
+
+// newTxid generates a unique (simulated) transaction ID.
+// Must be called with the network lock held.
+func (net *Network) newTxid() string {
+	net.numTxs++
+
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(net.numTxs))
+
+	return chainhash.HashH(append([]byte("tx"), b[:]...)).String()
+}
+
+// NewAddress generates a new address of the node wallet.
+// Coins sent to the address by other nodes credit the node wallet.
+func (n *Node) NewAddress(_ context.Context, addrType lnchat.AddressType) (string, error) {
+	if err := n.lock(); err != nil {
+		return "", err
+	}
+	defer n.unlock()
+
+	n.numAddresses++
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], n.numAddresses)
+	keyHash := btcutil.Hash160(append(n.key.PubKey().SerializeCompressed(), b[:]...))
+
+	witnessAddr, err := btcutil.NewAddressWitnessPubKeyHash(keyHash, chainParams)
+	if err != nil {
+		return "", err
+	}
+
+	var addr btcutil.Address
+	switch addrType {
+	case lnchat.AddressWITNESSPUBKEYHASH:
+		addr = witnessAddr
+	case lnchat.AddressNESTEDPUBKEYHASH:
+		script, err := txscript.PayToAddrScript(witnessAddr)
+		if err != nil {
+			return "", err
+		}
+		if addr, err = btcutil.NewAddressScriptHash(script, chainParams); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unknown address type %d", addrType)
+	}
+
+	n.net.addresses[addr.EncodeAddress()] = n
+
+	return addr.EncodeAddress(), nil
+}
+
+// SendCoins sends an on-chain payment from the node wallet,
+// returning the ID of the (simulated) transaction.
+// Payments to addresses of network nodes credit their wallet,
+// and all transactions are confirmed immediately.
+func (n *Node) SendCoins(_ context.Context, address string, amtSat int64,
+	sendAll bool, txOpts lnchat.TxFeeOptions) (string, error) {
+
+	if err := n.lock(); err != nil {
+		return "", err
+	}
+	defer n.unlock()
+
+	if _, err := btcutil.DecodeAddress(address, chainParams); err != nil {
+		return "", fmt.Errorf("%w: %v", lnchat.ErrInvalidAddress, err)
+	}
+
+	feeSat := estimateFee(txOpts.SatPerVByte).FeeSat
+	switch {
+	case sendAll && amtSat != 0:
+		return "", fmt.Errorf("amount must not be specified when sending all funds")
+	case sendAll:
+		amtSat = n.walletSat - feeSat
+	case amtSat <= 0:
+		return "", fmt.Errorf("invalid amount")
+	}
+	if amtSat <= 0 || amtSat+feeSat > n.walletSat {
+		return "", ErrInsufficientFunds
+	}
+
+	txid := n.net.newTxid()
+	n.walletSat -= amtSat + feeSat
+	n.recordTransaction(txid, -(amtSat + feeSat), feeSat, address)
+
+	if recipient := n.net.addresses[address]; recipient != nil {
+		recipient.walletSat += amtSat
+		recipient.recordTransaction(txid, amtSat, 0, address)
+	}
+
+	return txid, nil
+}
+
+// recordTransaction appends a confirmed transaction to the node history.
+// Must be called with the network lock held.
+func (n *Node) recordTransaction(txid string, amtSat, feeSat int64, address string) {
+	n.transactions = append(n.transactions, lnchat.Transaction{
+		Txid:          txid,
+		AmountSat:     amtSat,
+		FeeSat:        feeSat,
+		Confirmations: 1,
+		BlockHeight:   startHeight,
+		DestAddresses: []string{address},
+	})
+}
+
+// ListUnspent returns the unspent outputs of the node wallet.
+// The whole wallet balance is held in a single confirmed output.
+func (n *Node) ListUnspent(_ context.Context, minConfs, maxConfs int32) ([]lnchat.Utxo, error) {
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	if n.walletSat == 0 || minConfs > 1 || maxConfs < 1 {
+		return nil, nil
+	}
+
+	return []lnchat.Utxo{{
+		AmountSat:     n.walletSat,
+		Txid:          chainhash.HashH([]byte(n.address)).String(),
+		Confirmations: 1,
+	}}, nil
+}
+
+// ListTransactions returns the on-chain transactions of the node
+// within the provided block heights.
+// All transactions are confirmed at the (constant) network height.
+func (n *Node) ListTransactions(_ context.Context,
+	fromHeight, toHeight int32) ([]lnchat.Transaction, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	if fromHeight > startHeight || (toHeight > 0 && toHeight < startHeight) {
+		return nil, nil
+	}
+
+	txs := make([]lnchat.Transaction, len(n.transactions))
+	for i, tx := range n.transactions {
+		tx.DestAddresses = append([]string(nil), tx.DestAddresses...)
+		txs[i] = tx
+	}
+
+	return txs, nil
+}
+
+// EstimateFee estimates the fee of an on-chain payment,
+// which is constant for all simulated transactions.
+func (n *Node) EstimateFee(_ context.Context, _ string,
+	_ int64, _ uint32) (*lnchat.FeeEstimate, error) {
+
+	if err := n.lock(); err != nil {
+		return nil, err
+	}
+	defer n.unlock()
+
+	return estimateFee(0), nil
+}
+
+// estimateFee returns the fee of a simulated transaction
+// at the provided fee rate (or the default, if zero).
+func estimateFee(satPerVByte uint64) *lnchat.FeeEstimate {
+	if satPerVByte == 0 {
+		satPerVByte = defaultFeeRateSatPerVByte
+	}
+
+	return &lnchat.FeeEstimate{
+		FeeSat:      int64(satPerVByte * txVBytes),
+		SatPerVByte: satPerVByte,
+	}
+}
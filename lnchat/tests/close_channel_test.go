@@ -0,0 +1,114 @@
+package itest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+func testCloseChannel(net *lntest.NetworkHarness, t *harnessTest) {
+	type testCase struct {
+		name  string
+		force bool
+	}
+
+	subTests := []testCase{
+		{
+			name:  "Cooperative",
+			force: false,
+		},
+		{
+			name:  "Forced",
+			force: true,
+		},
+	}
+
+	for _, subTest := range subTests {
+		subTest := subTest
+
+		success := t.t.Run(subTest.name, func(t1 *testing.T) {
+			ht := newHarnessTest(t1, net)
+			testCloseChannelSuccess(net, ht, subTest.force)
+		})
+
+		if !success {
+			break
+		}
+	}
+}
+
+func testCloseChannelSuccess(net *lntest.NetworkHarness, t *harnessTest, force bool) {
+	ctxb := context.Background()
+
+	chanAmt := btcutil.Amount(1000000)
+	rpcChanPoint := openChannelAndAssert(
+		t, net, net.Alice, net.Bob,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	fundingTxid, err := lnrpc.GetChanPointFundingTxid(rpcChanPoint)
+	require.NoError(t.t, err)
+
+	chanPoint := lnchat.ChannelPoint{
+		FundingTxid: fundingTxid.String(),
+		OutputIndex: rpcChanPoint.OutputIndex,
+	}
+
+	mgrAlice, err := createNodeManager(net.Alice)
+	require.NoError(t.t, err)
+	defer func() {
+		assert.NoError(t.t, mgrAlice.Close())
+	}()
+
+	// The channel should be listed as open.
+	channels, err := mgrAlice.ListChannels(ctxb, lnchat.ChannelFilter{
+		Peer: net.Bob.PubKeyStr,
+	})
+	require.NoError(t.t, err)
+	require.Len(t.t, channels, 1)
+	assert.Equal(t.t, chanPoint, channels[0].ChannelPoint)
+	assert.Equal(t.t, net.Bob.PubKeyStr, channels[0].RemoteAddress)
+	assert.Equal(t.t, int64(chanAmt)*1000, channels[0].CapacityMsat)
+	assert.True(t.t, channels[0].Initiator)
+
+	ctxt, cancel := context.WithTimeout(ctxb, channelCloseTimeout)
+	defer cancel()
+
+	updates, err := mgrAlice.CloseChannel(ctxt, chanPoint, force,
+		lnchat.TxFeeOptions{})
+	require.NoError(t.t, err)
+
+	// The closing transaction should be reported as pending.
+	update := <-updates
+	require.NoError(t.t, update.Err)
+	require.NotNil(t.t, update.Status)
+	assert.Equal(t.t, lnchat.ChannelClosePENDING, update.Status.State)
+	closingTxid := update.Status.ClosingTxid
+
+	pending, err := mgrAlice.ListPendingChannels(ctxb)
+	require.NoError(t.t, err)
+	require.Len(t.t, pending, 1)
+	assert.Equal(t.t, chanPoint, pending[0].ChannelPoint)
+	assert.Equal(t.t, lnchat.PendingWAITINGCLOSE, pending[0].State)
+	assert.Equal(t.t, closingTxid, pending[0].ClosingTxid)
+
+	// Mine a block to confirm the closing transaction.
+	mineBlocks(t, net, 1, 1)
+
+	update = <-updates
+	require.NoError(t.t, update.Err)
+	require.NotNil(t.t, update.Status)
+	assert.Equal(t.t, lnchat.ChannelCloseCONFIRMED, update.Status.State)
+	assert.Equal(t.t, closingTxid, update.Status.ClosingTxid)
+
+	_, ok := <-updates
+	assert.False(t.t, ok, "update channel should be closed")
+}
@@ -0,0 +1,49 @@
+package itest
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCustomMessage(net *lntest.NetworkHarness, t *harnessTest) {
+	ctxb := context.Background()
+
+	net.EnsureConnected(t.t, net.Alice, net.Bob)
+
+	mgrAlice, err := createNodeManager(net.Alice)
+	require.NoError(t.t, err)
+	defer func() {
+		assert.NoError(t.t, mgrAlice.Close())
+	}()
+
+	mgrBob, err := createNodeManager(net.Bob)
+	require.NoError(t.t, err)
+	defer func() {
+		assert.NoError(t.t, mgrBob.Close())
+	}()
+
+	ctxc, cancel := context.WithCancel(ctxb)
+	defer cancel()
+
+	msgCh, err := mgrBob.SubscribeCustomMessages(ctxc)
+	require.NoError(t.t, err)
+
+	records := map[uint64][]byte{
+		0x117C17A7: []byte("a custom message payload"),
+	}
+	err = mgrAlice.SendCustomMessage(ctxb, net.Bob.PubKeyStr, records)
+	require.NoError(t.t, err)
+
+	select {
+	case update := <-msgCh:
+		require.NoError(t.t, update.Err)
+		assert.Equal(t.t, net.Alice.PubKeyStr, update.Msg.Peer)
+		assert.Equal(t.t, records, update.Msg.Records)
+	case <-time.After(DefaultTimeout):
+		assert.Fail(t.t, "custom message not received")
+	}
+}
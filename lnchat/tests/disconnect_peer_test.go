@@ -0,0 +1,34 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDisconnectPeer(net *lntest.NetworkHarness, t *harnessTest) {
+	ctxb := context.Background()
+
+	net.EnsureConnected(t.t, net.Alice, net.Bob)
+
+	mgrAlice, err := createNodeManager(net.Alice)
+	require.NoError(t.t, err)
+	defer func() {
+		assert.NoError(t.t, mgrAlice.Close())
+	}()
+
+	err = mgrAlice.DisconnectPeer(ctxb, net.Bob.PubKeyStr)
+	require.NoError(t.t, err)
+
+	err = wait.Predicate(func() bool {
+		return !findTargetInPeerList(net.Alice, net.Bob) &&
+			!findTargetInPeerList(net.Bob, net.Alice)
+	}, DefaultTimeout)
+	assert.NoError(t.t, err)
+
+	// Restore the connection for subsequent tests.
+	net.EnsureConnected(t.t, net.Alice, net.Bob)
+}
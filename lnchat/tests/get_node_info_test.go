@@ -0,0 +1,33 @@
+package itest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+func testGetNodeInfo(net *lntest.NetworkHarness, t *harnessTest) {
+	ctxb := context.Background()
+
+	mgrAlice, err := createNodeManager(net.Alice)
+	require.NoError(t.t, err)
+	defer func() {
+		assert.NoError(t.t, mgrAlice.Close())
+	}()
+
+	info, err := mgrAlice.GetNodeInfo(ctxb, net.Alice.PubKeyStr)
+	require.NoError(t.t, err)
+
+	assert.Equal(t.t, net.Alice.PubKeyStr, info.Node.Address)
+	assert.NotEmpty(t.t, info.Features)
+	assert.False(t.t, info.HasChannel)
+
+	unknown := "021111111111111111111111111111111111111111111111111111111111111111"
+	_, err = mgrAlice.GetNodeInfo(ctxb, unknown)
+	assert.True(t.t, errors.Is(err, lnchat.ErrNodeNotFound))
+}
@@ -0,0 +1,44 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+func testHoldInvoice(net *lntest.NetworkHarness, t *harnessTest) {
+	mgrAlice, err := createNodeManager(net.Alice)
+	require.NoError(t.t, err)
+
+	ctxb := context.Background()
+
+	preimage := lntypes.Preimage{0x01, 0x02, 0x03}
+	hash := preimage.Hash()
+
+	memo, amt, expiry := "hold invoice", lnchat.NewAmount(12000), int64(3600)
+	inv, err := mgrAlice.CreateHoldInvoice(ctxb,
+		memo, hash.String(), amt, expiry, false)
+	require.NoError(t.t, err)
+
+	assert.Equal(t.t, memo, inv.Memo)
+	assert.Equal(t.t, hash.String(), inv.Hash)
+	assert.EqualValues(t.t, amt.Msat(), inv.Value.Msat())
+	assert.Equal(t.t, expiry, inv.Expiry)
+	assert.Equal(t.t, lnchat.InvoiceOPEN, inv.State)
+
+	// An open hold invoice cannot be settled.
+	err = mgrAlice.SettleInvoice(ctxb, preimage.String())
+	assert.Error(t.t, err)
+
+	err = mgrAlice.CancelInvoice(ctxb, hash.String())
+	require.NoError(t.t, err)
+
+	inv, err = mgrAlice.LookupInvoice(ctxb, hash.String())
+	require.NoError(t.t, err)
+	assert.Equal(t.t, lnchat.InvoiceCANCELLED, inv.State)
+}
@@ -0,0 +1,39 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testListPeers(net *lntest.NetworkHarness, t *harnessTest) {
+	ctxb := context.Background()
+
+	net.EnsureConnected(t.t, net.Alice, net.Bob)
+
+	mgrAlice, err := createNodeManager(net.Alice)
+	require.NoError(t.t, err)
+	defer func() {
+		assert.NoError(t.t, mgrAlice.Close())
+	}()
+
+	peers, err := mgrAlice.ListPeers(ctxb)
+	require.NoError(t.t, err)
+
+	var found bool
+	for _, p := range peers {
+		if p.Address != net.Bob.PubKeyStr {
+			continue
+		}
+		found = true
+
+		assert.NotEmpty(t.t, p.Host)
+		assert.NotEmpty(t.t, p.Features)
+		for i := 1; i < len(p.Features); i++ {
+			assert.Less(t.t, p.Features[i-1].Bit, p.Features[i].Bit)
+		}
+	}
+	assert.True(t.t, found, "peer not found in peer list")
+}
@@ -0,0 +1,69 @@
+package itest
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+func testSendToRoute(net *lntest.NetworkHarness, t *harnessTest) {
+	for i := 0; i < 2; i++ {
+		net.SendCoins(t.t, btcutil.SatoshiPerBitcoin, net.Alice)
+	}
+
+	chanPoint := openChannelAndAssert(
+		t, net, net.Alice, net.Bob,
+		lntest.OpenChannelParams{
+			Amt: btcutil.Amount(1000000),
+		},
+	)
+
+	err := net.Alice.WaitForNetworkChannelOpen(chanPoint)
+	require.NoError(t.t, err)
+	err = net.Bob.WaitForNetworkChannelOpen(chanPoint)
+	require.NoError(t.t, err)
+
+	mgrAlice, err := createNodeManager(net.Alice)
+	require.NoError(t.t, err)
+	defer mgrAlice.Close()
+
+	var recordTypeKey uint64 = record.CustomTypeStart + 311
+	payload := map[uint64][]byte{
+		recordTypeKey: []byte("test"),
+	}
+
+	payOpts := lnchat.PaymentOptions{
+		FinalCltvDelta: 60,
+	}
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	route, _, err := mgrAlice.GetRoute(ctxt, net.Bob.PubKeyStr,
+		lnchat.NewAmount(1000), "", payOpts, payload)
+	require.NoError(t.t, err)
+
+	payment, err := mgrAlice.SendToRoute(ctxt, route, payload)
+	require.NoError(t.t, err)
+
+	assert.Equal(t.t, lnchat.PaymentSUCCEEDED, payment.Status)
+	require.Len(t.t, payment.Htlcs, 1)
+	assert.Equal(t.t, route.Fees, payment.Htlcs[0].Route.Fees)
+	assert.Equal(t.t, route.Amt, payment.Htlcs[0].Route.Amt)
+
+	if err := wait.NoError(
+		assertNumPendingHTLCs(0, net.Alice, net.Bob),
+		pendingHTLCTimeout,
+	); err != nil {
+		t.Fatalf("Unable to assert no pending htlcs: %v", err)
+	}
+
+	closeChannelAndAssert(t, net, net.Alice, chanPoint, false)
+}
@@ -0,0 +1,83 @@
+package itest
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+func testSubscribeChannelEvents(net *lntest.NetworkHarness, t *harnessTest) {
+	ctxb := context.Background()
+
+	mgrAlice, err := createNodeManager(net.Alice)
+	require.NoError(t.t, err)
+	defer func() {
+		assert.NoError(t.t, mgrAlice.Close())
+	}()
+
+	ctxc, cancel := context.WithCancel(ctxb)
+	defer cancel()
+
+	events, err := mgrAlice.SubscribeChannelEvents(ctxc)
+	require.NoError(t.t, err)
+
+	// awaitEvent waits for an event of the provided type
+	// for the provided channel point, ignoring any other events.
+	awaitEvent := func(eventType lnchat.ChannelEventType,
+		chanPoint lnchat.ChannelPoint) *lnchat.ChannelEvent {
+
+		timeout := time.After(defaultTimeout)
+		for {
+			select {
+			case <-timeout:
+				t.Fatalf("timed out waiting for channel event %v", eventType)
+				return nil
+			case update, ok := <-events:
+				require.True(t.t, ok, "event channel closed unexpectedly")
+				require.NoError(t.t, update.Err)
+				if update.Event.Type == eventType &&
+					update.Event.ChannelPoint == chanPoint {
+
+					return update.Event
+				}
+			}
+		}
+	}
+
+	chanAmt := btcutil.Amount(1000000)
+	rpcChanPoint := openChannelAndAssert(
+		t, net, net.Alice, net.Bob,
+		lntest.OpenChannelParams{
+			Amt: chanAmt,
+		},
+	)
+	fundingTxid, err := lnrpc.GetChanPointFundingTxid(rpcChanPoint)
+	require.NoError(t.t, err)
+
+	chanPoint := lnchat.ChannelPoint{
+		FundingTxid: fundingTxid.String(),
+		OutputIndex: rpcChanPoint.OutputIndex,
+	}
+
+	awaitEvent(lnchat.ChannelEventPENDINGOPEN, chanPoint)
+
+	openEvent := awaitEvent(lnchat.ChannelEventOPEN, chanPoint)
+	require.NotNil(t.t, openEvent.Channel)
+	assert.Equal(t.t, net.Bob.PubKeyStr, openEvent.Channel.RemoteAddress)
+	assert.Equal(t.t, int64(chanAmt)*1000, openEvent.Channel.CapacityMsat)
+
+	closingTxid := closeChannelAndAssert(t, net, net.Alice, rpcChanPoint, false)
+
+	closeEvent := awaitEvent(lnchat.ChannelEventCLOSED, chanPoint)
+	require.NotNil(t.t, closeEvent.ClosedChannel)
+	assert.Equal(t.t, closingTxid.String(), closeEvent.ClosedChannel.ClosingTxid)
+	assert.Equal(t.t, lnchat.ChannelCloseCOOPERATIVE,
+		closeEvent.ClosedChannel.CloseType)
+}
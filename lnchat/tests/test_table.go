@@ -55,10 +55,34 @@ var testsCases = []*testCase{
 		name: "OpenChannel",
 		test: testOpenChannel,
 	},
+	{
+		name: "CloseChannel",
+		test: testCloseChannel,
+	},
+	{
+		name: "SubscribeChannelEvents",
+		test: testSubscribeChannelEvents,
+	},
+	{
+		name: "GetNodeInfo",
+		test: testGetNodeInfo,
+	},
 	{
 		name: "ConnectNode",
 		test: testConnectNode,
 	},
+	{
+		name: "ListPeers",
+		test: testListPeers,
+	},
+	{
+		name: "DisconnectPeer",
+		test: testDisconnectPeer,
+	},
+	{
+		name: "CustomMessage",
+		test: testCustomMessage,
+	},
 	{
 		name: "DecodePayReq",
 		test: testDecodePayReq,
@@ -71,6 +95,14 @@ var testsCases = []*testCase{
 		name: "LookupInvoice",
 		test: testLookupInvoice,
 	},
+	{
+		name: "HoldInvoice",
+		test: testHoldInvoice,
+	},
+	{
+		name: "SendToRoute",
+		test: testSendToRoute,
+	},
 	{
 		name: "SubscribePaymentUpdates",
 		test: testSubscribePaymentUpdates,
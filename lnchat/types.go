@@ -92,6 +92,49 @@ type PaymentOptions struct {
 	// TimeoutSecs is the upper limit (in seconds) afforded for
 	// attempting to send a message.
 	TimeoutSecs int32
+	// MaxShards is the maximum number of partial payments
+	// a payment may be split into.
+	// A value of 0 uses the default of the underlying node.
+	// Spontaneous payments are split only if sent as AMP payments.
+	MaxShards uint32
+	// MaxShardSizeMsat is the maximum amount (in millisatoshi)
+	// carried by each partial payment.
+	// A value of 0 does not restrict the partial payment amount.
+	MaxShardSizeMsat uint64
+	// AMP controls whether spontaneous payments are sent as
+	// atomic multi-path payments, allowing them to be split.
+	// The recipient must accept AMP payments.
+	AMP bool
+	// OutgoingChanIDs restricts the channels of the underlying node
+	// a payment may be sent over. An empty set does not restrict them.
+	OutgoingChanIDs []uint64
+	// LastHopAddress is the address of the node a payment must
+	// reach the recipient through. If empty, it is not restricted.
+	LastHopAddress string
+	// IgnoredNodes are the addresses of nodes
+	// a payment must not be routed through.
+	// Ignored nodes or channels and a minimum success probability
+	// are not supported for payment requests or AMP payments.
+	IgnoredNodes []string
+	// IgnoredChannels are the IDs of channels
+	// a payment must not be routed over (in either direction).
+	IgnoredChannels []uint64
+	// CltvLimit is the maximum total timelock delta (in blocks)
+	// of a payment route. A value of 0 does not restrict it.
+	CltvLimit uint32
+	// MinSuccessProb is the minimum estimated success probability
+	// a route must have. A value of 0 does not restrict it.
+	MinSuccessProb float64
+}
+
+// requiresRouteQuery returns whether the options restrict routes
+// in ways that can only be expressed in route queries.
+// Such payments are sent along queried routes (see SendPayment),
+// so they are not split, and are not supported
+// for payment requests or AMP payments.
+func (o PaymentOptions) requiresRouteQuery() bool {
+	return len(o.IgnoredNodes) != 0 || len(o.IgnoredChannels) != 0 ||
+		o.MinSuccessProb != 0
 }
 
 // PreImageHash is the preimage hash of a payment.
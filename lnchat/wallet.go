@@ -0,0 +1,194 @@
+package lnchat
+
+import (
+	"context"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/pkg/errors"
+)
+
+// AddressType represents the type of an on-chain address.
+type AddressType int32
+
+const (
+	// AddressWITNESSPUBKEYHASH denotes a native segwit (bech32) address.
+	AddressWITNESSPUBKEYHASH AddressType = iota
+	// AddressNESTEDPUBKEYHASH denotes a segwit address nested in P2SH.
+	AddressNESTEDPUBKEYHASH
+)
+
+// Utxo represents an unspent output of the on-chain wallet.
+type Utxo struct {
+	// The address the output pays to.
+	Address string
+	// The amount of the output (in satoshi).
+	AmountSat int64
+	// The transaction ID of the output.
+	Txid string
+	// The index of the output in its transaction.
+	OutputIndex uint32
+	// The number of confirmations of the output.
+	Confirmations int64
+}
+
+// Transaction represents an on-chain transaction of the wallet.
+type Transaction struct {
+	// The transaction ID.
+	Txid string
+	// The net amount of the transaction for the wallet (in satoshi).
+	AmountSat int64
+	// The fees paid by the wallet for the transaction (in satoshi).
+	FeeSat int64
+	// The number of confirmations of the transaction.
+	Confirmations int32
+	// The height of the block including the transaction (0 if unconfirmed).
+	BlockHeight int32
+	// The time the transaction was seen or confirmed (in seconds since Unix epoch).
+	TimestampSec int64
+	// The addresses the transaction pays to.
+	DestAddresses []string
+	// The label of the transaction.
+	Label string
+}
+
+// FeeEstimate represents the estimated fee of an on-chain transaction.
+type FeeEstimate struct {
+	// The total fee of the transaction (in satoshi).
+	FeeSat int64
+	// The fee rate of the transaction (in satoshi per virtual byte).
+	SatPerVByte uint64
+}
+
+var addressTypeMap = map[AddressType]lnrpc.AddressType{
+	AddressWITNESSPUBKEYHASH: lnrpc.AddressType_WITNESS_PUBKEY_HASH,
+	AddressNESTEDPUBKEYHASH:  lnrpc.AddressType_NESTED_PUBKEY_HASH,
+}
+
+// NewAddress generates a new address of the on-chain wallet.
+func (m *manager) NewAddress(ctx context.Context, addrType AddressType) (string, error) {
+	lnAddrType, ok := addressTypeMap[addrType]
+	if !ok {
+		return "", newErrorf(ErrUnknown, "unknown address type %d", addrType)
+	}
+
+	resp, err := m.lnClient.NewAddress(ctx, &lnrpc.NewAddressRequest{
+		Type: lnAddrType,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return "", terr
+		}
+		return "", interceptRPCError(err, ErrUnknown)
+	}
+
+	return resp.GetAddress(), nil
+}
+
+// SendCoins sends an on-chain payment of amtSat to an address,
+// returning the ID of the transaction.
+// If sendAll is true, the whole wallet balance is sent
+// and amtSat must be zero.
+func (m *manager) SendCoins(ctx context.Context, address string, amtSat int64,
+	sendAll bool, txOpts TxFeeOptions) (string, error) {
+
+	resp, err := m.lnClient.SendCoins(ctx, &lnrpc.SendCoinsRequest{
+		Addr:        address,
+		Amount:      amtSat,
+		SendAll:     sendAll,
+		TargetConf:  int32(txOpts.TargetConfBlock),
+		SatPerVbyte: txOpts.SatPerVByte,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return "", terr
+		}
+		return "", errors.Wrap(interceptRPCError(err, ErrUnknown),
+			"on-chain payment failed")
+	}
+
+	return resp.GetTxid(), nil
+}
+
+// ListUnspent returns the unspent outputs of the on-chain wallet
+// with at least minConfs and at most maxConfs confirmations.
+func (m *manager) ListUnspent(ctx context.Context, minConfs, maxConfs int32) ([]Utxo, error) {
+	resp, err := m.lnClient.ListUnspent(ctx, &lnrpc.ListUnspentRequest{
+		MinConfs: minConfs,
+		MaxConfs: maxConfs,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	utxos := make([]Utxo, len(resp.GetUtxos()))
+	for i, u := range resp.GetUtxos() {
+		utxos[i] = Utxo{
+			Address:       u.GetAddress(),
+			AmountSat:     u.GetAmountSat(),
+			Txid:          u.GetOutpoint().GetTxidStr(),
+			OutputIndex:   u.GetOutpoint().GetOutputIndex(),
+			Confirmations: u.GetConfirmations(),
+		}
+	}
+
+	return utxos, nil
+}
+
+// ListTransactions returns the on-chain transactions of the wallet
+// between startHeight and endHeight (inclusive).
+// An endHeight of 0 or -1 includes unconfirmed transactions.
+func (m *manager) ListTransactions(ctx context.Context,
+	startHeight, endHeight int32) ([]Transaction, error) {
+
+	resp, err := m.lnClient.GetTransactions(ctx, &lnrpc.GetTransactionsRequest{
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	txs := make([]Transaction, len(resp.GetTransactions()))
+	for i, tx := range resp.GetTransactions() {
+		txs[i] = Transaction{
+			Txid:          tx.GetTxHash(),
+			AmountSat:     tx.GetAmount(),
+			FeeSat:        tx.GetTotalFees(),
+			Confirmations: tx.GetNumConfirmations(),
+			BlockHeight:   tx.GetBlockHeight(),
+			TimestampSec:  tx.GetTimeStamp(),
+			DestAddresses: tx.GetDestAddresses(),
+			Label:         tx.GetLabel(),
+		}
+	}
+
+	return txs, nil
+}
+
+// EstimateFee estimates the fee of an on-chain payment of amtSat
+// to an address, confirming within targetConfBlock blocks.
+func (m *manager) EstimateFee(ctx context.Context, address string,
+	amtSat int64, targetConfBlock uint32) (*FeeEstimate, error) {
+
+	resp, err := m.lnClient.EstimateFee(ctx, &lnrpc.EstimateFeeRequest{
+		AddrToAmount: map[string]int64{address: amtSat},
+		TargetConf:   int32(targetConfBlock),
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	return &FeeEstimate{
+		FeeSat:      resp.GetFeeSat(),
+		SatPerVByte: resp.GetSatPerVbyte(),
+	}, nil
+}
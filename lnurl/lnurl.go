@@ -0,0 +1,300 @@
+// Package lnurl resolves LNURL-pay endpoints, identified by
+// bech32-encoded LNURLs (LUD-01) or Lightning Addresses (LUD-16),
+// and requests invoices from them (LUD-06), optionally
+// carrying a comment (LUD-12).
+package lnurl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+var (
+	// ErrInvalidLNURL signifies that the provided string
+	// is neither a valid LNURL nor a valid Lightning Address.
+	ErrInvalidLNURL = fmt.Errorf("Invalid LNURL")
+	// ErrServiceFailure signifies that an LNURL service
+	// responded with an error or an invalid response.
+	ErrServiceFailure = fmt.Errorf("LNURL service failure")
+	// ErrAmountOutOfBounds signifies that a payment amount
+	// is not accepted by an LNURL service.
+	ErrAmountOutOfBounds = fmt.Errorf("Amount out of bounds")
+	// ErrCommentTooLong signifies that a comment exceeds
+	// the length accepted by an LNURL service.
+	ErrCommentTooLong = fmt.Errorf("Comment too long")
+	// ErrInvoiceMismatch signifies that an invoice returned
+	// by an LNURL service does not match the payment parameters.
+	ErrInvoiceMismatch = fmt.Errorf("Invoice mismatch")
+)
+
+const (
+	// lnurlHRP is the human-readable part of bech32-encoded LNURLs.
+	lnurlHRP = "lnurl"
+	// lightningScheme is the URI scheme LNURLs may be prefixed with.
+	lightningScheme = "lightning:"
+	// payRequestTag is the tag of LNURL-pay responses.
+	payRequestTag = "payRequest"
+	// maxResponseSize is the maximum size of LNURL service responses.
+	maxResponseSize = 1 << 20
+)
+
+// lightningAddressUser matches the user part of Lightning Addresses.
+var lightningAddressUser = regexp.MustCompile(`^[a-z0-9\-_.+]+$`)
+
+// PayParams represents the parameters of an LNURL-pay endpoint.
+type PayParams struct {
+	// The URL invoices are requested from.
+	Callback string
+	// The minimum amount the endpoint accepts (in millisatoshi).
+	MinSendableMsat int64
+	// The maximum amount the endpoint accepts (in millisatoshi).
+	MaxSendableMsat int64
+	// The metadata of the endpoint, which the description hash
+	// of returned invoices commits to.
+	Metadata string
+	// The maximum length of comments the endpoint accepts.
+	// A value of 0 signifies that comments are not accepted.
+	CommentAllowed int
+}
+
+// Client resolves LNURL-pay endpoints and requests invoices from them.
+type Client struct {
+	// HTTPClient performs the requests of the client.
+	// If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// IsLNURL returns whether the provided string appears to be
+// an LNURL or a Lightning Address, as opposed to
+// a node address or a BOLT11 payment request.
+func IsLNURL(s string) bool {
+	s = Normalize(s)
+
+	return strings.HasPrefix(s, lnurlHRP+"1") || strings.Contains(s, "@")
+}
+
+// Normalize returns the canonical (lowercase, scheme-less) form
+// of an LNURL or a Lightning Address.
+func Normalize(s string) string {
+	return strings.TrimPrefix(strings.ToLower(s), lightningScheme)
+}
+
+// ParseLightningAddress validates a Lightning Address
+// and returns its (lowercase) user and domain parts.
+func ParseLightningAddress(address string) (user, domain string, err error) {
+	parts := strings.Split(strings.ToLower(address), "@")
+	if len(parts) != 2 || !lightningAddressUser.MatchString(parts[0]) {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidLNURL, address)
+	}
+
+	u, err := url.Parse("https://" + parts[1])
+	if err != nil || u.Host != parts[1] || u.Hostname() == "" {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidLNURL, address)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// isOnion returns whether a host is an onion service.
+func isOnion(host string) bool {
+	return strings.HasSuffix(host, ".onion")
+}
+
+// endpointURL returns the URL of the LNURL-pay endpoint
+// identified by an LNURL or a Lightning Address.
+func endpointURL(target string) (*url.URL, error) {
+	target = Normalize(target)
+
+	if strings.Contains(target, "@") {
+		user, domain, err := ParseLightningAddress(target)
+		if err != nil {
+			return nil, err
+		}
+		scheme := "https"
+		if isOnion(domain) {
+			scheme = "http"
+		}
+
+		return &url.URL{
+			Scheme: scheme,
+			Host:   domain,
+			Path:   "/.well-known/lnurlp/" + user,
+		}, nil
+	}
+
+	hrp, data, err := bech32.DecodeNoLimit(target)
+	if err != nil || hrp != lnurlHRP {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidLNURL, target)
+	}
+	decoded, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLNURL, err)
+	}
+
+	u, err := url.Parse(string(decoded))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLNURL, err)
+	}
+	// Clearnet services must be reached over TLS.
+	if u.Scheme != "https" && !(u.Scheme == "http" && isOnion(u.Hostname())) {
+		return nil, fmt.Errorf("%w: insecure URL %q", ErrInvalidLNURL, u)
+	}
+
+	return u, nil
+}
+
+// get performs a GET request and decodes the JSON response into resp.
+// Responses with an error status are returned as errors.
+func (c *Client) get(ctx context.Context, u *url.URL, resp interface{}) error {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	httpResp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrServiceFailure, err)
+	}
+	defer httpResp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrServiceFailure, err)
+	}
+
+	var status struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal(body, &status); err != nil {
+		return fmt.Errorf("%w: invalid response (HTTP %d)",
+			ErrServiceFailure, httpResp.StatusCode)
+	}
+	if strings.EqualFold(status.Status, "ERROR") {
+		return fmt.Errorf("%w: %s", ErrServiceFailure, status.Reason)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: HTTP %d", ErrServiceFailure, httpResp.StatusCode)
+	}
+
+	if err := json.Unmarshal(body, resp); err != nil {
+		return fmt.Errorf("%w: invalid response: %v", ErrServiceFailure, err)
+	}
+
+	return nil
+}
+
+// Resolve retrieves the parameters of the LNURL-pay endpoint
+// identified by an LNURL or a Lightning Address.
+func (c *Client) Resolve(ctx context.Context, target string) (*PayParams, error) {
+	u, err := endpointURL(target)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Tag            string `json:"tag"`
+		Callback       string `json:"callback"`
+		MinSendable    int64  `json:"minSendable"`
+		MaxSendable    int64  `json:"maxSendable"`
+		Metadata       string `json:"metadata"`
+		CommentAllowed int    `json:"commentAllowed"`
+	}
+	if err := c.get(ctx, u, &resp); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.Tag != payRequestTag:
+		return nil, fmt.Errorf("%w: unsupported tag %q", ErrServiceFailure, resp.Tag)
+	case resp.Callback == "":
+		return nil, fmt.Errorf("%w: missing callback", ErrServiceFailure)
+	case resp.MinSendable <= 0 || resp.MaxSendable < resp.MinSendable:
+		return nil, fmt.Errorf("%w: invalid amount bounds [%d, %d]",
+			ErrServiceFailure, resp.MinSendable, resp.MaxSendable)
+	}
+	var metadata [][]interface{}
+	if err := json.Unmarshal([]byte(resp.Metadata), &metadata); err != nil {
+		return nil, fmt.Errorf("%w: invalid metadata: %v", ErrServiceFailure, err)
+	}
+
+	return &PayParams{
+		Callback:        resp.Callback,
+		MinSendableMsat: resp.MinSendable,
+		MaxSendableMsat: resp.MaxSendable,
+		Metadata:        resp.Metadata,
+		CommentAllowed:  resp.CommentAllowed,
+	}, nil
+}
+
+// FetchInvoice requests an invoice for the provided amount
+// from an LNURL-pay endpoint, carrying the comment if not empty.
+// The returned invoice must be verified through VerifyInvoice.
+func (c *Client) FetchInvoice(ctx context.Context, params *PayParams,
+	amtMsat int64, comment string) (string, error) {
+
+	if amtMsat < params.MinSendableMsat || amtMsat > params.MaxSendableMsat {
+		return "", fmt.Errorf("%w: %d msat not in [%d, %d]", ErrAmountOutOfBounds,
+			amtMsat, params.MinSendableMsat, params.MaxSendableMsat)
+	}
+	if n := len([]rune(comment)); n > params.CommentAllowed {
+		return "", fmt.Errorf("%w: %d characters exceed the allowed %d",
+			ErrCommentTooLong, n, params.CommentAllowed)
+	}
+
+	u, err := url.Parse(params.Callback)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid callback: %v", ErrServiceFailure, err)
+	}
+	query := u.Query()
+	query.Set("amount", strconv.FormatInt(amtMsat, 10))
+	if comment != "" {
+		query.Set("comment", comment)
+	}
+	u.RawQuery = query.Encode()
+
+	var resp struct {
+		PR string `json:"pr"`
+	}
+	if err := c.get(ctx, u, &resp); err != nil {
+		return "", err
+	}
+	if resp.PR == "" {
+		return "", fmt.Errorf("%w: missing invoice", ErrServiceFailure)
+	}
+
+	return resp.PR, nil
+}
+
+// VerifyInvoice verifies that an invoice returned by an LNURL-pay endpoint
+// commits to the endpoint metadata and requests the expected amount,
+// provided the hex-encoded description hash and the amount of the invoice.
+func (p *PayParams) VerifyInvoice(descHash string, invAmtMsat, amtMsat int64) error {
+	hash := sha256.Sum256([]byte(p.Metadata))
+	if !strings.EqualFold(descHash, hex.EncodeToString(hash[:])) {
+		return fmt.Errorf("%w: description hash does not match metadata",
+			ErrInvoiceMismatch)
+	}
+	if invAmtMsat != amtMsat {
+		return fmt.Errorf("%w: invoice amount %d msat differs from %d msat",
+			ErrInvoiceMismatch, invAmtMsat, amtMsat)
+	}
+
+	return nil
+}
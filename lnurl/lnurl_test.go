@@ -0,0 +1,209 @@
+package lnurl
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMetadata = `[["text/plain","Pay to alice"]]`
+
+// newTestService starts an LNURL-pay service for user alice,
+// recording the query of the last callback request.
+func newTestService(t *testing.T, lastQuery *map[string]string) *httptest.Server {
+	mux := http.NewServeMux()
+	srv := httptest.NewTLSServer(mux)
+	t.Cleanup(srv.Close)
+
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		require.NoError(t, json.NewEncoder(w).Encode(v))
+	}
+
+	mux.HandleFunc("/.well-known/lnurlp/alice", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"tag":            "payRequest",
+			"callback":       srv.URL + "/callback/alice",
+			"minSendable":    1000,
+			"maxSendable":    100000,
+			"metadata":       testMetadata,
+			"commentAllowed": 10,
+		})
+	})
+	mux.HandleFunc("/.well-known/lnurlp/bob", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"status": "ERROR",
+			"reason": "unknown user",
+		})
+	})
+	mux.HandleFunc("/.well-known/lnurlp/carol", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{
+			"tag":      "withdrawRequest",
+			"callback": srv.URL + "/callback/carol",
+		})
+	})
+	mux.HandleFunc("/callback/alice", func(w http.ResponseWriter, r *http.Request) {
+		*lastQuery = map[string]string{
+			"amount":  r.URL.Query().Get("amount"),
+			"comment": r.URL.Query().Get("comment"),
+		}
+		writeJSON(w, map[string]interface{}{
+			"pr":     "lnbcrt1invoice",
+			"routes": []interface{}{},
+		})
+	})
+
+	return srv
+}
+
+func encodeLNURL(t *testing.T, rawURL string) string {
+	data, err := bech32.ConvertBits([]byte(rawURL), 8, 5, true)
+	require.NoError(t, err)
+	encoded, err := bech32.Encode(lnurlHRP, data)
+	require.NoError(t, err)
+
+	return strings.ToUpper(encoded)
+}
+
+func TestIsLNURL(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "alice@example.com", expected: true},
+		{input: "LNURL1DP68GURN8GHJ7", expected: true},
+		{input: "lightning:lnurl1dp68gurn8ghj7", expected: true},
+		{input: "lnbcrt1invoice", expected: false},
+		{input: "0212121212121212121212121212121212121212121212121212121212121212", expected: false},
+		{input: "", expected: false},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.expected, IsLNURL(c.input), c.input)
+	}
+}
+
+func TestParseLightningAddress(t *testing.T) {
+	user, domain, err := ParseLightningAddress("Alice.B+tips@Example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "alice.b+tips", user)
+	assert.Equal(t, "example.com", domain)
+
+	for _, invalid := range []string{
+		"alice", "@example.com", "alice@", "al ice@example.com",
+		"alice@bob@example.com", "alice@example.com/path",
+	} {
+		_, _, err := ParseLightningAddress(invalid)
+		assert.ErrorIs(t, err, ErrInvalidLNURL, invalid)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	var lastQuery map[string]string
+	srv := newTestService(t, &lastQuery)
+	client := &Client{HTTPClient: srv.Client()}
+	host := strings.TrimPrefix(srv.URL, "https://")
+
+	expected := &PayParams{
+		Callback:        srv.URL + "/callback/alice",
+		MinSendableMsat: 1000,
+		MaxSendableMsat: 100000,
+		Metadata:        testMetadata,
+		CommentAllowed:  10,
+	}
+
+	cases := []struct {
+		name        string
+		target      string
+		expectedErr error
+	}{
+		{
+			name:   "Lightning Address",
+			target: "alice@" + host,
+		},
+		{
+			name:   "LNURL",
+			target: encodeLNURL(t, srv.URL+"/.well-known/lnurlp/alice"),
+		},
+		{
+			name:        "insecure LNURL",
+			target:      encodeLNURL(t, "http://"+host+"/.well-known/lnurlp/alice"),
+			expectedErr: ErrInvalidLNURL,
+		},
+		{
+			name:        "invalid LNURL",
+			target:      "lnurl1invalid",
+			expectedErr: ErrInvalidLNURL,
+		},
+		{
+			name:        "service error",
+			target:      "bob@" + host,
+			expectedErr: ErrServiceFailure,
+		},
+		{
+			name:        "unsupported tag",
+			target:      "carol@" + host,
+			expectedErr: ErrServiceFailure,
+		},
+		{
+			name:        "unknown user",
+			target:      "dave@" + host,
+			expectedErr: ErrServiceFailure,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			params, err := client.Resolve(context.Background(), c.target)
+			if c.expectedErr != nil {
+				assert.ErrorIs(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, expected, params)
+		})
+	}
+}
+
+func TestFetchInvoice(t *testing.T) {
+	var lastQuery map[string]string
+	srv := newTestService(t, &lastQuery)
+	client := &Client{HTTPClient: srv.Client()}
+
+	params, err := client.Resolve(context.Background(),
+		"alice@"+strings.TrimPrefix(srv.URL, "https://"))
+	require.NoError(t, err)
+
+	payReq, err := client.FetchInvoice(context.Background(), params, 5000, "hi alice")
+	require.NoError(t, err)
+	assert.Equal(t, "lnbcrt1invoice", payReq)
+	assert.Equal(t, map[string]string{
+		"amount":  "5000",
+		"comment": "hi alice",
+	}, lastQuery)
+
+	_, err = client.FetchInvoice(context.Background(), params, 999, "")
+	assert.ErrorIs(t, err, ErrAmountOutOfBounds)
+	_, err = client.FetchInvoice(context.Background(), params, 100001, "")
+	assert.ErrorIs(t, err, ErrAmountOutOfBounds)
+	_, err = client.FetchInvoice(context.Background(), params, 5000, "hello alice")
+	assert.ErrorIs(t, err, ErrCommentTooLong)
+}
+
+func TestVerifyInvoice(t *testing.T) {
+	params := &PayParams{Metadata: testMetadata}
+	hash := sha256.Sum256([]byte(testMetadata))
+	descHash := hex.EncodeToString(hash[:])
+
+	assert.NoError(t, params.VerifyInvoice(descHash, 5000, 5000))
+	assert.ErrorIs(t, params.VerifyInvoice(descHash, 4000, 5000), ErrInvoiceMismatch)
+	assert.ErrorIs(t, params.VerifyInvoice("", 5000, 5000), ErrInvoiceMismatch)
+}
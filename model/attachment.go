@@ -0,0 +1,82 @@
+package model
+
+// Attachment represents a blob (such as an image or a document)
+// transferred in chunks, each carried by a separate payment.
+type Attachment struct {
+	// The transfer id (hex-encoded), identifying the attachment.
+	TransferID string `badgerhold:"key"`
+	// The id of the discussion the attachment belongs to.
+	DiscussionID uint64
+	// The Lightning address of the sender.
+	Sender string
+	// Whether the sender was the one that signed all received chunks.
+	SenderVerified bool
+	// Whether the attachment was received (or sent).
+	Incoming bool
+	// The file name of the attachment.
+	Name string
+	// The MIME type of the attachment.
+	MimeType string
+	// The size of the attachment (in bytes).
+	Size uint64
+	// The SHA-256 hash of the attachment contents.
+	ContentHash []byte
+	// The number of chunks the attachment is split into.
+	TotalChunks uint32
+	// The indexes of the received chunks (incoming).
+	ReceivedChunks []uint32
+	// The indexes of the chunks delivered to each recipient (outgoing).
+	DeliveredChunks map[string][]uint32
+	// The indexes of the chunks delivered to all recipients (outgoing).
+	SentChunks []uint32
+	// The message payload accompanying the attachment,
+	// carried along with the first chunk.
+	RawPayload []byte
+	// The signature of the first chunk.
+	Signature []byte
+	// The PaymentIndexes of the payments used
+	// to transport the chunks (outgoing).
+	PaymentIndexes []uint64
+	// Whether all chunks were received (or delivered)
+	// and the attachment message was stored.
+	Complete bool
+	// The creation time of the attachment (in nanoseconds).
+	CreatedTimeNs int64
+}
+
+// AttachmentChunk represents a chunk of an attachment.
+type AttachmentChunk struct {
+	// The chunk id, composed of the transfer id and chunk index.
+	ID string `badgerhold:"key"`
+	// The transfer id of the attachment the chunk belongs to.
+	TransferID string `badgerholdIndex:"TransferID"`
+	// The index of the chunk.
+	Index uint32
+	// The chunk data.
+	Data []byte
+}
+
+// HasChunk returns whether the provided chunk index
+// is contained in a set of chunk indexes.
+func HasChunk(chunks []uint32, index uint32) bool {
+	for _, c := range chunks {
+		if c == index {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MissingChunks returns the indexes of the chunks of
+// the attachment that are not contained in the provided set.
+func (a *Attachment) MissingChunks(chunks []uint32) []uint32 {
+	var missing []uint32
+	for i := uint32(0); i < a.TotalChunks; i++ {
+		if !HasChunk(chunks, i) {
+			missing = append(missing, i)
+		}
+	}
+
+	return missing
+}
@@ -7,3 +7,42 @@ import "github.com/c13n-io/c13n-go/lnchat"
 type ChannelPoint struct {
 	lnchat.ChannelPoint
 }
+
+// Channel represents the model for an open channel.
+type Channel struct {
+	lnchat.Channel
+}
+
+// PendingChannel represents the model for a channel
+// that is being opened or closed.
+type PendingChannel struct {
+	lnchat.PendingChannel
+}
+
+// ChannelFilter specifies the channels of interest
+// when listing open channels.
+type ChannelFilter struct {
+	ActiveOnly   bool
+	InactiveOnly bool
+	PublicOnly   bool
+	PrivateOnly  bool
+	Peer         string
+}
+
+// ChannelCloseStatus describes the progress of a channel closing.
+type ChannelCloseStatus struct {
+	lnchat.ChannelCloseStatus
+}
+
+// ChannelCloseUpdate represents an update on the progress
+// of a channel closing, or an error that terminated it.
+type ChannelCloseUpdate struct {
+	Status *ChannelCloseStatus
+	Err    error
+}
+
+// ChannelEvent represents the model for a change
+// in the state of a channel.
+type ChannelEvent struct {
+	lnchat.ChannelEvent
+}
@@ -4,5 +4,8 @@ package model
 type Contact struct {
 	ID          uint64 `badgerhold:"key"`
 	DisplayName string
+	// LightningAddress is the Lightning Address (user@domain)
+	// of the contact, if known.
+	LightningAddress string
 	Node
 }
@@ -0,0 +1,210 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// ControlType is the type of the control carried by a message,
+// relating it to a previous message of the same discussion.
+type ControlType int
+
+const (
+	// ControlNone signifies a message carrying no control.
+	ControlNone ControlType = iota
+	// ControlReply signifies a reply to a message.
+	ControlReply
+	// ControlReaction signifies a reaction to a message,
+	// with the message payload as the reaction.
+	ControlReaction
+	// ControlEdit signifies an edit of a message,
+	// with the message payload as the new message contents.
+	ControlEdit
+	// ControlDelete signifies a request to delete a message.
+	ControlDelete
+)
+
+// controlTypeNames are the names of the control types,
+// as carried in JSON payloads.
+var controlTypeNames = map[ControlType]string{
+	ControlReply:    "reply",
+	ControlReaction: "reaction",
+	ControlEdit:     "edit",
+	ControlDelete:   "delete",
+}
+
+func (t ControlType) String() string {
+	if name, ok := controlTypeNames[t]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("ControlType(%d)", int(t))
+}
+
+// IsAnnotation returns whether the control annotates the referenced
+// message (reactions, edits and deletions), instead of
+// being part of the discussion history itself (replies).
+func (t ControlType) IsAnnotation() bool {
+	return t == ControlReaction || t == ControlEdit || t == ControlDelete
+}
+
+func parseControlType(name string) (ControlType, error) {
+	for t, n := range controlTypeNames {
+		if n == name {
+			return t, nil
+		}
+	}
+
+	return ControlNone, fmt.Errorf("unknown control type %q", name)
+}
+
+// Control represents the control carried by a message.
+type Control struct {
+	// The control type.
+	Type ControlType
+	// The hash of the referenced message.
+	Ref []byte
+}
+
+// Validate checks that the control has a known type
+// and references a message hash.
+func (c *Control) Validate() error {
+	if _, ok := controlTypeNames[c.Type]; !ok {
+		return fmt.Errorf("invalid control type %s", c.Type)
+	}
+	if len(c.Ref) != sha256.Size {
+		return fmt.Errorf("invalid referenced message hash length %d", len(c.Ref))
+	}
+
+	return nil
+}
+
+// controlPayload is the encoding of a control in JSON payloads.
+type controlPayload struct {
+	Type string `json:"type"`
+	Ref  string `json:"ref"`
+}
+
+func newControlPayload(c *Control) *controlPayload {
+	if c == nil {
+		return nil
+	}
+
+	return &controlPayload{
+		Type: c.Type.String(),
+		Ref:  hex.EncodeToString(c.Ref),
+	}
+}
+
+func (c *controlPayload) control() (*Control, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	t, err := parseControlType(c.Type)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := hex.DecodeString(c.Ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid referenced message hash: %w", err)
+	}
+
+	ctrl := &Control{Type: t, Ref: ref}
+	return ctrl, ctrl.Validate()
+}
+
+// Reaction represents a reaction to a message.
+type Reaction struct {
+	// The Lightning address of the reacting node.
+	Sender string
+	// Whether the sender was verified via signature.
+	SenderVerified bool
+	// The reaction (such as an emoji).
+	Reaction string
+	// The time the reaction was stored.
+	Timestamp time.Time
+}
+
+// MessageEdit represents a version of an edited message.
+type MessageEdit struct {
+	// The message contents.
+	Payload string
+	// The time the version was stored.
+	Timestamp time.Time
+}
+
+// ApplyAnnotations aggregates the reactions, edits and deletions
+// annotating a message.
+// Edits and deletions are applied only if signed by the message sender,
+// while reactions of the same sender are deduplicated.
+func (agg *MessageAggregate) ApplyAnnotations() error {
+	raw := agg.RawMessage
+	if raw == nil || len(agg.Annotations) == 0 {
+		return nil
+	}
+
+	agg.Reactions, agg.Edits, agg.Deleted = nil, nil, false
+	for _, annotation := range agg.Annotations {
+		ann := annotation.RawMessage
+		payload, _, err := ann.UnmarshalPayload()
+		if err != nil {
+			return fmt.Errorf("cannot unmarshal annotation %d payload: %w",
+				ann.ID, err)
+		}
+
+		switch ann.ControlType {
+		case ControlReaction:
+			if hasReaction(agg.Reactions, ann.Sender, payload) {
+				continue
+			}
+			agg.Reactions = append(agg.Reactions, Reaction{
+				Sender:         ann.Sender,
+				SenderVerified: ann.SignatureVerified,
+				Reaction:       payload,
+				Timestamp:      ann.Timestamp,
+			})
+		case ControlEdit, ControlDelete:
+			if !raw.SignedBySameSender(ann) {
+				continue
+			}
+			if ann.ControlType == ControlDelete {
+				agg.Deleted = true
+				continue
+			}
+			if len(agg.Edits) == 0 {
+				original, _, err := raw.UnmarshalPayload()
+				if err != nil {
+					return fmt.Errorf("cannot unmarshal message payload: %w", err)
+				}
+				agg.Edits = append(agg.Edits, MessageEdit{
+					Payload:   original,
+					Timestamp: raw.Timestamp,
+				})
+			}
+			agg.Edits = append(agg.Edits, MessageEdit{
+				Payload:   payload,
+				Timestamp: ann.Timestamp,
+			})
+		}
+	}
+
+	// Deleted messages retain neither their contents nor reactions.
+	if agg.Deleted {
+		agg.Reactions, agg.Edits = nil, nil
+	}
+
+	return nil
+}
+
+func hasReaction(reactions []Reaction, sender, reaction string) bool {
+	for _, r := range reactions {
+		if r.Sender == sender && r.Reaction == reaction {
+			return true
+		}
+	}
+
+	return false
+}
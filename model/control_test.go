@@ -0,0 +1,67 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyAnnotations(t *testing.T) {
+	sender := "022bd806c97f0e00af1a1fc3328fa763a9269723c8db8fac4f93af71db186d6e90"
+	other := "0281b637d8fcd2c6da6359e6963113a1170de795e4b725b84d1e0b4cfd9ec58ce9"
+	disc := &Discussion{Participants: []string{other}}
+	ts := time.Unix(1600000000, 0)
+
+	raw, err := NewRawMessage(disc, "hello", PayloadFormatJSON)
+	require.NoError(t, err)
+	raw.Sender, raw.SignatureVerified, raw.Timestamp = sender, true, ts
+	ref := raw.Hash()
+
+	annotation := func(ctrlType ControlType, payload, from string,
+		verified bool, i int) MessageAggregate {
+
+		ann, err := NewControlRawMessage(disc, &Control{Type: ctrlType, Ref: ref},
+			payload, PayloadFormatCompact)
+		require.NoError(t, err)
+		ann.Sender, ann.SignatureVerified = from, verified
+		ann.Timestamp = ts.Add(time.Duration(i) * time.Second)
+		ann.IndexRelations()
+		return MessageAggregate{RawMessage: ann}
+	}
+
+	agg := &MessageAggregate{
+		RawMessage: raw,
+		Annotations: []MessageAggregate{
+			annotation(ControlReaction, "+1", other, true, 1),
+			annotation(ControlReaction, "+1", other, true, 2),
+			annotation(ControlReaction, "+1", sender, false, 3),
+			annotation(ControlEdit, "hello!", sender, true, 4),
+			// Edits not signed by the message sender are not applied.
+			annotation(ControlEdit, "forged", other, true, 5),
+			annotation(ControlEdit, "forged", sender, false, 6),
+			annotation(ControlEdit, "hello!!", sender, true, 7),
+			annotation(ControlDelete, "", other, true, 8),
+		},
+	}
+	require.NoError(t, agg.ApplyAnnotations())
+
+	assert.False(t, agg.Deleted)
+	assert.Equal(t, []Reaction{
+		{Sender: other, SenderVerified: true, Reaction: "+1", Timestamp: ts.Add(time.Second)},
+		{Sender: sender, Reaction: "+1", Timestamp: ts.Add(3 * time.Second)},
+	}, agg.Reactions)
+	assert.Equal(t, []MessageEdit{
+		{Payload: "hello", Timestamp: ts},
+		{Payload: "hello!", Timestamp: ts.Add(4 * time.Second)},
+		{Payload: "hello!!", Timestamp: ts.Add(7 * time.Second)},
+	}, agg.Edits)
+
+	agg.Annotations = append(agg.Annotations,
+		annotation(ControlDelete, "", sender, true, 9))
+	require.NoError(t, agg.ApplyAnnotations())
+	assert.True(t, agg.Deleted)
+	assert.Empty(t, agg.Reactions)
+	assert.Empty(t, agg.Edits)
+}
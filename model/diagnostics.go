@@ -0,0 +1,9 @@
+package model
+
+import "github.com/c13n-io/c13n-go/lnchat"
+
+// PairHistory represents the model for the payment attempt history
+// of the underlying node between a pair of nodes.
+type PairHistory struct {
+	lnchat.PairHistory
+}
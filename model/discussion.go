@@ -15,6 +15,11 @@ type Discussion struct {
 	LastReadID    uint64         `json:"last_read_message_id"`
 	LastMessageID uint64         `json:"last_message_id"`
 	Options       MessageOptions `json:"options"`
+	// The LNURL or Lightning Address (in canonical form) that messages
+	// of the discussion are paid through, if any.
+	// Such discussions are distinct from the discussion
+	// with the node of the LNURL service.
+	LNURL string `json:"lnurl"`
 }
 
 // Type satisfies badgerhold.Storer interface.
@@ -42,8 +47,11 @@ func (d *Discussion) Indexes() map[string]badgerhold.Index {
 		copy(participantSet, disc.Participants)
 		sort.Strings(participantSet)
 
-		// Encode participant set as bytes.
+		// Encode participant set (and LNURL, if any) as bytes.
 		participants := strings.Join(participantSet, ",")
+		if disc.LNURL != "" {
+			participants += "|" + disc.LNURL
+		}
 
 		return []byte(participants), nil
 	}
@@ -0,0 +1,39 @@
+package model
+
+import "github.com/c13n-io/c13n-go/lnchat"
+
+// ChannelPolicy represents the model for the forwarding policy
+// of the underlying node for a channel.
+type ChannelPolicy struct {
+	lnchat.ChannelPolicy
+}
+
+// ForwardingEvent represents the model for an HTLC
+// forwarded by the underlying node.
+type ForwardingEvent struct {
+	lnchat.ForwardingEvent
+}
+
+// ChannelForwardingStats aggregates the forwarding events
+// concerning a channel.
+type ChannelForwardingStats struct {
+	// The ID of the channel.
+	ChannelID uint64
+	// The number of HTLCs that arrived on the channel.
+	ForwardsIn uint64
+	// The number of HTLCs forwarded on the channel.
+	ForwardsOut uint64
+	// The total amount that arrived on the channel (in millisatoshi).
+	AmtInMsat uint64
+	// The total amount forwarded on the channel (in millisatoshi).
+	AmtOutMsat uint64
+	// The total fee earned by forwarding on the channel (in millisatoshi).
+	FeeMsat uint64
+}
+
+// ForwardingHistory represents a range of forwarding events,
+// along with per-channel aggregates over the range.
+type ForwardingHistory struct {
+	Events   []ForwardingEvent
+	Channels []ChannelForwardingStats
+}
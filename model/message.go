@@ -11,6 +11,14 @@ type MessageAggregate struct {
 	Invoice *Invoice
 	// The payments fulfilling the message (valid only for outgoing messages).
 	Payments []*Payment
+	// The messages annotating (reacting to, editing or deleting) the message.
+	Annotations []MessageAggregate
+	// The reactions to the message, aggregated from its annotations.
+	Reactions []Reaction
+	// The versions of the message, oldest first, if it was edited.
+	Edits []MessageEdit
+	// Whether the message was deleted by its sender.
+	Deleted bool
 }
 
 // Hop represents a hop in a payment route.
@@ -23,6 +31,8 @@ type Hop struct {
 	AmtToForwardMsat int64 `json:"amt_to_forward_msat"`
 	// The fee to be paid to the hop (in millisatoshi).
 	FeeMsat int64 `json:"fee_msat"`
+	// The HTLC expiry height at the hop.
+	Expiry uint32 `json:"expiry"`
 	// The custom records for the hop.
 	CustomRecords map[uint64][]byte `json:"custom_records"`
 }
@@ -74,4 +84,8 @@ type Message struct {
 	PayReq string `json:"pay_req"`
 	// Arrival success probability.
 	SuccessProb float64
+	// The estimated onion space (in bytes) remaining after the payload,
+	// which is negative if the payload does not fit.
+	// Only meaningful for message estimates.
+	PayloadBudgetBytes int64 `json:"payload_budget_bytes"`
 }
@@ -13,3 +13,8 @@ type SelfInfo struct {
 	Node   Node
 	Chains []lnchat.Chain
 }
+
+// NodeInfo represents the details of a network node.
+type NodeInfo struct {
+	lnchat.NodeInfo
+}
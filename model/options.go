@@ -8,12 +8,59 @@ var defaultPaymentOpts = lnchat.PaymentOptions{
 	TimeoutSecs:    30,
 }
 
+// MessageTransport represents the transport used for sending a message.
+type MessageTransport int
+
+const (
+	// TransportAUTO sends messages over custom peer messages if possible,
+	// and over payments otherwise.
+	TransportAUTO MessageTransport = iota
+	// TransportPAYMENT sends messages over payments.
+	TransportPAYMENT
+	// TransportPEER sends messages over custom peer messages.
+	TransportPEER
+)
+
 // MessageOptions represents options for a message.
 type MessageOptions struct {
 	// The maximum fee allowed for sending a message (in millisatoshi).
 	FeeLimitMsat int64 `json:"fee_limit_msat"`
+	// The maximum fee limit (in millisatoshi) that retries of failed
+	// message payments may raise the fee limit to.
+	// A value of 0 does not allow retries to raise the fee limit.
+	MaxFeeLimitMsat int64 `json:"max_fee_limit_msat"`
 	// Whether to include the sender address in the message.
 	Anonymous bool `json:"anonymous"`
+	// The transport used for sending a message.
+	Transport MessageTransport `json:"transport"`
+	// The maximum number of partial payments a message payment
+	// may be split into. A value of 0 uses the node default.
+	MaxShards uint32 `json:"max_shards"`
+	// The maximum amount carried by each partial payment (in millisatoshi).
+	// A value of 0 does not restrict the partial payment amount.
+	MaxShardSizeMsat uint64 `json:"max_shard_size_msat"`
+	// Whether to send spontaneous message payments as AMP payments,
+	// allowing them to be split across channels.
+	AMP bool `json:"amp"`
+	// The IDs of the channels message payments may be sent over.
+	// An empty set does not restrict them.
+	OutgoingChanIDs []uint64 `json:"outgoing_chan_ids"`
+	// The address of the node message payments must reach
+	// the recipient through. If empty, it is not restricted.
+	LastHopAddress string `json:"last_hop_address"`
+	// The addresses of nodes message payments must not be routed through.
+	// Ignored nodes or channels and a minimum success probability
+	// are not supported for payments to payment requests or LNURLs,
+	// or for AMP payments.
+	IgnoredNodes []string `json:"ignored_nodes"`
+	// The IDs of channels message payments must not be routed over.
+	IgnoredChannels []uint64 `json:"ignored_channels"`
+	// The maximum total timelock delta (in blocks) of message payment routes.
+	// A value of 0 does not restrict it.
+	CltvLimit uint32 `json:"cltv_limit"`
+	// The minimum estimated success probability of message payment routes.
+	// A value of 0 does not restrict it.
+	MinSuccessProb float64 `json:"min_success_prob"`
 }
 
 // WithFeeLimit sets the fee limit option.
@@ -27,6 +74,15 @@ func (o MessageOptions) WithFeeLimit(feeLimitMsat int64) MessageOptions {
 func (o MessageOptions) GetPaymentOptions() lnchat.PaymentOptions {
 	payOpts := defaultPaymentOpts
 	payOpts.FeeLimitMsat = o.FeeLimitMsat
+	payOpts.MaxShards = o.MaxShards
+	payOpts.MaxShardSizeMsat = o.MaxShardSizeMsat
+	payOpts.AMP = o.AMP
+	payOpts.OutgoingChanIDs = o.OutgoingChanIDs
+	payOpts.LastHopAddress = o.LastHopAddress
+	payOpts.IgnoredNodes = o.IgnoredNodes
+	payOpts.IgnoredChannels = o.IgnoredChannels
+	payOpts.CltvLimit = o.CltvLimit
+	payOpts.MinSuccessProb = o.MinSuccessProb
 
 	return payOpts
 }
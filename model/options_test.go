@@ -26,6 +26,46 @@ func TestGetPaymentOptions(t *testing.T) {
 				TimeoutSecs:    defaultPaymentOpts.TimeoutSecs,
 			},
 		},
+		{
+			name: "multi-path",
+			opts: MessageOptions{
+				FeeLimitMsat:     3000,
+				MaxShards:        6,
+				MaxShardSizeMsat: 200000,
+				AMP:              true,
+			},
+			expectedPayOpts: lnchat.PaymentOptions{
+				FeeLimitMsat:     3000,
+				FinalCltvDelta:   defaultPaymentOpts.FinalCltvDelta,
+				TimeoutSecs:      defaultPaymentOpts.TimeoutSecs,
+				MaxShards:        6,
+				MaxShardSizeMsat: 200000,
+				AMP:              true,
+			},
+		},
+		{
+			name: "route restrictions",
+			opts: MessageOptions{
+				FeeLimitMsat:    3000,
+				OutgoingChanIDs: []uint64{1, 2},
+				LastHopAddress:  "hop",
+				IgnoredNodes:    []string{"node"},
+				IgnoredChannels: []uint64{3},
+				CltvLimit:       200,
+				MinSuccessProb:  0.5,
+			},
+			expectedPayOpts: lnchat.PaymentOptions{
+				FeeLimitMsat:    3000,
+				FinalCltvDelta:  defaultPaymentOpts.FinalCltvDelta,
+				TimeoutSecs:     defaultPaymentOpts.TimeoutSecs,
+				OutgoingChanIDs: []uint64{1, 2},
+				LastHopAddress:  "hop",
+				IgnoredNodes:    []string{"node"},
+				IgnoredChannels: []uint64{3},
+				CltvLimit:       200,
+				MinSuccessProb:  0.5,
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -0,0 +1,199 @@
+package model
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// PayloadFormat is the encoding of c13n message payloads.
+type PayloadFormat int
+
+const (
+	// PayloadFormatJSON is the legacy encoding, carrying the hex-encoded
+	// participant addresses and the message in a JSON object.
+	PayloadFormatJSON PayloadFormat = iota
+	// PayloadFormatCompact is the versioned binary encoding,
+	// carrying the participant addresses as raw public keys
+	// and compressing the payload if that reduces its size.
+	PayloadFormatCompact
+)
+
+const (
+	// compactPayloadVersion is the current compact payload version.
+	compactPayloadVersion = 1
+	// compactFlagDeflate signifies a DEFLATE-compressed compact payload body.
+	compactFlagDeflate = 1 << 0
+	// compactFlagControl signifies a compact payload carrying a control.
+	compactFlagControl = 1 << 1
+	// compactControlSize is the size of a control
+	// (type and referenced message hash).
+	compactControlSize = 1 + sha256.Size
+	// compactFlagNonce signifies a compact payload carrying a nonce.
+	compactFlagNonce = 1 << 2
+	// compactPayloadHeaderSize is the size of the version and flags fields.
+	compactPayloadHeaderSize = 2
+	// maxCompactPayloadSize is the maximum size of a decompressed payload body.
+	maxCompactPayloadSize = 1 << 16
+)
+
+// IsCompactPayload returns whether a raw payload is in the compact format.
+// Legacy JSON payloads always start with an opening brace.
+func IsCompactPayload(data []byte) bool {
+	return len(data) != 0 && data[0] != '{'
+}
+
+// marshalCompactPayload encodes participants, a nonce and a control
+// (both of which may be empty) and a message in the compact payload format
+// (version 1), structured as:
+//
+//	version (1 byte) | flags (1 byte) | body
+//
+// where body (compressed if flags signify so) is structured as:
+//
+//	participant count (uvarint) | participant public keys (33 bytes each) |
+//	nonce (if flags signify so) | control (if flags signify so) | message
+//
+// and control is structured as:
+//
+//	control type (1 byte) | referenced message hash (32 bytes)
+func marshalCompactPayload(participants []string, nonce []byte, ctrl *Control,
+	message string) ([]byte, error) {
+
+	var body bytes.Buffer
+
+	var count [binary.MaxVarintLen64]byte
+	body.Write(count[:binary.PutUvarint(count[:], uint64(len(participants)))])
+	for _, p := range participants {
+		node, err := lnchat.NewNodeFromString(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid participant address %q: %w", p, err)
+		}
+		body.Write(node.Bytes())
+	}
+	var flags byte
+	if len(nonce) != 0 {
+		if len(nonce) != payloadNonceSize {
+			return nil, fmt.Errorf("invalid nonce length %d", len(nonce))
+		}
+		flags |= compactFlagNonce
+		body.Write(nonce)
+	}
+	if ctrl != nil {
+		flags |= compactFlagControl
+		body.WriteByte(byte(ctrl.Type))
+		body.Write(ctrl.Ref)
+	}
+	body.WriteString(message)
+
+	var compressed bytes.Buffer
+	w, err := flate.NewWriter(&compressed, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(body.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	data := body.Bytes()
+	if compressed.Len() < body.Len() {
+		flags, data = flags|compactFlagDeflate, compressed.Bytes()
+	}
+
+	return append([]byte{compactPayloadVersion, flags}, data...), nil
+}
+
+// unmarshalCompactPayload decodes a compact payload.
+func unmarshalCompactPayload(data []byte) (*compositePayload, error) {
+	if len(data) < compactPayloadHeaderSize {
+		return nil, fmt.Errorf("compact payload too short")
+	}
+	if version := data[0]; version != compactPayloadVersion {
+		return nil, fmt.Errorf("unsupported compact payload version %d", version)
+	}
+	flags, body := data[1], data[compactPayloadHeaderSize:]
+	if flags&^(compactFlagDeflate|compactFlagControl|compactFlagNonce) != 0 {
+		return nil, fmt.Errorf("unsupported compact payload flags %#x", flags)
+	}
+
+	if flags&compactFlagDeflate != 0 {
+		r := flate.NewReader(bytes.NewReader(body))
+		defer r.Close()
+
+		decompressed, err := io.ReadAll(io.LimitReader(r, maxCompactPayloadSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("compact payload decompression failed: %w", err)
+		}
+		if len(decompressed) > maxCompactPayloadSize {
+			return nil, fmt.Errorf("compact payload exceeds %d bytes",
+				maxCompactPayloadSize)
+		}
+		body = decompressed
+	}
+
+	count, n := binary.Uvarint(body)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid compact payload participant count")
+	}
+	body = body[n:]
+	if count > uint64(len(body)/route.VertexSize) {
+		return nil, fmt.Errorf("compact payload too short for %d participants", count)
+	}
+
+	var participants []string
+	if count != 0 {
+		participants = make([]string, count)
+	}
+	for i := range participants {
+		node, err := lnchat.NewNodeFromBytes(body[:route.VertexSize])
+		if err != nil {
+			return nil, fmt.Errorf("invalid participant address: %w", err)
+		}
+		participants[i] = node.String()
+		body = body[route.VertexSize:]
+	}
+
+	var nonce string
+	if flags&compactFlagNonce != 0 {
+		if len(body) < payloadNonceSize {
+			return nil, fmt.Errorf("compact payload too short for nonce")
+		}
+		nonce = hex.EncodeToString(body[:payloadNonceSize])
+		body = body[payloadNonceSize:]
+	}
+
+	var ctrl *controlPayload
+	if flags&compactFlagControl != 0 {
+		if len(body) < compactControlSize {
+			return nil, fmt.Errorf("compact payload too short for control")
+		}
+		ctrl = newControlPayload(&Control{
+			Type: ControlType(body[0]),
+			Ref:  body[1:compactControlSize],
+		})
+		body = body[compactControlSize:]
+	}
+
+	if !utf8.Valid(body) {
+		return nil, fmt.Errorf("compact payload message is not valid UTF-8")
+	}
+
+	return &compositePayload{
+		Participants: participants,
+		Message:      string(body),
+		Nonce:        nonce,
+		Control:      ctrl,
+	}, nil
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompactPayload(t *testing.T) {
+	participants := []string{
+		"022bd806c97f0e00af1a1fc3328fa763a9269723c8db8fac4f93af71db186d6e90",
+		"0281b637d8fcd2c6da6359e6963113a1170de795e4b725b84d1e0b4cfd9ec58ce9",
+	}
+
+	cases := []struct {
+		name         string
+		participants []string
+		message      string
+		compressed   bool
+	}{
+		{
+			name:         "Short message",
+			participants: participants,
+			message:      "hello",
+		},
+		{
+			name:         "Compressible message",
+			participants: participants,
+			message:      strings.Repeat("hello ", 50),
+			compressed:   true,
+		},
+		{
+			name:    "No participants",
+			message: "hello",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			raw, err := NewRawMessage(&Discussion{Participants: c.participants},
+				c.message, PayloadFormatCompact)
+			require.NoError(t, err)
+			require.True(t, IsCompactPayload(raw.RawPayload))
+			assert.Equal(t, byte(compactPayloadVersion), raw.RawPayload[0])
+			assert.Equal(t, c.compressed, raw.RawPayload[1]&compactFlagDeflate != 0)
+
+			legacy, err := json.Marshal(compositePayload{
+				Participants: c.participants,
+				Message:      c.message,
+			})
+			require.NoError(t, err)
+			assert.Less(t, len(raw.RawPayload), len(legacy))
+
+			message, decoded, err := raw.UnmarshalPayload()
+			require.NoError(t, err)
+			assert.Equal(t, c.message, message)
+			assert.Equal(t, c.participants, decoded)
+		})
+	}
+}
+
+func TestUnmarshalPayloadFormats(t *testing.T) {
+	legacy := &RawMessage{
+		RawPayload: []byte(`{"participants":["0200000000000000000000000000` +
+			`00000000000000000000000000000000000001"],"message":"hello"}`),
+	}
+	message, participants, err := legacy.UnmarshalPayload()
+	require.NoError(t, err)
+	assert.Equal(t, "hello", message)
+	assert.Equal(t, []string{
+		"020000000000000000000000000000000000000000000000000000000000000001",
+	}, participants)
+
+	for name, payload := range map[string][]byte{
+		"unsupported version":  {2, 0, 0},
+		"unsupported flags":    {compactPayloadVersion, 0x80, 0},
+		"missing participants": {compactPayloadVersion, 0, 2, 0x02},
+		"truncated header":     {compactPayloadVersion},
+		"invalid compression":  {compactPayloadVersion, compactFlagDeflate, 0xff},
+	} {
+		_, _, err := (&RawMessage{RawPayload: payload}).UnmarshalPayload()
+		assert.Error(t, err, name)
+	}
+}
+
+func TestPayloadControl(t *testing.T) {
+	disc := &Discussion{Participants: []string{
+		"022bd806c97f0e00af1a1fc3328fa763a9269723c8db8fac4f93af71db186d6e90",
+	}}
+	ref := (&RawMessage{RawPayload: []byte("referenced")}).Hash()
+
+	for _, format := range []PayloadFormat{PayloadFormatJSON, PayloadFormatCompact} {
+		for _, ctrl := range []*Control{
+			nil,
+			{Type: ControlReply, Ref: ref},
+			{Type: ControlReaction, Ref: ref},
+			{Type: ControlEdit, Ref: ref},
+			{Type: ControlDelete, Ref: ref},
+		} {
+			raw, err := NewControlRawMessage(disc, ctrl, "hello", format)
+			require.NoError(t, err)
+
+			message, participants, err := raw.UnmarshalPayload()
+			require.NoError(t, err)
+			assert.Equal(t, "hello", message)
+			assert.Equal(t, disc.Participants, participants)
+
+			decoded, err := raw.UnmarshalControl()
+			require.NoError(t, err)
+			assert.Equal(t, ctrl, decoded)
+		}
+
+		_, err := NewControlRawMessage(disc, &Control{Type: ControlEdit, Ref: ref[:8]},
+			"hello", format)
+		assert.Error(t, err)
+		_, err = NewControlRawMessage(disc, &Control{Type: ControlNone, Ref: ref},
+			"hello", format)
+		assert.Error(t, err)
+	}
+
+	unknown := append([]byte{compactPayloadVersion, compactFlagControl, 0, 9}, ref...)
+	_, err := (&RawMessage{RawPayload: unknown}).UnmarshalControl()
+	assert.Error(t, err)
+}
+
+func TestPayloadNonce(t *testing.T) {
+	disc := &Discussion{Participants: []string{
+		"022bd806c97f0e00af1a1fc3328fa763a9269723c8db8fac4f93af71db186d6e90",
+	}}
+
+	for _, format := range []PayloadFormat{PayloadFormatJSON, PayloadFormatCompact} {
+		first, err := NewRawMessage(disc, "ok", format)
+		require.NoError(t, err)
+		second, err := NewRawMessage(disc, "ok", format)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first.RawPayload, second.RawPayload)
+		assert.NotEqual(t, first.Hash(), second.Hash())
+
+		for _, raw := range []*RawMessage{first, second} {
+			message, participants, err := raw.UnmarshalPayload()
+			require.NoError(t, err)
+			assert.Equal(t, "ok", message)
+			assert.Equal(t, disc.Participants, participants)
+		}
+	}
+
+	// Payloads without a nonce (of earlier versions) are decoded.
+	legacy := &RawMessage{RawPayload: []byte(`{"participants":[],"message":"ok"}`)}
+	message, _, err := legacy.UnmarshalPayload()
+	require.NoError(t, err)
+	assert.Equal(t, "ok", message)
+
+	truncated := []byte{compactPayloadVersion, compactFlagNonce, 0, 1, 2}
+	_, _, err = (&RawMessage{RawPayload: truncated}).UnmarshalPayload()
+	assert.Error(t, err)
+}
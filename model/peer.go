@@ -0,0 +1,18 @@
+package model
+
+import "github.com/c13n-io/c13n-go/lnchat"
+
+// Peer represents the model for a node connected
+// to the underlying node.
+type Peer struct {
+	lnchat.Peer
+}
+
+// PersistentPeer represents a node that the application
+// keeps connected to the underlying node.
+type PersistentPeer struct {
+	// The Lightning address of the node.
+	Address string `badgerhold:"key"`
+	// The network address (host:port) used to connect to the node.
+	Hostport string
+}
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -16,7 +19,8 @@ import (
 // RawMessage represents a raw message over the Lightning network,
 // associated with a payment or an invoice - depending on whether it
 // was incoming or outgoing.
-// Exactly one of InvoiceSettleIndex or PaymentIndex must be populated.
+// Exactly one of InvoiceSettleIndex or PaymentIndex must be populated,
+// unless the message was exchanged over custom peer messages.
 type RawMessage struct {
 	// The message id (store index).
 	ID uint64 `badgerhold:"key"`
@@ -30,6 +34,8 @@ type RawMessage struct {
 	// The message signature.
 	Signature []byte
 	// Whether the sender was the one that signed the payload.
+	// For peer messages, the sender is authenticated by the
+	// peer connection and is always verified.
 	SignatureVerified bool
 	// The SettleIndex of the invoice associated
 	// with the message (incoming).
@@ -37,51 +43,197 @@ type RawMessage struct {
 	// The PaymentIndexes of the payments
 	// used to transport the message (outgoing).
 	PaymentIndexes []uint64
+	// Whether the message was exchanged over custom peer messages,
+	// in which case it is not associated with an invoice or payments.
+	PeerTransport bool
+	// The messaging convention the message payload follows.
+	// Messages stored before sources were recorded have an empty source.
+	Source string
+	// The transfer id of the attachment accompanying the message, if any.
+	AttachmentID string
+	// The hash identifying the message (hex-encoded),
+	// referenced by replies, reactions, edits and deletions.
+	MessageHash string `badgerholdIndex:"MessageHash"`
+	// The type of the control carried by the message, if any.
+	ControlType ControlType
+	// The hash of the message referenced by the control (hex-encoded).
+	ControlRef string `badgerholdIndex:"ControlRef"`
 	// The timestamp of the message.
 	// It  is an internal field and does not correspond
 	// to the sent or received time of the message.
 	Timestamp time.Time
 }
 
+// Sources of message payloads, identifying
+// the messaging convention a message follows.
+const (
+	// SourceC13N identifies messages following the c13n convention.
+	SourceC13N = "c13n"
+	// SourceKeysend identifies keysend chat messages,
+	// as sent by Whatsat and other Lightning messaging apps.
+	SourceKeysend = "keysend"
+	// SourceBoostagram identifies podcasting boostagrams.
+	SourceBoostagram = "boostagram"
+)
+
+// payloadNonceSize is the size of the random nonce carried by c13n payloads,
+// distinguishing otherwise identical messages.
+const payloadNonceSize = 8
+
 type compositePayload struct {
-	Participants []string `json:"participants"`
-	Message      string   `json:"message"`
+	Participants []string        `json:"participants"`
+	Message      string          `json:"message"`
+	Nonce        string          `json:"nonce,omitempty"`
+	Control      *controlPayload `json:"control,omitempty"`
 }
 
-// UnmarshalPayload returns the message participants.
-func (raw *RawMessage) UnmarshalPayload() (string, []string, error) {
+// unmarshalPayload decodes both compact and legacy JSON payloads.
+func (raw *RawMessage) unmarshalPayload() (*compositePayload, error) {
+	if IsCompactPayload(raw.RawPayload) {
+		return unmarshalCompactPayload(raw.RawPayload)
+	}
+
 	msg := &compositePayload{}
 	if raw.RawPayload != nil {
 		if err := json.Unmarshal(raw.RawPayload, msg); err != nil {
-			return "", nil, err
+			return nil, err
 		}
 	}
 
+	return msg, nil
+}
+
+// UnmarshalPayload returns the message and the participants,
+// decoding both compact and legacy JSON payloads.
+func (raw *RawMessage) UnmarshalPayload() (string, []string, error) {
+	msg, err := raw.unmarshalPayload()
+	if err != nil {
+		return "", nil, err
+	}
+
 	return msg.Message, msg.Participants, nil
 }
 
-// NewRawMessage constructs a raw message from a discussion and payload.
-func NewRawMessage(discussion *Discussion, payload string) (*RawMessage, error) {
+// UnmarshalControl returns the control carried by the message payload,
+// or nil if the payload carries no control.
+func (raw *RawMessage) UnmarshalControl() (*Control, error) {
+	msg, err := raw.unmarshalPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	return msg.Control.control()
+}
+
+// Hash returns the hash identifying the message, computed over
+// the sender address, the signature and the raw payload.
+// Since these are carried verbatim, the hash is the same
+// for the sender and all recipients of a message.
+// c13n payloads carry a random nonce, and keysend signatures are over
+// a timestamp, so identical messages of a sender have different hashes.
+func (raw *RawMessage) Hash() []byte {
+	h := sha256.New()
+	h.Write([]byte(raw.Sender))
+	h.Write(raw.Signature)
+	h.Write(raw.RawPayload)
+
+	return h.Sum(nil)
+}
+
+// IndexRelations populates the message hash and,
+// if the payload carries a control, the control type
+// and the hash of the referenced message.
+// Payloads that cannot be decoded carry no control.
+func (raw *RawMessage) IndexRelations() {
+	raw.MessageHash = hex.EncodeToString(raw.Hash())
+	raw.ControlType, raw.ControlRef = ControlNone, ""
+
+	if ctrl, err := raw.UnmarshalControl(); err == nil && ctrl != nil {
+		raw.ControlType = ctrl.Type
+		raw.ControlRef = hex.EncodeToString(ctrl.Ref)
+	}
+}
+
+// SignedBySameSender returns whether a message was signed
+// by the (identified) sender of the raw message.
+func (raw *RawMessage) SignedBySameSender(msg *RawMessage) bool {
+	return raw.Sender != "" && msg.Sender == raw.Sender && msg.SignatureVerified
+}
+
+// NewRawMessage constructs a raw message from a discussion and payload,
+// encoding the payload in the provided format.
+func NewRawMessage(discussion *Discussion, payload string,
+	format PayloadFormat) (*RawMessage, error) {
+
+	return NewControlRawMessage(discussion, nil, payload, format)
+}
+
+// NewControlRawMessage constructs a raw message from a discussion,
+// a control (which may be nil) and a payload,
+// encoding the payload in the provided format.
+// The payload carries a random nonce, so that the hashes
+// of identical messages differ.
+func NewControlRawMessage(discussion *Discussion, ctrl *Control, payload string,
+	format PayloadFormat) (*RawMessage, error) {
+
 	if discussion == nil {
 		return nil, fmt.Errorf("cannot create raw message for empty discussion")
 	}
+	if ctrl != nil {
+		if err := ctrl.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	rawMsg := new(RawMessage)
 
-	data, err := json.Marshal(compositePayload{
-		Participants: discussion.Participants,
-		Message:      payload,
-	})
+	nonce := make([]byte, payloadNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "could not generate payload nonce")
+	}
+
+	var data []byte
+	var err error
+	switch format {
+	case PayloadFormatCompact:
+		data, err = marshalCompactPayload(discussion.Participants,
+			nonce, ctrl, payload)
+	default:
+		data, err = json.Marshal(compositePayload{
+			Participants: discussion.Participants,
+			Message:      payload,
+			Nonce:        hex.EncodeToString(nonce),
+			Control:      newControlPayload(ctrl),
+		})
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal payload")
 	}
 
 	rawMsg.RawPayload = data
 	rawMsg.DiscussionID = discussion.ID
+	rawMsg.Source = SourceC13N
 
 	return rawMsg, nil
 }
 
+// NewTextRawMessage constructs a raw message from a text payload
+// following a messaging convention other than c13n.
+// Such payloads do not carry the discussion participants.
+func NewTextRawMessage(source, text string) (*RawMessage, error) {
+	data, err := json.Marshal(compositePayload{
+		Message: text,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal payload")
+	}
+
+	return &RawMessage{
+		RawPayload: data,
+		Source:     source,
+	}, nil
+}
+
 // WithTimestamp adds the provided timestamp to the raw message.
 func (raw *RawMessage) WithTimestamp(ts time.Time) {
 	raw.Timestamp = ts
@@ -212,6 +364,10 @@ func NewOutgoingMessage(rawMsg *RawMessage, onlySuccessfulPayments bool,
 		}(payments)
 	}
 
+	if rawMsg != nil && rawMsg.PeerTransport {
+		return newPeerMessage(rawMsg)
+	}
+
 	if rawMsg == nil || len(usedPayments) <= 0 {
 		return nil, fmt.Errorf("raw message or payments missing")
 	}
@@ -305,6 +461,25 @@ func newOutgoingMessage(rawMsg *RawMessage, payments ...*Payment) (*Message, err
 	}, nil
 }
 
+func newPeerMessage(rawMsg *RawMessage) (*Message, error) {
+	payload, _, err := rawMsg.UnmarshalPayload()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal raw message")
+	}
+
+	ts := rawMsg.Timestamp.UnixNano()
+
+	return &Message{
+		ID:             rawMsg.ID,
+		DiscussionID:   rawMsg.DiscussionID,
+		Payload:        payload,
+		Sender:         rawMsg.Sender,
+		SenderVerified: rawMsg.SignatureVerified,
+		SentTimeNs:     ts,
+		ReceivedTimeNs: ts,
+	}, nil
+}
+
 func newRoute(route lnchat.Route) Route {
 	hops := make([]Hop, len(route.Hops))
 	for i, hop := range route.Hops {
@@ -313,6 +488,7 @@ func newRoute(route lnchat.Route) Route {
 			HopAddress:       hop.NodeID.String(),
 			AmtToForwardMsat: hop.AmtToForward.Msat(),
 			FeeMsat:          hop.Fees.Msat(),
+			Expiry:           hop.Expiry,
 			CustomRecords:    hop.CustomRecords,
 		}
 	}
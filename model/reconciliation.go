@@ -0,0 +1,19 @@
+package model
+
+// ReconciliationReport summarizes a reconciliation of the stored
+// invoices, payments and messages against the Lightning node history.
+type ReconciliationReport struct {
+	// The number of settled invoices checked.
+	InvoicesChecked int
+	// The number of resolved payments checked.
+	PaymentsChecked int
+	// The settle indexes of the invoices that were missing and were stored.
+	BackfilledInvoices []uint64
+	// The payment indexes of the payments that were missing and were stored.
+	BackfilledPayments []uint64
+	// The IDs of the messages that were missing and were recovered
+	// from the payload of their invoice or payments.
+	RecoveredMessages []uint64
+	// The number of invoices and payments that could not be reconciled.
+	Failures int
+}
@@ -0,0 +1,21 @@
+package model
+
+import "github.com/c13n-io/c13n-go/lnchat"
+
+// Utxo represents the model for an unspent output
+// of the on-chain wallet.
+type Utxo struct {
+	lnchat.Utxo
+}
+
+// Transaction represents the model for an on-chain transaction
+// of the wallet.
+type Transaction struct {
+	lnchat.Transaction
+}
+
+// FeeEstimate represents the model for the estimated fee
+// of an on-chain transaction.
+type FeeEstimate struct {
+	lnchat.FeeEstimate
+}
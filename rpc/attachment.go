@@ -0,0 +1,150 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/c13n-io/c13n-go/app"
+	"github.com/c13n-io/c13n-go/model"
+	pb "github.com/c13n-io/c13n-go/rpc/services"
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+// attachmentStreamChunkSize is the maximum size
+// of the attachment contents sent per stream message.
+const attachmentStreamChunkSize = 32 * 1024
+
+type attachmentServiceServer struct {
+	Log *slog.Logger
+
+	App *app.App
+
+	pb.UnimplementedAttachmentServiceServer
+}
+
+func (s *attachmentServiceServer) logError(err error) error {
+	if err != nil {
+		s.Log.Errorf("%+v", err)
+	}
+	return err
+}
+
+// Interface implementation
+
+// SendAttachment sends an attachment to a discussion.
+func (s *attachmentServiceServer) SendAttachment(ctx context.Context,
+	req *pb.SendAttachmentRequest) (*pb.SendAttachmentResponse, error) {
+
+	att, err := s.App.SendAttachment(ctx, req.GetDiscussionId(),
+		req.GetName(), req.GetMimeType(), req.GetData(), req.GetPayload(),
+		messageOptionsFromRequest(req.GetOptions()))
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	info, err := newAttachmentInfo(att)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.SendAttachmentResponse{
+		Attachment: info,
+	}, nil
+}
+
+// ResumeAttachment resumes the transfer of an outgoing attachment.
+func (s *attachmentServiceServer) ResumeAttachment(ctx context.Context,
+	req *pb.ResumeAttachmentRequest) (*pb.ResumeAttachmentResponse, error) {
+
+	att, err := s.App.ResumeAttachment(ctx, req.GetTransferId(),
+		messageOptionsFromRequest(req.GetOptions()))
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	info, err := newAttachmentInfo(att)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.ResumeAttachmentResponse{
+		Attachment: info,
+	}, nil
+}
+
+// GetAttachment sends the info and (if the transfer is complete)
+// the contents of an attachment over the provided stream.
+func (s *attachmentServiceServer) GetAttachment(req *pb.GetAttachmentRequest,
+	srv pb.AttachmentService_GetAttachmentServer) error {
+
+	att, data, err := s.App.GetAttachment(srv.Context(), req.GetTransferId())
+	if err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+	info, err := newAttachmentInfo(att)
+	if err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+	if err := srv.Send(&pb.GetAttachmentResponse{
+		Content: &pb.GetAttachmentResponse_Info{
+			Info: info,
+		},
+	}); err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+	for len(data) > 0 {
+		n := attachmentStreamChunkSize
+		if n > len(data) {
+			n = len(data)
+		}
+		if err := srv.Send(&pb.GetAttachmentResponse{
+			Content: &pb.GetAttachmentResponse_Data{
+				Data: data[:n],
+			},
+		}); err != nil {
+			return associateStatusCode(s.logError(err))
+		}
+		data = data[n:]
+	}
+
+	return nil
+}
+
+func newAttachmentInfo(att *model.Attachment) (*pb.AttachmentInfo, error) {
+	createdAt, err := newProtoTimestamp(time.Unix(0, att.CreatedTimeNs))
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: invalid timestamp: %v", err)
+	}
+
+	transferred := len(att.SentChunks)
+	if att.Incoming {
+		transferred = len(att.ReceivedChunks)
+	}
+
+	return &pb.AttachmentInfo{
+		TransferId:        att.TransferID,
+		DiscussionId:      att.DiscussionID,
+		Sender:            att.Sender,
+		SenderVerified:    att.SenderVerified,
+		Incoming:          att.Incoming,
+		Name:              att.Name,
+		MimeType:          att.MimeType,
+		Size:              att.Size,
+		ContentHash:       att.ContentHash,
+		TotalChunks:       att.TotalChunks,
+		TransferredChunks: uint32(transferred),
+		Complete:          att.Complete,
+		CreatedTimestamp:  createdAt,
+	}, nil
+}
+
+// NewAttachmentServiceServer initializes a new attachment service.
+func NewAttachmentServiceServer(app *app.App) pb.AttachmentServiceServer {
+	return &attachmentServiceServer{
+		Log: slog.NewLogger("attachment-service"),
+		App: app,
+	}
+}
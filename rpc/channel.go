@@ -2,8 +2,10 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/c13n-io/c13n-go/app"
+	"github.com/c13n-io/c13n-go/lnchat"
 	"github.com/c13n-io/c13n-go/model"
 	pb "github.com/c13n-io/c13n-go/rpc/services"
 	"github.com/c13n-io/c13n-go/slog"
@@ -48,6 +50,361 @@ func (s *channelServiceServer) OpenChannel(ctx context.Context,
 	}, nil
 }
 
+// ListChannels returns the open channels of the underlying node.
+func (s *channelServiceServer) ListChannels(ctx context.Context,
+	req *pb.ListChannelsRequest) (*pb.ListChannelsResponse, error) {
+
+	channels, err := s.App.ListChannels(ctx, model.ChannelFilter{
+		ActiveOnly:   req.GetActiveOnly(),
+		InactiveOnly: req.GetInactiveOnly(),
+		PublicOnly:   req.GetPublicOnly(),
+		PrivateOnly:  req.GetPrivateOnly(),
+		Peer:         req.GetPeer(),
+	})
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp := make([]*pb.Channel, len(channels))
+	for i, ch := range channels {
+		resp[i] = newChannel(ch)
+	}
+
+	return &pb.ListChannelsResponse{
+		Channels: resp,
+	}, nil
+}
+
+// ListPendingChannels returns the channels of the underlying node
+// that are pending opening or closing.
+func (s *channelServiceServer) ListPendingChannels(ctx context.Context,
+	_ *pb.ListPendingChannelsRequest) (*pb.ListPendingChannelsResponse, error) {
+
+	channels, err := s.App.ListPendingChannels(ctx)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp := make([]*pb.PendingChannel, len(channels))
+	for i, ch := range channels {
+		if resp[i], err = newPendingChannel(ch); err != nil {
+			return nil, associateStatusCode(s.logError(err))
+		}
+	}
+
+	return &pb.ListPendingChannelsResponse{
+		Channels: resp,
+	}, nil
+}
+
+// CloseChannel closes a channel and returns a stream
+// over which the progress of the closing is received.
+func (s *channelServiceServer) CloseChannel(req *pb.CloseChannelRequest,
+	srv pb.ChannelService_CloseChannelServer) error {
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	chanPoint := model.ChannelPoint{
+		ChannelPoint: lnchat.ChannelPoint{
+			FundingTxid: req.GetChannelPoint().GetFundingTxid(),
+			OutputIndex: req.GetChannelPoint().GetOutputIndex(),
+		},
+	}
+
+	closeUpdates, err := s.App.CloseChannel(ctx, chanPoint, req.GetForce(),
+		model.TxFeeOptions{
+			SatPerVByte:     req.GetSatPerVbyte(),
+			TargetConfBlock: req.GetTargetConfirmationBlock(),
+		},
+	)
+	if err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+closeLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			s.Log.Printf("client channel close stream ended")
+			break closeLoop
+		case update, ok := <-closeUpdates:
+			if !ok {
+				break closeLoop
+			}
+			if update.Err != nil {
+				return associateStatusCode(s.logError(update.Err))
+			}
+
+			resp, err := newCloseChannelResponse(update.Status)
+			if err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+			if err := srv.Send(resp); err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// SubscribeChannelEvents returns a stream over which
+// events concerning the channels of the underlying node are received.
+func (s *channelServiceServer) SubscribeChannelEvents(_ *pb.SubscribeChannelEventsRequest,
+	srv pb.ChannelService_SubscribeChannelEventsServer) error {
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	eventChannel, err := s.App.SubscribeChannelEvents(ctx)
+	if err != nil {
+		return associateStatusCode(s.logError(
+			fmt.Errorf("client subscription failed: %w", err)))
+	}
+
+eventLoop:
+	for {
+		select {
+		case <-ctx.Done():
+			s.Log.Printf("client subscription ended")
+			break eventLoop
+		case ev, ok := <-eventChannel:
+			if !ok {
+				s.Log.Printf("subscription channel closed")
+				break eventLoop
+			}
+
+			event, err := newChannelEvent(ev)
+			if err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+			if err := srv.Send(event); err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetChannelPolicy returns the forwarding policy of the underlying node for a channel.
+func (s *channelServiceServer) GetChannelPolicy(ctx context.Context,
+	req *pb.GetChannelPolicyRequest) (*pb.ChannelPolicy, error) {
+
+	policy, err := s.App.GetChannelPolicy(ctx, model.ChannelPoint{
+		ChannelPoint: lnchat.ChannelPoint{
+			FundingTxid: req.GetChannelPoint().GetFundingTxid(),
+			OutputIndex: req.GetChannelPoint().GetOutputIndex(),
+		},
+	})
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.ChannelPolicy{
+		BaseFeeMsat:   policy.BaseFeeMsat,
+		FeeRatePpm:    policy.FeeRatePPM,
+		TimeLockDelta: policy.TimeLockDelta,
+		MinHtlcMsat:   policy.MinHTLCMsat,
+		MaxHtlcMsat:   policy.MaxHTLCMsat,
+	}, nil
+}
+
+// UpdateChannelPolicy updates the forwarding policy
+// of the underlying node for a channel, or for all channels.
+func (s *channelServiceServer) UpdateChannelPolicy(ctx context.Context,
+	req *pb.UpdateChannelPolicyRequest) (*pb.UpdateChannelPolicyResponse, error) {
+
+	var chanPoint *model.ChannelPoint
+	if cp := req.GetChannelPoint(); cp != nil {
+		chanPoint = &model.ChannelPoint{
+			ChannelPoint: lnchat.ChannelPoint{
+				FundingTxid: cp.GetFundingTxid(),
+				OutputIndex: cp.GetOutputIndex(),
+			},
+		}
+	}
+
+	policy := req.GetPolicy()
+	if err := s.App.UpdateChannelPolicy(ctx, chanPoint, model.ChannelPolicy{
+		ChannelPolicy: lnchat.ChannelPolicy{
+			BaseFeeMsat:   policy.GetBaseFeeMsat(),
+			FeeRatePPM:    policy.GetFeeRatePpm(),
+			TimeLockDelta: policy.GetTimeLockDelta(),
+			MinHTLCMsat:   policy.GetMinHtlcMsat(),
+			MaxHTLCMsat:   policy.GetMaxHtlcMsat(),
+		},
+	}); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.UpdateChannelPolicyResponse{}, nil
+}
+
+// ForwardingHistory returns the forwarding events of the underlying node
+// within a time range, along with per-channel aggregates.
+func (s *channelServiceServer) ForwardingHistory(ctx context.Context,
+	req *pb.ForwardingHistoryRequest) (*pb.ForwardingHistoryResponse, error) {
+
+	history, err := s.App.ForwardingHistory(ctx,
+		req.GetStartTime(), req.GetEndTime(),
+		pageOptionsFromKeySet(req.GetPageOptions()))
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	events := make([]*pb.ForwardingEvent, len(history.Events))
+	for i, e := range history.Events {
+		events[i] = &pb.ForwardingEvent{
+			Index:       e.Index,
+			TimestampNs: e.TimestampNs,
+			ChanIdIn:    e.ChanIDIn,
+			ChanIdOut:   e.ChanIDOut,
+			AmtInMsat:   e.AmtInMsat,
+			AmtOutMsat:  e.AmtOutMsat,
+			FeeMsat:     e.FeeMsat,
+		}
+	}
+	channels := make([]*pb.ChannelForwardingStats, len(history.Channels))
+	for i, c := range history.Channels {
+		channels[i] = &pb.ChannelForwardingStats{
+			ChanId:      c.ChannelID,
+			ForwardsIn:  c.ForwardsIn,
+			ForwardsOut: c.ForwardsOut,
+			AmtInMsat:   c.AmtInMsat,
+			AmtOutMsat:  c.AmtOutMsat,
+			FeeMsat:     c.FeeMsat,
+		}
+	}
+
+	return &pb.ForwardingHistoryResponse{
+		Events:   events,
+		Channels: channels,
+	}, nil
+}
+
+func newChannelPoint(chanPoint lnchat.ChannelPoint) *pb.ChannelPoint {
+	return &pb.ChannelPoint{
+		FundingTxid: chanPoint.FundingTxid,
+		OutputIndex: chanPoint.OutputIndex,
+	}
+}
+
+func newChannel(ch model.Channel) *pb.Channel {
+	return &pb.Channel{
+		ChannelPoint:         newChannelPoint(ch.ChannelPoint),
+		ChanId:               ch.ChannelID,
+		RemoteAddress:        ch.RemoteAddress,
+		Active:               ch.Active,
+		Private:              ch.Private,
+		Initiator:            ch.Initiator,
+		CapacityMsat:         ch.CapacityMsat,
+		LocalBalanceMsat:     ch.Balance.LocalMsat,
+		RemoteBalanceMsat:    ch.Balance.RemoteMsat,
+		UnsettledBalanceMsat: ch.UnsettledBalanceMsat,
+		TotalSentMsat:        ch.TotalSentMsat,
+		TotalReceivedMsat:    ch.TotalReceivedMsat,
+		NumUpdates:           ch.NumUpdates,
+	}
+}
+
+var pendingChannelStateMap = map[lnchat.PendingChannelState]pb.PendingChannelState{
+	lnchat.PendingOPEN:         pb.PendingChannelState_PENDING_OPEN,
+	lnchat.PendingWAITINGCLOSE: pb.PendingChannelState_PENDING_WAITING_CLOSE,
+	lnchat.PendingFORCECLOSING: pb.PendingChannelState_PENDING_FORCE_CLOSING,
+}
+
+func newPendingChannel(ch model.PendingChannel) (*pb.PendingChannel, error) {
+	state, ok := pendingChannelStateMap[ch.State]
+	if !ok {
+		return nil, fmt.Errorf("marshal error: invalid pending channel state: %v", ch.State)
+	}
+
+	return &pb.PendingChannel{
+		ChannelPoint:      newChannelPoint(ch.ChannelPoint),
+		RemoteAddress:     ch.RemoteAddress,
+		State:             state,
+		Initiator:         ch.Initiator,
+		CapacityMsat:      ch.CapacityMsat,
+		LocalBalanceMsat:  ch.Balance.LocalMsat,
+		RemoteBalanceMsat: ch.Balance.RemoteMsat,
+		ClosingTxid:       ch.ClosingTxid,
+		LimboBalanceMsat:  ch.LimboBalanceMsat,
+		MaturityHeight:    ch.MaturityHeight,
+		BlocksTilMaturity: ch.BlocksTilMaturity,
+	}, nil
+}
+
+func newCloseChannelResponse(status *model.ChannelCloseStatus) (*pb.CloseChannelResponse, error) {
+	var state pb.ChannelCloseState
+	switch status.State {
+	case lnchat.ChannelClosePENDING:
+		state = pb.ChannelCloseState_CHANNEL_CLOSE_PENDING
+	case lnchat.ChannelCloseCONFIRMED:
+		state = pb.ChannelCloseState_CHANNEL_CLOSE_CONFIRMED
+	default:
+		return nil, fmt.Errorf("marshal error: invalid channel close state: %v", status.State)
+	}
+
+	return &pb.CloseChannelResponse{
+		State:       state,
+		ClosingTxid: status.ClosingTxid,
+	}, nil
+}
+
+var channelEventTypeMap = map[lnchat.ChannelEventType]pb.ChannelEventType{
+	lnchat.ChannelEventPENDINGOPEN:   pb.ChannelEventType_CHANNEL_EVENT_PENDING_OPEN,
+	lnchat.ChannelEventOPEN:          pb.ChannelEventType_CHANNEL_EVENT_OPEN,
+	lnchat.ChannelEventACTIVE:        pb.ChannelEventType_CHANNEL_EVENT_ACTIVE,
+	lnchat.ChannelEventINACTIVE:      pb.ChannelEventType_CHANNEL_EVENT_INACTIVE,
+	lnchat.ChannelEventCLOSED:        pb.ChannelEventType_CHANNEL_EVENT_CLOSED,
+	lnchat.ChannelEventFULLYRESOLVED: pb.ChannelEventType_CHANNEL_EVENT_FULLY_RESOLVED,
+}
+
+var channelCloseTypeMap = map[lnchat.ChannelCloseType]pb.ChannelCloseType{
+	lnchat.ChannelCloseCOOPERATIVE:      pb.ChannelCloseType_COOPERATIVE_CLOSE,
+	lnchat.ChannelCloseLOCALFORCE:       pb.ChannelCloseType_LOCAL_FORCE_CLOSE,
+	lnchat.ChannelCloseREMOTEFORCE:      pb.ChannelCloseType_REMOTE_FORCE_CLOSE,
+	lnchat.ChannelCloseBREACH:           pb.ChannelCloseType_BREACH_CLOSE,
+	lnchat.ChannelCloseFUNDINGCANCELLED: pb.ChannelCloseType_FUNDING_CANCELLED,
+	lnchat.ChannelCloseABANDONED:        pb.ChannelCloseType_ABANDONED,
+}
+
+func newChannelEvent(ev *model.ChannelEvent) (*pb.ChannelEvent, error) {
+	eventType, ok := channelEventTypeMap[ev.Type]
+	if !ok {
+		return nil, fmt.Errorf("marshal error: invalid channel event type: %v", ev.Type)
+	}
+
+	event := &pb.ChannelEvent{
+		Type:         eventType,
+		ChannelPoint: newChannelPoint(ev.ChannelPoint),
+	}
+	if ev.Channel != nil {
+		event.Channel = newChannel(model.Channel{Channel: *ev.Channel})
+	}
+	if c := ev.ClosedChannel; c != nil {
+		closeType, ok := channelCloseTypeMap[c.CloseType]
+		if !ok {
+			return nil, fmt.Errorf("marshal error: invalid channel close type: %v", c.CloseType)
+		}
+
+		event.ClosedChannel = &pb.ClosedChannel{
+			ChanId:                c.ChannelID,
+			RemoteAddress:         c.RemoteAddress,
+			CapacityMsat:          c.CapacityMsat,
+			ClosingTxid:           c.ClosingTxid,
+			CloseHeight:           c.CloseHeight,
+			SettledBalanceMsat:    c.SettledBalanceMsat,
+			TimeLockedBalanceMsat: c.TimeLockedBalanceMsat,
+			CloseType:             closeType,
+		}
+	}
+
+	return event, nil
+}
+
 // NewChannelServiceServer initializes a new channel service.
 func NewChannelServiceServer(app *app.App) pb.ChannelServiceServer {
 	return &channelServiceServer{
@@ -0,0 +1,125 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/c13n-io/c13n-go/app"
+	"github.com/c13n-io/c13n-go/model"
+	pb "github.com/c13n-io/c13n-go/rpc/services"
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+type diagnosticsServiceServer struct {
+	Log *slog.Logger
+
+	App *app.App
+
+	pb.UnimplementedDiagnosticsServiceServer
+}
+
+func (s *diagnosticsServiceServer) logError(err error) error {
+	if err != nil {
+		s.Log.Errorf("%+v", err)
+	}
+	return err
+}
+
+// Interface implementation
+
+// QueryMissionControl returns the payment attempt history
+// of the underlying node for all node pairs.
+func (s *diagnosticsServiceServer) QueryMissionControl(ctx context.Context,
+	_ *pb.QueryMissionControlRequest) (*pb.QueryMissionControlResponse, error) {
+
+	pairs, err := s.App.QueryMissionControl(ctx)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp := make([]*pb.PairHistory, len(pairs))
+	for i, p := range pairs {
+		if resp[i], err = newPairHistory(p); err != nil {
+			return nil, associateStatusCode(s.logError(err))
+		}
+	}
+
+	return &pb.QueryMissionControlResponse{
+		Pairs: resp,
+	}, nil
+}
+
+// QueryProbability estimates the probability that the underlying node
+// can forward a payment from a node to another.
+func (s *diagnosticsServiceServer) QueryProbability(ctx context.Context,
+	req *pb.QueryProbabilityRequest) (*pb.QueryProbabilityResponse, error) {
+
+	prob, history, err := s.App.QueryProbability(ctx,
+		req.GetFromNode(), req.GetToNode(), req.GetAmtMsat())
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp, err := newPairHistory(*history)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.QueryProbabilityResponse{
+		Probability: prob,
+		History:     resp,
+	}, nil
+}
+
+// ResetMissionControl clears the payment attempt history of the underlying node.
+func (s *diagnosticsServiceServer) ResetMissionControl(ctx context.Context,
+	_ *pb.ResetMissionControlRequest) (*pb.ResetMissionControlResponse, error) {
+
+	if err := s.App.ResetMissionControl(ctx); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.ResetMissionControlResponse{}, nil
+}
+
+func newPairHistory(p model.PairHistory) (*pb.PairHistory, error) {
+	timestamp := func(sec int64) (*timestamppb.Timestamp, error) {
+		if sec == 0 {
+			return nil, nil
+		}
+		ts, err := newProtoTimestamp(time.Unix(sec, 0))
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: invalid timestamp: %v", err)
+		}
+		return ts, nil
+	}
+
+	failTime, err := timestamp(p.FailTimeSec)
+	if err != nil {
+		return nil, err
+	}
+	successTime, err := timestamp(p.SuccessTimeSec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PairHistory{
+		NodeFrom:       p.NodeFrom,
+		NodeTo:         p.NodeTo,
+		FailTime:       failTime,
+		FailAmtMsat:    p.FailAmtMsat,
+		SuccessTime:    successTime,
+		SuccessAmtMsat: p.SuccessAmtMsat,
+	}, nil
+}
+
+// NewDiagnosticsServiceServer initializes a new diagnostics service.
+func NewDiagnosticsServiceServer(app *app.App) pb.DiagnosticsServiceServer {
+	return &diagnosticsServiceServer{
+		Log: slog.NewLogger("diagnostics-service"),
+		App: app,
+	}
+}
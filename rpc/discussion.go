@@ -174,9 +174,40 @@ func (s *discussionServiceServer) RemoveDiscussion(ctx context.Context, req *pb.
 // If a payment request is specified the discussion with the recipient is used,
 // (creating it with default options if it does not exist).
 func (s *discussionServiceServer) Send(ctx context.Context, req *pb.SendRequest) (*pb.SendResponse, error) {
-	msgAggregate, err := s.App.SendMessage(ctx,
-		req.GetDiscussionId(), req.GetAmtMsat(), req.GetPayReq(),
-		req.GetPayload(), messageOptionsFromRequest(req.GetOptions()))
+	var msgAggregate *model.MessageAggregate
+	var err error
+	switch routes := req.GetRoutes(); {
+	case len(routes) != 0 && req.GetPayReq() != "":
+		return nil, status.Error(codes.InvalidArgument,
+			"routes cannot be used with a payment request")
+	case len(routes) != 0 && req.GetContactId() != 0:
+		return nil, status.Error(codes.InvalidArgument,
+			"routes cannot be used with a contact")
+	case req.GetControl() != nil && (len(routes) != 0 ||
+		req.GetPayReq() != "" || req.GetContactId() != 0):
+		return nil, status.Error(codes.InvalidArgument,
+			"controls cannot be used with routes, a payment request or a contact")
+	case req.GetControl() != nil:
+		ctrl := controlFromRequest(req.GetControl())
+		if err := ctrl.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		msgAggregate, err = s.App.SendControlMessage(ctx,
+			req.GetDiscussionId(), req.GetAmtMsat(), *ctrl,
+			req.GetPayload(), messageOptionsFromRequest(req.GetOptions()))
+	case len(routes) != 0:
+		msgAggregate, err = s.App.SendMessageToRoutes(ctx,
+			req.GetDiscussionId(), req.GetPayload(), routesFromRequest(routes),
+			messageOptionsFromRequest(req.GetOptions()))
+	case req.GetContactId() != 0:
+		msgAggregate, err = s.App.SendMessageToContact(ctx,
+			req.GetContactId(), req.GetAmtMsat(), req.GetPayload(),
+			messageOptionsFromRequest(req.GetOptions()))
+	default:
+		msgAggregate, err = s.App.SendMessage(ctx,
+			req.GetDiscussionId(), req.GetAmtMsat(), req.GetPayReq(),
+			req.GetPayload(), messageOptionsFromRequest(req.GetOptions()))
+	}
 	// SendMessage can partially succeed, in which case log the failures.
 	if err != nil {
 		rpcErr := associateStatusCode(s.logError(err))
@@ -250,12 +281,43 @@ func newMessage(aggregate *model.MessageAggregate) (*pb.Message, error) {
 		Sender:         raw.Sender,
 		SenderVerified: raw.SignatureVerified,
 		Payload:        payload,
+		PeerTransport:  raw.PeerTransport,
+		Source:         raw.Source,
+		AttachmentId:   raw.AttachmentID,
+		MessageHash:    raw.Hash(),
+		Deleted:        aggregate.Deleted,
+	}
+
+	ctrl, err := raw.UnmarshalControl()
+	if err != nil {
+		return nil, err
+	}
+	msg.Control = newMessageControl(ctrl)
+
+	if msg.Reactions, err = newReactions(aggregate.Reactions); err != nil {
+		return nil, err
+	}
+	if msg.Edits, err = newMessageEdits(aggregate.Edits); err != nil {
+		return nil, err
+	}
+	switch {
+	case aggregate.Deleted:
+		msg.Payload = ""
+	case len(aggregate.Edits) != 0:
+		msg.Payload = aggregate.Edits[len(aggregate.Edits)-1].Payload
 	}
 
 	var amtMsat uint64
 	var sentAt, receivedAt *timestamppb.Timestamp
 	switch {
-	case raw.InvoiceSettleIndex != 0:
+	case raw.PeerTransport:
+		if sentAt, err = newProtoTimestamp(raw.Timestamp); err != nil {
+			return nil, fmt.Errorf("marshal error: invalid timestamp: %v", err)
+		}
+		receivedAt = sentAt
+	case aggregate.Invoice != nil:
+		// Messages carried by accepted hold invoices
+		// are not yet settled and stored.
 		invoice, err := newInvoice(aggregate.Invoice)
 		if err != nil {
 			return nil, err
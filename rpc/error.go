@@ -7,9 +7,15 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/c13n-io/c13n-go/app"
+	pb "github.com/c13n-io/c13n-go/rpc/services"
 )
 
 func associateStatusCode(err error) error {
+	var noRouteErr *app.NoRouteError
+	if errors.As(err, &noRouteErr) {
+		return noRouteStatus(err, noRouteErr)
+	}
+
 	var e app.Error
 	if errors.As(err, &e) {
 		switch e.Kind {
@@ -20,13 +26,19 @@ func associateStatusCode(err error) error {
 		// Missing app.NetworkError
 		case app.PermissionError:
 			return status.Errorf(codes.PermissionDenied, "%v", err)
-		case app.NoRouteFound, app.ContactNotFound, app.DiscussionNotFound:
+		case app.NoRouteFound, app.NodeNotFound, app.ContactNotFound,
+			app.DiscussionNotFound, app.PersistentPeerNotFound,
+			app.AttachmentNotFound, app.MessageNotFound:
 			return status.Errorf(codes.NotFound, "%v", err)
-		case app.InvalidAddress:
+		case app.InvalidAddress, app.IncorrectPaymentDetails,
+			app.CommentTooLong:
 			return status.Errorf(codes.InvalidArgument, "%v", err)
-		// Missing app.InsufficientBalance
+		case app.InsufficientBalance:
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
 		case app.ContactAlreadyExists, app.DiscussionAlreadyExists:
 			return status.Errorf(codes.AlreadyExists, "%v", err)
+		case app.NotSupported:
+			return status.Errorf(codes.Unimplemented, "%v", err)
 		case app.UnknownError:
 			return status.Errorf(codes.Unknown, "%v", err)
 		case app.InternalError:
@@ -40,3 +52,28 @@ func associateStatusCode(err error) error {
 	}
 	return status.Errorf(codes.Internal, "%v", err)
 }
+
+// noRouteStatus returns a NotFound status for route discovery errors,
+// with the recently failed node pairs attached as details.
+func noRouteStatus(err error, noRouteErr *app.NoRouteError) error {
+	st := status.New(codes.NotFound, err.Error())
+
+	diag := &pb.RouteDiagnostics{
+		Destination:    noRouteErr.Destination,
+		RecentFailures: make([]*pb.PairHistory, 0, len(noRouteErr.Pairs)),
+	}
+	for _, p := range noRouteErr.Pairs {
+		pair, pairErr := newPairHistory(p)
+		if pairErr != nil {
+			return st.Err()
+		}
+		diag.RecentFailures = append(diag.RecentFailures, pair)
+	}
+
+	detailed, detailsErr := st.WithDetails(diag)
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -108,7 +108,9 @@ messageLoop:
 			}
 
 			// For behaviour compatibility, ignore sent messages.
-			if len(message.Payments) != 0 {
+			if len(message.Payments) != 0 || (message.RawMessage.PeerTransport &&
+				message.RawMessage.Sender == s.App.Self.Node.Address) {
+
 				continue
 			}
 
@@ -2,8 +2,12 @@ package rpc
 
 import (
 	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/c13n-io/c13n-go/app"
+	"github.com/c13n-io/c13n-go/lnchat"
 	"github.com/c13n-io/c13n-go/model"
 	pb "github.com/c13n-io/c13n-go/rpc/services"
 	"github.com/c13n-io/c13n-go/slog"
@@ -40,6 +44,22 @@ func (s *nodeInfoServiceServer) GetVersion(_ context.Context,
 	}, nil
 }
 
+var connectionStateMap = map[lnchat.ConnectionState]pb.ConnectionState{
+	lnchat.ConnectionCONNECTING:   pb.ConnectionState_CONNECTION_CONNECTING,
+	lnchat.ConnectionWALLETLOCKED: pb.ConnectionState_CONNECTION_WALLET_LOCKED,
+	lnchat.ConnectionSYNCING:      pb.ConnectionState_CONNECTION_SYNCING,
+	lnchat.ConnectionREADY:        pb.ConnectionState_CONNECTION_READY,
+}
+
+// GetStatus returns the state of the connection to the underlying node.
+func (s *nodeInfoServiceServer) GetStatus(_ context.Context,
+	_ *pb.StatusRequest) (*pb.StatusResponse, error) {
+
+	return &pb.StatusResponse{
+		State: connectionStateMap[s.App.GetConnectionState()],
+	}, nil
+}
+
 // GetSelfInfo queries the lightning network and returns
 // the current underlying node's info.
 func (s *nodeInfoServiceServer) GetSelfInfo(ctx context.Context, _ *pb.SelfInfoRequest) (*pb.SelfInfoResponse, error) {
@@ -153,6 +173,23 @@ func (s *nodeInfoServiceServer) SearchNodeByAlias(ctx context.Context, req *pb.S
 	}, nil
 }
 
+// GetNodeInfo returns the details of a node.
+func (s *nodeInfoServiceServer) GetNodeInfo(ctx context.Context,
+	req *pb.GetNodeInfoRequest) (*pb.GetNodeInfoResponse, error) {
+
+	info, err := s.App.GetNodeInfo(ctx, req.GetAddress())
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp, err := newGetNodeInfoResponse(info)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return resp, nil
+}
+
 // ConnectNode creates a peer connection with a node
 // as specified in the request parameters.
 func (s *nodeInfoServiceServer) ConnectNode(ctx context.Context,
@@ -166,6 +203,52 @@ func (s *nodeInfoServiceServer) ConnectNode(ctx context.Context,
 	return &pb.ConnectNodeResponse{}, nil
 }
 
+func newGetNodeInfoResponse(info *model.NodeInfo) (*pb.GetNodeInfoResponse, error) {
+	addrs := make([]*pb.NodeAddress, len(info.Addresses))
+	for i, a := range info.Addresses {
+		addrs[i] = &pb.NodeAddress{
+			Network:  a.Network,
+			Hostport: a.Addr,
+		}
+	}
+
+	var lastUpdate *timestamppb.Timestamp
+	if !info.LastUpdate.IsZero() {
+		var err error
+		if lastUpdate, err = newProtoTimestamp(info.LastUpdate); err != nil {
+			return nil, fmt.Errorf("marshal error: invalid timestamp: %v", err)
+		}
+	}
+
+	return &pb.GetNodeInfoResponse{
+		Node: &pb.NodeInfo{
+			Alias:   info.Node.Alias,
+			Address: info.Node.Address,
+		},
+		Addresses:         addrs,
+		Features:          newFeatures(info.Features),
+		LastUpdate:        lastUpdate,
+		Color:             info.Color,
+		NumChannels:       info.NumChannels,
+		TotalCapacityMsat: info.TotalCapacityMsat,
+		HasChannel:        info.HasChannel,
+	}, nil
+}
+
+func newFeatures(fs []lnchat.Feature) []*pb.Feature {
+	features := make([]*pb.Feature, len(fs))
+	for i, f := range fs {
+		features[i] = &pb.Feature{
+			Bit:        f.Bit,
+			Name:       f.Name,
+			IsRequired: f.IsRequired,
+			IsKnown:    f.IsKnown,
+		}
+	}
+
+	return features
+}
+
 // NewNodeInfoServiceServer initializes a new node info service.
 func NewNodeInfoServiceServer(app *app.App) pb.NodeInfoServiceServer {
 	return &nodeInfoServiceServer{
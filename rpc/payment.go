@@ -7,6 +7,7 @@ import (
 
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/c13n-io/c13n-go/app"
@@ -70,18 +71,71 @@ func (s *paymentServiceServer) LookupInvoice(ctx context.Context, req *pb.Lookup
 	}, nil
 }
 
+// CreateHoldInvoice creates and returns a hold invoice
+// for the specified payment hash and amount.
+func (s *paymentServiceServer) CreateHoldInvoice(ctx context.Context,
+	req *pb.CreateHoldInvoiceRequest) (*pb.CreateHoldInvoiceResponse, error) {
+
+	inv, err := s.App.CreateHoldInvoice(ctx, req.GetMemo(), req.GetHash(),
+		int64(req.GetAmtMsat()), req.GetExpiry(), req.GetPrivate())
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp, err := newInvoice(inv)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.CreateHoldInvoiceResponse{
+		Invoice: resp,
+	}, nil
+}
+
+// SettleInvoice settles an accepted hold invoice.
+func (s *paymentServiceServer) SettleInvoice(ctx context.Context,
+	req *pb.SettleInvoiceRequest) (*pb.SettleInvoiceResponse, error) {
+
+	if err := s.App.SettleInvoice(ctx, req.GetPreimage()); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.SettleInvoiceResponse{}, nil
+}
+
+// CancelInvoice cancels an open or accepted invoice.
+func (s *paymentServiceServer) CancelInvoice(ctx context.Context,
+	req *pb.CancelInvoiceRequest) (*pb.CancelInvoiceResponse, error) {
+
+	if err := s.App.CancelInvoice(ctx, req.GetHash()); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.CancelInvoiceResponse{}, nil
+}
+
 // Pay performs a payment and returns it.
 func (s *paymentServiceServer) Pay(ctx context.Context,
 	req *pb.PayRequest) (*pb.PayResponse, error) {
 
 	paymentOptions := app.DefaultPaymentOptions
 	paymentOptions.FeeLimitMsat = req.Options.GetFeeLimitMsat()
+	paymentOptions.MaxShards = req.Options.GetMaxShards()
+	paymentOptions.MaxShardSizeMsat = req.Options.GetMaxShardSizeMsat()
+	paymentOptions.AMP = req.Options.GetAmp()
+	paymentOptions.OutgoingChanIDs = req.Options.GetOutgoingChanIds()
+	paymentOptions.LastHopAddress = req.Options.GetLastHopAddress()
+	paymentOptions.IgnoredNodes = req.Options.GetIgnoredNodes()
+	paymentOptions.IgnoredChannels = req.Options.GetIgnoredChannels()
+	paymentOptions.CltvLimit = req.Options.GetCltvLimit()
+	paymentOptions.MinSuccessProb = req.Options.GetMinSuccessProb()
 
 	payment, err := s.App.SendPayment(ctx,
 		req.GetAddress(), int64(req.GetAmtMsat()), req.GetPayReq(),
 		paymentOptions, nil)
 	if err != nil {
-		return nil, associateStatusCode(s.logError(err))
+		return nil, withPaymentDetails(
+			associateStatusCode(s.logError(err)), payment)
 	}
 
 	resp, err := newPayment(payment)
@@ -245,6 +299,37 @@ func (s *paymentServiceServer) GetRoute(ctx context.Context,
 	}, nil
 }
 
+// withPaymentDetails attaches a failed payment to the details
+// of a status error. The status is returned unchanged
+// if there is no payment or it cannot be attached.
+func withPaymentDetails(err error, payment *model.Payment) error {
+	st, ok := status.FromError(err)
+	if !ok || payment == nil {
+		return err
+	}
+
+	pbPayment, marshalErr := newPayment(payment)
+	if marshalErr != nil {
+		return err
+	}
+
+	detailed, detailsErr := st.WithDetails(pbPayment)
+	if detailsErr != nil {
+		return err
+	}
+
+	return detailed.Err()
+}
+
+var pbFailureCategoryMap = map[lnchat.FailureCategory]pb.PaymentFailureCategory{
+	lnchat.FailureUNKNOWN:             pb.PaymentFailureCategory_FAILURE_UNKNOWN,
+	lnchat.FailureINSUFFICIENTBALANCE: pb.PaymentFailureCategory_FAILURE_INSUFFICIENT_BALANCE,
+	lnchat.FailureNOROUTE:             pb.PaymentFailureCategory_FAILURE_NO_ROUTE,
+	lnchat.FailureINCORRECTDETAILS:    pb.PaymentFailureCategory_FAILURE_INCORRECT_DETAILS,
+	lnchat.FailureFEETOOLOW:           pb.PaymentFailureCategory_FAILURE_FEE_TOO_LOW,
+	lnchat.FailureTIMEOUT:             pb.PaymentFailureCategory_FAILURE_TIMEOUT,
+}
+
 func newPayment(payment *model.Payment) (*pb.Payment, error) {
 	var err error
 	var createdTime, resolvedTime *timestamppb.Timestamp
@@ -288,6 +373,7 @@ func newPayment(payment *model.Payment) (*pb.Payment, error) {
 		State:             state,
 		PaymentIndex:      payment.PaymentIndex,
 		HTLCs:             htlcs,
+		FailureCategory:   pbFailureCategoryMap[payment.ClassifyFailure()],
 	}, nil
 }
 
@@ -299,6 +385,7 @@ func newPaymentHTLC(h lnchat.HTLCAttempt) (*pb.PaymentHTLC, error) {
 			HopAddress:       hop.NodeID.String(),
 			AmtToForwardMsat: hop.AmtToForward.Msat(),
 			FeeMsat:          int64(hop.Fees.Msat()),
+			Expiry:           hop.Expiry,
 		}
 	}
 
@@ -383,9 +470,14 @@ func newInvoice(invoice *model.Invoice) (*pb.Invoice, error) {
 		}
 	}
 
-	preimage, err := lntypes.MakePreimage(invoice.Preimage)
-	if err != nil {
-		return nil, fmt.Errorf("marshal error: invalid preimage: %v", err)
+	// The preimage of a hold invoice is unknown until settlement.
+	var preimageStr string
+	if len(invoice.Preimage) != 0 {
+		preimage, err := lntypes.MakePreimage(invoice.Preimage)
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: invalid preimage: %v", err)
+		}
+		preimageStr = preimage.String()
 	}
 
 	hints, err := newInvoiceHints(invoice.RouteHints)
@@ -401,7 +493,7 @@ func newInvoice(invoice *model.Invoice) (*pb.Invoice, error) {
 	return &pb.Invoice{
 		Memo:             invoice.Memo,
 		Hash:             invoice.Hash,
-		Preimage:         preimage.String(),
+		Preimage:         preimageStr,
 		PaymentRequest:   invoice.PaymentRequest,
 		ValueMsat:        uint64(invoice.Value.Msat()),
 		AmtPaidMsat:      uint64(invoice.AmtPaid.Msat()),
@@ -485,6 +577,7 @@ func newRoute(route *model.Route) *pb.PaymentRoute {
 			HopAddress:       hop.HopAddress,
 			AmtToForwardMsat: hop.AmtToForwardMsat,
 			FeeMsat:          hop.FeeMsat,
+			Expiry:           hop.Expiry,
 		}
 	}
 
@@ -0,0 +1,61 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/c13n-io/c13n-go/app"
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	pb "github.com/c13n-io/c13n-go/rpc/services"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestPayFailure(t *testing.T) {
+	dest := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	failed := &lnchat.Payment{
+		Status:        lnchat.PaymentFAILED,
+		FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_INSUFFICIENT_BALANCE,
+	}
+	updates := make(chan lnchat.PaymentUpdate, 1)
+	updates <- lnchat.PaymentUpdate{Payment: failed}
+	close(updates)
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	mockLNManager.On("SendPayment", mock.Anything, dest, lnchat.NewAmount(5000), "",
+		mock.Anything, map[uint64][]byte(nil), mock.Anything).
+		Return((<-chan lnchat.PaymentUpdate)(updates), nil).Once()
+	mockDB.On("AddPayments", mock.AnythingOfType("*model.Payment")).Return(nil).Once()
+
+	a, err := app.New(mockLNManager, mockDB)
+	require.NoError(t, err)
+
+	srv := NewPaymentServiceServer(a)
+	resp, err := srv.Pay(context.Background(), &pb.PayRequest{
+		Destination: &pb.PayRequest_Address{Address: dest},
+		AmtMsat:     5000,
+	})
+	require.Nil(t, resp)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+
+	require.Len(t, st.Details(), 1)
+	payment, ok := st.Details()[0].(*pb.Payment)
+	require.True(t, ok)
+	assert.Equal(t, pb.PaymentState_PAYMENT_FAILED, payment.State)
+	assert.Equal(t, pb.PaymentFailureCategory_FAILURE_INSUFFICIENT_BALANCE,
+		payment.FailureCategory)
+
+	mockLNManager.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+}
@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/c13n-io/c13n-go/app"
+	"github.com/c13n-io/c13n-go/model"
+	pb "github.com/c13n-io/c13n-go/rpc/services"
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+type peerServiceServer struct {
+	Log *slog.Logger
+
+	App *app.App
+
+	pb.UnimplementedPeerServiceServer
+}
+
+func (s *peerServiceServer) logError(err error) error {
+	if err != nil {
+		s.Log.Errorf("%+v", err)
+	}
+	return err
+}
+
+// Interface implementation
+
+// ListPeers returns the nodes connected to the underlying node.
+func (s *peerServiceServer) ListPeers(ctx context.Context,
+	_ *pb.ListPeersRequest) (*pb.ListPeersResponse, error) {
+
+	peers, err := s.App.ListPeers(ctx)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp := make([]*pb.Peer, len(peers))
+	for i, p := range peers {
+		resp[i] = newPeer(p)
+	}
+
+	return &pb.ListPeersResponse{
+		Peers: resp,
+	}, nil
+}
+
+// DisconnectPeer terminates the peer connection with a node.
+func (s *peerServiceServer) DisconnectPeer(ctx context.Context,
+	req *pb.DisconnectPeerRequest) (*pb.DisconnectPeerResponse, error) {
+
+	if err := s.App.DisconnectPeer(ctx, req.GetAddress()); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.DisconnectPeerResponse{}, nil
+}
+
+// AddPersistentPeer adds a node to the persistent peer list.
+func (s *peerServiceServer) AddPersistentPeer(ctx context.Context,
+	req *pb.AddPersistentPeerRequest) (*pb.AddPersistentPeerResponse, error) {
+
+	err := s.App.AddPersistentPeer(ctx, req.GetAddress(), req.GetHostport())
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.AddPersistentPeerResponse{}, nil
+}
+
+// GetPersistentPeers returns the persistent peer list.
+func (s *peerServiceServer) GetPersistentPeers(ctx context.Context,
+	_ *pb.GetPersistentPeersRequest) (*pb.GetPersistentPeersResponse, error) {
+
+	peers, err := s.App.GetPersistentPeers(ctx)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp := make([]*pb.PersistentPeer, len(peers))
+	for i, p := range peers {
+		resp[i] = &pb.PersistentPeer{
+			Address:  p.Address,
+			Hostport: p.Hostport,
+		}
+	}
+
+	return &pb.GetPersistentPeersResponse{
+		Peers: resp,
+	}, nil
+}
+
+// RemovePersistentPeer removes a node from the persistent peer list.
+func (s *peerServiceServer) RemovePersistentPeer(ctx context.Context,
+	req *pb.RemovePersistentPeerRequest) (*pb.RemovePersistentPeerResponse, error) {
+
+	if err := s.App.RemovePersistentPeer(ctx, req.GetAddress()); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.RemovePersistentPeerResponse{}, nil
+}
+
+func newPeer(p model.Peer) *pb.Peer {
+	return &pb.Peer{
+		Address:        p.Address,
+		Hostport:       p.Host,
+		Inbound:        p.Inbound,
+		PingTimeMicros: p.PingTimeMicros,
+		BytesSent:      p.BytesSent,
+		BytesReceived:  p.BytesReceived,
+		Features:       newFeatures(p.Features),
+	}
+}
+
+// NewPeerServiceServer initializes a new peer service.
+func NewPeerServiceServer(app *app.App) pb.PeerServiceServer {
+	return &peerServiceServer{
+		Log: slog.NewLogger("peer-service"),
+		App: app,
+	}
+}
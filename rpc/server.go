@@ -142,16 +142,24 @@ func (s *Server) registerAllServices() {
 	messenger := NewMessageServiceServer(s.App)
 	discusser := NewDiscussionServiceServer(s.App)
 	channeler := NewChannelServiceServer(s.App)
+	peerManager := NewPeerServiceServer(s.App)
 	nodeInformant := NewNodeInfoServiceServer(s.App)
 	financier := NewPaymentServiceServer(s.App)
+	treasurer := NewWalletServiceServer(s.App)
+	diagnostician := NewDiagnosticsServiceServer(s.App)
+	archivist := NewAttachmentServiceServer(s.App)
 
 	// Register services
 	pb.RegisterContactServiceServer(s.Server, contacter)
 	pb.RegisterMessageServiceServer(s.Server, messenger)
 	pb.RegisterDiscussionServiceServer(s.Server, discusser)
 	pb.RegisterChannelServiceServer(s.Server, channeler)
+	pb.RegisterPeerServiceServer(s.Server, peerManager)
 	pb.RegisterNodeInfoServiceServer(s.Server, nodeInformant)
 	pb.RegisterPaymentServiceServer(s.Server, financier)
+	pb.RegisterWalletServiceServer(s.Server, treasurer)
+	pb.RegisterDiagnosticsServiceServer(s.Server, diagnostician)
+	pb.RegisterAttachmentServiceServer(s.Server, archivist)
 }
 
 // WithBasicAuth creates an authorization interceptor with the provided basic auth credentials.
@@ -23,6 +23,447 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+//* Represents the state of the connection to the underlying Lightning node.
+type ConnectionState int32
+
+const (
+	//* The underlying node is unreachable or still starting up.
+	ConnectionState_CONNECTION_CONNECTING ConnectionState = 0
+	//* The wallet of the underlying node is locked or not yet created.
+	ConnectionState_CONNECTION_WALLET_LOCKED ConnectionState = 1
+	//* The underlying node is running, but not yet synced to the chain.
+	ConnectionState_CONNECTION_SYNCING ConnectionState = 2
+	//* The underlying node is running and synced to the chain.
+	ConnectionState_CONNECTION_READY ConnectionState = 3
+)
+
+// Enum value maps for ConnectionState.
+var (
+	ConnectionState_name = map[int32]string{
+		0: "CONNECTION_CONNECTING",
+		1: "CONNECTION_WALLET_LOCKED",
+		2: "CONNECTION_SYNCING",
+		3: "CONNECTION_READY",
+	}
+	ConnectionState_value = map[string]int32{
+		"CONNECTION_CONNECTING":    0,
+		"CONNECTION_WALLET_LOCKED": 1,
+		"CONNECTION_SYNCING":       2,
+		"CONNECTION_READY":         3,
+	}
+)
+
+func (x ConnectionState) Enum() *ConnectionState {
+	p := new(ConnectionState)
+	*p = x
+	return p
+}
+
+func (x ConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[0].Descriptor()
+}
+
+func (ConnectionState) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[0]
+}
+
+func (x ConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectionState.Descriptor instead.
+func (ConnectionState) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{0}
+}
+
+//* Represents the state of a pending channel.
+type PendingChannelState int32
+
+const (
+	PendingChannelState_PENDING_OPEN          PendingChannelState = 0
+	PendingChannelState_PENDING_WAITING_CLOSE PendingChannelState = 1
+	PendingChannelState_PENDING_FORCE_CLOSING PendingChannelState = 2
+)
+
+// Enum value maps for PendingChannelState.
+var (
+	PendingChannelState_name = map[int32]string{
+		0: "PENDING_OPEN",
+		1: "PENDING_WAITING_CLOSE",
+		2: "PENDING_FORCE_CLOSING",
+	}
+	PendingChannelState_value = map[string]int32{
+		"PENDING_OPEN":          0,
+		"PENDING_WAITING_CLOSE": 1,
+		"PENDING_FORCE_CLOSING": 2,
+	}
+)
+
+func (x PendingChannelState) Enum() *PendingChannelState {
+	p := new(PendingChannelState)
+	*p = x
+	return p
+}
+
+func (x PendingChannelState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingChannelState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[1].Descriptor()
+}
+
+func (PendingChannelState) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[1]
+}
+
+func (x PendingChannelState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingChannelState.Descriptor instead.
+func (PendingChannelState) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{1}
+}
+
+//* Represents the state of a channel closing.
+type ChannelCloseState int32
+
+const (
+	ChannelCloseState_CHANNEL_CLOSE_PENDING   ChannelCloseState = 0
+	ChannelCloseState_CHANNEL_CLOSE_CONFIRMED ChannelCloseState = 1
+)
+
+// Enum value maps for ChannelCloseState.
+var (
+	ChannelCloseState_name = map[int32]string{
+		0: "CHANNEL_CLOSE_PENDING",
+		1: "CHANNEL_CLOSE_CONFIRMED",
+	}
+	ChannelCloseState_value = map[string]int32{
+		"CHANNEL_CLOSE_PENDING":   0,
+		"CHANNEL_CLOSE_CONFIRMED": 1,
+	}
+)
+
+func (x ChannelCloseState) Enum() *ChannelCloseState {
+	p := new(ChannelCloseState)
+	*p = x
+	return p
+}
+
+func (x ChannelCloseState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelCloseState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[2].Descriptor()
+}
+
+func (ChannelCloseState) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[2]
+}
+
+func (x ChannelCloseState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelCloseState.Descriptor instead.
+func (ChannelCloseState) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{2}
+}
+
+//* Represents the type of a channel event.
+type ChannelEventType int32
+
+const (
+	ChannelEventType_CHANNEL_EVENT_PENDING_OPEN   ChannelEventType = 0
+	ChannelEventType_CHANNEL_EVENT_OPEN           ChannelEventType = 1
+	ChannelEventType_CHANNEL_EVENT_ACTIVE         ChannelEventType = 2
+	ChannelEventType_CHANNEL_EVENT_INACTIVE       ChannelEventType = 3
+	ChannelEventType_CHANNEL_EVENT_CLOSED         ChannelEventType = 4
+	ChannelEventType_CHANNEL_EVENT_FULLY_RESOLVED ChannelEventType = 5
+)
+
+// Enum value maps for ChannelEventType.
+var (
+	ChannelEventType_name = map[int32]string{
+		0: "CHANNEL_EVENT_PENDING_OPEN",
+		1: "CHANNEL_EVENT_OPEN",
+		2: "CHANNEL_EVENT_ACTIVE",
+		3: "CHANNEL_EVENT_INACTIVE",
+		4: "CHANNEL_EVENT_CLOSED",
+		5: "CHANNEL_EVENT_FULLY_RESOLVED",
+	}
+	ChannelEventType_value = map[string]int32{
+		"CHANNEL_EVENT_PENDING_OPEN":   0,
+		"CHANNEL_EVENT_OPEN":           1,
+		"CHANNEL_EVENT_ACTIVE":         2,
+		"CHANNEL_EVENT_INACTIVE":       3,
+		"CHANNEL_EVENT_CLOSED":         4,
+		"CHANNEL_EVENT_FULLY_RESOLVED": 5,
+	}
+)
+
+func (x ChannelEventType) Enum() *ChannelEventType {
+	p := new(ChannelEventType)
+	*p = x
+	return p
+}
+
+func (x ChannelEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[3].Descriptor()
+}
+
+func (ChannelEventType) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[3]
+}
+
+func (x ChannelEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelEventType.Descriptor instead.
+func (ChannelEventType) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{3}
+}
+
+//* Represents the way a channel was closed.
+type ChannelCloseType int32
+
+const (
+	ChannelCloseType_COOPERATIVE_CLOSE  ChannelCloseType = 0
+	ChannelCloseType_LOCAL_FORCE_CLOSE  ChannelCloseType = 1
+	ChannelCloseType_REMOTE_FORCE_CLOSE ChannelCloseType = 2
+	ChannelCloseType_BREACH_CLOSE       ChannelCloseType = 3
+	ChannelCloseType_FUNDING_CANCELLED  ChannelCloseType = 4
+	ChannelCloseType_ABANDONED          ChannelCloseType = 5
+)
+
+// Enum value maps for ChannelCloseType.
+var (
+	ChannelCloseType_name = map[int32]string{
+		0: "COOPERATIVE_CLOSE",
+		1: "LOCAL_FORCE_CLOSE",
+		2: "REMOTE_FORCE_CLOSE",
+		3: "BREACH_CLOSE",
+		4: "FUNDING_CANCELLED",
+		5: "ABANDONED",
+	}
+	ChannelCloseType_value = map[string]int32{
+		"COOPERATIVE_CLOSE":  0,
+		"LOCAL_FORCE_CLOSE":  1,
+		"REMOTE_FORCE_CLOSE": 2,
+		"BREACH_CLOSE":       3,
+		"FUNDING_CANCELLED":  4,
+		"ABANDONED":          5,
+	}
+)
+
+func (x ChannelCloseType) Enum() *ChannelCloseType {
+	p := new(ChannelCloseType)
+	*p = x
+	return p
+}
+
+func (x ChannelCloseType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelCloseType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[4].Descriptor()
+}
+
+func (ChannelCloseType) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[4]
+}
+
+func (x ChannelCloseType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelCloseType.Descriptor instead.
+func (ChannelCloseType) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{4}
+}
+
+//* The type of an on-chain address.
+type AddressType int32
+
+const (
+	//* A native segwit (bech32) address.
+	AddressType_WITNESS_PUBKEY_HASH AddressType = 0
+	//* A segwit address nested in a P2SH address.
+	AddressType_NESTED_PUBKEY_HASH AddressType = 1
+)
+
+// Enum value maps for AddressType.
+var (
+	AddressType_name = map[int32]string{
+		0: "WITNESS_PUBKEY_HASH",
+		1: "NESTED_PUBKEY_HASH",
+	}
+	AddressType_value = map[string]int32{
+		"WITNESS_PUBKEY_HASH": 0,
+		"NESTED_PUBKEY_HASH":  1,
+	}
+)
+
+func (x AddressType) Enum() *AddressType {
+	p := new(AddressType)
+	*p = x
+	return p
+}
+
+func (x AddressType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[5].Descriptor()
+}
+
+func (AddressType) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[5]
+}
+
+func (x AddressType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressType.Descriptor instead.
+func (AddressType) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{5}
+}
+
+//* The type of a message control.
+type ControlType int32
+
+const (
+	//* No control.
+	ControlType_CONTROL_NONE ControlType = 0
+	//* A reply to the referenced message.
+	ControlType_CONTROL_REPLY ControlType = 1
+	//* A reaction to the referenced message, carried as the payload.
+	ControlType_CONTROL_REACTION ControlType = 2
+	//* An edit of the referenced message, carrying the new payload.
+	ControlType_CONTROL_EDIT ControlType = 3
+	//* A request to delete the referenced message.
+	ControlType_CONTROL_DELETE ControlType = 4
+)
+
+// Enum value maps for ControlType.
+var (
+	ControlType_name = map[int32]string{
+		0: "CONTROL_NONE",
+		1: "CONTROL_REPLY",
+		2: "CONTROL_REACTION",
+		3: "CONTROL_EDIT",
+		4: "CONTROL_DELETE",
+	}
+	ControlType_value = map[string]int32{
+		"CONTROL_NONE":     0,
+		"CONTROL_REPLY":    1,
+		"CONTROL_REACTION": 2,
+		"CONTROL_EDIT":     3,
+		"CONTROL_DELETE":   4,
+	}
+)
+
+func (x ControlType) Enum() *ControlType {
+	p := new(ControlType)
+	*p = x
+	return p
+}
+
+func (x ControlType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ControlType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[6].Descriptor()
+}
+
+func (ControlType) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[6]
+}
+
+func (x ControlType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ControlType.Descriptor instead.
+func (ControlType) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{6}
+}
+
+//* Represents the transport used for sending a message.
+type MessageTransport int32
+
+const (
+	//*
+	//Messages not carrying an amount or payment request are sent over
+	//custom peer messages if every recipient is a connected peer,
+	//unless they are anonymous. All other messages are sent over payments.
+	MessageTransport_TRANSPORT_AUTO MessageTransport = 0
+	//* Messages are sent over payments.
+	MessageTransport_TRANSPORT_PAYMENT MessageTransport = 1
+	//*
+	//Messages are sent over custom peer messages, at no cost.
+	//Such messages cannot carry an amount or be anonymous,
+	//and are delivered only to connected peers.
+	MessageTransport_TRANSPORT_PEER MessageTransport = 2
+)
+
+// Enum value maps for MessageTransport.
+var (
+	MessageTransport_name = map[int32]string{
+		0: "TRANSPORT_AUTO",
+		1: "TRANSPORT_PAYMENT",
+		2: "TRANSPORT_PEER",
+	}
+	MessageTransport_value = map[string]int32{
+		"TRANSPORT_AUTO":    0,
+		"TRANSPORT_PAYMENT": 1,
+		"TRANSPORT_PEER":    2,
+	}
+)
+
+func (x MessageTransport) Enum() *MessageTransport {
+	p := new(MessageTransport)
+	*p = x
+	return p
+}
+
+func (x MessageTransport) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageTransport) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[7].Descriptor()
+}
+
+func (MessageTransport) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[7]
+}
+
+func (x MessageTransport) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageTransport.Descriptor instead.
+func (MessageTransport) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{7}
+}
+
 //* Represents the state of an invoice.
 type PaymentState int32
 
@@ -60,11 +501,11 @@ func (x PaymentState) String() string {
 }
 
 func (PaymentState) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_services_rpc_proto_enumTypes[0].Descriptor()
+	return file_rpc_services_rpc_proto_enumTypes[8].Descriptor()
 }
 
 func (PaymentState) Type() protoreflect.EnumType {
-	return &file_rpc_services_rpc_proto_enumTypes[0]
+	return &file_rpc_services_rpc_proto_enumTypes[8]
 }
 
 func (x PaymentState) Number() protoreflect.EnumNumber {
@@ -73,7 +514,66 @@ func (x PaymentState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PaymentState.Descriptor instead.
 func (PaymentState) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{0}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+//* Represents the failure category of a failed payment.
+type PaymentFailureCategory int32
+
+const (
+	PaymentFailureCategory_FAILURE_UNKNOWN              PaymentFailureCategory = 0
+	PaymentFailureCategory_FAILURE_INSUFFICIENT_BALANCE PaymentFailureCategory = 1
+	PaymentFailureCategory_FAILURE_NO_ROUTE             PaymentFailureCategory = 2
+	PaymentFailureCategory_FAILURE_INCORRECT_DETAILS    PaymentFailureCategory = 3
+	PaymentFailureCategory_FAILURE_FEE_TOO_LOW          PaymentFailureCategory = 4
+	PaymentFailureCategory_FAILURE_TIMEOUT              PaymentFailureCategory = 5
+)
+
+// Enum value maps for PaymentFailureCategory.
+var (
+	PaymentFailureCategory_name = map[int32]string{
+		0: "FAILURE_UNKNOWN",
+		1: "FAILURE_INSUFFICIENT_BALANCE",
+		2: "FAILURE_NO_ROUTE",
+		3: "FAILURE_INCORRECT_DETAILS",
+		4: "FAILURE_FEE_TOO_LOW",
+		5: "FAILURE_TIMEOUT",
+	}
+	PaymentFailureCategory_value = map[string]int32{
+		"FAILURE_UNKNOWN":              0,
+		"FAILURE_INSUFFICIENT_BALANCE": 1,
+		"FAILURE_NO_ROUTE":             2,
+		"FAILURE_INCORRECT_DETAILS":    3,
+		"FAILURE_FEE_TOO_LOW":          4,
+		"FAILURE_TIMEOUT":              5,
+	}
+)
+
+func (x PaymentFailureCategory) Enum() *PaymentFailureCategory {
+	p := new(PaymentFailureCategory)
+	*p = x
+	return p
+}
+
+func (x PaymentFailureCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentFailureCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[9].Descriptor()
+}
+
+func (PaymentFailureCategory) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[9]
+}
+
+func (x PaymentFailureCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentFailureCategory.Descriptor instead.
+func (PaymentFailureCategory) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{9}
 }
 
 //* Represents the state of a HTLC.
@@ -110,11 +610,11 @@ func (x HTLCState) String() string {
 }
 
 func (HTLCState) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_services_rpc_proto_enumTypes[1].Descriptor()
+	return file_rpc_services_rpc_proto_enumTypes[10].Descriptor()
 }
 
 func (HTLCState) Type() protoreflect.EnumType {
-	return &file_rpc_services_rpc_proto_enumTypes[1]
+	return &file_rpc_services_rpc_proto_enumTypes[10]
 }
 
 func (x HTLCState) Number() protoreflect.EnumNumber {
@@ -123,7 +623,7 @@ func (x HTLCState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HTLCState.Descriptor instead.
 func (HTLCState) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{1}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{10}
 }
 
 //* Represents the state of an invoice.
@@ -163,11 +663,11 @@ func (x InvoiceState) String() string {
 }
 
 func (InvoiceState) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_services_rpc_proto_enumTypes[2].Descriptor()
+	return file_rpc_services_rpc_proto_enumTypes[11].Descriptor()
 }
 
 func (InvoiceState) Type() protoreflect.EnumType {
-	return &file_rpc_services_rpc_proto_enumTypes[2]
+	return &file_rpc_services_rpc_proto_enumTypes[11]
 }
 
 func (x InvoiceState) Number() protoreflect.EnumNumber {
@@ -176,7 +676,7 @@ func (x InvoiceState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InvoiceState.Descriptor instead.
 func (InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{2}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{11}
 }
 
 //* Represents the state of an invoice HTLC.
@@ -213,11 +713,11 @@ func (x InvoiceHTLCState) String() string {
 }
 
 func (InvoiceHTLCState) Descriptor() protoreflect.EnumDescriptor {
-	return file_rpc_services_rpc_proto_enumTypes[3].Descriptor()
+	return file_rpc_services_rpc_proto_enumTypes[12].Descriptor()
 }
 
 func (InvoiceHTLCState) Type() protoreflect.EnumType {
-	return &file_rpc_services_rpc_proto_enumTypes[3]
+	return &file_rpc_services_rpc_proto_enumTypes[12]
 }
 
 func (x InvoiceHTLCState) Number() protoreflect.EnumNumber {
@@ -226,7 +726,7 @@ func (x InvoiceHTLCState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InvoiceHTLCState.Descriptor instead.
 func (InvoiceHTLCState) EnumDescriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{3}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{12}
 }
 
 //*
@@ -405,7 +905,95 @@ func (x *Version) GetCommitHash() string {
 	return ""
 }
 
-//* A message representing a node on the Lightning network.
+//* Corresponds to a query to retrieve the status of the connection to the underlying node.
+type StatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StatusRequest) Reset() {
+	*x = StatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusRequest) ProtoMessage() {}
+
+func (x *StatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
+func (*StatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{3}
+}
+
+//* A StatusResponse is received in response to a GetStatus rpc call.
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The state of the connection to the underlying node.
+	State ConnectionState `protobuf:"varint,1,opt,name=state,proto3,enum=services.ConnectionState" json:"state,omitempty"`
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StatusResponse) GetState() ConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return ConnectionState_CONNECTION_CONNECTING
+}
+
+//* A message representing a node on the Lightning network.
 type NodeInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -420,7 +1008,7 @@ type NodeInfo struct {
 func (x *NodeInfo) Reset() {
 	*x = NodeInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[3]
+		mi := &file_rpc_services_rpc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -433,7 +1021,7 @@ func (x *NodeInfo) String() string {
 func (*NodeInfo) ProtoMessage() {}
 
 func (x *NodeInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[3]
+	mi := &file_rpc_services_rpc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +1034,7 @@ func (x *NodeInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{3}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *NodeInfo) GetAlias() string {
@@ -473,7 +1061,7 @@ type SelfInfoRequest struct {
 func (x *SelfInfoRequest) Reset() {
 	*x = SelfInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[4]
+		mi := &file_rpc_services_rpc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -486,7 +1074,7 @@ func (x *SelfInfoRequest) String() string {
 func (*SelfInfoRequest) ProtoMessage() {}
 
 func (x *SelfInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[4]
+	mi := &file_rpc_services_rpc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +1087,7 @@ func (x *SelfInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfInfoRequest.ProtoReflect.Descriptor instead.
 func (*SelfInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{4}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{6}
 }
 
 //* Represents a blockchain and network for a Lightning node.
@@ -517,7 +1105,7 @@ type Chain struct {
 func (x *Chain) Reset() {
 	*x = Chain{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[5]
+		mi := &file_rpc_services_rpc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -530,7 +1118,7 @@ func (x *Chain) String() string {
 func (*Chain) ProtoMessage() {}
 
 func (x *Chain) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[5]
+	mi := &file_rpc_services_rpc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -543,7 +1131,7 @@ func (x *Chain) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Chain.ProtoReflect.Descriptor instead.
 func (*Chain) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{5}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Chain) GetChain() string {
@@ -575,7 +1163,7 @@ type SelfInfoResponse struct {
 func (x *SelfInfoResponse) Reset() {
 	*x = SelfInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[6]
+		mi := &file_rpc_services_rpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -588,7 +1176,7 @@ func (x *SelfInfoResponse) String() string {
 func (*SelfInfoResponse) ProtoMessage() {}
 
 func (x *SelfInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[6]
+	mi := &file_rpc_services_rpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +1189,7 @@ func (x *SelfInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfInfoResponse.ProtoReflect.Descriptor instead.
 func (*SelfInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{6}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SelfInfoResponse) GetInfo() *NodeInfo {
@@ -628,7 +1216,7 @@ type SelfBalanceRequest struct {
 func (x *SelfBalanceRequest) Reset() {
 	*x = SelfBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[7]
+		mi := &file_rpc_services_rpc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -641,7 +1229,7 @@ func (x *SelfBalanceRequest) String() string {
 func (*SelfBalanceRequest) ProtoMessage() {}
 
 func (x *SelfBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[7]
+	mi := &file_rpc_services_rpc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +1242,7 @@ func (x *SelfBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfBalanceRequest.ProtoReflect.Descriptor instead.
 func (*SelfBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{7}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{9}
 }
 
 //* A SelfBalanceResponse is received in response to a GetSelfBalance rpc call.
@@ -684,7 +1272,7 @@ type SelfBalanceResponse struct {
 func (x *SelfBalanceResponse) Reset() {
 	*x = SelfBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[8]
+		mi := &file_rpc_services_rpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -697,7 +1285,7 @@ func (x *SelfBalanceResponse) String() string {
 func (*SelfBalanceResponse) ProtoMessage() {}
 
 func (x *SelfBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[8]
+	mi := &file_rpc_services_rpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +1298,7 @@ func (x *SelfBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SelfBalanceResponse.ProtoReflect.Descriptor instead.
 func (*SelfBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{8}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SelfBalanceResponse) GetWalletConfirmedSat() int64 {
@@ -779,7 +1367,7 @@ type GetNodesRequest struct {
 func (x *GetNodesRequest) Reset() {
 	*x = GetNodesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[9]
+		mi := &file_rpc_services_rpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -792,7 +1380,7 @@ func (x *GetNodesRequest) String() string {
 func (*GetNodesRequest) ProtoMessage() {}
 
 func (x *GetNodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[9]
+	mi := &file_rpc_services_rpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +1393,7 @@ func (x *GetNodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodesRequest.ProtoReflect.Descriptor instead.
 func (*GetNodesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{9}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{11}
 }
 
 //* Corresponds to a node query based on node address.
@@ -821,7 +1409,7 @@ type SearchNodeByAddressRequest struct {
 func (x *SearchNodeByAddressRequest) Reset() {
 	*x = SearchNodeByAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[10]
+		mi := &file_rpc_services_rpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -834,7 +1422,7 @@ func (x *SearchNodeByAddressRequest) String() string {
 func (*SearchNodeByAddressRequest) ProtoMessage() {}
 
 func (x *SearchNodeByAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[10]
+	mi := &file_rpc_services_rpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +1435,7 @@ func (x *SearchNodeByAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchNodeByAddressRequest.ProtoReflect.Descriptor instead.
 func (*SearchNodeByAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{10}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SearchNodeByAddressRequest) GetAddress() string {
@@ -870,7 +1458,7 @@ type SearchNodeByAliasRequest struct {
 func (x *SearchNodeByAliasRequest) Reset() {
 	*x = SearchNodeByAliasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[11]
+		mi := &file_rpc_services_rpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -883,7 +1471,7 @@ func (x *SearchNodeByAliasRequest) String() string {
 func (*SearchNodeByAliasRequest) ProtoMessage() {}
 
 func (x *SearchNodeByAliasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[11]
+	mi := &file_rpc_services_rpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -896,7 +1484,7 @@ func (x *SearchNodeByAliasRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchNodeByAliasRequest.ProtoReflect.Descriptor instead.
 func (*SearchNodeByAliasRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{11}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *SearchNodeByAliasRequest) GetAlias() string {
@@ -922,7 +1510,7 @@ type NodeInfoResponse struct {
 func (x *NodeInfoResponse) Reset() {
 	*x = NodeInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[12]
+		mi := &file_rpc_services_rpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -935,7 +1523,7 @@ func (x *NodeInfoResponse) String() string {
 func (*NodeInfoResponse) ProtoMessage() {}
 
 func (x *NodeInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[12]
+	mi := &file_rpc_services_rpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,7 +1536,7 @@ func (x *NodeInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeInfoResponse.ProtoReflect.Descriptor instead.
 func (*NodeInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{12}
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *NodeInfoResponse) GetNodes() []*NodeInfo {
@@ -958,35 +1546,33 @@ func (x *NodeInfoResponse) GetNodes() []*NodeInfo {
 	return nil
 }
 
-//* Corresponds to a request to create a peer connection with a node.
-type ConnectNodeRequest struct {
+//* Corresponds to a request to retrieve the details of a node.
+type GetNodeInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The address of the node to connect.
+	//* The address of the node.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	//* The network location of the node.
-	Hostport string `protobuf:"bytes,2,opt,name=hostport,proto3" json:"hostport,omitempty"`
 }
 
-func (x *ConnectNodeRequest) Reset() {
-	*x = ConnectNodeRequest{}
+func (x *GetNodeInfoRequest) Reset() {
+	*x = GetNodeInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[13]
+		mi := &file_rpc_services_rpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectNodeRequest) String() string {
+func (x *GetNodeInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectNodeRequest) ProtoMessage() {}
+func (*GetNodeInfoRequest) ProtoMessage() {}
 
-func (x *ConnectNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[13]
+func (x *GetNodeInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,49 +1583,47 @@ func (x *ConnectNodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectNodeRequest.ProtoReflect.Descriptor instead.
-func (*ConnectNodeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetNodeInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeInfoRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ConnectNodeRequest) GetAddress() string {
+func (x *GetNodeInfoRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *ConnectNodeRequest) GetHostport() string {
-	if x != nil {
-		return x.Hostport
-	}
-	return ""
-}
-
-//* A ConnectNodeResponse is received in response to a ConnectNode request.
-type ConnectNodeResponse struct {
+//* A network address of a node.
+type NodeAddress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The network of the address (e.g. tcp).
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	//* The network location of the node.
+	Hostport string `protobuf:"bytes,2,opt,name=hostport,proto3" json:"hostport,omitempty"`
 }
 
-func (x *ConnectNodeResponse) Reset() {
-	*x = ConnectNodeResponse{}
+func (x *NodeAddress) Reset() {
+	*x = NodeAddress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[14]
+		mi := &file_rpc_services_rpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectNodeResponse) String() string {
+func (x *NodeAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectNodeResponse) ProtoMessage() {}
+func (*NodeAddress) ProtoMessage() {}
 
-func (x *ConnectNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[14]
+func (x *NodeAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,53 +1634,66 @@ func (x *ConnectNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectNodeResponse.ProtoReflect.Descriptor instead.
-func (*ConnectNodeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use NodeAddress.ProtoReflect.Descriptor instead.
+func (*NodeAddress) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{16}
 }
 
-//* Corresponds to a request to open a channel.
-type OpenChannelRequest struct {
+func (x *NodeAddress) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *NodeAddress) GetHostport() string {
+	if x != nil {
+		return x.Hostport
+	}
+	return ""
+}
+
+//* A GetNodeInfoResponse contains the details of a node.
+type GetNodeInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The address of the node to open a channel to.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	//* The total amount to be committed to the channel (in millisatoshi).
-	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The amount to be sent to the other party (in millisatoshi).
-	PushAmtMsat uint64 `protobuf:"varint,3,opt,name=push_amt_msat,json=pushAmtMsat,proto3" json:"push_amt_msat,omitempty"`
-	//* The minimum number of confirmations
-	//each input of the channel funding transaction must have.
-	//
-	//In case of a negative value being provided, unconfirmed funds can be used.
-	MinInputConfs int32 `protobuf:"varint,4,opt,name=min_input_confs,json=minInputConfs,proto3" json:"min_input_confs,omitempty"`
-	//* The number of blocks the funding transaction should confirm by.
-	//
-	//Used for fee estimation.
-	TargetConfirmationBlock uint32 `protobuf:"varint,5,opt,name=target_confirmation_block,json=targetConfirmationBlock,proto3" json:"target_confirmation_block,omitempty"`
-	//* The fee rate (satoshis per virtual byte) the funding transaction should cost.
-	SatPerVbyte uint64 `protobuf:"varint,6,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
-}
-
-func (x *OpenChannelRequest) Reset() {
-	*x = OpenChannelRequest{}
+	//* The node alias and address.
+	Node *NodeInfo `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	//* The network addresses advertised by the node.
+	Addresses []*NodeAddress `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	//* The features advertised by the node, ordered by feature bit.
+	Features []*Feature `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	//* The time of the last node announcement.
+	LastUpdate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	//* The color advertised by the node, in hex format.
+	Color string `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	//* The number of channels of the node.
+	NumChannels uint32 `protobuf:"varint,6,opt,name=num_channels,json=numChannels,proto3" json:"num_channels,omitempty"`
+	//* The total capacity of the node channels (in millisatoshi).
+	TotalCapacityMsat int64 `protobuf:"varint,7,opt,name=total_capacity_msat,json=totalCapacityMsat,proto3" json:"total_capacity_msat,omitempty"`
+	//* Whether the underlying node has an open channel with the node.
+	HasChannel bool `protobuf:"varint,8,opt,name=has_channel,json=hasChannel,proto3" json:"has_channel,omitempty"`
+}
+
+func (x *GetNodeInfoResponse) Reset() {
+	*x = GetNodeInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[15]
+		mi := &file_rpc_services_rpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenChannelRequest) String() string {
+func (x *GetNodeInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenChannelRequest) ProtoMessage() {}
+func (*GetNodeInfoResponse) ProtoMessage() {}
 
-func (x *OpenChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[15]
+func (x *GetNodeInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,82 +1704,96 @@ func (x *OpenChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenChannelRequest.ProtoReflect.Descriptor instead.
-func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetNodeInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeInfoResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *OpenChannelRequest) GetAddress() string {
+func (x *GetNodeInfoResponse) GetNode() *NodeInfo {
 	if x != nil {
-		return x.Address
+		return x.Node
 	}
-	return ""
+	return nil
 }
 
-func (x *OpenChannelRequest) GetAmtMsat() uint64 {
+func (x *GetNodeInfoResponse) GetAddresses() []*NodeAddress {
 	if x != nil {
-		return x.AmtMsat
+		return x.Addresses
 	}
-	return 0
+	return nil
 }
 
-func (x *OpenChannelRequest) GetPushAmtMsat() uint64 {
+func (x *GetNodeInfoResponse) GetFeatures() []*Feature {
 	if x != nil {
-		return x.PushAmtMsat
+		return x.Features
 	}
-	return 0
+	return nil
 }
 
-func (x *OpenChannelRequest) GetMinInputConfs() int32 {
+func (x *GetNodeInfoResponse) GetLastUpdate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MinInputConfs
+		return x.LastUpdate
 	}
-	return 0
+	return nil
 }
 
-func (x *OpenChannelRequest) GetTargetConfirmationBlock() uint32 {
+func (x *GetNodeInfoResponse) GetColor() string {
 	if x != nil {
-		return x.TargetConfirmationBlock
+		return x.Color
+	}
+	return ""
+}
+
+func (x *GetNodeInfoResponse) GetNumChannels() uint32 {
+	if x != nil {
+		return x.NumChannels
 	}
 	return 0
 }
 
-func (x *OpenChannelRequest) GetSatPerVbyte() uint64 {
+func (x *GetNodeInfoResponse) GetTotalCapacityMsat() int64 {
 	if x != nil {
-		return x.SatPerVbyte
+		return x.TotalCapacityMsat
 	}
 	return 0
 }
 
-//* An OpenChannelResponse is received in response to an OpenChannel call.
-type OpenChannelResponse struct {
+func (x *GetNodeInfoResponse) GetHasChannel() bool {
+	if x != nil {
+		return x.HasChannel
+	}
+	return false
+}
+
+//* Corresponds to a request to create a peer connection with a node.
+type ConnectNodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The channel funding transaction.
-	FundingTxid string `protobuf:"bytes,1,opt,name=funding_txid,json=fundingTxid,proto3" json:"funding_txid,omitempty"`
-	//* The output index of the funding transaction.
-	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	//* The address of the node to connect.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//* The network location of the node.
+	Hostport string `protobuf:"bytes,2,opt,name=hostport,proto3" json:"hostport,omitempty"`
 }
 
-func (x *OpenChannelResponse) Reset() {
-	*x = OpenChannelResponse{}
+func (x *ConnectNodeRequest) Reset() {
+	*x = ConnectNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[16]
+		mi := &file_rpc_services_rpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenChannelResponse) String() string {
+func (x *ConnectNodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenChannelResponse) ProtoMessage() {}
+func (*ConnectNodeRequest) ProtoMessage() {}
 
-func (x *OpenChannelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[16]
+func (x *ConnectNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1193,56 +1804,49 @@ func (x *OpenChannelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenChannelResponse.ProtoReflect.Descriptor instead.
-func (*OpenChannelResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ConnectNodeRequest.ProtoReflect.Descriptor instead.
+func (*ConnectNodeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *OpenChannelResponse) GetFundingTxid() string {
+func (x *ConnectNodeRequest) GetAddress() string {
 	if x != nil {
-		return x.FundingTxid
+		return x.Address
 	}
 	return ""
 }
 
-func (x *OpenChannelResponse) GetOutputIndex() uint32 {
+func (x *ConnectNodeRequest) GetHostport() string {
 	if x != nil {
-		return x.OutputIndex
+		return x.Hostport
 	}
-	return 0
+	return ""
 }
 
-//* A message representing a contact of the application.
-type ContactInfo struct {
+//* A ConnectNodeResponse is received in response to a ConnectNode request.
+type ConnectNodeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The node corresponding to the contact.
-	Node *NodeInfo `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	//* The contact id.
-	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	//* A contact's chat nickname.
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
 }
 
-func (x *ContactInfo) Reset() {
-	*x = ContactInfo{}
+func (x *ConnectNodeResponse) Reset() {
+	*x = ConnectNodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[17]
+		mi := &file_rpc_services_rpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContactInfo) String() string {
+func (x *ConnectNodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContactInfo) ProtoMessage() {}
+func (*ConnectNodeResponse) ProtoMessage() {}
 
-func (x *ContactInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[17]
+func (x *ConnectNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1253,56 +1857,53 @@ func (x *ContactInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContactInfo.ProtoReflect.Descriptor instead.
-func (*ContactInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *ContactInfo) GetNode() *NodeInfo {
-	if x != nil {
-		return x.Node
-	}
-	return nil
-}
-
-func (x *ContactInfo) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ContactInfo) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+// Deprecated: Use ConnectNodeResponse.ProtoReflect.Descriptor instead.
+func (*ConnectNodeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{19}
 }
 
-//* Corresponds to a request to list all contacts.
-type GetContactsRequest struct {
+//* Corresponds to a request to open a channel.
+type OpenChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The address of the node to open a channel to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//* The total amount to be committed to the channel (in millisatoshi).
+	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The amount to be sent to the other party (in millisatoshi).
+	PushAmtMsat uint64 `protobuf:"varint,3,opt,name=push_amt_msat,json=pushAmtMsat,proto3" json:"push_amt_msat,omitempty"`
+	//* The minimum number of confirmations
+	//each input of the channel funding transaction must have.
+	//
+	//In case of a negative value being provided, unconfirmed funds can be used.
+	MinInputConfs int32 `protobuf:"varint,4,opt,name=min_input_confs,json=minInputConfs,proto3" json:"min_input_confs,omitempty"`
+	//* The number of blocks the funding transaction should confirm by.
+	//
+	//Used for fee estimation.
+	TargetConfirmationBlock uint32 `protobuf:"varint,5,opt,name=target_confirmation_block,json=targetConfirmationBlock,proto3" json:"target_confirmation_block,omitempty"`
+	//* The fee rate (satoshis per virtual byte) the funding transaction should cost.
+	SatPerVbyte uint64 `protobuf:"varint,6,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
 }
 
-func (x *GetContactsRequest) Reset() {
-	*x = GetContactsRequest{}
+func (x *OpenChannelRequest) Reset() {
+	*x = OpenChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[18]
+		mi := &file_rpc_services_rpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetContactsRequest) String() string {
+func (x *OpenChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactsRequest) ProtoMessage() {}
+func (*OpenChannelRequest) ProtoMessage() {}
 
-func (x *GetContactsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[18]
+func (x *OpenChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,87 +1914,82 @@ func (x *GetContactsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactsRequest.ProtoReflect.Descriptor instead.
-func (*GetContactsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use OpenChannelRequest.ProtoReflect.Descriptor instead.
+func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{20}
 }
 
-//* A GetContactsResponse is received in response to a GetContacts rpc call.
-type GetContactsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//* The list of contacts in the database.
-	Contacts []*ContactInfo `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+func (x *OpenChannelRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (x *GetContactsResponse) Reset() {
-	*x = GetContactsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *OpenChannelRequest) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
 	}
+	return 0
 }
 
-func (x *GetContactsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OpenChannelRequest) GetPushAmtMsat() uint64 {
+	if x != nil {
+		return x.PushAmtMsat
+	}
+	return 0
 }
 
-func (*GetContactsResponse) ProtoMessage() {}
-
-func (x *GetContactsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OpenChannelRequest) GetMinInputConfs() int32 {
+	if x != nil {
+		return x.MinInputConfs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetContactsResponse.ProtoReflect.Descriptor instead.
-func (*GetContactsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{19}
+func (x *OpenChannelRequest) GetTargetConfirmationBlock() uint32 {
+	if x != nil {
+		return x.TargetConfirmationBlock
+	}
+	return 0
 }
 
-func (x *GetContactsResponse) GetContacts() []*ContactInfo {
+func (x *OpenChannelRequest) GetSatPerVbyte() uint64 {
 	if x != nil {
-		return x.Contacts
+		return x.SatPerVbyte
 	}
-	return nil
+	return 0
 }
 
-//* Corresponds to a request to add a node as a contact.
-type AddContactRequest struct {
+//* An OpenChannelResponse is received in response to an OpenChannel call.
+type OpenChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The node to add as a contact.
-	Contact *ContactInfo `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	//* The channel funding transaction.
+	FundingTxid string `protobuf:"bytes,1,opt,name=funding_txid,json=fundingTxid,proto3" json:"funding_txid,omitempty"`
+	//* The output index of the funding transaction.
+	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
 }
 
-func (x *AddContactRequest) Reset() {
-	*x = AddContactRequest{}
+func (x *OpenChannelResponse) Reset() {
+	*x = OpenChannelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[20]
+		mi := &file_rpc_services_rpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddContactRequest) String() string {
+func (x *OpenChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddContactRequest) ProtoMessage() {}
+func (*OpenChannelResponse) ProtoMessage() {}
 
-func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[20]
+func (x *OpenChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,45 +2000,54 @@ func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddContactRequest.ProtoReflect.Descriptor instead.
-func (*AddContactRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use OpenChannelResponse.ProtoReflect.Descriptor instead.
+func (*OpenChannelResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AddContactRequest) GetContact() *ContactInfo {
+func (x *OpenChannelResponse) GetFundingTxid() string {
 	if x != nil {
-		return x.Contact
+		return x.FundingTxid
 	}
-	return nil
+	return ""
 }
 
-//* A AddContactResponse is received in response to an AddContact rpc call.
-type AddContactResponse struct {
+func (x *OpenChannelResponse) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+//* Identifies a channel by its funding transaction output.
+type ChannelPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The newly added contact's information.
-	Contact *ContactInfo `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	//* The channel funding transaction.
+	FundingTxid string `protobuf:"bytes,1,opt,name=funding_txid,json=fundingTxid,proto3" json:"funding_txid,omitempty"`
+	//* The output index of the funding transaction.
+	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
 }
 
-func (x *AddContactResponse) Reset() {
-	*x = AddContactResponse{}
+func (x *ChannelPoint) Reset() {
+	*x = ChannelPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[21]
+		mi := &file_rpc_services_rpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddContactResponse) String() string {
+func (x *ChannelPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddContactResponse) ProtoMessage() {}
+func (*ChannelPoint) ProtoMessage() {}
 
-func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[21]
+func (x *ChannelPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1453,45 +2058,76 @@ func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddContactResponse.ProtoReflect.Descriptor instead.
-func (*AddContactResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ChannelPoint.ProtoReflect.Descriptor instead.
+func (*ChannelPoint) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AddContactResponse) GetContact() *ContactInfo {
+func (x *ChannelPoint) GetFundingTxid() string {
 	if x != nil {
-		return x.Contact
+		return x.FundingTxid
 	}
-	return nil
+	return ""
 }
 
-//* Corresponds to a request to remove a contact.
-type RemoveContactByIDRequest struct {
+func (x *ChannelPoint) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+//* A message representing an open channel of the underlying node.
+type Channel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The id of the contact to remove.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *RemoveContactByIDRequest) Reset() {
-	*x = RemoveContactByIDRequest{}
+	//* The channel point identifying the channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	//* The id of the channel (short channel id).
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The address of the remote endpoint of the channel.
+	RemoteAddress string `protobuf:"bytes,3,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	//* Whether the channel is active.
+	Active bool `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	//* Whether the channel is private.
+	Private bool `protobuf:"varint,5,opt,name=private,proto3" json:"private,omitempty"`
+	//* Whether the channel was initiated by the underlying node.
+	Initiator bool `protobuf:"varint,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//* The capacity of the channel (in millisatoshi).
+	CapacityMsat int64 `protobuf:"varint,7,opt,name=capacity_msat,json=capacityMsat,proto3" json:"capacity_msat,omitempty"`
+	//* The local balance of the channel (in millisatoshi).
+	LocalBalanceMsat uint64 `protobuf:"varint,8,opt,name=local_balance_msat,json=localBalanceMsat,proto3" json:"local_balance_msat,omitempty"`
+	//* The remote balance of the channel (in millisatoshi).
+	RemoteBalanceMsat uint64 `protobuf:"varint,9,opt,name=remote_balance_msat,json=remoteBalanceMsat,proto3" json:"remote_balance_msat,omitempty"`
+	//* The balance of unsettled HTLCs on the channel (in millisatoshi).
+	UnsettledBalanceMsat int64 `protobuf:"varint,10,opt,name=unsettled_balance_msat,json=unsettledBalanceMsat,proto3" json:"unsettled_balance_msat,omitempty"`
+	//* The total amount sent over the channel (in millisatoshi).
+	TotalSentMsat int64 `protobuf:"varint,11,opt,name=total_sent_msat,json=totalSentMsat,proto3" json:"total_sent_msat,omitempty"`
+	//* The total amount received over the channel (in millisatoshi).
+	TotalReceivedMsat int64 `protobuf:"varint,12,opt,name=total_received_msat,json=totalReceivedMsat,proto3" json:"total_received_msat,omitempty"`
+	//* The number of updates to the channel state.
+	NumUpdates uint64 `protobuf:"varint,13,opt,name=num_updates,json=numUpdates,proto3" json:"num_updates,omitempty"`
+}
+
+func (x *Channel) Reset() {
+	*x = Channel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[22]
+		mi := &file_rpc_services_rpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveContactByIDRequest) String() string {
+func (x *Channel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveContactByIDRequest) ProtoMessage() {}
+func (*Channel) ProtoMessage() {}
 
-func (x *RemoveContactByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[22]
+func (x *Channel) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,76 +2138,122 @@ func (x *RemoveContactByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveContactByIDRequest.ProtoReflect.Descriptor instead.
-func (*RemoveContactByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RemoveContactByIDRequest) GetId() uint64 {
+func (x *Channel) GetChannelPoint() *ChannelPoint {
 	if x != nil {
-		return x.Id
+		return x.ChannelPoint
+	}
+	return nil
+}
+
+func (x *Channel) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
 	}
 	return 0
 }
 
-//* Corresponds to a request to remove a contact.
-type RemoveContactByAddressRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Channel) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
 
-	//* The Lightning address of the contact to remove.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (x *Channel) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
 }
 
-func (x *RemoveContactByAddressRequest) Reset() {
-	*x = RemoveContactByAddressRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[23]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Channel) GetPrivate() bool {
+	if x != nil {
+		return x.Private
 	}
+	return false
 }
 
-func (x *RemoveContactByAddressRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Channel) GetInitiator() bool {
+	if x != nil {
+		return x.Initiator
+	}
+	return false
 }
 
-func (*RemoveContactByAddressRequest) ProtoMessage() {}
+func (x *Channel) GetCapacityMsat() int64 {
+	if x != nil {
+		return x.CapacityMsat
+	}
+	return 0
+}
 
-func (x *RemoveContactByAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[23]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Channel) GetLocalBalanceMsat() uint64 {
+	if x != nil {
+		return x.LocalBalanceMsat
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RemoveContactByAddressRequest.ProtoReflect.Descriptor instead.
-func (*RemoveContactByAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{23}
+func (x *Channel) GetRemoteBalanceMsat() uint64 {
+	if x != nil {
+		return x.RemoteBalanceMsat
+	}
+	return 0
 }
 
-func (x *RemoveContactByAddressRequest) GetAddress() string {
+func (x *Channel) GetUnsettledBalanceMsat() int64 {
 	if x != nil {
-		return x.Address
+		return x.UnsettledBalanceMsat
 	}
-	return ""
+	return 0
 }
 
-//* A RemoveContactResponse is received in response to a RemoveContactBy* rpc call.
-type RemoveContactResponse struct {
+func (x *Channel) GetTotalSentMsat() int64 {
+	if x != nil {
+		return x.TotalSentMsat
+	}
+	return 0
+}
+
+func (x *Channel) GetTotalReceivedMsat() int64 {
+	if x != nil {
+		return x.TotalReceivedMsat
+	}
+	return 0
+}
+
+func (x *Channel) GetNumUpdates() uint64 {
+	if x != nil {
+		return x.NumUpdates
+	}
+	return 0
+}
+
+//* Corresponds to a request to list the open channels of the underlying node.
+type ListChannelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* Whether to return only active channels.
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	//* Whether to return only inactive channels.
+	InactiveOnly bool `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
+	//* Whether to return only public channels.
+	PublicOnly bool `protobuf:"varint,3,opt,name=public_only,json=publicOnly,proto3" json:"public_only,omitempty"`
+	//* Whether to return only private channels.
+	PrivateOnly bool `protobuf:"varint,4,opt,name=private_only,json=privateOnly,proto3" json:"private_only,omitempty"`
+	//* If set, only channels with the node with this address are returned.
+	Peer string `protobuf:"bytes,5,opt,name=peer,proto3" json:"peer,omitempty"`
 }
 
-func (x *RemoveContactResponse) Reset() {
-	*x = RemoveContactResponse{}
+func (x *ListChannelsRequest) Reset() {
+	*x = ListChannelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1579,13 +2261,13 @@ func (x *RemoveContactResponse) Reset() {
 	}
 }
 
-func (x *RemoveContactResponse) String() string {
+func (x *ListChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveContactResponse) ProtoMessage() {}
+func (*ListChannelsRequest) ProtoMessage() {}
 
-func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
+func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1597,23 +2279,58 @@ func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveContactResponse.ProtoReflect.Descriptor instead.
-func (*RemoveContactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{24}
 }
 
-//* Represents a list of payments.
-type Payments struct {
+func (x *ListChannelsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListChannelsRequest) GetInactiveOnly() bool {
+	if x != nil {
+		return x.InactiveOnly
+	}
+	return false
+}
+
+func (x *ListChannelsRequest) GetPublicOnly() bool {
+	if x != nil {
+		return x.PublicOnly
+	}
+	return false
+}
+
+func (x *ListChannelsRequest) GetPrivateOnly() bool {
+	if x != nil {
+		return x.PrivateOnly
+	}
+	return false
+}
+
+func (x *ListChannelsRequest) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+//* A ListChannelsResponse is received in response to a ListChannels call.
+type ListChannelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The list of payments fulfilling a message.
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	//* The list of open channels.
+	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
 }
 
-func (x *Payments) Reset() {
-	*x = Payments{}
+func (x *ListChannelsResponse) Reset() {
+	*x = ListChannelsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1621,13 +2338,13 @@ func (x *Payments) Reset() {
 	}
 }
 
-func (x *Payments) String() string {
+func (x *ListChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Payments) ProtoMessage() {}
+func (*ListChannelsResponse) ProtoMessage() {}
 
-func (x *Payments) ProtoReflect() protoreflect.Message {
+func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1639,77 +2356,50 @@ func (x *Payments) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Payments.ProtoReflect.Descriptor instead.
-func (*Payments) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Payments) GetPayments() []*Payment {
+func (x *ListChannelsResponse) GetChannels() []*Channel {
 	if x != nil {
-		return x.Payments
+		return x.Channels
 	}
 	return nil
 }
 
-//* Represents a message sent over the Lightning network.
-type Message struct {
+//* A message representing a channel that is pending opening or closing.
+type PendingChannel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The unique id of the message.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	//* The discussion id this message is associated with.
-	DiscussionId uint64 `protobuf:"varint,2,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
-	//* The Lightning address of the sender node.
-	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
-	//* The Lightning address of the receiver node.
-	// Deprecated: Do not use.
-	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	//* Whether the message sender was verified.
-	SenderVerified bool `protobuf:"varint,11,opt,name=sender_verified,json=senderVerified,proto3" json:"sender_verified,omitempty"`
-	//* The message payload.
-	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
-	//* The amount paid over this message (in millisatoshi).
-	AmtMsat int64 `protobuf:"varint,6,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The total routing fees paid for this message across all routes (in millisatoshi).
-	//
-	//This field is meaningful only for sent and estimated messages.
-	//
-	// Deprecated: Do not use.
-	TotalFeesMsat int64 `protobuf:"varint,7,opt,name=total_fees_msat,json=totalFeesMsat,proto3" json:"total_fees_msat,omitempty"`
-	//* The time the message was sent.
-	SentTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sent_timestamp,json=sentTimestamp,proto3" json:"sent_timestamp,omitempty"`
-	//* The time the message was received.
-	ReceivedTimestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=received_timestamp,json=receivedTimestamp,proto3" json:"received_timestamp,omitempty"`
-	//* The routes that fulfilled this message.
-	//
-	//This field is meaningful only for sent and estimated messages.
-	//
-	// Deprecated: Do not use.
-	PaymentRoutes []*PaymentRoute `protobuf:"bytes,10,rep,name=payment_routes,json=paymentRoutes,proto3" json:"payment_routes,omitempty"`
-	//* The preimage belonging to the associated payment.
-	//
-	//This field is only meaningful for received messages and
-	//messages sent successfully to non-group discussions.
-	//
-	// Deprecated: Do not use.
-	Preimage string `protobuf:"bytes,12,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	//* The payment request this message was paid to.
-	//
-	//If empty, corresponds to a spontaneous payment.
-	//
-	// Deprecated: Do not use.
-	PayReq string `protobuf:"bytes,13,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
-	//* The associated Lightning entity.
-	// Types that are assignable to LightningData:
-	//	*Message_Payments
-	//	*Message_Invoice
-	LightningData isMessage_LightningData `protobuf_oneof:"lightning_data"`
-}
-
-func (x *Message) Reset() {
-	*x = Message{}
+	//* The channel point identifying the channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	//* The address of the remote endpoint of the channel.
+	RemoteAddress string `protobuf:"bytes,2,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	//* The state of the pending channel.
+	State PendingChannelState `protobuf:"varint,3,opt,name=state,proto3,enum=services.PendingChannelState" json:"state,omitempty"`
+	//* Whether the channel was initiated by the underlying node.
+	Initiator bool `protobuf:"varint,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	//* The capacity of the channel (in millisatoshi).
+	CapacityMsat int64 `protobuf:"varint,5,opt,name=capacity_msat,json=capacityMsat,proto3" json:"capacity_msat,omitempty"`
+	//* The local balance of the channel (in millisatoshi).
+	LocalBalanceMsat uint64 `protobuf:"varint,6,opt,name=local_balance_msat,json=localBalanceMsat,proto3" json:"local_balance_msat,omitempty"`
+	//* The remote balance of the channel (in millisatoshi).
+	RemoteBalanceMsat uint64 `protobuf:"varint,7,opt,name=remote_balance_msat,json=remoteBalanceMsat,proto3" json:"remote_balance_msat,omitempty"`
+	//* The closing transaction, if the channel is being closed.
+	ClosingTxid string `protobuf:"bytes,8,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
+	//* The balance awaiting resolution (in millisatoshi).
+	LimboBalanceMsat int64 `protobuf:"varint,9,opt,name=limbo_balance_msat,json=limboBalanceMsat,proto3" json:"limbo_balance_msat,omitempty"`
+	//* The height at which funds can be swept, for forcibly closed channels.
+	MaturityHeight uint32 `protobuf:"varint,10,opt,name=maturity_height,json=maturityHeight,proto3" json:"maturity_height,omitempty"`
+	//* The number of blocks until funds can be swept, for forcibly closed channels.
+	BlocksTilMaturity int32 `protobuf:"varint,11,opt,name=blocks_til_maturity,json=blocksTilMaturity,proto3" json:"blocks_til_maturity,omitempty"`
+}
+
+func (x *PendingChannel) Reset() {
+	*x = PendingChannel{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1717,13 +2407,13 @@ func (x *Message) Reset() {
 	}
 }
 
-func (x *Message) String() string {
+func (x *PendingChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*PendingChannel) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
+func (x *PendingChannel) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1735,177 +2425,154 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
+// Deprecated: Use PendingChannel.ProtoReflect.Descriptor instead.
+func (*PendingChannel) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Message) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Message) GetDiscussionId() uint64 {
+func (x *PendingChannel) GetChannelPoint() *ChannelPoint {
 	if x != nil {
-		return x.DiscussionId
+		return x.ChannelPoint
 	}
-	return 0
+	return nil
 }
 
-func (x *Message) GetSender() string {
+func (x *PendingChannel) GetRemoteAddress() string {
 	if x != nil {
-		return x.Sender
+		return x.RemoteAddress
 	}
 	return ""
 }
 
-// Deprecated: Do not use.
-func (x *Message) GetReceiver() string {
+func (x *PendingChannel) GetState() PendingChannelState {
 	if x != nil {
-		return x.Receiver
+		return x.State
 	}
-	return ""
+	return PendingChannelState_PENDING_OPEN
 }
 
-func (x *Message) GetSenderVerified() bool {
+func (x *PendingChannel) GetInitiator() bool {
 	if x != nil {
-		return x.SenderVerified
+		return x.Initiator
 	}
 	return false
 }
 
-func (x *Message) GetPayload() string {
+func (x *PendingChannel) GetCapacityMsat() int64 {
 	if x != nil {
-		return x.Payload
+		return x.CapacityMsat
 	}
-	return ""
+	return 0
 }
 
-func (x *Message) GetAmtMsat() int64 {
+func (x *PendingChannel) GetLocalBalanceMsat() uint64 {
 	if x != nil {
-		return x.AmtMsat
+		return x.LocalBalanceMsat
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (x *Message) GetTotalFeesMsat() int64 {
+func (x *PendingChannel) GetRemoteBalanceMsat() uint64 {
 	if x != nil {
-		return x.TotalFeesMsat
+		return x.RemoteBalanceMsat
 	}
 	return 0
 }
 
-func (x *Message) GetSentTimestamp() *timestamppb.Timestamp {
+func (x *PendingChannel) GetClosingTxid() string {
 	if x != nil {
-		return x.SentTimestamp
+		return x.ClosingTxid
 	}
-	return nil
+	return ""
 }
 
-func (x *Message) GetReceivedTimestamp() *timestamppb.Timestamp {
+func (x *PendingChannel) GetLimboBalanceMsat() int64 {
 	if x != nil {
-		return x.ReceivedTimestamp
+		return x.LimboBalanceMsat
 	}
-	return nil
+	return 0
 }
 
-// Deprecated: Do not use.
-func (x *Message) GetPaymentRoutes() []*PaymentRoute {
+func (x *PendingChannel) GetMaturityHeight() uint32 {
 	if x != nil {
-		return x.PaymentRoutes
+		return x.MaturityHeight
 	}
-	return nil
+	return 0
 }
 
-// Deprecated: Do not use.
-func (x *Message) GetPreimage() string {
+func (x *PendingChannel) GetBlocksTilMaturity() int32 {
 	if x != nil {
-		return x.Preimage
+		return x.BlocksTilMaturity
 	}
-	return ""
-}
-
-// Deprecated: Do not use.
-func (x *Message) GetPayReq() string {
-	if x != nil {
-		return x.PayReq
-	}
-	return ""
+	return 0
 }
 
-func (m *Message) GetLightningData() isMessage_LightningData {
-	if m != nil {
-		return m.LightningData
-	}
-	return nil
+//* Corresponds to a request to list the pending channels of the underlying node.
+type ListPendingChannelsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Message) GetPayments() *Payments {
-	if x, ok := x.GetLightningData().(*Message_Payments); ok {
-		return x.Payments
+func (x *ListPendingChannelsRequest) Reset() {
+	*x = ListPendingChannelsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Message) GetInvoice() *Invoice {
-	if x, ok := x.GetLightningData().(*Message_Invoice); ok {
-		return x.Invoice
-	}
-	return nil
+func (x *ListPendingChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type isMessage_LightningData interface {
-	isMessage_LightningData()
-}
+func (*ListPendingChannelsRequest) ProtoMessage() {}
 
-type Message_Payments struct {
-	Payments *Payments `protobuf:"bytes,14,opt,name=payments,proto3,oneof"`
+func (x *ListPendingChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Message_Invoice struct {
-	Invoice *Invoice `protobuf:"bytes,15,opt,name=invoice,proto3,oneof"`
+// Deprecated: Use ListPendingChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{27}
 }
 
-func (*Message_Payments) isMessage_LightningData() {}
-
-func (*Message_Invoice) isMessage_LightningData() {}
-
-//* Represents a route fulfilling a payment HTLC.
-type PaymentRoute struct {
+//* A ListPendingChannelsResponse is received in response to a ListPendingChannels call.
+type ListPendingChannelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The list of hops for this route.
-	Hops []*PaymentHop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
-	//* The total timelock of the route.
-	TotalTimelock uint32 `protobuf:"varint,2,opt,name=total_timelock,json=totalTimelock,proto3" json:"total_timelock,omitempty"`
-	//* The amount sent via this route, disregarding the route fees (in millisatoshi).
-	RouteAmtMsat int64 `protobuf:"varint,3,opt,name=route_amt_msat,json=routeAmtMsat,proto3" json:"route_amt_msat,omitempty"`
-	//* The fees paid for this route (in millisatoshi).
-	RouteFeesMsat int64 `protobuf:"varint,4,opt,name=route_fees_msat,json=routeFeesMsat,proto3" json:"route_fees_msat,omitempty"`
+	//* The list of pending channels.
+	Channels []*PendingChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
 }
 
-func (x *PaymentRoute) Reset() {
-	*x = PaymentRoute{}
+func (x *ListPendingChannelsResponse) Reset() {
+	*x = ListPendingChannelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[27]
+		mi := &file_rpc_services_rpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaymentRoute) String() string {
+func (x *ListPendingChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentRoute) ProtoMessage() {}
+func (*ListPendingChannelsResponse) ProtoMessage() {}
 
-func (x *PaymentRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[27]
+func (x *ListPendingChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1916,72 +2583,53 @@ func (x *PaymentRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentRoute.ProtoReflect.Descriptor instead.
-func (*PaymentRoute) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListPendingChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *PaymentRoute) GetHops() []*PaymentHop {
+func (x *ListPendingChannelsResponse) GetChannels() []*PendingChannel {
 	if x != nil {
-		return x.Hops
+		return x.Channels
 	}
 	return nil
 }
 
-func (x *PaymentRoute) GetTotalTimelock() uint32 {
-	if x != nil {
-		return x.TotalTimelock
-	}
-	return 0
-}
-
-func (x *PaymentRoute) GetRouteAmtMsat() int64 {
-	if x != nil {
-		return x.RouteAmtMsat
-	}
-	return 0
-}
-
-func (x *PaymentRoute) GetRouteFeesMsat() int64 {
-	if x != nil {
-		return x.RouteFeesMsat
-	}
-	return 0
-}
-
-//* Represents a hop of a route of a message.
-type PaymentHop struct {
+//* Corresponds to a request to close a channel.
+type CloseChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The channel id.
-	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	//* The address of the hop node.
-	HopAddress string `protobuf:"bytes,2,opt,name=hop_address,json=hopAddress,proto3" json:"hop_address,omitempty"`
-	//* The amount to be forwarded by the hop (in millisatoshi).
-	AmtToForwardMsat int64 `protobuf:"varint,3,opt,name=amt_to_forward_msat,json=amtToForwardMsat,proto3" json:"amt_to_forward_msat,omitempty"`
-	//* The fee to be paid to the hop for forwarding the message (in millisatoshi).
-	FeeMsat int64 `protobuf:"varint,4,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	//* The channel point of the channel to close.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	//* Whether the channel should be closed forcibly (unilaterally).
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	//* The number of blocks the closing transaction should confirm by.
+	//
+	//Used for fee estimation.
+	TargetConfirmationBlock uint32 `protobuf:"varint,3,opt,name=target_confirmation_block,json=targetConfirmationBlock,proto3" json:"target_confirmation_block,omitempty"`
+	//* The fee rate (satoshis per virtual byte) the closing transaction should cost.
+	SatPerVbyte uint64 `protobuf:"varint,4,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
 }
 
-func (x *PaymentHop) Reset() {
-	*x = PaymentHop{}
+func (x *CloseChannelRequest) Reset() {
+	*x = CloseChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[28]
+		mi := &file_rpc_services_rpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaymentHop) String() string {
+func (x *CloseChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentHop) ProtoMessage() {}
+func (*CloseChannelRequest) ProtoMessage() {}
 
-func (x *PaymentHop) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[28]
+func (x *CloseChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1992,68 +2640,68 @@ func (x *PaymentHop) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentHop.ProtoReflect.Descriptor instead.
-func (*PaymentHop) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CloseChannelRequest.ProtoReflect.Descriptor instead.
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *PaymentHop) GetChanId() uint64 {
+func (x *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
 	if x != nil {
-		return x.ChanId
+		return x.ChannelPoint
 	}
-	return 0
+	return nil
 }
 
-func (x *PaymentHop) GetHopAddress() string {
+func (x *CloseChannelRequest) GetForce() bool {
 	if x != nil {
-		return x.HopAddress
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-func (x *PaymentHop) GetAmtToForwardMsat() int64 {
+func (x *CloseChannelRequest) GetTargetConfirmationBlock() uint32 {
 	if x != nil {
-		return x.AmtToForwardMsat
+		return x.TargetConfirmationBlock
 	}
 	return 0
 }
 
-func (x *PaymentHop) GetFeeMsat() int64 {
+func (x *CloseChannelRequest) GetSatPerVbyte() uint64 {
 	if x != nil {
-		return x.FeeMsat
+		return x.SatPerVbyte
 	}
 	return 0
 }
 
-//* Represents messaging options.
-type MessageOptions struct {
+//* A CloseChannelResponse is received as an update to a CloseChannel call.
+type CloseChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The maximum fee allowed for a message (in millisatoshi).
-	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
-	//* Whether to include the sender address when sending a message.
-	Anonymous bool `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	//* The state of the channel closing.
+	State ChannelCloseState `protobuf:"varint,1,opt,name=state,proto3,enum=services.ChannelCloseState" json:"state,omitempty"`
+	//* The closing transaction.
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
 }
 
-func (x *MessageOptions) Reset() {
-	*x = MessageOptions{}
+func (x *CloseChannelResponse) Reset() {
+	*x = CloseChannelResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[29]
+		mi := &file_rpc_services_rpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageOptions) String() string {
+func (x *CloseChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageOptions) ProtoMessage() {}
+func (*CloseChannelResponse) ProtoMessage() {}
 
-func (x *MessageOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[29]
+func (x *CloseChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2064,59 +2712,49 @@ func (x *MessageOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
-func (*MessageOptions) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CloseChannelResponse.ProtoReflect.Descriptor instead.
+func (*CloseChannelResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MessageOptions) GetFeeLimitMsat() int64 {
+func (x *CloseChannelResponse) GetState() ChannelCloseState {
 	if x != nil {
-		return x.FeeLimitMsat
+		return x.State
 	}
-	return 0
+	return ChannelCloseState_CHANNEL_CLOSE_PENDING
 }
 
-func (x *MessageOptions) GetAnonymous() bool {
+func (x *CloseChannelResponse) GetClosingTxid() string {
 	if x != nil {
-		return x.Anonymous
+		return x.ClosingTxid
 	}
-	return false
+	return ""
 }
 
-//* Corresponds to a request to estimate a message.
-// Deprecated: Do not use.
-type EstimateMessageRequest struct {
+//* Corresponds to a channel event subscription request.
+type SubscribeChannelEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The discussion id where the message is to be sent.
-	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
-	//* The message payload (as a string).
-	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	//* The intended payment amount to the recipient of the message (in millisatoshi).
-	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The message option overrides for the current message.
-	Options *MessageOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *EstimateMessageRequest) Reset() {
-	*x = EstimateMessageRequest{}
+func (x *SubscribeChannelEventsRequest) Reset() {
+	*x = SubscribeChannelEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[30]
+		mi := &file_rpc_services_rpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EstimateMessageRequest) String() string {
+func (x *SubscribeChannelEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateMessageRequest) ProtoMessage() {}
+func (*SubscribeChannelEventsRequest) ProtoMessage() {}
 
-func (x *EstimateMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[30]
+func (x *SubscribeChannelEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,70 +2765,52 @@ func (x *EstimateMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateMessageRequest.ProtoReflect.Descriptor instead.
-func (*EstimateMessageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *EstimateMessageRequest) GetDiscussionId() uint64 {
-	if x != nil {
-		return x.DiscussionId
-	}
-	return 0
-}
-
-func (x *EstimateMessageRequest) GetPayload() string {
-	if x != nil {
-		return x.Payload
-	}
-	return ""
-}
-
-func (x *EstimateMessageRequest) GetAmtMsat() int64 {
-	if x != nil {
-		return x.AmtMsat
-	}
-	return 0
-}
-
-func (x *EstimateMessageRequest) GetOptions() *MessageOptions {
-	if x != nil {
-		return x.Options
-	}
-	return nil
+// Deprecated: Use SubscribeChannelEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeChannelEventsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{31}
 }
 
-//* A EstimateMessageResponse is received in response to a EstimateMessage rpc call.
-type EstimateMessageResponse struct {
+//* A message summarizing a closed channel.
+type ClosedChannel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//*
-	//The probability of successful arrival of the message,
-	//as reported by the Lightning daemon's mission control.
-	SuccessProb float64 `protobuf:"fixed64,1,opt,name=success_prob,json=successProb,proto3" json:"success_prob,omitempty"`
-	//* Contains the estimated route and fees for the requested message.
-	Message *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *EstimateMessageResponse) Reset() {
-	*x = EstimateMessageResponse{}
+	//* The id of the channel (short channel id).
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The address of the remote endpoint of the channel.
+	RemoteAddress string `protobuf:"bytes,2,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	//* The capacity of the channel (in millisatoshi).
+	CapacityMsat int64 `protobuf:"varint,3,opt,name=capacity_msat,json=capacityMsat,proto3" json:"capacity_msat,omitempty"`
+	//* The closing transaction.
+	ClosingTxid string `protobuf:"bytes,4,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
+	//* The height at which the closing transaction was confirmed.
+	CloseHeight uint32 `protobuf:"varint,5,opt,name=close_height,json=closeHeight,proto3" json:"close_height,omitempty"`
+	//* The balance settled to the underlying node (in millisatoshi).
+	SettledBalanceMsat int64 `protobuf:"varint,6,opt,name=settled_balance_msat,json=settledBalanceMsat,proto3" json:"settled_balance_msat,omitempty"`
+	//* The balance that is time-locked (in millisatoshi).
+	TimeLockedBalanceMsat int64 `protobuf:"varint,7,opt,name=time_locked_balance_msat,json=timeLockedBalanceMsat,proto3" json:"time_locked_balance_msat,omitempty"`
+	//* The way the channel was closed.
+	CloseType ChannelCloseType `protobuf:"varint,8,opt,name=close_type,json=closeType,proto3,enum=services.ChannelCloseType" json:"close_type,omitempty"`
+}
+
+func (x *ClosedChannel) Reset() {
+	*x = ClosedChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[31]
+		mi := &file_rpc_services_rpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EstimateMessageResponse) String() string {
+func (x *ClosedChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateMessageResponse) ProtoMessage() {}
+func (*ClosedChannel) ProtoMessage() {}
 
-func (x *EstimateMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[31]
+func (x *ClosedChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,130 +2821,87 @@ func (x *EstimateMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateMessageResponse.ProtoReflect.Descriptor instead.
-func (*EstimateMessageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ClosedChannel.ProtoReflect.Descriptor instead.
+func (*ClosedChannel) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *EstimateMessageResponse) GetSuccessProb() float64 {
+func (x *ClosedChannel) GetChanId() uint64 {
 	if x != nil {
-		return x.SuccessProb
+		return x.ChanId
 	}
 	return 0
 }
 
-func (x *EstimateMessageResponse) GetMessage() *Message {
+func (x *ClosedChannel) GetRemoteAddress() string {
 	if x != nil {
-		return x.Message
+		return x.RemoteAddress
 	}
-	return nil
+	return ""
 }
 
-//* Corresponds to a request to send a message.
-// Deprecated: Do not use.
-type SendMessageRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//* The discussion id where the message is to be sent.
-	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
-	//* The message payload (as a string).
-	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	//* The intended payment amount to the recipient of the message (in millisatoshi).
-	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* A payment request (invoice) to pay to.
-	//
-	//If empty, a spontaneous message is sent.
-	//If specified, discussion_id is not used and should not be specified.
-	//Instead, the message will be sent to the discussion associated with
-	//the recipient specified by the the payment request (which will be
-	//created if it does not exist).
-	//The discussion_id will be returned in the response.
-	PayReq string `protobuf:"bytes,5,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
-	//* The message option overrides for the current message.
-	Options *MessageOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
-}
-
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SendMessageRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SendMessageRequest) ProtoMessage() {}
-
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ClosedChannel) GetCapacityMsat() int64 {
+	if x != nil {
+		return x.CapacityMsat
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{32}
+	return 0
 }
 
-func (x *SendMessageRequest) GetDiscussionId() uint64 {
+func (x *ClosedChannel) GetClosingTxid() string {
 	if x != nil {
-		return x.DiscussionId
+		return x.ClosingTxid
 	}
-	return 0
+	return ""
 }
 
-func (x *SendMessageRequest) GetPayload() string {
+func (x *ClosedChannel) GetCloseHeight() uint32 {
 	if x != nil {
-		return x.Payload
+		return x.CloseHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *SendMessageRequest) GetAmtMsat() int64 {
+func (x *ClosedChannel) GetSettledBalanceMsat() int64 {
 	if x != nil {
-		return x.AmtMsat
+		return x.SettledBalanceMsat
 	}
 	return 0
 }
 
-func (x *SendMessageRequest) GetPayReq() string {
+func (x *ClosedChannel) GetTimeLockedBalanceMsat() int64 {
 	if x != nil {
-		return x.PayReq
+		return x.TimeLockedBalanceMsat
 	}
-	return ""
+	return 0
 }
 
-func (x *SendMessageRequest) GetOptions() *MessageOptions {
+func (x *ClosedChannel) GetCloseType() ChannelCloseType {
 	if x != nil {
-		return x.Options
+		return x.CloseType
 	}
-	return nil
+	return ChannelCloseType_COOPERATIVE_CLOSE
 }
 
-//* A SendMessageResponse is received in response to a SendMessage rpc call.
-type SendMessageResponse struct {
+//*
+//A ChannelEvent is received as an update to a SubscribeChannelEvents call,
+//and represents a change in the state of a channel.
+type ChannelEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The sent message.
-	SentMessage *Message `protobuf:"bytes,1,opt,name=sent_message,json=sentMessage,proto3" json:"sent_message,omitempty"`
+	//* The type of the event.
+	Type ChannelEventType `protobuf:"varint,1,opt,name=type,proto3,enum=services.ChannelEventType" json:"type,omitempty"`
+	//* The channel point of the channel the event refers to.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,2,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	//* The opened channel, for CHANNEL_EVENT_OPEN events.
+	Channel *Channel `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	//* The closed channel summary, for CHANNEL_EVENT_CLOSED events.
+	ClosedChannel *ClosedChannel `protobuf:"bytes,4,opt,name=closed_channel,json=closedChannel,proto3" json:"closed_channel,omitempty"`
 }
 
-func (x *SendMessageResponse) Reset() {
-	*x = SendMessageResponse{}
+func (x *ChannelEvent) Reset() {
+	*x = ChannelEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2332,13 +2909,13 @@ func (x *SendMessageResponse) Reset() {
 	}
 }
 
-func (x *SendMessageResponse) String() string {
+func (x *ChannelEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageResponse) ProtoMessage() {}
+func (*ChannelEvent) ProtoMessage() {}
 
-func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+func (x *ChannelEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2350,90 +2927,66 @@ func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChannelEvent.ProtoReflect.Descriptor instead.
+func (*ChannelEvent) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SendMessageResponse) GetSentMessage() *Message {
+func (x *ChannelEvent) GetType() ChannelEventType {
 	if x != nil {
-		return x.SentMessage
+		return x.Type
 	}
-	return nil
-}
-
-//*
-//Corresponds to a request to create a stream
-//over which to be notified of received messages.
-//
-// Deprecated: Do not use.
-type SubscribeMessageRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return ChannelEventType_CHANNEL_EVENT_PENDING_OPEN
 }
 
-func (x *SubscribeMessageRequest) Reset() {
-	*x = SubscribeMessageRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChannelEvent) GetChannelPoint() *ChannelPoint {
+	if x != nil {
+		return x.ChannelPoint
 	}
+	return nil
 }
 
-func (x *SubscribeMessageRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SubscribeMessageRequest) ProtoMessage() {}
-
-func (x *SubscribeMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChannelEvent) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SubscribeMessageRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeMessageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{34}
+func (x *ChannelEvent) GetClosedChannel() *ClosedChannel {
+	if x != nil {
+		return x.ClosedChannel
+	}
+	return nil
 }
 
-//*
-//A SubscribeMessageResponse is received in the stream returned in response to
-//a SubscribeMessages rpc call, and represents a received message.
-type SubscribeMessageResponse struct {
+//* Corresponds to a request for the forwarding policy of a channel.
+type GetChannelPolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The received message.
-	ReceivedMessage *Message `protobuf:"bytes,1,opt,name=received_message,json=receivedMessage,proto3" json:"received_message,omitempty"`
+	//* The channel point of the channel.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
 }
 
-func (x *SubscribeMessageResponse) Reset() {
-	*x = SubscribeMessageResponse{}
+func (x *GetChannelPolicyRequest) Reset() {
+	*x = GetChannelPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[35]
+		mi := &file_rpc_services_rpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeMessageResponse) String() string {
+func (x *GetChannelPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeMessageResponse) ProtoMessage() {}
+func (*GetChannelPolicyRequest) ProtoMessage() {}
 
-func (x *SubscribeMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[35]
+func (x *GetChannelPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2444,53 +2997,54 @@ func (x *SubscribeMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeMessageResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeMessageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetChannelPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SubscribeMessageResponse) GetReceivedMessage() *Message {
+func (x *GetChannelPolicyRequest) GetChannelPoint() *ChannelPoint {
 	if x != nil {
-		return x.ReceivedMessage
+		return x.ChannelPoint
 	}
 	return nil
 }
 
-//* Represents the information for a specific discussion.
-type DiscussionInfo struct {
+//* Represents the terms under which HTLCs are forwarded over a channel.
+type ChannelPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The discussion id.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	//* The list of participants in the discussion.
-	Participants []string `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	//* The default options applicable for all discussion messages.
-	Options *DiscussionOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
-	//* The id of the last read message in the discussion.
-	LastReadMsgId uint64 `protobuf:"varint,4,opt,name=last_read_msg_id,json=lastReadMsgId,proto3" json:"last_read_msg_id,omitempty"`
-	//* The id of the last discussion message.
-	LastMsgId uint64 `protobuf:"varint,5,opt,name=last_msg_id,json=lastMsgId,proto3" json:"last_msg_id,omitempty"`
-}
-
-func (x *DiscussionInfo) Reset() {
-	*x = DiscussionInfo{}
+	//* The base fee charged for forwarding an HTLC (in millisatoshi).
+	BaseFeeMsat int64 `protobuf:"varint,1,opt,name=base_fee_msat,json=baseFeeMsat,proto3" json:"base_fee_msat,omitempty"`
+	//* The proportional fee charged for forwarding an HTLC
+	//(in millionths of the forwarded amount).
+	FeeRatePpm uint32 `protobuf:"varint,2,opt,name=fee_rate_ppm,json=feeRatePpm,proto3" json:"fee_rate_ppm,omitempty"`
+	//* The time-lock delta required for forwarding an HTLC (in blocks).
+	TimeLockDelta uint32 `protobuf:"varint,3,opt,name=time_lock_delta,json=timeLockDelta,proto3" json:"time_lock_delta,omitempty"`
+	//* The minimum amount of forwarded HTLCs (in millisatoshi).
+	MinHtlcMsat uint64 `protobuf:"varint,4,opt,name=min_htlc_msat,json=minHtlcMsat,proto3" json:"min_htlc_msat,omitempty"`
+	//* The maximum amount of forwarded HTLCs (in millisatoshi).
+	MaxHtlcMsat uint64 `protobuf:"varint,5,opt,name=max_htlc_msat,json=maxHtlcMsat,proto3" json:"max_htlc_msat,omitempty"`
+}
+
+func (x *ChannelPolicy) Reset() {
+	*x = ChannelPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[36]
+		mi := &file_rpc_services_rpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiscussionInfo) String() string {
+func (x *ChannelPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscussionInfo) ProtoMessage() {}
+func (*ChannelPolicy) ProtoMessage() {}
 
-func (x *DiscussionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[36]
+func (x *ChannelPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2501,77 +3055,79 @@ func (x *DiscussionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscussionInfo.ProtoReflect.Descriptor instead.
-func (*DiscussionInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ChannelPolicy.ProtoReflect.Descriptor instead.
+func (*ChannelPolicy) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DiscussionInfo) GetId() uint64 {
+func (x *ChannelPolicy) GetBaseFeeMsat() int64 {
 	if x != nil {
-		return x.Id
+		return x.BaseFeeMsat
 	}
 	return 0
 }
 
-func (x *DiscussionInfo) GetParticipants() []string {
+func (x *ChannelPolicy) GetFeeRatePpm() uint32 {
 	if x != nil {
-		return x.Participants
+		return x.FeeRatePpm
 	}
-	return nil
+	return 0
 }
 
-func (x *DiscussionInfo) GetOptions() *DiscussionOptions {
+func (x *ChannelPolicy) GetTimeLockDelta() uint32 {
 	if x != nil {
-		return x.Options
+		return x.TimeLockDelta
 	}
-	return nil
+	return 0
 }
 
-func (x *DiscussionInfo) GetLastReadMsgId() uint64 {
+func (x *ChannelPolicy) GetMinHtlcMsat() uint64 {
 	if x != nil {
-		return x.LastReadMsgId
+		return x.MinHtlcMsat
 	}
 	return 0
 }
 
-func (x *DiscussionInfo) GetLastMsgId() uint64 {
+func (x *ChannelPolicy) GetMaxHtlcMsat() uint64 {
 	if x != nil {
-		return x.LastMsgId
+		return x.MaxHtlcMsat
 	}
 	return 0
 }
 
-//* DiscussionOptions represents the per-discussion options.
-type DiscussionOptions struct {
+//* Corresponds to a request to update the forwarding policy.
+type UpdateChannelPolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The maximum fee allowed for sending a message (in millisatoshi).
+	//* The channel point of the channel to update.
 	//
-	//If not set, the default fee limit, as defined in the app package, is used.
-	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
-	//* Whether to send as anonymous on this discussion.
-	Anonymous bool `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	//If not specified, the policy of all channels is updated.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	//* The updated policy.
+	//
+	//A zero minimum or maximum HTLC amount leaves the respective limit unchanged.
+	Policy *ChannelPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
 }
 
-func (x *DiscussionOptions) Reset() {
-	*x = DiscussionOptions{}
+func (x *UpdateChannelPolicyRequest) Reset() {
+	*x = UpdateChannelPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[37]
+		mi := &file_rpc_services_rpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiscussionOptions) String() string {
+func (x *UpdateChannelPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscussionOptions) ProtoMessage() {}
+func (*UpdateChannelPolicyRequest) ProtoMessage() {}
 
-func (x *DiscussionOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[37]
+func (x *UpdateChannelPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,49 +3138,49 @@ func (x *DiscussionOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscussionOptions.ProtoReflect.Descriptor instead.
-func (*DiscussionOptions) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use UpdateChannelPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateChannelPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DiscussionOptions) GetFeeLimitMsat() int64 {
+func (x *UpdateChannelPolicyRequest) GetChannelPoint() *ChannelPoint {
 	if x != nil {
-		return x.FeeLimitMsat
+		return x.ChannelPoint
 	}
-	return 0
+	return nil
 }
 
-func (x *DiscussionOptions) GetAnonymous() bool {
+func (x *UpdateChannelPolicyRequest) GetPolicy() *ChannelPolicy {
 	if x != nil {
-		return x.Anonymous
+		return x.Policy
 	}
-	return false
+	return nil
 }
 
-//* Corresponds to a request to receive all discussion info.
-type GetDiscussionsRequest struct {
+//* An UpdateChannelPolicyResponse is received in response to an UpdateChannelPolicy call.
+type UpdateChannelPolicyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetDiscussionsRequest) Reset() {
-	*x = GetDiscussionsRequest{}
+func (x *UpdateChannelPolicyResponse) Reset() {
+	*x = UpdateChannelPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[38]
+		mi := &file_rpc_services_rpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionsRequest) String() string {
+func (x *UpdateChannelPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionsRequest) ProtoMessage() {}
+func (*UpdateChannelPolicyResponse) ProtoMessage() {}
 
-func (x *GetDiscussionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[38]
+func (x *UpdateChannelPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2635,39 +3191,44 @@ func (x *GetDiscussionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionsRequest.ProtoReflect.Descriptor instead.
-func (*GetDiscussionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UpdateChannelPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateChannelPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{37}
 }
 
-//*
-//A GetDiscussionsResponse is received in the stream returned in response
-//to a GetDiscussions rpc call, and represents a discussion.
-type GetDiscussionsResponse struct {
+//* Corresponds to a request for forwarding events.
+type ForwardingHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+	//* The start of the time range (in seconds since Unix epoch).
+	StartTime int64 `protobuf:"varint,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	//* The end of the time range (in seconds since Unix epoch).
+	//
+	//If not specified, the current time is used.
+	EndTime int64 `protobuf:"varint,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	//* The pagination options of the request.
+	PageOptions *KeySetPageOptions `protobuf:"bytes,3,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
 }
 
-func (x *GetDiscussionsResponse) Reset() {
-	*x = GetDiscussionsResponse{}
+func (x *ForwardingHistoryRequest) Reset() {
+	*x = ForwardingHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[39]
+		mi := &file_rpc_services_rpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionsResponse) String() string {
+func (x *ForwardingHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionsResponse) ProtoMessage() {}
+func (*ForwardingHistoryRequest) ProtoMessage() {}
 
-func (x *GetDiscussionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[39]
+func (x *ForwardingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2678,107 +3239,71 @@ func (x *GetDiscussionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionsResponse.ProtoReflect.Descriptor instead.
-func (*GetDiscussionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ForwardingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetDiscussionsResponse) GetDiscussion() *DiscussionInfo {
+func (x *ForwardingHistoryRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.Discussion
+		return x.StartTime
 	}
-	return nil
+	return 0
 }
 
-//*
-//Corresponds to a request to create a stream over which to receive
-//previously exchanged messages of the identified discussion.
-type GetDiscussionHistoryByIDRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//* The discussion id of interest.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	//* The pagination options of the request.
-	PageOptions *KeySetPageOptions `protobuf:"bytes,2,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
-}
-
-func (x *GetDiscussionHistoryByIDRequest) Reset() {
-	*x = GetDiscussionHistoryByIDRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[40]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetDiscussionHistoryByIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetDiscussionHistoryByIDRequest) ProtoMessage() {}
-
-func (x *GetDiscussionHistoryByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[40]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetDiscussionHistoryByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetDiscussionHistoryByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *GetDiscussionHistoryByIDRequest) GetId() uint64 {
+func (x *ForwardingHistoryRequest) GetEndTime() int64 {
 	if x != nil {
-		return x.Id
+		return x.EndTime
 	}
 	return 0
 }
 
-func (x *GetDiscussionHistoryByIDRequest) GetPageOptions() *KeySetPageOptions {
+func (x *ForwardingHistoryRequest) GetPageOptions() *KeySetPageOptions {
 	if x != nil {
 		return x.PageOptions
 	}
 	return nil
 }
 
-//*
-//A GetDiscussionHistoryResponse is received in response to
-//a GetHistory rpc call, and represents an exchanged message.
-type GetDiscussionHistoryResponse struct {
+//* Represents an HTLC forwarded by the underlying node.
+type ForwardingEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The exchanged message.
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *GetDiscussionHistoryResponse) Reset() {
-	*x = GetDiscussionHistoryResponse{}
+	//* The index of the event within the requested time range.
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	//* The time the forwarded HTLC was settled (in nanoseconds since Unix epoch).
+	TimestampNs int64 `protobuf:"varint,2,opt,name=timestamp_ns,json=timestampNs,proto3" json:"timestamp_ns,omitempty"`
+	//* The id of the channel the HTLC arrived on.
+	ChanIdIn uint64 `protobuf:"varint,3,opt,name=chan_id_in,json=chanIdIn,proto3" json:"chan_id_in,omitempty"`
+	//* The id of the channel the HTLC was forwarded on.
+	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out,json=chanIdOut,proto3" json:"chan_id_out,omitempty"`
+	//* The amount of the incoming HTLC (in millisatoshi).
+	AmtInMsat uint64 `protobuf:"varint,5,opt,name=amt_in_msat,json=amtInMsat,proto3" json:"amt_in_msat,omitempty"`
+	//* The amount of the outgoing HTLC (in millisatoshi).
+	AmtOutMsat uint64 `protobuf:"varint,6,opt,name=amt_out_msat,json=amtOutMsat,proto3" json:"amt_out_msat,omitempty"`
+	//* The fee earned by the forward (in millisatoshi).
+	FeeMsat uint64 `protobuf:"varint,7,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+}
+
+func (x *ForwardingEvent) Reset() {
+	*x = ForwardingEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[41]
+		mi := &file_rpc_services_rpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionHistoryResponse) String() string {
+func (x *ForwardingEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionHistoryResponse) ProtoMessage() {}
+func (*ForwardingEvent) ProtoMessage() {}
 
-func (x *GetDiscussionHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[41]
+func (x *ForwardingEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2789,106 +3314,97 @@ func (x *GetDiscussionHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetDiscussionHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ForwardingEvent.ProtoReflect.Descriptor instead.
+func (*ForwardingEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetDiscussionHistoryResponse) GetMessage() *Message {
+func (x *ForwardingEvent) GetIndex() uint64 {
 	if x != nil {
-		return x.Message
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-//*
-//Corresponds to a request for statistics about the requested
-//discussion, identified by its id.
-type GetDiscussionStatisticsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//* The discussion id.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ForwardingEvent) GetTimestampNs() int64 {
+	if x != nil {
+		return x.TimestampNs
+	}
+	return 0
 }
 
-func (x *GetDiscussionStatisticsRequest) Reset() {
-	*x = GetDiscussionStatisticsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ForwardingEvent) GetChanIdIn() uint64 {
+	if x != nil {
+		return x.ChanIdIn
 	}
+	return 0
 }
 
-func (x *GetDiscussionStatisticsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ForwardingEvent) GetChanIdOut() uint64 {
+	if x != nil {
+		return x.ChanIdOut
+	}
+	return 0
 }
 
-func (*GetDiscussionStatisticsRequest) ProtoMessage() {}
-
-func (x *GetDiscussionStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ForwardingEvent) GetAmtInMsat() uint64 {
+	if x != nil {
+		return x.AmtInMsat
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetDiscussionStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetDiscussionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{42}
+func (x *ForwardingEvent) GetAmtOutMsat() uint64 {
+	if x != nil {
+		return x.AmtOutMsat
+	}
+	return 0
 }
 
-func (x *GetDiscussionStatisticsRequest) GetId() uint64 {
+func (x *ForwardingEvent) GetFeeMsat() uint64 {
 	if x != nil {
-		return x.Id
+		return x.FeeMsat
 	}
 	return 0
 }
 
-//*
-//A GetDiscussionStatisticsResponse is received in response to
-//a GetDiscussionStatistics rpc call.
-type GetDiscussionStatisticsResponse struct {
+//* Aggregates the forwarding events concerning a channel.
+type ChannelForwardingStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The total amount sent in the discussion (in millisatoshi).
-	AmtMsatSent uint64 `protobuf:"varint,1,opt,name=amt_msat_sent,json=amtMsatSent,proto3" json:"amt_msat_sent,omitempty"`
-	//* The total amount received in the discussion (in millisatoshi).
-	AmtMsatReceived uint64 `protobuf:"varint,2,opt,name=amt_msat_received,json=amtMsatReceived,proto3" json:"amt_msat_received,omitempty"`
-	//* The total amount of fees for sent messages in the discussion (in millisatoshi).
-	AmtMsatFees uint64 `protobuf:"varint,3,opt,name=amt_msat_fees,json=amtMsatFees,proto3" json:"amt_msat_fees,omitempty"`
-	//* The total amount of sent messages in the discussion.
-	MessagesSent uint64 `protobuf:"varint,4,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
-	//* The total amount of received messages in the discussion.
-	MessagesReceived uint64 `protobuf:"varint,5,opt,name=messages_received,json=messagesReceived,proto3" json:"messages_received,omitempty"`
-}
-
-func (x *GetDiscussionStatisticsResponse) Reset() {
-	*x = GetDiscussionStatisticsResponse{}
+	//* The id of the channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The number of HTLCs that arrived on the channel.
+	ForwardsIn uint64 `protobuf:"varint,2,opt,name=forwards_in,json=forwardsIn,proto3" json:"forwards_in,omitempty"`
+	//* The number of HTLCs forwarded on the channel.
+	ForwardsOut uint64 `protobuf:"varint,3,opt,name=forwards_out,json=forwardsOut,proto3" json:"forwards_out,omitempty"`
+	//* The total amount that arrived on the channel (in millisatoshi).
+	AmtInMsat uint64 `protobuf:"varint,4,opt,name=amt_in_msat,json=amtInMsat,proto3" json:"amt_in_msat,omitempty"`
+	//* The total amount forwarded on the channel (in millisatoshi).
+	AmtOutMsat uint64 `protobuf:"varint,5,opt,name=amt_out_msat,json=amtOutMsat,proto3" json:"amt_out_msat,omitempty"`
+	//* The total fee earned by forwarding on the channel (in millisatoshi).
+	FeeMsat uint64 `protobuf:"varint,6,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+}
+
+func (x *ChannelForwardingStats) Reset() {
+	*x = ChannelForwardingStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[43]
+		mi := &file_rpc_services_rpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionStatisticsResponse) String() string {
+func (x *ChannelForwardingStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionStatisticsResponse) ProtoMessage() {}
+func (*ChannelForwardingStats) ProtoMessage() {}
 
-func (x *GetDiscussionStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[43]
+func (x *ChannelForwardingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2899,72 +3415,82 @@ func (x *GetDiscussionStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetDiscussionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ChannelForwardingStats.ProtoReflect.Descriptor instead.
+func (*ChannelForwardingStats) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetDiscussionStatisticsResponse) GetAmtMsatSent() uint64 {
+func (x *ChannelForwardingStats) GetChanId() uint64 {
 	if x != nil {
-		return x.AmtMsatSent
+		return x.ChanId
 	}
 	return 0
 }
 
-func (x *GetDiscussionStatisticsResponse) GetAmtMsatReceived() uint64 {
+func (x *ChannelForwardingStats) GetForwardsIn() uint64 {
 	if x != nil {
-		return x.AmtMsatReceived
+		return x.ForwardsIn
 	}
 	return 0
 }
 
-func (x *GetDiscussionStatisticsResponse) GetAmtMsatFees() uint64 {
+func (x *ChannelForwardingStats) GetForwardsOut() uint64 {
 	if x != nil {
-		return x.AmtMsatFees
+		return x.ForwardsOut
 	}
 	return 0
 }
 
-func (x *GetDiscussionStatisticsResponse) GetMessagesSent() uint64 {
+func (x *ChannelForwardingStats) GetAmtInMsat() uint64 {
 	if x != nil {
-		return x.MessagesSent
+		return x.AmtInMsat
 	}
 	return 0
 }
 
-func (x *GetDiscussionStatisticsResponse) GetMessagesReceived() uint64 {
+func (x *ChannelForwardingStats) GetAmtOutMsat() uint64 {
 	if x != nil {
-		return x.MessagesReceived
+		return x.AmtOutMsat
 	}
 	return 0
 }
 
-//* Corresponds to a request to add a discussion to database.
-type AddDiscussionRequest struct {
+func (x *ChannelForwardingStats) GetFeeMsat() uint64 {
+	if x != nil {
+		return x.FeeMsat
+	}
+	return 0
+}
+
+//* A ForwardingHistoryResponse is received in response to a ForwardingHistory call.
+type ForwardingHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+	//* The forwarding events.
+	Events []*ForwardingEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	//* The per-channel aggregates over the returned events.
+	Channels []*ChannelForwardingStats `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
 }
 
-func (x *AddDiscussionRequest) Reset() {
-	*x = AddDiscussionRequest{}
+func (x *ForwardingHistoryResponse) Reset() {
+	*x = ForwardingHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[44]
+		mi := &file_rpc_services_rpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddDiscussionRequest) String() string {
+func (x *ForwardingHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDiscussionRequest) ProtoMessage() {}
+func (*ForwardingHistoryResponse) ProtoMessage() {}
 
-func (x *AddDiscussionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[44]
+func (x *ForwardingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2975,44 +3501,52 @@ func (x *AddDiscussionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDiscussionRequest.ProtoReflect.Descriptor instead.
-func (*AddDiscussionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ForwardingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AddDiscussionRequest) GetDiscussion() *DiscussionInfo {
+func (x *ForwardingHistoryResponse) GetEvents() []*ForwardingEvent {
 	if x != nil {
-		return x.Discussion
+		return x.Events
 	}
 	return nil
 }
 
-//* An AddDiscussionResponse is received in response to an AddDiscussion rpc call.
-type AddDiscussionResponse struct {
+func (x *ForwardingHistoryResponse) GetChannels() []*ChannelForwardingStats {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+//* Corresponds to a request to generate a new address.
+type NewAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+	//* The type of the address to generate.
+	Type AddressType `protobuf:"varint,1,opt,name=type,proto3,enum=services.AddressType" json:"type,omitempty"`
 }
 
-func (x *AddDiscussionResponse) Reset() {
-	*x = AddDiscussionResponse{}
+func (x *NewAddressRequest) Reset() {
+	*x = NewAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[45]
+		mi := &file_rpc_services_rpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddDiscussionResponse) String() string {
+func (x *NewAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDiscussionResponse) ProtoMessage() {}
+func (*NewAddressRequest) ProtoMessage() {}
 
-func (x *AddDiscussionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[45]
+func (x *NewAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3023,47 +3557,45 @@ func (x *AddDiscussionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDiscussionResponse.ProtoReflect.Descriptor instead.
-func (*AddDiscussionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use NewAddressRequest.ProtoReflect.Descriptor instead.
+func (*NewAddressRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *AddDiscussionResponse) GetDiscussion() *DiscussionInfo {
+func (x *NewAddressRequest) GetType() AddressType {
 	if x != nil {
-		return x.Discussion
+		return x.Type
 	}
-	return nil
+	return AddressType_WITNESS_PUBKEY_HASH
 }
 
-//* Represents a request to update the last read discussion message.
-type UpdateDiscussionLastReadRequest struct {
+//* A NewAddressResponse is received in response to a NewAddress call.
+type NewAddressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The discussion id.
-	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
-	//* The message id to mark as the last read.
-	LastReadMsgId uint64 `protobuf:"varint,2,opt,name=last_read_msg_id,json=lastReadMsgId,proto3" json:"last_read_msg_id,omitempty"`
+	//* The generated address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *UpdateDiscussionLastReadRequest) Reset() {
-	*x = UpdateDiscussionLastReadRequest{}
+func (x *NewAddressResponse) Reset() {
+	*x = NewAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[46]
+		mi := &file_rpc_services_rpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDiscussionLastReadRequest) String() string {
+func (x *NewAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDiscussionLastReadRequest) ProtoMessage() {}
+func (*NewAddressResponse) ProtoMessage() {}
 
-func (x *UpdateDiscussionLastReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[46]
+func (x *NewAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3074,51 +3606,55 @@ func (x *UpdateDiscussionLastReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDiscussionLastReadRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDiscussionLastReadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *UpdateDiscussionLastReadRequest) GetDiscussionId() uint64 {
-	if x != nil {
-		return x.DiscussionId
-	}
-	return 0
+// Deprecated: Use NewAddressResponse.ProtoReflect.Descriptor instead.
+func (*NewAddressResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateDiscussionLastReadRequest) GetLastReadMsgId() uint64 {
+func (x *NewAddressResponse) GetAddress() string {
 	if x != nil {
-		return x.LastReadMsgId
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-//*
-//An UpdateDiscussionResponse is returned in reponse
-//to an UpdateDiscussionLastRead request.
-type UpdateDiscussionResponse struct {
+//* Corresponds to a request to send an on-chain payment.
+type SendCoinsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The address to send the payment to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//* The amount to send (in satoshi). Must be zero if send_all is set.
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	//* Whether to send the whole wallet balance.
+	SendAll bool `protobuf:"varint,3,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	//* The number of blocks the transaction should confirm by.
+	//
+	//Used for fee estimation.
+	TargetConfirmationBlock uint32 `protobuf:"varint,4,opt,name=target_confirmation_block,json=targetConfirmationBlock,proto3" json:"target_confirmation_block,omitempty"`
+	//* The fee rate (satoshis per virtual byte) the transaction should cost.
+	SatPerVbyte uint64 `protobuf:"varint,5,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
 }
 
-func (x *UpdateDiscussionResponse) Reset() {
-	*x = UpdateDiscussionResponse{}
+func (x *SendCoinsRequest) Reset() {
+	*x = SendCoinsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[47]
+		mi := &file_rpc_services_rpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDiscussionResponse) String() string {
+func (x *SendCoinsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDiscussionResponse) ProtoMessage() {}
+func (*SendCoinsRequest) ProtoMessage() {}
 
-func (x *UpdateDiscussionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[47]
+func (x *SendCoinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3129,38 +3665,73 @@ func (x *UpdateDiscussionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDiscussionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDiscussionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use SendCoinsRequest.ProtoReflect.Descriptor instead.
+func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{44}
 }
 
-//* Corresponds to a request to remove a discussion.
-type RemoveDiscussionRequest struct {
+func (x *SendCoinsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SendCoinsRequest) GetAmtSat() int64 {
+	if x != nil {
+		return x.AmtSat
+	}
+	return 0
+}
+
+func (x *SendCoinsRequest) GetSendAll() bool {
+	if x != nil {
+		return x.SendAll
+	}
+	return false
+}
+
+func (x *SendCoinsRequest) GetTargetConfirmationBlock() uint32 {
+	if x != nil {
+		return x.TargetConfirmationBlock
+	}
+	return 0
+}
+
+func (x *SendCoinsRequest) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+//* A SendCoinsResponse is received in response to a SendCoins call.
+type SendCoinsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The id of the discussion to remove.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* The ID of the published transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (x *RemoveDiscussionRequest) Reset() {
-	*x = RemoveDiscussionRequest{}
+func (x *SendCoinsResponse) Reset() {
+	*x = SendCoinsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[48]
+		mi := &file_rpc_services_rpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveDiscussionRequest) String() string {
+func (x *SendCoinsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscussionRequest) ProtoMessage() {}
+func (*SendCoinsResponse) ProtoMessage() {}
 
-func (x *RemoveDiscussionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[48]
+func (x *SendCoinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3171,42 +3742,51 @@ func (x *RemoveDiscussionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscussionRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDiscussionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use SendCoinsResponse.ProtoReflect.Descriptor instead.
+func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RemoveDiscussionRequest) GetId() uint64 {
+func (x *SendCoinsResponse) GetTxid() string {
 	if x != nil {
-		return x.Id
+		return x.Txid
 	}
-	return 0
+	return ""
 }
 
-//* A RemoveDiscussionResponse is received in response to a RemoveDiscussion rpc call.
-type RemoveDiscussionResponse struct {
+//* Corresponds to a request to list the unspent outputs of the wallet.
+type ListUnspentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The minimum number of confirmations of the listed outputs.
+	//
+	//Unconfirmed outputs are included if zero.
+	MinConfs int32 `protobuf:"varint,1,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	//* The maximum number of confirmations of the listed outputs.
+	//
+	//No maximum is applied if zero.
+	MaxConfs int32 `protobuf:"varint,2,opt,name=max_confs,json=maxConfs,proto3" json:"max_confs,omitempty"`
 }
 
-func (x *RemoveDiscussionResponse) Reset() {
-	*x = RemoveDiscussionResponse{}
+func (x *ListUnspentRequest) Reset() {
+	*x = ListUnspentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[49]
+		mi := &file_rpc_services_rpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveDiscussionResponse) String() string {
+func (x *ListUnspentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscussionResponse) ProtoMessage() {}
+func (*ListUnspentRequest) ProtoMessage() {}
 
-func (x *RemoveDiscussionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[49]
+func (x *ListUnspentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3217,46 +3797,60 @@ func (x *RemoveDiscussionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscussionResponse.ProtoReflect.Descriptor instead.
-func (*RemoveDiscussionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ListUnspentRequest.ProtoReflect.Descriptor instead.
+func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{46}
 }
 
-//* Represents a request to send a message.
-type SendRequest struct {
+func (x *ListUnspentRequest) GetMinConfs() int32 {
+	if x != nil {
+		return x.MinConfs
+	}
+	return 0
+}
+
+func (x *ListUnspentRequest) GetMaxConfs() int32 {
+	if x != nil {
+		return x.MaxConfs
+	}
+	return 0
+}
+
+//* A message representing an unspent output of the on-chain wallet.
+type Utxo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Destination:
-	//	*SendRequest_DiscussionId
-	//	*SendRequest_PayReq
-	Destination isSendRequest_Destination `protobuf_oneof:"destination"`
-	//* The intended amount to be used for payment to each recipient.
-	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The message payload.
-	Payload string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
-	//* The message options for the current message (overriding any discussion options).
-	Options *MessageOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
-}
-
-func (x *SendRequest) Reset() {
-	*x = SendRequest{}
+	//* The address the output pays to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//* The amount of the output (in satoshi).
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	//* The transaction ID of the output.
+	Txid string `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	//* The index of the output in its transaction.
+	OutputIndex uint32 `protobuf:"varint,4,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	//* The number of confirmations of the output.
+	Confirmations int64 `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (x *Utxo) Reset() {
+	*x = Utxo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[50]
+		mi := &file_rpc_services_rpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendRequest) String() string {
+func (x *Utxo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendRequest) ProtoMessage() {}
+func (*Utxo) ProtoMessage() {}
 
-func (x *SendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[50]
+func (x *Utxo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3267,101 +3861,73 @@ func (x *SendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendRequest.ProtoReflect.Descriptor instead.
-func (*SendRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{50}
-}
-
-func (m *SendRequest) GetDestination() isSendRequest_Destination {
-	if m != nil {
-		return m.Destination
-	}
-	return nil
-}
-
-func (x *SendRequest) GetDiscussionId() uint64 {
-	if x, ok := x.GetDestination().(*SendRequest_DiscussionId); ok {
-		return x.DiscussionId
-	}
-	return 0
+// Deprecated: Use Utxo.ProtoReflect.Descriptor instead.
+func (*Utxo) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SendRequest) GetPayReq() string {
-	if x, ok := x.GetDestination().(*SendRequest_PayReq); ok {
-		return x.PayReq
+func (x *Utxo) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SendRequest) GetAmtMsat() int64 {
+func (x *Utxo) GetAmtSat() int64 {
 	if x != nil {
-		return x.AmtMsat
+		return x.AmtSat
 	}
 	return 0
 }
 
-func (x *SendRequest) GetPayload() string {
+func (x *Utxo) GetTxid() string {
 	if x != nil {
-		return x.Payload
+		return x.Txid
 	}
 	return ""
 }
 
-func (x *SendRequest) GetOptions() *MessageOptions {
+func (x *Utxo) GetOutputIndex() uint32 {
 	if x != nil {
-		return x.Options
+		return x.OutputIndex
 	}
-	return nil
-}
-
-type isSendRequest_Destination interface {
-	isSendRequest_Destination()
-}
-
-type SendRequest_DiscussionId struct {
-	//* The discussion where the message is to be sent.
-	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3,oneof"`
+	return 0
 }
 
-type SendRequest_PayReq struct {
-	//* The payment request to fulfil.
-	//
-	//If empty, a spontaneous message is sent.
-	//A discussion with the recipient node will be created if it does not exist.
-	PayReq string `protobuf:"bytes,2,opt,name=pay_req,json=payReq,proto3,oneof"`
+func (x *Utxo) GetConfirmations() int64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
 }
 
-func (*SendRequest_DiscussionId) isSendRequest_Destination() {}
-
-func (*SendRequest_PayReq) isSendRequest_Destination() {}
-
-//* A SendResponse is received in response to a Send rpc call.
-type SendResponse struct {
+//* A ListUnspentResponse is received in response to a ListUnspent call.
+type ListUnspentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The sent message.
-	SentMessage *Message `protobuf:"bytes,1,opt,name=sent_message,json=sentMessage,proto3" json:"sent_message,omitempty"`
+	//* The unspent outputs of the wallet.
+	Utxos []*Utxo `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
 }
 
-func (x *SendResponse) Reset() {
-	*x = SendResponse{}
+func (x *ListUnspentResponse) Reset() {
+	*x = ListUnspentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[51]
+		mi := &file_rpc_services_rpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendResponse) String() string {
+func (x *ListUnspentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendResponse) ProtoMessage() {}
+func (*ListUnspentResponse) ProtoMessage() {}
 
-func (x *SendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[51]
+func (x *ListUnspentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3372,51 +3938,50 @@ func (x *SendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendResponse.ProtoReflect.Descriptor instead.
-func (*SendResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ListUnspentResponse.ProtoReflect.Descriptor instead.
+func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SendResponse) GetSentMessage() *Message {
+func (x *ListUnspentResponse) GetUtxos() []*Utxo {
 	if x != nil {
-		return x.SentMessage
+		return x.Utxos
 	}
 	return nil
 }
 
-//* Corresponds to an invoice creation request.
-type CreateInvoiceRequest struct {
+//* Corresponds to a request to list the transactions of the wallet.
+type ListTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* Memo of the invoice.
-	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	//* The invoice amount (in millisatoshi).
-	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* Invoice expiry time (in seconds since creation).
-	Expiry int64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	//* Whether to include hints for private channels.
-	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	//* The height of the first block whose transactions are listed.
+	StartHeight int32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	//* The height of the last block whose transactions are listed.
+	//
+	//All transactions after start_height, including unconfirmed ones,
+	//are listed if zero or -1.
+	EndHeight int32 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func (x *CreateInvoiceRequest) Reset() {
-	*x = CreateInvoiceRequest{}
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[52]
+		mi := &file_rpc_services_rpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateInvoiceRequest) String() string {
+func (x *ListTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvoiceRequest) ProtoMessage() {}
+func (*ListTransactionsRequest) ProtoMessage() {}
 
-func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[52]
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3427,66 +3992,66 @@ func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
-func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *CreateInvoiceRequest) GetMemo() string {
-	if x != nil {
-		return x.Memo
-	}
-	return ""
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateInvoiceRequest) GetAmtMsat() uint64 {
+func (x *ListTransactionsRequest) GetStartHeight() int32 {
 	if x != nil {
-		return x.AmtMsat
+		return x.StartHeight
 	}
 	return 0
 }
 
-func (x *CreateInvoiceRequest) GetExpiry() int64 {
+func (x *ListTransactionsRequest) GetEndHeight() int32 {
 	if x != nil {
-		return x.Expiry
+		return x.EndHeight
 	}
 	return 0
 }
 
-func (x *CreateInvoiceRequest) GetPrivate() bool {
-	if x != nil {
-		return x.Private
-	}
-	return false
-}
-
-//* A CreateInvoiceResponse is received in response to an invoice creation request.
-type CreateInvoiceResponse struct {
+//* A message representing an on-chain transaction of the wallet.
+type Transaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The created invoice.
-	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
-}
-
-func (x *CreateInvoiceResponse) Reset() {
-	*x = CreateInvoiceResponse{}
+	//* The transaction ID.
+	Txid string `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	//* The net amount of the transaction for the wallet (in satoshi).
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	//* The fees paid by the wallet for the transaction (in satoshi).
+	FeeSat int64 `protobuf:"varint,3,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	//* The number of confirmations of the transaction.
+	Confirmations int32 `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	//* The height of the block including the transaction (zero if unconfirmed).
+	BlockHeight int32 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	//* The time the transaction was seen or confirmed.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	//* The addresses the transaction pays to.
+	DestAddresses []string `protobuf:"bytes,7,rep,name=dest_addresses,json=destAddresses,proto3" json:"dest_addresses,omitempty"`
+	//* The label of the transaction.
+	Label string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[53]
+		mi := &file_rpc_services_rpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateInvoiceResponse) String() string {
+func (x *Transaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvoiceResponse) ProtoMessage() {}
+func (*Transaction) ProtoMessage() {}
 
-func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[53]
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3497,45 +4062,94 @@ func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvoiceResponse.ProtoReflect.Descriptor instead.
-func (*CreateInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CreateInvoiceResponse) GetInvoice() *Invoice {
+func (x *Transaction) GetTxid() string {
 	if x != nil {
-		return x.Invoice
+		return x.Txid
 	}
-	return nil
+	return ""
 }
 
-//* Corresponds to an invoice lookup request.
-type LookupInvoiceRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//* Payment Request
-	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+func (x *Transaction) GetAmtSat() int64 {
+	if x != nil {
+		return x.AmtSat
+	}
+	return 0
 }
 
-func (x *LookupInvoiceRequest) Reset() {
-	*x = LookupInvoiceRequest{}
+func (x *Transaction) GetFeeSat() int64 {
+	if x != nil {
+		return x.FeeSat
+	}
+	return 0
+}
+
+func (x *Transaction) GetConfirmations() int32 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *Transaction) GetBlockHeight() int32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *Transaction) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Transaction) GetDestAddresses() []string {
+	if x != nil {
+		return x.DestAddresses
+	}
+	return nil
+}
+
+func (x *Transaction) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+//* A ListTransactionsResponse is received in response to a ListTransactions call.
+type ListTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The transactions of the wallet.
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *ListTransactionsResponse) Reset() {
+	*x = ListTransactionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[54]
+		mi := &file_rpc_services_rpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupInvoiceRequest) String() string {
+func (x *ListTransactionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupInvoiceRequest) ProtoMessage() {}
+func (*ListTransactionsResponse) ProtoMessage() {}
 
-func (x *LookupInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[54]
+func (x *ListTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3546,45 +4160,49 @@ func (x *LookupInvoiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupInvoiceRequest.ProtoReflect.Descriptor instead.
-func (*LookupInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ListTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *LookupInvoiceRequest) GetPayReq() string {
+func (x *ListTransactionsResponse) GetTransactions() []*Transaction {
 	if x != nil {
-		return x.PayReq
+		return x.Transactions
 	}
-	return ""
+	return nil
 }
 
-//* A LookupResponse is received in response to an invoice lookup request.
-type LookupInvoiceResponse struct {
+//* Corresponds to a request to estimate the fee of an on-chain payment.
+type EstimateFeeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The returned invoice.
-	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	//* The address of the payment.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//* The amount of the payment (in satoshi).
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	//* The number of blocks the transaction should confirm by.
+	TargetConfirmationBlock uint32 `protobuf:"varint,3,opt,name=target_confirmation_block,json=targetConfirmationBlock,proto3" json:"target_confirmation_block,omitempty"`
 }
 
-func (x *LookupInvoiceResponse) Reset() {
-	*x = LookupInvoiceResponse{}
+func (x *EstimateFeeRequest) Reset() {
+	*x = EstimateFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[55]
+		mi := &file_rpc_services_rpc_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupInvoiceResponse) String() string {
+func (x *EstimateFeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupInvoiceResponse) ProtoMessage() {}
+func (*EstimateFeeRequest) ProtoMessage() {}
 
-func (x *LookupInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[55]
+func (x *EstimateFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3595,51 +4213,61 @@ func (x *LookupInvoiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupInvoiceResponse.ProtoReflect.Descriptor instead.
-func (*LookupInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use EstimateFeeRequest.ProtoReflect.Descriptor instead.
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *LookupInvoiceResponse) GetInvoice() *Invoice {
+func (x *EstimateFeeRequest) GetAddress() string {
 	if x != nil {
-		return x.Invoice
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-//* Corresponds to a pay request.
-type PayRequest struct {
+func (x *EstimateFeeRequest) GetAmtSat() int64 {
+	if x != nil {
+		return x.AmtSat
+	}
+	return 0
+}
+
+func (x *EstimateFeeRequest) GetTargetConfirmationBlock() uint32 {
+	if x != nil {
+		return x.TargetConfirmationBlock
+	}
+	return 0
+}
+
+//* An EstimateFeeResponse is received in response to an EstimateFee call.
+type EstimateFeeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Destination:
-	//	*PayRequest_PayReq
-	//	*PayRequest_Address
-	Destination isPayRequest_Destination `protobuf_oneof:"destination"`
-	//* The payment amount (in millisatoshi).
-	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The payment options.
-	Options *PaymentOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	//* The total fee of the transaction (in satoshi).
+	FeeSat int64 `protobuf:"varint,1,opt,name=fee_sat,json=feeSat,proto3" json:"fee_sat,omitempty"`
+	//* The fee rate of the transaction (in satoshis per virtual byte).
+	SatPerVbyte uint64 `protobuf:"varint,2,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
 }
 
-func (x *PayRequest) Reset() {
-	*x = PayRequest{}
+func (x *EstimateFeeResponse) Reset() {
+	*x = EstimateFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[56]
+		mi := &file_rpc_services_rpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PayRequest) String() string {
+func (x *EstimateFeeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PayRequest) ProtoMessage() {}
+func (*EstimateFeeResponse) ProtoMessage() {}
 
-func (x *PayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[56]
+func (x *EstimateFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3650,91 +4278,145 @@ func (x *PayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PayRequest.ProtoReflect.Descriptor instead.
-func (*PayRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use EstimateFeeResponse.ProtoReflect.Descriptor instead.
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{53}
 }
 
-func (m *PayRequest) GetDestination() isPayRequest_Destination {
-	if m != nil {
-		return m.Destination
+func (x *EstimateFeeResponse) GetFeeSat() int64 {
+	if x != nil {
+		return x.FeeSat
 	}
-	return nil
+	return 0
 }
 
-func (x *PayRequest) GetPayReq() string {
-	if x, ok := x.GetDestination().(*PayRequest_PayReq); ok {
-		return x.PayReq
+func (x *EstimateFeeResponse) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
 	}
-	return ""
+	return 0
 }
 
-func (x *PayRequest) GetAddress() string {
-	if x, ok := x.GetDestination().(*PayRequest_Address); ok {
-		return x.Address
+//* A message representing past payment attempts between a pair of nodes.
+type PairHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The address of the node the pair starts from.
+	NodeFrom string `protobuf:"bytes,1,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	//* The address of the node the pair ends at.
+	NodeTo string `protobuf:"bytes,2,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+	//* The time of the last failure, if any.
+	FailTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=fail_time,json=failTime,proto3" json:"fail_time,omitempty"`
+	//* The smallest amount that failed to be forwarded
+	//at the time of the last failure (in millisatoshi).
+	FailAmtMsat int64 `protobuf:"varint,4,opt,name=fail_amt_msat,json=failAmtMsat,proto3" json:"fail_amt_msat,omitempty"`
+	//* The time of the last success, if any.
+	SuccessTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=success_time,json=successTime,proto3" json:"success_time,omitempty"`
+	//* The largest amount successfully forwarded
+	//at the time of the last success (in millisatoshi).
+	SuccessAmtMsat int64 `protobuf:"varint,6,opt,name=success_amt_msat,json=successAmtMsat,proto3" json:"success_amt_msat,omitempty"`
+}
+
+func (x *PairHistory) Reset() {
+	*x = PairHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PairHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PairHistory) ProtoMessage() {}
+
+func (x *PairHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PairHistory.ProtoReflect.Descriptor instead.
+func (*PairHistory) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *PairHistory) GetNodeFrom() string {
+	if x != nil {
+		return x.NodeFrom
 	}
 	return ""
 }
 
-func (x *PayRequest) GetAmtMsat() uint64 {
+func (x *PairHistory) GetNodeTo() string {
 	if x != nil {
-		return x.AmtMsat
+		return x.NodeTo
 	}
-	return 0
+	return ""
 }
 
-func (x *PayRequest) GetOptions() *PaymentOptions {
+func (x *PairHistory) GetFailTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Options
+		return x.FailTime
 	}
 	return nil
 }
 
-type isPayRequest_Destination interface {
-	isPayRequest_Destination()
+func (x *PairHistory) GetFailAmtMsat() int64 {
+	if x != nil {
+		return x.FailAmtMsat
+	}
+	return 0
 }
 
-type PayRequest_PayReq struct {
-	//* The payment request to pay to.
-	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3,oneof"`
+func (x *PairHistory) GetSuccessTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuccessTime
+	}
+	return nil
 }
 
-type PayRequest_Address struct {
-	//* The address to pay to.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
+func (x *PairHistory) GetSuccessAmtMsat() int64 {
+	if x != nil {
+		return x.SuccessAmtMsat
+	}
+	return 0
 }
 
-func (*PayRequest_PayReq) isPayRequest_Destination() {}
-
-func (*PayRequest_Address) isPayRequest_Destination() {}
-
-//* PaymentOptions represents the payment's options.
-type PaymentOptions struct {
+//* Corresponds to a request to list the payment attempt history.
+type QueryMissionControlRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The maximum fee allowed for sending a payment.
-	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
 }
 
-func (x *PaymentOptions) Reset() {
-	*x = PaymentOptions{}
+func (x *QueryMissionControlRequest) Reset() {
+	*x = QueryMissionControlRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[57]
+		mi := &file_rpc_services_rpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaymentOptions) String() string {
+func (x *QueryMissionControlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentOptions) ProtoMessage() {}
+func (*QueryMissionControlRequest) ProtoMessage() {}
 
-func (x *PaymentOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[57]
+func (x *QueryMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3745,45 +4427,38 @@ func (x *PaymentOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentOptions.ProtoReflect.Descriptor instead.
-func (*PaymentOptions) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *PaymentOptions) GetFeeLimitMsat() int64 {
-	if x != nil {
-		return x.FeeLimitMsat
-	}
-	return 0
+// Deprecated: Use QueryMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*QueryMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{55}
 }
 
-//* A PayResponse is received in response to a pay request.
-type PayResponse struct {
+//* A QueryMissionControlResponse is received in response to a QueryMissionControl call.
+type QueryMissionControlResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The returned payment.
-	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	//* The payment attempt history of each node pair.
+	Pairs []*PairHistory `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
 }
 
-func (x *PayResponse) Reset() {
-	*x = PayResponse{}
+func (x *QueryMissionControlResponse) Reset() {
+	*x = QueryMissionControlResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[58]
+		mi := &file_rpc_services_rpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PayResponse) String() string {
+func (x *QueryMissionControlResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PayResponse) ProtoMessage() {}
+func (*QueryMissionControlResponse) ProtoMessage() {}
 
-func (x *PayResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[58]
+func (x *QueryMissionControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3794,61 +4469,49 @@ func (x *PayResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PayResponse.ProtoReflect.Descriptor instead.
-func (*PayResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use QueryMissionControlResponse.ProtoReflect.Descriptor instead.
+func (*QueryMissionControlResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *PayResponse) GetPayment() *Payment {
+func (x *QueryMissionControlResponse) GetPairs() []*PairHistory {
 	if x != nil {
-		return x.Payment
+		return x.Pairs
 	}
 	return nil
 }
 
-//* Represents a Lightning network payment.
-type Payment struct {
+//* Corresponds to a request to estimate the probability of forwarding a payment.
+type QueryProbabilityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The payment hash of the payment.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	//* The preimage of the payment hash.
-	Preimage string `protobuf:"bytes,2,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	//* The payment amount.
-	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The time the payment was created.
-	CreatedTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
-	//* The time the payment was finalized.
-	ResolvedTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolved_timestamp,json=resolvedTimestamp,proto3" json:"resolved_timestamp,omitempty"`
-	//* The fulfilled payment request (if any).
-	PayReq string `protobuf:"bytes,6,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
-	//* The payment state.
-	State PaymentState `protobuf:"varint,7,opt,name=state,proto3,enum=services.PaymentState" json:"state,omitempty"`
-	//* The payment index.
-	PaymentIndex uint64 `protobuf:"varint,8,opt,name=payment_index,json=paymentIndex,proto3" json:"payment_index,omitempty"`
-	//* The payment HTLCs.
-	HTLCs []*PaymentHTLC `protobuf:"bytes,9,rep,name=HTLCs,proto3" json:"HTLCs,omitempty"`
+	//* The address of the node the payment is forwarded from.
+	FromNode string `protobuf:"bytes,1,opt,name=from_node,json=fromNode,proto3" json:"from_node,omitempty"`
+	//* The address of the node the payment is forwarded to.
+	ToNode string `protobuf:"bytes,2,opt,name=to_node,json=toNode,proto3" json:"to_node,omitempty"`
+	//* The forwarded amount (in millisatoshi).
+	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
 }
 
-func (x *Payment) Reset() {
-	*x = Payment{}
+func (x *QueryProbabilityRequest) Reset() {
+	*x = QueryProbabilityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[59]
+		mi := &file_rpc_services_rpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Payment) String() string {
+func (x *QueryProbabilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Payment) ProtoMessage() {}
+func (*QueryProbabilityRequest) ProtoMessage() {}
 
-func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[59]
+func (x *QueryProbabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3859,109 +4522,114 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
-func (*Payment) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use QueryProbabilityRequest.ProtoReflect.Descriptor instead.
+func (*QueryProbabilityRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Payment) GetHash() string {
+func (x *QueryProbabilityRequest) GetFromNode() string {
 	if x != nil {
-		return x.Hash
+		return x.FromNode
 	}
 	return ""
 }
 
-func (x *Payment) GetPreimage() string {
+func (x *QueryProbabilityRequest) GetToNode() string {
 	if x != nil {
-		return x.Preimage
+		return x.ToNode
 	}
 	return ""
 }
 
-func (x *Payment) GetAmtMsat() uint64 {
+func (x *QueryProbabilityRequest) GetAmtMsat() int64 {
 	if x != nil {
 		return x.AmtMsat
 	}
 	return 0
 }
 
-func (x *Payment) GetCreatedTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedTimestamp
-	}
-	return nil
+//* A QueryProbabilityResponse is received in response to a QueryProbability call.
+type QueryProbabilityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The estimated success probability.
+	Probability float64 `protobuf:"fixed64,1,opt,name=probability,proto3" json:"probability,omitempty"`
+	//* The payment attempt history of the node pair.
+	History *PairHistory `protobuf:"bytes,2,opt,name=history,proto3" json:"history,omitempty"`
 }
 
-func (x *Payment) GetResolvedTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ResolvedTimestamp
+func (x *QueryProbabilityResponse) Reset() {
+	*x = QueryProbabilityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Payment) GetPayReq() string {
-	if x != nil {
-		return x.PayReq
-	}
-	return ""
+func (x *QueryProbabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Payment) GetState() PaymentState {
-	if x != nil {
-		return x.State
+func (*QueryProbabilityResponse) ProtoMessage() {}
+
+func (x *QueryProbabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return PaymentState_PAYMENT_UNKNOWN
+	return mi.MessageOf(x)
 }
 
-func (x *Payment) GetPaymentIndex() uint64 {
+// Deprecated: Use QueryProbabilityResponse.ProtoReflect.Descriptor instead.
+func (*QueryProbabilityResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *QueryProbabilityResponse) GetProbability() float64 {
 	if x != nil {
-		return x.PaymentIndex
+		return x.Probability
 	}
 	return 0
 }
 
-func (x *Payment) GetHTLCs() []*PaymentHTLC {
+func (x *QueryProbabilityResponse) GetHistory() *PairHistory {
 	if x != nil {
-		return x.HTLCs
+		return x.History
 	}
 	return nil
 }
 
-//* Represents an HTLC attempt of a payment.
-type PaymentHTLC struct {
+//* Corresponds to a request to clear the payment attempt history.
+type ResetMissionControlRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The route of the HTLC.
-	Route *PaymentRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
-	//* The time the HTLC was sent.
-	AttemptTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=attempt_timestamp,json=attemptTimestamp,proto3" json:"attempt_timestamp,omitempty"`
-	//* The time the HTLC was resolved.
-	ResolveTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=resolve_timestamp,json=resolveTimestamp,proto3" json:"resolve_timestamp,omitempty"`
-	//* The HTLC state.
-	State HTLCState `protobuf:"varint,4,opt,name=state,proto3,enum=services.HTLCState" json:"state,omitempty"`
-	//* The preimage used to settle the HTLC.
-	Preimage string `protobuf:"bytes,5,opt,name=preimage,proto3" json:"preimage,omitempty"`
 }
 
-func (x *PaymentHTLC) Reset() {
-	*x = PaymentHTLC{}
+func (x *ResetMissionControlRequest) Reset() {
+	*x = ResetMissionControlRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[60]
+		mi := &file_rpc_services_rpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PaymentHTLC) String() string {
+func (x *ResetMissionControlRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentHTLC) ProtoMessage() {}
+func (*ResetMissionControlRequest) ProtoMessage() {}
 
-func (x *PaymentHTLC) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[60]
+func (x *ResetMissionControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3972,86 +4640,67 @@ func (x *PaymentHTLC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentHTLC.ProtoReflect.Descriptor instead.
-func (*PaymentHTLC) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use ResetMissionControlRequest.ProtoReflect.Descriptor instead.
+func (*ResetMissionControlRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *PaymentHTLC) GetRoute() *PaymentRoute {
-	if x != nil {
-		return x.Route
-	}
-	return nil
+//* A ResetMissionControlResponse is received in response to a ResetMissionControl call.
+type ResetMissionControlResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *PaymentHTLC) GetAttemptTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AttemptTimestamp
+func (x *ResetMissionControlResponse) Reset() {
+	*x = ResetMissionControlResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *PaymentHTLC) GetResolveTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ResolveTimestamp
-	}
-	return nil
+func (x *ResetMissionControlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PaymentHTLC) GetState() HTLCState {
-	if x != nil {
-		return x.State
+func (*ResetMissionControlResponse) ProtoMessage() {}
+
+func (x *ResetMissionControlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return HTLCState_HTLC_IN_FLIGHT
+	return mi.MessageOf(x)
 }
 
-func (x *PaymentHTLC) GetPreimage() string {
-	if x != nil {
-		return x.Preimage
-	}
-	return ""
+// Deprecated: Use ResetMissionControlResponse.ProtoReflect.Descriptor instead.
+func (*ResetMissionControlResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{60}
 }
 
-//* Represents an Lightning network invoice.
-type Invoice struct {
+//*
+//RouteDiagnostics is attached to the status details of errors
+//caused by failing to find a route to a destination.
+type RouteDiagnostics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The invoice memo.
-	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	//* The preimage hash.
-	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	//* The invoice preimage.
-	Preimage string `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	//* The payment request of the invoice.
-	PaymentRequest string `protobuf:"bytes,4,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
-	//* The value (amount requested) of the invoice (in millisatoshi).
-	ValueMsat uint64 `protobuf:"varint,5,opt,name=value_msat,json=valueMsat,proto3" json:"value_msat,omitempty"`
-	//* The amount paid to the invoice (in millisatoshi).
-	AmtPaidMsat uint64 `protobuf:"varint,6,opt,name=amt_paid_msat,json=amtPaidMsat,proto3" json:"amt_paid_msat,omitempty"`
-	//* The time the invoice was created.
-	CreatedTimestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
-	//* The time the invoice was settled.
-	SettledTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=settled_timestamp,json=settledTimestamp,proto3" json:"settled_timestamp,omitempty"`
-	//* The invoice expiry (in seconds since creation time).
-	Expiry int64 `protobuf:"varint,9,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	//* Whether the invoice contains hints for private channels.
-	Private bool `protobuf:"varint,10,opt,name=private,proto3" json:"private,omitempty"`
-	//* Invoice route hints.
-	RouteHints []*RouteHint `protobuf:"bytes,11,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
-	//* The invoice state.
-	State InvoiceState `protobuf:"varint,12,opt,name=state,proto3,enum=services.InvoiceState" json:"state,omitempty"`
-	//* The add index of the invoice.
-	AddIndex uint64 `protobuf:"varint,13,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
-	//* The settle index of the invoice.
-	SettleIndex uint64 `protobuf:"varint,14,opt,name=settle_index,json=settleIndex,proto3" json:"settle_index,omitempty"`
-	//* The set of HTLCs paying to the invoice.
-	InvoiceHtlcs []*InvoiceHTLC `protobuf:"bytes,15,rep,name=invoice_htlcs,json=invoiceHtlcs,proto3" json:"invoice_htlcs,omitempty"`
+	//* The destination no route was found to.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	//* The node pairs that recently failed to forward payments,
+	//most recent first.
+	RecentFailures []*PairHistory `protobuf:"bytes,2,rep,name=recent_failures,json=recentFailures,proto3" json:"recent_failures,omitempty"`
 }
 
-func (x *Invoice) Reset() {
-	*x = Invoice{}
+func (x *RouteDiagnostics) Reset() {
+	*x = RouteDiagnostics{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4059,13 +4708,13 @@ func (x *Invoice) Reset() {
 	}
 }
 
-func (x *Invoice) String() string {
+func (x *RouteDiagnostics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Invoice) ProtoMessage() {}
+func (*RouteDiagnostics) ProtoMessage() {}
 
-func (x *Invoice) ProtoReflect() protoreflect.Message {
+func (x *RouteDiagnostics) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4077,143 +4726,270 @@ func (x *Invoice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
-func (*Invoice) Descriptor() ([]byte, []int) {
+// Deprecated: Use RouteDiagnostics.ProtoReflect.Descriptor instead.
+func (*RouteDiagnostics) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *Invoice) GetMemo() string {
+func (x *RouteDiagnostics) GetDestination() string {
 	if x != nil {
-		return x.Memo
+		return x.Destination
 	}
 	return ""
 }
 
-func (x *Invoice) GetHash() string {
+func (x *RouteDiagnostics) GetRecentFailures() []*PairHistory {
 	if x != nil {
-		return x.Hash
+		return x.RecentFailures
 	}
-	return ""
+	return nil
 }
 
-func (x *Invoice) GetPreimage() string {
-	if x != nil {
-		return x.Preimage
+//* A message representing a feature advertised by a node.
+type Feature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The feature bit.
+	Bit uint32 `protobuf:"varint,1,opt,name=bit,proto3" json:"bit,omitempty"`
+	//* The feature name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//* Whether the feature is required.
+	IsRequired bool `protobuf:"varint,3,opt,name=is_required,json=isRequired,proto3" json:"is_required,omitempty"`
+	//* Whether the feature is known by the underlying node.
+	IsKnown bool `protobuf:"varint,4,opt,name=is_known,json=isKnown,proto3" json:"is_known,omitempty"`
+}
+
+func (x *Feature) Reset() {
+	*x = Feature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Invoice) GetPaymentRequest() string {
-	if x != nil {
-		return x.PaymentRequest
+func (x *Feature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feature) ProtoMessage() {}
+
+func (x *Feature) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Invoice) GetValueMsat() uint64 {
+// Deprecated: Use Feature.ProtoReflect.Descriptor instead.
+func (*Feature) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Feature) GetBit() uint32 {
 	if x != nil {
-		return x.ValueMsat
+		return x.Bit
 	}
 	return 0
 }
 
-func (x *Invoice) GetAmtPaidMsat() uint64 {
+func (x *Feature) GetName() string {
 	if x != nil {
-		return x.AmtPaidMsat
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Invoice) GetCreatedTimestamp() *timestamppb.Timestamp {
+func (x *Feature) GetIsRequired() bool {
 	if x != nil {
-		return x.CreatedTimestamp
+		return x.IsRequired
 	}
-	return nil
+	return false
 }
 
-func (x *Invoice) GetSettledTimestamp() *timestamppb.Timestamp {
+func (x *Feature) GetIsKnown() bool {
 	if x != nil {
-		return x.SettledTimestamp
+		return x.IsKnown
 	}
-	return nil
+	return false
 }
 
-func (x *Invoice) GetExpiry() int64 {
-	if x != nil {
-		return x.Expiry
+//* A message representing a node connected to the underlying node.
+type Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The address of the peer.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//* The network location of the peer connection.
+	Hostport string `protobuf:"bytes,2,opt,name=hostport,proto3" json:"hostport,omitempty"`
+	//* Whether the connection was initiated by the peer.
+	Inbound bool `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
+	//* The ping time to the peer (in microseconds).
+	PingTimeMicros int64 `protobuf:"varint,4,opt,name=ping_time_micros,json=pingTimeMicros,proto3" json:"ping_time_micros,omitempty"`
+	//* The number of bytes sent to the peer.
+	BytesSent uint64 `protobuf:"varint,5,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	//* The number of bytes received from the peer.
+	BytesReceived uint64 `protobuf:"varint,6,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	//* The features advertised by the peer.
+	Features []*Feature `protobuf:"bytes,7,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *Peer) Reset() {
+	*x = Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Invoice) GetPrivate() bool {
-	if x != nil {
-		return x.Private
+func (x *Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Peer) ProtoMessage() {}
+
+func (x *Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Invoice) GetRouteHints() []*RouteHint {
+// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
+func (*Peer) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Peer) GetAddress() string {
 	if x != nil {
-		return x.RouteHints
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *Invoice) GetState() InvoiceState {
+func (x *Peer) GetHostport() string {
 	if x != nil {
-		return x.State
+		return x.Hostport
 	}
-	return InvoiceState_INVOICE_OPEN
+	return ""
 }
 
-func (x *Invoice) GetAddIndex() uint64 {
+func (x *Peer) GetInbound() bool {
 	if x != nil {
-		return x.AddIndex
+		return x.Inbound
+	}
+	return false
+}
+
+func (x *Peer) GetPingTimeMicros() int64 {
+	if x != nil {
+		return x.PingTimeMicros
 	}
 	return 0
 }
 
-func (x *Invoice) GetSettleIndex() uint64 {
+func (x *Peer) GetBytesSent() uint64 {
 	if x != nil {
-		return x.SettleIndex
+		return x.BytesSent
 	}
 	return 0
 }
 
-func (x *Invoice) GetInvoiceHtlcs() []*InvoiceHTLC {
+func (x *Peer) GetBytesReceived() uint64 {
 	if x != nil {
-		return x.InvoiceHtlcs
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *Peer) GetFeatures() []*Feature {
+	if x != nil {
+		return x.Features
 	}
 	return nil
 }
 
-//* Represents a route hint for assistance in invoice payment.
-type RouteHint struct {
+//* Corresponds to a request to list the peers of the underlying node.
+type ListPeersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	//* A chain of hop hints that can reach the desetination.
-	HopHints []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,json=hopHints,proto3" json:"hop_hints,omitempty"`
+func (x *ListPeersRequest) Reset() {
+	*x = ListPeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *RouteHint) Reset() {
-	*x = RouteHint{}
+func (x *ListPeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPeersRequest) ProtoMessage() {}
+
+func (x *ListPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPeersRequest.ProtoReflect.Descriptor instead.
+func (*ListPeersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{64}
+}
+
+//* A ListPeersResponse is received in response to a ListPeers call.
+type ListPeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The list of peers.
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *ListPeersResponse) Reset() {
+	*x = ListPeersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[62]
+		mi := &file_rpc_services_rpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RouteHint) String() string {
+func (x *ListPeersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteHint) ProtoMessage() {}
+func (*ListPeersResponse) ProtoMessage() {}
 
-func (x *RouteHint) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[62]
+func (x *ListPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4224,53 +5000,45 @@ func (x *RouteHint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteHint.ProtoReflect.Descriptor instead.
-func (*RouteHint) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ListPeersResponse.ProtoReflect.Descriptor instead.
+func (*ListPeersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *RouteHint) GetHopHints() []*HopHint {
+func (x *ListPeersResponse) GetPeers() []*Peer {
 	if x != nil {
-		return x.HopHints
+		return x.Peers
 	}
 	return nil
 }
 
-//* Represents a hop hint.
-type HopHint struct {
+//* Corresponds to a request to terminate a peer connection.
+type DisconnectPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* Public key of hop ingress node.
-	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	//* The short channel id of the channel to be used for the hop.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	//* The base fee of the channel (in millisatoshi).
-	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
-	//* The fee rate of the channel (in microsatoshi/sat).
-	FeeRate uint32 `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
-	//* The timelock delta of the channel.
-	CltvExpiryDelta uint32 `protobuf:"varint,5,opt,name=cltv_expiry_delta,json=cltvExpiryDelta,proto3" json:"cltv_expiry_delta,omitempty"`
+	//* The address of the node to disconnect.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *HopHint) Reset() {
-	*x = HopHint{}
+func (x *DisconnectPeerRequest) Reset() {
+	*x = DisconnectPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[63]
+		mi := &file_rpc_services_rpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HopHint) String() string {
+func (x *DisconnectPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HopHint) ProtoMessage() {}
+func (*DisconnectPeerRequest) ProtoMessage() {}
 
-func (x *HopHint) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[63]
+func (x *DisconnectPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4281,83 +5049,86 @@ func (x *HopHint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HopHint.ProtoReflect.Descriptor instead.
-func (*HopHint) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use DisconnectPeerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *HopHint) GetPubkey() string {
+func (x *DisconnectPeerRequest) GetAddress() string {
 	if x != nil {
-		return x.Pubkey
+		return x.Address
 	}
 	return ""
 }
 
-func (x *HopHint) GetChanId() uint64 {
-	if x != nil {
-		return x.ChanId
-	}
-	return 0
+//* A DisconnectPeerResponse is received in response to a DisconnectPeer call.
+type DisconnectPeerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HopHint) GetFeeBaseMsat() uint32 {
-	if x != nil {
-		return x.FeeBaseMsat
+func (x *DisconnectPeerResponse) Reset() {
+	*x = DisconnectPeerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *HopHint) GetFeeRate() uint32 {
-	if x != nil {
-		return x.FeeRate
-	}
-	return 0
+func (x *DisconnectPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *HopHint) GetCltvExpiryDelta() uint32 {
-	if x != nil {
-		return x.CltvExpiryDelta
+func (*DisconnectPeerResponse) ProtoMessage() {}
+
+func (x *DisconnectPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-//* Represents an HTLC paying to an invoice.
-type InvoiceHTLC struct {
+// Deprecated: Use DisconnectPeerResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{67}
+}
+
+//* A message representing a node in the persistent peer list.
+type PersistentPeer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The short channel id of the channel the HTLC was arrived.
-	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	//* The amount of this HTLC (in millisatoshi).
-	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* State of the HTLC.
-	State InvoiceHTLCState `protobuf:"varint,3,opt,name=state,proto3,enum=services.InvoiceHTLCState" json:"state,omitempty"`
-	//* HTLC accept timestamp.
-	AcceptTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accept_timestamp,json=acceptTimestamp,proto3" json:"accept_timestamp,omitempty"`
-	//* HTLC resolve timestamp.
-	ResolveTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolve_timestamp,json=resolveTimestamp,proto3" json:"resolve_timestamp,omitempty"`
-	//* Block height at which this HTLC expires.
-	ExpiryHeight int32 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	//* The address of the node.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//* The network location used to connect to the node.
+	Hostport string `protobuf:"bytes,2,opt,name=hostport,proto3" json:"hostport,omitempty"`
 }
 
-func (x *InvoiceHTLC) Reset() {
-	*x = InvoiceHTLC{}
+func (x *PersistentPeer) Reset() {
+	*x = PersistentPeer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[64]
+		mi := &file_rpc_services_rpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InvoiceHTLC) String() string {
+func (x *PersistentPeer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvoiceHTLC) ProtoMessage() {}
+func (*PersistentPeer) ProtoMessage() {}
 
-func (x *InvoiceHTLC) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[64]
+func (x *PersistentPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4368,77 +5139,107 @@ func (x *InvoiceHTLC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvoiceHTLC.ProtoReflect.Descriptor instead.
-func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use PersistentPeer.ProtoReflect.Descriptor instead.
+func (*PersistentPeer) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *InvoiceHTLC) GetChanId() uint64 {
+func (x *PersistentPeer) GetAddress() string {
 	if x != nil {
-		return x.ChanId
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *InvoiceHTLC) GetAmtMsat() uint64 {
+func (x *PersistentPeer) GetHostport() string {
 	if x != nil {
-		return x.AmtMsat
+		return x.Hostport
 	}
-	return 0
+	return ""
 }
 
-func (x *InvoiceHTLC) GetState() InvoiceHTLCState {
-	if x != nil {
-		return x.State
+//* Corresponds to a request to add a node to the persistent peer list.
+type AddPersistentPeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The address of the node.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//* The network location of the node.
+	Hostport string `protobuf:"bytes,2,opt,name=hostport,proto3" json:"hostport,omitempty"`
+}
+
+func (x *AddPersistentPeerRequest) Reset() {
+	*x = AddPersistentPeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return InvoiceHTLCState_INVOICE_HTLC_ACCEPTED
 }
 
-func (x *InvoiceHTLC) GetAcceptTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AcceptTimestamp
+func (x *AddPersistentPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPersistentPeerRequest) ProtoMessage() {}
+
+func (x *AddPersistentPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *InvoiceHTLC) GetResolveTimestamp() *timestamppb.Timestamp {
+// Deprecated: Use AddPersistentPeerRequest.ProtoReflect.Descriptor instead.
+func (*AddPersistentPeerRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AddPersistentPeerRequest) GetAddress() string {
 	if x != nil {
-		return x.ResolveTimestamp
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *InvoiceHTLC) GetExpiryHeight() int32 {
+func (x *AddPersistentPeerRequest) GetHostport() string {
 	if x != nil {
-		return x.ExpiryHeight
+		return x.Hostport
 	}
-	return 0
+	return ""
 }
 
-//* Corresponds to a subscription request for invoice updates.
-type SubscribeInvoicesRequest struct {
+//* An AddPersistentPeerResponse is received in response to an AddPersistentPeer call.
+type AddPersistentPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SubscribeInvoicesRequest) Reset() {
-	*x = SubscribeInvoicesRequest{}
+func (x *AddPersistentPeerResponse) Reset() {
+	*x = AddPersistentPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[65]
+		mi := &file_rpc_services_rpc_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeInvoicesRequest) String() string {
+func (x *AddPersistentPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeInvoicesRequest) ProtoMessage() {}
+func (*AddPersistentPeerResponse) ProtoMessage() {}
 
-func (x *SubscribeInvoicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[65]
+func (x *AddPersistentPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4449,35 +5250,35 @@ func (x *SubscribeInvoicesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeInvoicesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeInvoicesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use AddPersistentPeerResponse.ProtoReflect.Descriptor instead.
+func (*AddPersistentPeerResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{70}
 }
 
-//* Corresponds to a subscription request for payment updates.
-type SubscribePaymentsRequest struct {
+//* Corresponds to a request to list the persistent peer list.
+type GetPersistentPeersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SubscribePaymentsRequest) Reset() {
-	*x = SubscribePaymentsRequest{}
+func (x *GetPersistentPeersRequest) Reset() {
+	*x = GetPersistentPeersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[66]
+		mi := &file_rpc_services_rpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribePaymentsRequest) String() string {
+func (x *GetPersistentPeersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribePaymentsRequest) ProtoMessage() {}
+func (*GetPersistentPeersRequest) ProtoMessage() {}
 
-func (x *SubscribePaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[66]
+func (x *GetPersistentPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4488,35 +5289,38 @@ func (x *SubscribePaymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribePaymentsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribePaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use GetPersistentPeersRequest.ProtoReflect.Descriptor instead.
+func (*GetPersistentPeersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{71}
 }
 
-//* Corresponds to a message subscription request.
-type SubscribeMessagesRequest struct {
+//* A GetPersistentPeersResponse is received in response to a GetPersistentPeers call.
+type GetPersistentPeersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The persistent peer list.
+	Peers []*PersistentPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
 }
 
-func (x *SubscribeMessagesRequest) Reset() {
-	*x = SubscribeMessagesRequest{}
+func (x *GetPersistentPeersResponse) Reset() {
+	*x = GetPersistentPeersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[67]
+		mi := &file_rpc_services_rpc_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscribeMessagesRequest) String() string {
+func (x *GetPersistentPeersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeMessagesRequest) ProtoMessage() {}
+func (*GetPersistentPeersResponse) ProtoMessage() {}
 
-func (x *SubscribeMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[67]
+func (x *GetPersistentPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4527,44 +5331,45 @@ func (x *SubscribeMessagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeMessagesRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use GetPersistentPeersResponse.ProtoReflect.Descriptor instead.
+func (*GetPersistentPeersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{72}
 }
 
-//* Corresponds to a route discovery request.
-type RouteRequest struct {
+func (x *GetPersistentPeersResponse) GetPeers() []*PersistentPeer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+//* Corresponds to a request to remove a node from the persistent peer list.
+type RemovePersistentPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Destination:
-	//	*RouteRequest_PayReq
-	//	*RouteRequest_Address
-	Destination isRouteRequest_Destination `protobuf_oneof:"destination"`
-	//* The payment amount (in millisatoshi).
-	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The payment options.
-	Options *PaymentOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	//* The address of the node.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *RouteRequest) Reset() {
-	*x = RouteRequest{}
+func (x *RemovePersistentPeerRequest) Reset() {
+	*x = RemovePersistentPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[68]
+		mi := &file_rpc_services_rpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RouteRequest) String() string {
+func (x *RemovePersistentPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteRequest) ProtoMessage() {}
+func (*RemovePersistentPeerRequest) ProtoMessage() {}
 
-func (x *RouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[68]
+func (x *RemovePersistentPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4575,91 +5380,199 @@ func (x *RouteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteRequest.ProtoReflect.Descriptor instead.
-func (*RouteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use RemovePersistentPeerRequest.ProtoReflect.Descriptor instead.
+func (*RemovePersistentPeerRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (m *RouteRequest) GetDestination() isRouteRequest_Destination {
-	if m != nil {
-		return m.Destination
+func (x *RemovePersistentPeerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *RouteRequest) GetPayReq() string {
-	if x, ok := x.GetDestination().(*RouteRequest_PayReq); ok {
-		return x.PayReq
+//* A RemovePersistentPeerResponse is received in response to a RemovePersistentPeer call.
+type RemovePersistentPeerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemovePersistentPeerResponse) Reset() {
+	*x = RemovePersistentPeerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RouteRequest) GetAddress() string {
-	if x, ok := x.GetDestination().(*RouteRequest_Address); ok {
-		return x.Address
+func (x *RemovePersistentPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePersistentPeerResponse) ProtoMessage() {}
+
+func (x *RemovePersistentPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RouteRequest) GetAmtMsat() uint64 {
+// Deprecated: Use RemovePersistentPeerResponse.ProtoReflect.Descriptor instead.
+func (*RemovePersistentPeerResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{74}
+}
+
+//* A message representing a contact of the application.
+type ContactInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The node corresponding to the contact.
+	Node *NodeInfo `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	//* The contact id.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	//* A contact's chat nickname.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	//* The contact's Lightning Address (user@domain), if known.
+	LightningAddress string `protobuf:"bytes,4,opt,name=lightning_address,json=lightningAddress,proto3" json:"lightning_address,omitempty"`
+}
+
+func (x *ContactInfo) Reset() {
+	*x = ContactInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactInfo) ProtoMessage() {}
+
+func (x *ContactInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContactInfo.ProtoReflect.Descriptor instead.
+func (*ContactInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ContactInfo) GetNode() *NodeInfo {
 	if x != nil {
-		return x.AmtMsat
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ContactInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *RouteRequest) GetOptions() *PaymentOptions {
+func (x *ContactInfo) GetDisplayName() string {
 	if x != nil {
-		return x.Options
+		return x.DisplayName
 	}
-	return nil
+	return ""
 }
 
-type isRouteRequest_Destination interface {
-	isRouteRequest_Destination()
+func (x *ContactInfo) GetLightningAddress() string {
+	if x != nil {
+		return x.LightningAddress
+	}
+	return ""
 }
 
-type RouteRequest_PayReq struct {
-	//* The payment request to pay to.
-	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3,oneof"`
+//* Corresponds to a request to list all contacts.
+type GetContactsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-type RouteRequest_Address struct {
-	//* The address to pay to.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
+func (x *GetContactsRequest) Reset() {
+	*x = GetContactsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*RouteRequest_PayReq) isRouteRequest_Destination() {}
+func (x *GetContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*RouteRequest_Address) isRouteRequest_Destination() {}
+func (*GetContactsRequest) ProtoMessage() {}
 
-//* A RouteResponse is received in response to a route discovery request.
-type RouteResponse struct {
+func (x *GetContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactsRequest.ProtoReflect.Descriptor instead.
+func (*GetContactsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{76}
+}
+
+//* A GetContactsResponse is received in response to a GetContacts rpc call.
+type GetContactsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* A route that can fulfil the requested payment.
-	Route *PaymentRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	//* The list of contacts in the database.
+	Contacts []*ContactInfo `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
 }
 
-func (x *RouteResponse) Reset() {
-	*x = RouteResponse{}
+func (x *GetContactsResponse) Reset() {
+	*x = GetContactsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[69]
+		mi := &file_rpc_services_rpc_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RouteResponse) String() string {
+func (x *GetContactsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteResponse) ProtoMessage() {}
+func (*GetContactsResponse) ProtoMessage() {}
 
-func (x *RouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[69]
+func (x *GetContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4670,45 +5583,45 @@ func (x *RouteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteResponse.ProtoReflect.Descriptor instead.
-func (*RouteResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use GetContactsResponse.ProtoReflect.Descriptor instead.
+func (*GetContactsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *RouteResponse) GetRoute() *PaymentRoute {
+func (x *GetContactsResponse) GetContacts() []*ContactInfo {
 	if x != nil {
-		return x.Route
+		return x.Contacts
 	}
 	return nil
 }
 
-//* Corresponds to an invoice retrieval request.
-type GetInvoicesRequest struct {
+//* Corresponds to a request to add a node as a contact.
+type AddContactRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The pagination options of the request.
-	PageOptions *KeySetPageOptions `protobuf:"bytes,1,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
+	//* The node to add as a contact.
+	Contact *ContactInfo `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *GetInvoicesRequest) Reset() {
-	*x = GetInvoicesRequest{}
+func (x *AddContactRequest) Reset() {
+	*x = AddContactRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[70]
+		mi := &file_rpc_services_rpc_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInvoicesRequest) String() string {
+func (x *AddContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInvoicesRequest) ProtoMessage() {}
+func (*AddContactRequest) ProtoMessage() {}
 
-func (x *GetInvoicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[70]
+func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4719,45 +5632,45 @@ func (x *GetInvoicesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInvoicesRequest.ProtoReflect.Descriptor instead.
-func (*GetInvoicesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use AddContactRequest.ProtoReflect.Descriptor instead.
+func (*AddContactRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetInvoicesRequest) GetPageOptions() *KeySetPageOptions {
+func (x *AddContactRequest) GetContact() *ContactInfo {
 	if x != nil {
-		return x.PageOptions
+		return x.Contact
 	}
 	return nil
 }
 
-//* Corresponds to a payment retrieval request.
-type GetPaymentsRequest struct {
+//* A AddContactResponse is received in response to an AddContact rpc call.
+type AddContactResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The pagination options of the request.
-	PageOptions *KeySetPageOptions `protobuf:"bytes,1,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
+	//* The newly added contact's information.
+	Contact *ContactInfo `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *GetPaymentsRequest) Reset() {
-	*x = GetPaymentsRequest{}
+func (x *AddContactResponse) Reset() {
+	*x = AddContactResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[71]
+		mi := &file_rpc_services_rpc_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPaymentsRequest) String() string {
+func (x *AddContactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPaymentsRequest) ProtoMessage() {}
+func (*AddContactResponse) ProtoMessage() {}
 
-func (x *GetPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[71]
+func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4768,979 +5681,7765 @@ func (x *GetPaymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPaymentsRequest.ProtoReflect.Descriptor instead.
-func (*GetPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use AddContactResponse.ProtoReflect.Descriptor instead.
+func (*AddContactResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetPaymentsRequest) GetPageOptions() *KeySetPageOptions {
+func (x *AddContactResponse) GetContact() *ContactInfo {
 	if x != nil {
-		return x.PageOptions
+		return x.Contact
 	}
 	return nil
 }
 
-var File_rpc_services_rpc_proto protoreflect.FileDescriptor
-
-var file_rpc_services_rpc_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x72,
-	0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6d, 0x77, 0x69, 0x74, 0x6b, 0x6f, 0x77, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a, 0x11, 0x4b,
-	0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x5c, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
-	0x22, 0x52, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69,
-	0x61, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x22, 0x6b, 0x0a, 0x10, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x14, 0x0a,
-	0x12, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xb1, 0x03, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f,
-	0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x34, 0x0a,
-	0x16, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
-	0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x35, 0x0a, 0x17, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x4c,
-	0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
-	0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x12, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d,
-	0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64,
-	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x13, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a,
-	0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
-	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
-	0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xf5, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a,
-	0x0d, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61,
-	0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x4f, 0x70, 0x65,
-	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
-	0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x78, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
-	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
-	0x22, 0x4c, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2,
-	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x4d,
-	0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x32, 0x0a,
-	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x51, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a,
-	0x08, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xbb, 0x05, 0x0a, 0x07, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73,
-	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a,
-	0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52,
-	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0x18, 0x01, 0xe2, 0xdf, 0x1f,
-	0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d,
-	0x24, 0x20, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x27, 0x0a,
-	0x0f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x12, 0x21, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d,
-	0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65,
-	0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0x18, 0x01,
-	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65,
-	0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x70, 0x72,
-	0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65,
-	0x71, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x70, 0x61, 0x79,
-	0x52, 0x65, 0x71, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
-	0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x70, 0x52, 0x04, 0x68, 0x6f, 0x70,
-	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c,
-	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26,
-	0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x65,
-	0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x35,
-	0x0a, 0x0b, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x0a, 0x68, 0x6f, 0x70, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x61, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x5f,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x10, 0x61, 0x6d, 0x74, 0x54, 0x6f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x4d, 0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x22,
-	0x54, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d,
-	0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
-	0x6d, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e,
-	0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x21, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73,
-	0x61, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x79, 0x0a, 0x17, 0x45, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x20, 0x01, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62,
-	0x12, 0x33, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x61,
-	0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2,
-	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x22,
-	0x53, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
-	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x1d, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3a,
-	0x02, 0x18, 0x01, 0x22, 0x60, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x44, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x20, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x60, 0x01, 0x52, 0x0c,
-	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x07,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c,
-	0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x11,
-	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d,
-	0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79,
-	0x6d, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e,
-	0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
-	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5a,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63,
-	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0a,
-	0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x1f, 0x47, 0x65,
-	0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20,
-	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
-	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
-	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6d, 0x74, 0x5f,
-	0x6d, 0x73, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
-	0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6d, 0x74, 0x5f,
-	0x6d, 0x73, 0x61, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x46, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x53, 0x65, 0x6e,
-	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x58,
-	0x0a, 0x14, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0a, 0x64, 0x69,
-	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x44,
-	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x1f, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
-	0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64,
-	0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c,
-	0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
-	0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74,
-	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6d, 0x74,
-	0x4d, 0x73, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x32,
-	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x44, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x77, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
-	0x65, 0x6d, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x22, 0x44, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x2f, 0x0a, 0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x22, 0x44, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xa1, 0x01,
-	0x0a, 0x0a, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x32,
-	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x36, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x3a, 0x0a, 0x0b, 0x50, 0x61, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x81, 0x03, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x47, 0x0a, 0x11,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x72,
-	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x05,
-	0x48, 0x54, 0x4c, 0x43, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x54,
-	0x4c, 0x43, 0x52, 0x05, 0x48, 0x54, 0x4c, 0x43, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0b, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65, 0x6d,
-	0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10,
-	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x22, 0xdd, 0x04, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6d, 0x74, 0x5f,
-	0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x61, 0x6d, 0x74, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x47, 0x0a, 0x11,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x12, 0x34, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x64, 0x64, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f,
-	0x68, 0x74, 0x6c, 0x63, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x54,
-	0x4c, 0x43, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x74, 0x6c, 0x63, 0x73,
-	0x22, 0x3b, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
-	0x09, 0x68, 0x6f, 0x70, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x6f, 0x70, 0x48,
-	0x69, 0x6e, 0x74, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xa5, 0x01,
-	0x0a, 0x07, 0x48, 0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x65,
-	0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x19,
-	0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x74,
-	0x76, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6c, 0x74, 0x76, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
-	0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xa8, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x48, 0x54, 0x4c, 0x43, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c,
-	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x22, 0x1a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71,
-	0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08,
-	0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x0d, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3e, 0x0a, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x64, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
-	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41,
-	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x46, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43,
-	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x41, 0x59, 0x4d, 0x45,
-	0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x44, 0x0a, 0x09, 0x48,
-	0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54, 0x4c, 0x43,
-	0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
-	0x48, 0x54, 0x4c, 0x43, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
-	0x02, 0x2a, 0x62, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x45,
-	0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x41,
-	0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56,
-	0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x15,
-	0x0a, 0x11, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
-	0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56,
-	0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f,
-	0x48, 0x54, 0x4c, 0x43, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a,
-	0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x43,
-	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x32, 0xac, 0x04, 0x0a, 0x0f, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
-	0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x13, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f,
-	0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42,
-	0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x5e, 0x0a, 0x0e, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x4f,
-	0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xeb, 0x02, 0x0a, 0x0e, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x41, 0x64,
-	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x64, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa1, 0x02, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x45, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x4f, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x61, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x30, 0x01, 0x32, 0xf0, 0x05, 0x0a, 0x11,
-	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x12, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x70, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x52, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x44,
-	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x44,
-	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73,
-	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x12,
-	0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63,
-	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x04, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xd5,
-	0x04, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x03, 0x50, 0x61, 0x79,
-	0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4e, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x4e, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x00,
-	0x30, 0x01, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x33, 0x6e, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x31, 0x33,
-	0x6e, 0x2d, 0x67, 0x6f, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+//* Corresponds to a request to remove a contact.
+type RemoveContactByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The id of the contact to remove.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var (
-	file_rpc_services_rpc_proto_rawDescOnce sync.Once
-	file_rpc_services_rpc_proto_rawDescData = file_rpc_services_rpc_proto_rawDesc
-)
+func (x *RemoveContactByIDRequest) Reset() {
+	*x = RemoveContactByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_rpc_services_rpc_proto_rawDescGZIP() []byte {
-	file_rpc_services_rpc_proto_rawDescOnce.Do(func() {
-		file_rpc_services_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_services_rpc_proto_rawDescData)
-	})
-	return file_rpc_services_rpc_proto_rawDescData
+func (x *RemoveContactByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_rpc_services_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_rpc_services_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
-var file_rpc_services_rpc_proto_goTypes = []interface{}{
-	(PaymentState)(0),                       // 0: services.PaymentState
-	(HTLCState)(0),                          // 1: services.HTLCState
-	(InvoiceState)(0),                       // 2: services.InvoiceState
-	(InvoiceHTLCState)(0),                   // 3: services.InvoiceHTLCState
-	(*KeySetPageOptions)(nil),               // 4: services.KeySetPageOptions
-	(*VersionRequest)(nil),                  // 5: services.VersionRequest
-	(*Version)(nil),                         // 6: services.Version
-	(*NodeInfo)(nil),                        // 7: services.NodeInfo
-	(*SelfInfoRequest)(nil),                 // 8: services.SelfInfoRequest
-	(*Chain)(nil),                           // 9: services.Chain
-	(*SelfInfoResponse)(nil),                // 10: services.SelfInfoResponse
-	(*SelfBalanceRequest)(nil),              // 11: services.SelfBalanceRequest
-	(*SelfBalanceResponse)(nil),             // 12: services.SelfBalanceResponse
-	(*GetNodesRequest)(nil),                 // 13: services.GetNodesRequest
-	(*SearchNodeByAddressRequest)(nil),      // 14: services.SearchNodeByAddressRequest
-	(*SearchNodeByAliasRequest)(nil),        // 15: services.SearchNodeByAliasRequest
-	(*NodeInfoResponse)(nil),                // 16: services.NodeInfoResponse
-	(*ConnectNodeRequest)(nil),              // 17: services.ConnectNodeRequest
-	(*ConnectNodeResponse)(nil),             // 18: services.ConnectNodeResponse
-	(*OpenChannelRequest)(nil),              // 19: services.OpenChannelRequest
-	(*OpenChannelResponse)(nil),             // 20: services.OpenChannelResponse
-	(*ContactInfo)(nil),                     // 21: services.ContactInfo
-	(*GetContactsRequest)(nil),              // 22: services.GetContactsRequest
-	(*GetContactsResponse)(nil),             // 23: services.GetContactsResponse
-	(*AddContactRequest)(nil),               // 24: services.AddContactRequest
-	(*AddContactResponse)(nil),              // 25: services.AddContactResponse
-	(*RemoveContactByIDRequest)(nil),        // 26: services.RemoveContactByIDRequest
-	(*RemoveContactByAddressRequest)(nil),   // 27: services.RemoveContactByAddressRequest
-	(*RemoveContactResponse)(nil),           // 28: services.RemoveContactResponse
-	(*Payments)(nil),                        // 29: services.Payments
-	(*Message)(nil),                         // 30: services.Message
-	(*PaymentRoute)(nil),                    // 31: services.PaymentRoute
-	(*PaymentHop)(nil),                      // 32: services.PaymentHop
-	(*MessageOptions)(nil),                  // 33: services.MessageOptions
-	(*EstimateMessageRequest)(nil),          // 34: services.EstimateMessageRequest
-	(*EstimateMessageResponse)(nil),         // 35: services.EstimateMessageResponse
-	(*SendMessageRequest)(nil),              // 36: services.SendMessageRequest
-	(*SendMessageResponse)(nil),             // 37: services.SendMessageResponse
-	(*SubscribeMessageRequest)(nil),         // 38: services.SubscribeMessageRequest
-	(*SubscribeMessageResponse)(nil),        // 39: services.SubscribeMessageResponse
-	(*DiscussionInfo)(nil),                  // 40: services.DiscussionInfo
-	(*DiscussionOptions)(nil),               // 41: services.DiscussionOptions
-	(*GetDiscussionsRequest)(nil),           // 42: services.GetDiscussionsRequest
-	(*GetDiscussionsResponse)(nil),          // 43: services.GetDiscussionsResponse
-	(*GetDiscussionHistoryByIDRequest)(nil), // 44: services.GetDiscussionHistoryByIDRequest
-	(*GetDiscussionHistoryResponse)(nil),    // 45: services.GetDiscussionHistoryResponse
-	(*GetDiscussionStatisticsRequest)(nil),  // 46: services.GetDiscussionStatisticsRequest
-	(*GetDiscussionStatisticsResponse)(nil), // 47: services.GetDiscussionStatisticsResponse
-	(*AddDiscussionRequest)(nil),            // 48: services.AddDiscussionRequest
-	(*AddDiscussionResponse)(nil),           // 49: services.AddDiscussionResponse
-	(*UpdateDiscussionLastReadRequest)(nil), // 50: services.UpdateDiscussionLastReadRequest
-	(*UpdateDiscussionResponse)(nil),        // 51: services.UpdateDiscussionResponse
-	(*RemoveDiscussionRequest)(nil),         // 52: services.RemoveDiscussionRequest
-	(*RemoveDiscussionResponse)(nil),        // 53: services.RemoveDiscussionResponse
-	(*SendRequest)(nil),                     // 54: services.SendRequest
-	(*SendResponse)(nil),                    // 55: services.SendResponse
-	(*CreateInvoiceRequest)(nil),            // 56: services.CreateInvoiceRequest
-	(*CreateInvoiceResponse)(nil),           // 57: services.CreateInvoiceResponse
-	(*LookupInvoiceRequest)(nil),            // 58: services.LookupInvoiceRequest
-	(*LookupInvoiceResponse)(nil),           // 59: services.LookupInvoiceResponse
-	(*PayRequest)(nil),                      // 60: services.PayRequest
-	(*PaymentOptions)(nil),                  // 61: services.PaymentOptions
-	(*PayResponse)(nil),                     // 62: services.PayResponse
-	(*Payment)(nil),                         // 63: services.Payment
-	(*PaymentHTLC)(nil),                     // 64: services.PaymentHTLC
-	(*Invoice)(nil),                         // 65: services.Invoice
-	(*RouteHint)(nil),                       // 66: services.RouteHint
-	(*HopHint)(nil),                         // 67: services.HopHint
-	(*InvoiceHTLC)(nil),                     // 68: services.InvoiceHTLC
-	(*SubscribeInvoicesRequest)(nil),        // 69: services.SubscribeInvoicesRequest
-	(*SubscribePaymentsRequest)(nil),        // 70: services.SubscribePaymentsRequest
-	(*SubscribeMessagesRequest)(nil),        // 71: services.SubscribeMessagesRequest
-	(*RouteRequest)(nil),                    // 72: services.RouteRequest
-	(*RouteResponse)(nil),                   // 73: services.RouteResponse
-	(*GetInvoicesRequest)(nil),              // 74: services.GetInvoicesRequest
-	(*GetPaymentsRequest)(nil),              // 75: services.GetPaymentsRequest
-	(*timestamppb.Timestamp)(nil),           // 76: google.protobuf.Timestamp
+func (*RemoveContactByIDRequest) ProtoMessage() {}
+
+func (x *RemoveContactByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_rpc_services_rpc_proto_depIdxs = []int32{
-	7,  // 0: services.SelfInfoResponse.info:type_name -> services.NodeInfo
-	9,  // 1: services.SelfInfoResponse.chains:type_name -> services.Chain
-	7,  // 2: services.NodeInfoResponse.nodes:type_name -> services.NodeInfo
-	7,  // 3: services.ContactInfo.node:type_name -> services.NodeInfo
-	21, // 4: services.GetContactsResponse.contacts:type_name -> services.ContactInfo
-	21, // 5: services.AddContactRequest.contact:type_name -> services.ContactInfo
-	21, // 6: services.AddContactResponse.contact:type_name -> services.ContactInfo
-	63, // 7: services.Payments.payments:type_name -> services.Payment
-	76, // 8: services.Message.sent_timestamp:type_name -> google.protobuf.Timestamp
-	76, // 9: services.Message.received_timestamp:type_name -> google.protobuf.Timestamp
-	31, // 10: services.Message.payment_routes:type_name -> services.PaymentRoute
-	29, // 11: services.Message.payments:type_name -> services.Payments
-	65, // 12: services.Message.invoice:type_name -> services.Invoice
-	32, // 13: services.PaymentRoute.hops:type_name -> services.PaymentHop
-	33, // 14: services.EstimateMessageRequest.options:type_name -> services.MessageOptions
-	30, // 15: services.EstimateMessageResponse.message:type_name -> services.Message
-	33, // 16: services.SendMessageRequest.options:type_name -> services.MessageOptions
-	30, // 17: services.SendMessageResponse.sent_message:type_name -> services.Message
-	30, // 18: services.SubscribeMessageResponse.received_message:type_name -> services.Message
-	41, // 19: services.DiscussionInfo.options:type_name -> services.DiscussionOptions
-	40, // 20: services.GetDiscussionsResponse.discussion:type_name -> services.DiscussionInfo
-	4,  // 21: services.GetDiscussionHistoryByIDRequest.page_options:type_name -> services.KeySetPageOptions
-	30, // 22: services.GetDiscussionHistoryResponse.message:type_name -> services.Message
-	40, // 23: services.AddDiscussionRequest.discussion:type_name -> services.DiscussionInfo
-	40, // 24: services.AddDiscussionResponse.discussion:type_name -> services.DiscussionInfo
-	33, // 25: services.SendRequest.options:type_name -> services.MessageOptions
-	30, // 26: services.SendResponse.sent_message:type_name -> services.Message
-	65, // 27: services.CreateInvoiceResponse.invoice:type_name -> services.Invoice
-	65, // 28: services.LookupInvoiceResponse.invoice:type_name -> services.Invoice
-	61, // 29: services.PayRequest.options:type_name -> services.PaymentOptions
-	63, // 30: services.PayResponse.payment:type_name -> services.Payment
-	76, // 31: services.Payment.created_timestamp:type_name -> google.protobuf.Timestamp
-	76, // 32: services.Payment.resolved_timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 33: services.Payment.state:type_name -> services.PaymentState
-	64, // 34: services.Payment.HTLCs:type_name -> services.PaymentHTLC
-	31, // 35: services.PaymentHTLC.route:type_name -> services.PaymentRoute
-	76, // 36: services.PaymentHTLC.attempt_timestamp:type_name -> google.protobuf.Timestamp
-	76, // 37: services.PaymentHTLC.resolve_timestamp:type_name -> google.protobuf.Timestamp
-	1,  // 38: services.PaymentHTLC.state:type_name -> services.HTLCState
-	76, // 39: services.Invoice.created_timestamp:type_name -> google.protobuf.Timestamp
-	76, // 40: services.Invoice.settled_timestamp:type_name -> google.protobuf.Timestamp
-	66, // 41: services.Invoice.route_hints:type_name -> services.RouteHint
-	2,  // 42: services.Invoice.state:type_name -> services.InvoiceState
-	68, // 43: services.Invoice.invoice_htlcs:type_name -> services.InvoiceHTLC
-	67, // 44: services.RouteHint.hop_hints:type_name -> services.HopHint
-	3,  // 45: services.InvoiceHTLC.state:type_name -> services.InvoiceHTLCState
-	76, // 46: services.InvoiceHTLC.accept_timestamp:type_name -> google.protobuf.Timestamp
-	76, // 47: services.InvoiceHTLC.resolve_timestamp:type_name -> google.protobuf.Timestamp
-	61, // 48: services.RouteRequest.options:type_name -> services.PaymentOptions
-	31, // 49: services.RouteResponse.route:type_name -> services.PaymentRoute
-	4,  // 50: services.GetInvoicesRequest.page_options:type_name -> services.KeySetPageOptions
-	4,  // 51: services.GetPaymentsRequest.page_options:type_name -> services.KeySetPageOptions
-	5,  // 52: services.NodeInfoService.GetVersion:input_type -> services.VersionRequest
-	8,  // 53: services.NodeInfoService.GetSelfInfo:input_type -> services.SelfInfoRequest
-	11, // 54: services.NodeInfoService.GetSelfBalance:input_type -> services.SelfBalanceRequest
-	13, // 55: services.NodeInfoService.GetNodes:input_type -> services.GetNodesRequest
-	14, // 56: services.NodeInfoService.SearchNodeByAddress:input_type -> services.SearchNodeByAddressRequest
-	15, // 57: services.NodeInfoService.SearchNodeByAlias:input_type -> services.SearchNodeByAliasRequest
-	17, // 58: services.NodeInfoService.ConnectNode:input_type -> services.ConnectNodeRequest
-	19, // 59: services.ChannelService.OpenChannel:input_type -> services.OpenChannelRequest
-	22, // 60: services.ContactService.GetContacts:input_type -> services.GetContactsRequest
-	24, // 61: services.ContactService.AddContact:input_type -> services.AddContactRequest
-	26, // 62: services.ContactService.RemoveContactByID:input_type -> services.RemoveContactByIDRequest
-	27, // 63: services.ContactService.RemoveContactByAddress:input_type -> services.RemoveContactByAddressRequest
-	34, // 64: services.MessageService.EstimateMessage:input_type -> services.EstimateMessageRequest
-	36, // 65: services.MessageService.SendMessage:input_type -> services.SendMessageRequest
-	38, // 66: services.MessageService.SubscribeMessages:input_type -> services.SubscribeMessageRequest
-	42, // 67: services.DiscussionService.GetDiscussions:input_type -> services.GetDiscussionsRequest
-	44, // 68: services.DiscussionService.GetDiscussionHistoryByID:input_type -> services.GetDiscussionHistoryByIDRequest
-	46, // 69: services.DiscussionService.GetDiscussionStatistics:input_type -> services.GetDiscussionStatisticsRequest
-	48, // 70: services.DiscussionService.AddDiscussion:input_type -> services.AddDiscussionRequest
-	50, // 71: services.DiscussionService.UpdateDiscussionLastRead:input_type -> services.UpdateDiscussionLastReadRequest
-	52, // 72: services.DiscussionService.RemoveDiscussion:input_type -> services.RemoveDiscussionRequest
-	54, // 73: services.DiscussionService.Send:input_type -> services.SendRequest
-	71, // 74: services.DiscussionService.Subscribe:input_type -> services.SubscribeMessagesRequest
-	56, // 75: services.PaymentService.CreateInvoice:input_type -> services.CreateInvoiceRequest
-	58, // 76: services.PaymentService.LookupInvoice:input_type -> services.LookupInvoiceRequest
-	60, // 77: services.PaymentService.Pay:input_type -> services.PayRequest
-	69, // 78: services.PaymentService.SubscribeInvoices:input_type -> services.SubscribeInvoicesRequest
-	70, // 79: services.PaymentService.SubscribePayments:input_type -> services.SubscribePaymentsRequest
-	72, // 80: services.PaymentService.GetRoute:input_type -> services.RouteRequest
-	74, // 81: services.PaymentService.GetInvoices:input_type -> services.GetInvoicesRequest
-	75, // 82: services.PaymentService.GetPayments:input_type -> services.GetPaymentsRequest
-	6,  // 83: services.NodeInfoService.GetVersion:output_type -> services.Version
-	10, // 84: services.NodeInfoService.GetSelfInfo:output_type -> services.SelfInfoResponse
-	12, // 85: services.NodeInfoService.GetSelfBalance:output_type -> services.SelfBalanceResponse
-	16, // 86: services.NodeInfoService.GetNodes:output_type -> services.NodeInfoResponse
-	16, // 87: services.NodeInfoService.SearchNodeByAddress:output_type -> services.NodeInfoResponse
-	16, // 88: services.NodeInfoService.SearchNodeByAlias:output_type -> services.NodeInfoResponse
-	18, // 89: services.NodeInfoService.ConnectNode:output_type -> services.ConnectNodeResponse
-	20, // 90: services.ChannelService.OpenChannel:output_type -> services.OpenChannelResponse
-	23, // 91: services.ContactService.GetContacts:output_type -> services.GetContactsResponse
-	25, // 92: services.ContactService.AddContact:output_type -> services.AddContactResponse
-	28, // 93: services.ContactService.RemoveContactByID:output_type -> services.RemoveContactResponse
-	28, // 94: services.ContactService.RemoveContactByAddress:output_type -> services.RemoveContactResponse
-	35, // 95: services.MessageService.EstimateMessage:output_type -> services.EstimateMessageResponse
-	37, // 96: services.MessageService.SendMessage:output_type -> services.SendMessageResponse
-	39, // 97: services.MessageService.SubscribeMessages:output_type -> services.SubscribeMessageResponse
-	43, // 98: services.DiscussionService.GetDiscussions:output_type -> services.GetDiscussionsResponse
-	45, // 99: services.DiscussionService.GetDiscussionHistoryByID:output_type -> services.GetDiscussionHistoryResponse
-	47, // 100: services.DiscussionService.GetDiscussionStatistics:output_type -> services.GetDiscussionStatisticsResponse
-	49, // 101: services.DiscussionService.AddDiscussion:output_type -> services.AddDiscussionResponse
-	51, // 102: services.DiscussionService.UpdateDiscussionLastRead:output_type -> services.UpdateDiscussionResponse
-	53, // 103: services.DiscussionService.RemoveDiscussion:output_type -> services.RemoveDiscussionResponse
-	55, // 104: services.DiscussionService.Send:output_type -> services.SendResponse
-	30, // 105: services.DiscussionService.Subscribe:output_type -> services.Message
-	57, // 106: services.PaymentService.CreateInvoice:output_type -> services.CreateInvoiceResponse
-	59, // 107: services.PaymentService.LookupInvoice:output_type -> services.LookupInvoiceResponse
-	62, // 108: services.PaymentService.Pay:output_type -> services.PayResponse
-	65, // 109: services.PaymentService.SubscribeInvoices:output_type -> services.Invoice
-	63, // 110: services.PaymentService.SubscribePayments:output_type -> services.Payment
-	73, // 111: services.PaymentService.GetRoute:output_type -> services.RouteResponse
-	65, // 112: services.PaymentService.GetInvoices:output_type -> services.Invoice
-	63, // 113: services.PaymentService.GetPayments:output_type -> services.Payment
-	83, // [83:114] is the sub-list for method output_type
-	52, // [52:83] is the sub-list for method input_type
-	52, // [52:52] is the sub-list for extension type_name
-	52, // [52:52] is the sub-list for extension extendee
-	0,  // [0:52] is the sub-list for field type_name
+
+// Deprecated: Use RemoveContactByIDRequest.ProtoReflect.Descriptor instead.
+func (*RemoveContactByIDRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{80}
 }
 
-func init() { file_rpc_services_rpc_proto_init() }
-func file_rpc_services_rpc_proto_init() {
-	if File_rpc_services_rpc_proto != nil {
-		return
+func (x *RemoveContactByIDRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_rpc_services_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeySetPageOptions); i {
+	return 0
+}
+
+//* Corresponds to a request to remove a contact.
+type RemoveContactByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The Lightning address of the contact to remove.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *RemoveContactByAddressRequest) Reset() {
+	*x = RemoveContactByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveContactByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContactByAddressRequest) ProtoMessage() {}
+
+func (x *RemoveContactByAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContactByAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveContactByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RemoveContactByAddressRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+//* A RemoveContactResponse is received in response to a RemoveContactBy* rpc call.
+type RemoveContactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveContactResponse) Reset() {
+	*x = RemoveContactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveContactResponse) ProtoMessage() {}
+
+func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveContactResponse.ProtoReflect.Descriptor instead.
+func (*RemoveContactResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{82}
+}
+
+//* Represents a list of payments.
+type Payments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The list of payments fulfilling a message.
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+}
+
+func (x *Payments) Reset() {
+	*x = Payments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payments) ProtoMessage() {}
+
+func (x *Payments) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payments.ProtoReflect.Descriptor instead.
+func (*Payments) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Payments) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+//* Represents a message sent over the Lightning network.
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The unique id of the message.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* The discussion id this message is associated with.
+	DiscussionId uint64 `protobuf:"varint,2,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The Lightning address of the sender node.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	//* The Lightning address of the receiver node.
+	// Deprecated: Do not use.
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	//* Whether the message sender was verified.
+	SenderVerified bool `protobuf:"varint,11,opt,name=sender_verified,json=senderVerified,proto3" json:"sender_verified,omitempty"`
+	//* The message payload.
+	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	//* The amount paid over this message (in millisatoshi).
+	AmtMsat int64 `protobuf:"varint,6,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The total routing fees paid for this message across all routes (in millisatoshi).
+	//
+	//This field is meaningful only for sent and estimated messages.
+	//
+	// Deprecated: Do not use.
+	TotalFeesMsat int64 `protobuf:"varint,7,opt,name=total_fees_msat,json=totalFeesMsat,proto3" json:"total_fees_msat,omitempty"`
+	//* The time the message was sent.
+	SentTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sent_timestamp,json=sentTimestamp,proto3" json:"sent_timestamp,omitempty"`
+	//* The time the message was received.
+	ReceivedTimestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=received_timestamp,json=receivedTimestamp,proto3" json:"received_timestamp,omitempty"`
+	//* The routes that fulfilled this message.
+	//
+	//This field is meaningful only for sent and estimated messages.
+	//
+	// Deprecated: Do not use.
+	PaymentRoutes []*PaymentRoute `protobuf:"bytes,10,rep,name=payment_routes,json=paymentRoutes,proto3" json:"payment_routes,omitempty"`
+	//* The preimage belonging to the associated payment.
+	//
+	//This field is only meaningful for received messages and
+	//messages sent successfully to non-group discussions.
+	//
+	// Deprecated: Do not use.
+	Preimage string `protobuf:"bytes,12,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//* The payment request this message was paid to.
+	//
+	//If empty, corresponds to a spontaneous payment.
+	//
+	// Deprecated: Do not use.
+	PayReq string `protobuf:"bytes,13,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+	//*
+	//The associated Lightning entity.
+	//
+	//Not set for messages exchanged over custom peer messages.
+	//
+	// Types that are assignable to LightningData:
+	//	*Message_Payments
+	//	*Message_Invoice
+	LightningData isMessage_LightningData `protobuf_oneof:"lightning_data"`
+	//* Whether the message was exchanged over custom peer messages.
+	PeerTransport bool `protobuf:"varint,16,opt,name=peer_transport,json=peerTransport,proto3" json:"peer_transport,omitempty"`
+	//*
+	//The messaging convention the message follows.
+	//
+	//One of "c13n", "keysend" (keysend chat messages of other
+	//Lightning messaging apps) or "boostagram" (podcasting boostagrams).
+	//Empty for messages stored by earlier versions.
+	Source string `protobuf:"bytes,17,opt,name=source,proto3" json:"source,omitempty"`
+	//*
+	//The transfer id of the attachment accompanying the message, if any.
+	//
+	//The attachment can be retrieved with GetAttachment.
+	AttachmentId string `protobuf:"bytes,18,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	//*
+	//The hash identifying the message.
+	//
+	//Replies, reactions, edits and deletions reference messages by their hash.
+	MessageHash []byte `protobuf:"bytes,19,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+	//* The control the message carries, if any.
+	Control *MessageControl `protobuf:"bytes,20,opt,name=control,proto3" json:"control,omitempty"`
+	//*
+	//The reactions to the message.
+	//
+	//Populated only in discussion history.
+	Reactions []*Reaction `protobuf:"bytes,21,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	//*
+	//The versions of the message, oldest first, if it was edited.
+	//
+	//The payload is the latest version.
+	//Populated only in discussion history.
+	Edits []*MessageEdit `protobuf:"bytes,22,rep,name=edits,proto3" json:"edits,omitempty"`
+	//*
+	//Whether the message was deleted by its sender,
+	//in which case its payload is cleared.
+	//
+	//Populated only in discussion history.
+	Deleted bool `protobuf:"varint,23,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Message) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Message) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *Message) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Message) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *Message) GetSenderVerified() bool {
+	if x != nil {
+		return x.SenderVerified
+	}
+	return false
+}
+
+func (x *Message) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *Message) GetAmtMsat() int64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (x *Message) GetTotalFeesMsat() int64 {
+	if x != nil {
+		return x.TotalFeesMsat
+	}
+	return 0
+}
+
+func (x *Message) GetSentTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentTimestamp
+	}
+	return nil
+}
+
+func (x *Message) GetReceivedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedTimestamp
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *Message) GetPaymentRoutes() []*PaymentRoute {
+	if x != nil {
+		return x.PaymentRoutes
+	}
+	return nil
+}
+
+// Deprecated: Do not use.
+func (x *Message) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *Message) GetPayReq() string {
+	if x != nil {
+		return x.PayReq
+	}
+	return ""
+}
+
+func (m *Message) GetLightningData() isMessage_LightningData {
+	if m != nil {
+		return m.LightningData
+	}
+	return nil
+}
+
+func (x *Message) GetPayments() *Payments {
+	if x, ok := x.GetLightningData().(*Message_Payments); ok {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *Message) GetInvoice() *Invoice {
+	if x, ok := x.GetLightningData().(*Message_Invoice); ok {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *Message) GetPeerTransport() bool {
+	if x != nil {
+		return x.PeerTransport
+	}
+	return false
+}
+
+func (x *Message) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Message) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *Message) GetMessageHash() []byte {
+	if x != nil {
+		return x.MessageHash
+	}
+	return nil
+}
+
+func (x *Message) GetControl() *MessageControl {
+	if x != nil {
+		return x.Control
+	}
+	return nil
+}
+
+func (x *Message) GetReactions() []*Reaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *Message) GetEdits() []*MessageEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+func (x *Message) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type isMessage_LightningData interface {
+	isMessage_LightningData()
+}
+
+type Message_Payments struct {
+	Payments *Payments `protobuf:"bytes,14,opt,name=payments,proto3,oneof"`
+}
+
+type Message_Invoice struct {
+	Invoice *Invoice `protobuf:"bytes,15,opt,name=invoice,proto3,oneof"`
+}
+
+func (*Message_Payments) isMessage_LightningData() {}
+
+func (*Message_Invoice) isMessage_LightningData() {}
+
+//*
+//Represents a message control, relating a message
+//to a previous message of the same discussion.
+//
+//Edits and deletions are applied by recipients only if
+//signed by the sender of the referenced message.
+type MessageControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The control type.
+	Type ControlType `protobuf:"varint,1,opt,name=type,proto3,enum=services.ControlType" json:"type,omitempty"`
+	//* The hash of the referenced message.
+	Ref []byte `protobuf:"bytes,2,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *MessageControl) Reset() {
+	*x = MessageControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageControl) ProtoMessage() {}
+
+func (x *MessageControl) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageControl.ProtoReflect.Descriptor instead.
+func (*MessageControl) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MessageControl) GetType() ControlType {
+	if x != nil {
+		return x.Type
+	}
+	return ControlType_CONTROL_NONE
+}
+
+func (x *MessageControl) GetRef() []byte {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+//* Represents a reaction to a message.
+type Reaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The Lightning address of the reacting node.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	//* Whether the sender was verified via signature.
+	SenderVerified bool `protobuf:"varint,2,opt,name=sender_verified,json=senderVerified,proto3" json:"sender_verified,omitempty"`
+	//* The reaction (such as an emoji).
+	Reaction string `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	//* The time the reaction was stored.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Reaction) Reset() {
+	*x = Reaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reaction) ProtoMessage() {}
+
+func (x *Reaction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reaction.ProtoReflect.Descriptor instead.
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Reaction) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Reaction) GetSenderVerified() bool {
+	if x != nil {
+		return x.SenderVerified
+	}
+	return false
+}
+
+func (x *Reaction) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *Reaction) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+//* Represents a version of an edited message.
+type MessageEdit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The message payload.
+	Payload string `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	//* The time the version was stored.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *MessageEdit) Reset() {
+	*x = MessageEdit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageEdit) ProtoMessage() {}
+
+func (x *MessageEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageEdit.ProtoReflect.Descriptor instead.
+func (*MessageEdit) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *MessageEdit) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *MessageEdit) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+//* Represents a route fulfilling a payment HTLC.
+type PaymentRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The list of hops for this route.
+	Hops []*PaymentHop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
+	//* The total timelock of the route.
+	TotalTimelock uint32 `protobuf:"varint,2,opt,name=total_timelock,json=totalTimelock,proto3" json:"total_timelock,omitempty"`
+	//* The amount sent via this route, disregarding the route fees (in millisatoshi).
+	RouteAmtMsat int64 `protobuf:"varint,3,opt,name=route_amt_msat,json=routeAmtMsat,proto3" json:"route_amt_msat,omitempty"`
+	//* The fees paid for this route (in millisatoshi).
+	RouteFeesMsat int64 `protobuf:"varint,4,opt,name=route_fees_msat,json=routeFeesMsat,proto3" json:"route_fees_msat,omitempty"`
+}
+
+func (x *PaymentRoute) Reset() {
+	*x = PaymentRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentRoute) ProtoMessage() {}
+
+func (x *PaymentRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentRoute.ProtoReflect.Descriptor instead.
+func (*PaymentRoute) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *PaymentRoute) GetHops() []*PaymentHop {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+func (x *PaymentRoute) GetTotalTimelock() uint32 {
+	if x != nil {
+		return x.TotalTimelock
+	}
+	return 0
+}
+
+func (x *PaymentRoute) GetRouteAmtMsat() int64 {
+	if x != nil {
+		return x.RouteAmtMsat
+	}
+	return 0
+}
+
+func (x *PaymentRoute) GetRouteFeesMsat() int64 {
+	if x != nil {
+		return x.RouteFeesMsat
+	}
+	return 0
+}
+
+//* Represents a hop of a route of a message.
+type PaymentHop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The channel id.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The address of the hop node.
+	HopAddress string `protobuf:"bytes,2,opt,name=hop_address,json=hopAddress,proto3" json:"hop_address,omitempty"`
+	//* The amount to be forwarded by the hop (in millisatoshi).
+	AmtToForwardMsat int64 `protobuf:"varint,3,opt,name=amt_to_forward_msat,json=amtToForwardMsat,proto3" json:"amt_to_forward_msat,omitempty"`
+	//* The fee to be paid to the hop for forwarding the message (in millisatoshi).
+	FeeMsat int64 `protobuf:"varint,4,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	//* The HTLC expiry height at the hop.
+	Expiry uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (x *PaymentHop) Reset() {
+	*x = PaymentHop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentHop) ProtoMessage() {}
+
+func (x *PaymentHop) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentHop.ProtoReflect.Descriptor instead.
+func (*PaymentHop) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PaymentHop) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
+	}
+	return 0
+}
+
+func (x *PaymentHop) GetHopAddress() string {
+	if x != nil {
+		return x.HopAddress
+	}
+	return ""
+}
+
+func (x *PaymentHop) GetAmtToForwardMsat() int64 {
+	if x != nil {
+		return x.AmtToForwardMsat
+	}
+	return 0
+}
+
+func (x *PaymentHop) GetFeeMsat() int64 {
+	if x != nil {
+		return x.FeeMsat
+	}
+	return 0
+}
+
+func (x *PaymentHop) GetExpiry() uint32 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+//* Represents messaging options.
+type MessageOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The maximum fee allowed for a message (in millisatoshi).
+	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
+	//* Whether to include the sender address when sending a message.
+	Anonymous bool `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	//*
+	//The transport used for sending a message.
+	//
+	//If set to TRANSPORT_AUTO, the discussion transport option is used.
+	Transport MessageTransport `protobuf:"varint,3,opt,name=transport,proto3,enum=services.MessageTransport" json:"transport,omitempty"`
+	//*
+	//The maximum number of partial payments a message payment may be split into.
+	//
+	//If not set, the discussion option is used.
+	MaxShards uint32 `protobuf:"varint,4,opt,name=max_shards,json=maxShards,proto3" json:"max_shards,omitempty"`
+	//*
+	//The maximum amount carried by each partial payment (in millisatoshi).
+	//
+	//If not set, the discussion option is used.
+	MaxShardSizeMsat uint64 `protobuf:"varint,5,opt,name=max_shard_size_msat,json=maxShardSizeMsat,proto3" json:"max_shard_size_msat,omitempty"`
+	//*
+	//Whether to send spontaneous message payments as AMP payments,
+	//which can be split across channels.
+	//The recipients must accept AMP payments.
+	Amp bool `protobuf:"varint,6,opt,name=amp,proto3" json:"amp,omitempty"`
+	//*
+	//The ids of the channels message payments may be sent over.
+	//
+	//If not set, the discussion option is used.
+	OutgoingChanIds []uint64 `protobuf:"varint,7,rep,packed,name=outgoing_chan_ids,json=outgoingChanIds,proto3" json:"outgoing_chan_ids,omitempty"`
+	//*
+	//The address of the node message payments must reach the recipient through.
+	//
+	//If not set, the discussion option is used.
+	LastHopAddress string `protobuf:"bytes,8,opt,name=last_hop_address,json=lastHopAddress,proto3" json:"last_hop_address,omitempty"`
+	//*
+	//The addresses of nodes message payments must not be routed through.
+	//
+	//Ignored nodes or channels and a minimum success probability
+	//restrict message payments to queried routes, which are not split
+	//and cannot pay payment requests (including LNURLs) or AMP payments.
+	IgnoredNodes []string `protobuf:"bytes,9,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	//* The ids of channels message payments must not be routed over.
+	IgnoredChannels []uint64 `protobuf:"varint,10,rep,packed,name=ignored_channels,json=ignoredChannels,proto3" json:"ignored_channels,omitempty"`
+	//*
+	//The maximum total timelock delta (in blocks) of message payments routes.
+	//
+	//If not set, the discussion option is used.
+	CltvLimit uint32 `protobuf:"varint,11,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	//*
+	//The minimum estimated success probability of message payments routes.
+	//
+	//If not set, the discussion option is used.
+	MinSuccessProb float64 `protobuf:"fixed64,12,opt,name=min_success_prob,json=minSuccessProb,proto3" json:"min_success_prob,omitempty"`
+	//*
+	//The maximum fee limit (in millisatoshi) that retries of failed
+	//message payments may raise the fee limit to.
+	//
+	//If not set, the discussion option is used.
+	MaxFeeLimitMsat int64 `protobuf:"varint,13,opt,name=max_fee_limit_msat,json=maxFeeLimitMsat,proto3" json:"max_fee_limit_msat,omitempty"`
+}
+
+func (x *MessageOptions) Reset() {
+	*x = MessageOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageOptions) ProtoMessage() {}
+
+func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *MessageOptions) GetFeeLimitMsat() int64 {
+	if x != nil {
+		return x.FeeLimitMsat
+	}
+	return 0
+}
+
+func (x *MessageOptions) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *MessageOptions) GetTransport() MessageTransport {
+	if x != nil {
+		return x.Transport
+	}
+	return MessageTransport_TRANSPORT_AUTO
+}
+
+func (x *MessageOptions) GetMaxShards() uint32 {
+	if x != nil {
+		return x.MaxShards
+	}
+	return 0
+}
+
+func (x *MessageOptions) GetMaxShardSizeMsat() uint64 {
+	if x != nil {
+		return x.MaxShardSizeMsat
+	}
+	return 0
+}
+
+func (x *MessageOptions) GetAmp() bool {
+	if x != nil {
+		return x.Amp
+	}
+	return false
+}
+
+func (x *MessageOptions) GetOutgoingChanIds() []uint64 {
+	if x != nil {
+		return x.OutgoingChanIds
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetLastHopAddress() string {
+	if x != nil {
+		return x.LastHopAddress
+	}
+	return ""
+}
+
+func (x *MessageOptions) GetIgnoredNodes() []string {
+	if x != nil {
+		return x.IgnoredNodes
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetIgnoredChannels() []uint64 {
+	if x != nil {
+		return x.IgnoredChannels
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetCltvLimit() uint32 {
+	if x != nil {
+		return x.CltvLimit
+	}
+	return 0
+}
+
+func (x *MessageOptions) GetMinSuccessProb() float64 {
+	if x != nil {
+		return x.MinSuccessProb
+	}
+	return 0
+}
+
+func (x *MessageOptions) GetMaxFeeLimitMsat() int64 {
+	if x != nil {
+		return x.MaxFeeLimitMsat
+	}
+	return 0
+}
+
+//* Corresponds to a request to estimate a message.
+// Deprecated: Do not use.
+type EstimateMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id where the message is to be sent.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The message payload (as a string).
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	//* The intended payment amount to the recipient of the message (in millisatoshi).
+	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The message option overrides for the current message.
+	Options *MessageOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *EstimateMessageRequest) Reset() {
+	*x = EstimateMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateMessageRequest) ProtoMessage() {}
+
+func (x *EstimateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateMessageRequest.ProtoReflect.Descriptor instead.
+func (*EstimateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *EstimateMessageRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *EstimateMessageRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *EstimateMessageRequest) GetAmtMsat() int64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *EstimateMessageRequest) GetOptions() *MessageOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+//* A EstimateMessageResponse is received in response to a EstimateMessage rpc call.
+type EstimateMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	//The probability of successful arrival of the message,
+	//as reported by the Lightning daemon's mission control.
+	SuccessProb float64 `protobuf:"fixed64,1,opt,name=success_prob,json=successProb,proto3" json:"success_prob,omitempty"`
+	//* Contains the estimated route and fees for the requested message.
+	Message *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	//*
+	//The estimated onion space (in bytes) remaining after the message payload,
+	//across the estimated routes.
+	//
+	//A negative value signifies that the payload does not fit,
+	//in which case sending the message will fail.
+	PayloadBudgetBytes int64 `protobuf:"varint,3,opt,name=payload_budget_bytes,json=payloadBudgetBytes,proto3" json:"payload_budget_bytes,omitempty"`
+}
+
+func (x *EstimateMessageResponse) Reset() {
+	*x = EstimateMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateMessageResponse) ProtoMessage() {}
+
+func (x *EstimateMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateMessageResponse.ProtoReflect.Descriptor instead.
+func (*EstimateMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *EstimateMessageResponse) GetSuccessProb() float64 {
+	if x != nil {
+		return x.SuccessProb
+	}
+	return 0
+}
+
+func (x *EstimateMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *EstimateMessageResponse) GetPayloadBudgetBytes() int64 {
+	if x != nil {
+		return x.PayloadBudgetBytes
+	}
+	return 0
+}
+
+//* Corresponds to a request to send a message.
+// Deprecated: Do not use.
+type SendMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id where the message is to be sent.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The message payload (as a string).
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	//* The intended payment amount to the recipient of the message (in millisatoshi).
+	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* A payment request (invoice) to pay to.
+	//
+	//If empty, a spontaneous message is sent.
+	//If specified, discussion_id is not used and should not be specified.
+	//Instead, the message will be sent to the discussion associated with
+	//the recipient specified by the the payment request (which will be
+	//created if it does not exist).
+	//The discussion_id will be returned in the response.
+	PayReq string `protobuf:"bytes,5,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+	//* The message option overrides for the current message.
+	Options *MessageOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageRequest) ProtoMessage() {}
+
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *SendMessageRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetAmtMsat() int64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetPayReq() string {
+	if x != nil {
+		return x.PayReq
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetOptions() *MessageOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+//* A SendMessageResponse is received in response to a SendMessage rpc call.
+type SendMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The sent message.
+	SentMessage *Message `protobuf:"bytes,1,opt,name=sent_message,json=sentMessage,proto3" json:"sent_message,omitempty"`
+}
+
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendMessageResponse) ProtoMessage() {}
+
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SendMessageResponse) GetSentMessage() *Message {
+	if x != nil {
+		return x.SentMessage
+	}
+	return nil
+}
+
+//*
+//Corresponds to a request to create a stream
+//over which to be notified of received messages.
+//
+// Deprecated: Do not use.
+type SubscribeMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeMessageRequest) Reset() {
+	*x = SubscribeMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeMessageRequest) ProtoMessage() {}
+
+func (x *SubscribeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeMessageRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{95}
+}
+
+//*
+//A SubscribeMessageResponse is received in the stream returned in response to
+//a SubscribeMessages rpc call, and represents a received message.
+type SubscribeMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The received message.
+	ReceivedMessage *Message `protobuf:"bytes,1,opt,name=received_message,json=receivedMessage,proto3" json:"received_message,omitempty"`
+}
+
+func (x *SubscribeMessageResponse) Reset() {
+	*x = SubscribeMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeMessageResponse) ProtoMessage() {}
+
+func (x *SubscribeMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeMessageResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SubscribeMessageResponse) GetReceivedMessage() *Message {
+	if x != nil {
+		return x.ReceivedMessage
+	}
+	return nil
+}
+
+//* Represents an attachment.
+type AttachmentInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The transfer id of the attachment.
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	//* The discussion id the attachment is associated with.
+	DiscussionId uint64 `protobuf:"varint,2,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The Lightning address of the sender.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	//* Whether the sender signed all received chunks.
+	SenderVerified bool `protobuf:"varint,4,opt,name=sender_verified,json=senderVerified,proto3" json:"sender_verified,omitempty"`
+	//* Whether the attachment was received.
+	Incoming bool `protobuf:"varint,5,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	//* The file name of the attachment.
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	//* The MIME type of the attachment.
+	MimeType string `protobuf:"bytes,7,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	//* The size of the attachment (in bytes).
+	Size uint64 `protobuf:"varint,8,opt,name=size,proto3" json:"size,omitempty"`
+	//* The SHA-256 hash of the attachment contents.
+	ContentHash []byte `protobuf:"bytes,9,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	//* The number of chunks the attachment is split into.
+	TotalChunks uint32 `protobuf:"varint,10,opt,name=total_chunks,json=totalChunks,proto3" json:"total_chunks,omitempty"`
+	//*
+	//The number of chunks transferred.
+	//
+	//For outgoing attachments, these are the chunks
+	//delivered to all discussion participants.
+	TransferredChunks uint32 `protobuf:"varint,11,opt,name=transferred_chunks,json=transferredChunks,proto3" json:"transferred_chunks,omitempty"`
+	//* Whether the transfer is complete.
+	Complete bool `protobuf:"varint,12,opt,name=complete,proto3" json:"complete,omitempty"`
+	//* The creation time of the attachment.
+	CreatedTimestamp *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+}
+
+func (x *AttachmentInfo) Reset() {
+	*x = AttachmentInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachmentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentInfo) ProtoMessage() {}
+
+func (x *AttachmentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentInfo.ProtoReflect.Descriptor instead.
+func (*AttachmentInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AttachmentInfo) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *AttachmentInfo) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetSenderVerified() bool {
+	if x != nil {
+		return x.SenderVerified
+	}
+	return false
+}
+
+func (x *AttachmentInfo) GetIncoming() bool {
+	if x != nil {
+		return x.Incoming
+	}
+	return false
+}
+
+func (x *AttachmentInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *AttachmentInfo) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AttachmentInfo) GetContentHash() []byte {
+	if x != nil {
+		return x.ContentHash
+	}
+	return nil
+}
+
+func (x *AttachmentInfo) GetTotalChunks() uint32 {
+	if x != nil {
+		return x.TotalChunks
+	}
+	return 0
+}
+
+func (x *AttachmentInfo) GetTransferredChunks() uint32 {
+	if x != nil {
+		return x.TransferredChunks
+	}
+	return 0
+}
+
+func (x *AttachmentInfo) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *AttachmentInfo) GetCreatedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTimestamp
+	}
+	return nil
+}
+
+//* Corresponds to a request to send an attachment.
+type SendAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id where the attachment is to be sent.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The file name of the attachment.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//* The MIME type of the attachment.
+	MimeType string `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	//* The attachment contents (up to 256 KiB).
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	//* The message accompanying the attachment (may be empty).
+	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	//*
+	//The message option overrides for the current attachment.
+	//
+	//Anonymous attachments are disallowed.
+	Options *MessageOptions `protobuf:"bytes,6,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *SendAttachmentRequest) Reset() {
+	*x = SendAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAttachmentRequest) ProtoMessage() {}
+
+func (x *SendAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*SendAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SendAttachmentRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *SendAttachmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SendAttachmentRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *SendAttachmentRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendAttachmentRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *SendAttachmentRequest) GetOptions() *MessageOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+//* A SendAttachmentResponse is received in response to a SendAttachment call.
+type SendAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The sent attachment.
+	Attachment *AttachmentInfo `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+}
+
+func (x *SendAttachmentResponse) Reset() {
+	*x = SendAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAttachmentResponse) ProtoMessage() {}
+
+func (x *SendAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*SendAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SendAttachmentResponse) GetAttachment() *AttachmentInfo {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+//* Corresponds to a request to resume the transfer of an attachment.
+type ResumeAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The transfer id of the attachment.
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	//* The message option overrides for the resumed transfer.
+	Options *MessageOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *ResumeAttachmentRequest) Reset() {
+	*x = ResumeAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeAttachmentRequest) ProtoMessage() {}
+
+func (x *ResumeAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*ResumeAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ResumeAttachmentRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *ResumeAttachmentRequest) GetOptions() *MessageOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+//* A ResumeAttachmentResponse is received in response to a ResumeAttachment call.
+type ResumeAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The resumed attachment.
+	Attachment *AttachmentInfo `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+}
+
+func (x *ResumeAttachmentResponse) Reset() {
+	*x = ResumeAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeAttachmentResponse) ProtoMessage() {}
+
+func (x *ResumeAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*ResumeAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ResumeAttachmentResponse) GetAttachment() *AttachmentInfo {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+//* Corresponds to a request to retrieve an attachment.
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The transfer id of the attachment.
+	TransferId string `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetAttachmentRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+//*
+//A GetAttachmentResponse is received in the stream returned in response to
+//a GetAttachment rpc call, and carries either the attachment info
+//or a part of the attachment contents.
+type GetAttachmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Content:
+	//	*GetAttachmentResponse_Info
+	//	*GetAttachmentResponse_Data
+	Content isGetAttachmentResponse_Content `protobuf_oneof:"content"`
+}
+
+func (x *GetAttachmentResponse) Reset() {
+	*x = GetAttachmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentResponse) ProtoMessage() {}
+
+func (x *GetAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*GetAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{103}
+}
+
+func (m *GetAttachmentResponse) GetContent() isGetAttachmentResponse_Content {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (x *GetAttachmentResponse) GetInfo() *AttachmentInfo {
+	if x, ok := x.GetContent().(*GetAttachmentResponse_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *GetAttachmentResponse) GetData() []byte {
+	if x, ok := x.GetContent().(*GetAttachmentResponse_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+type isGetAttachmentResponse_Content interface {
+	isGetAttachmentResponse_Content()
+}
+
+type GetAttachmentResponse_Info struct {
+	//* The attachment info.
+	Info *AttachmentInfo `protobuf:"bytes,1,opt,name=info,proto3,oneof"`
+}
+
+type GetAttachmentResponse_Data struct {
+	//* A part of the attachment contents.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3,oneof"`
+}
+
+func (*GetAttachmentResponse_Info) isGetAttachmentResponse_Content() {}
+
+func (*GetAttachmentResponse_Data) isGetAttachmentResponse_Content() {}
+
+//* Represents the information for a specific discussion.
+type DiscussionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* The list of participants in the discussion.
+	Participants []string `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	//* The default options applicable for all discussion messages.
+	Options *DiscussionOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	//* The id of the last read message in the discussion.
+	LastReadMsgId uint64 `protobuf:"varint,4,opt,name=last_read_msg_id,json=lastReadMsgId,proto3" json:"last_read_msg_id,omitempty"`
+	//* The id of the last discussion message.
+	LastMsgId uint64 `protobuf:"varint,5,opt,name=last_msg_id,json=lastMsgId,proto3" json:"last_msg_id,omitempty"`
+	//*
+	//The LNURL or Lightning Address the discussion messages are paid through, if any.
+	//
+	//Such discussions are distinct from the discussion with the node of the service.
+	Lnurl string `protobuf:"bytes,6,opt,name=lnurl,proto3" json:"lnurl,omitempty"`
+}
+
+func (x *DiscussionInfo) Reset() {
+	*x = DiscussionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscussionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscussionInfo) ProtoMessage() {}
+
+func (x *DiscussionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscussionInfo.ProtoReflect.Descriptor instead.
+func (*DiscussionInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DiscussionInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiscussionInfo) GetParticipants() []string {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *DiscussionInfo) GetOptions() *DiscussionOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *DiscussionInfo) GetLastReadMsgId() uint64 {
+	if x != nil {
+		return x.LastReadMsgId
+	}
+	return 0
+}
+
+func (x *DiscussionInfo) GetLastMsgId() uint64 {
+	if x != nil {
+		return x.LastMsgId
+	}
+	return 0
+}
+
+func (x *DiscussionInfo) GetLnurl() string {
+	if x != nil {
+		return x.Lnurl
+	}
+	return ""
+}
+
+//* DiscussionOptions represents the per-discussion options.
+type DiscussionOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The maximum fee allowed for sending a message (in millisatoshi).
+	//
+	//If not set, the default fee limit, as defined in the app package, is used.
+	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
+	//* Whether to send as anonymous on this discussion.
+	Anonymous bool `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	//* The transport used for sending messages on this discussion.
+	Transport MessageTransport `protobuf:"varint,3,opt,name=transport,proto3,enum=services.MessageTransport" json:"transport,omitempty"`
+	//*
+	//The maximum number of partial payments a message payment may be split into.
+	//
+	//If not set, the node default is used.
+	MaxShards uint32 `protobuf:"varint,4,opt,name=max_shards,json=maxShards,proto3" json:"max_shards,omitempty"`
+	//*
+	//The maximum amount carried by each partial payment (in millisatoshi).
+	//
+	//If not set, partial payment amounts are not restricted.
+	MaxShardSizeMsat uint64 `protobuf:"varint,5,opt,name=max_shard_size_msat,json=maxShardSizeMsat,proto3" json:"max_shard_size_msat,omitempty"`
+	//* Whether to send spontaneous message payments as AMP payments.
+	Amp bool `protobuf:"varint,6,opt,name=amp,proto3" json:"amp,omitempty"`
+	//*
+	//The ids of the channels message payments may be sent over.
+	//
+	//If not set, it is not restricted.
+	OutgoingChanIds []uint64 `protobuf:"varint,7,rep,packed,name=outgoing_chan_ids,json=outgoingChanIds,proto3" json:"outgoing_chan_ids,omitempty"`
+	//*
+	//The address of the node message payments must reach the recipient through.
+	//
+	//If not set, it is not restricted.
+	LastHopAddress string `protobuf:"bytes,8,opt,name=last_hop_address,json=lastHopAddress,proto3" json:"last_hop_address,omitempty"`
+	//*
+	//The addresses of nodes message payments must not be routed through.
+	//
+	//Ignored nodes or channels and a minimum success probability
+	//restrict message payments to queried routes, which are not split
+	//and cannot pay payment requests (including LNURLs) or AMP payments.
+	IgnoredNodes []string `protobuf:"bytes,9,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	//* The ids of channels message payments must not be routed over.
+	IgnoredChannels []uint64 `protobuf:"varint,10,rep,packed,name=ignored_channels,json=ignoredChannels,proto3" json:"ignored_channels,omitempty"`
+	//*
+	//The maximum total timelock delta (in blocks) of message payments routes.
+	//
+	//If not set, it is not restricted.
+	CltvLimit uint32 `protobuf:"varint,11,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	//*
+	//The minimum estimated success probability of message payments routes.
+	//
+	//If not set, it is not restricted.
+	MinSuccessProb float64 `protobuf:"fixed64,12,opt,name=min_success_prob,json=minSuccessProb,proto3" json:"min_success_prob,omitempty"`
+	//*
+	//The maximum fee limit (in millisatoshi) that retries of failed
+	//message payments may raise the fee limit to.
+	//
+	//If not set, retries do not raise the fee limit.
+	MaxFeeLimitMsat int64 `protobuf:"varint,13,opt,name=max_fee_limit_msat,json=maxFeeLimitMsat,proto3" json:"max_fee_limit_msat,omitempty"`
+}
+
+func (x *DiscussionOptions) Reset() {
+	*x = DiscussionOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscussionOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscussionOptions) ProtoMessage() {}
+
+func (x *DiscussionOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscussionOptions.ProtoReflect.Descriptor instead.
+func (*DiscussionOptions) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DiscussionOptions) GetFeeLimitMsat() int64 {
+	if x != nil {
+		return x.FeeLimitMsat
+	}
+	return 0
+}
+
+func (x *DiscussionOptions) GetAnonymous() bool {
+	if x != nil {
+		return x.Anonymous
+	}
+	return false
+}
+
+func (x *DiscussionOptions) GetTransport() MessageTransport {
+	if x != nil {
+		return x.Transport
+	}
+	return MessageTransport_TRANSPORT_AUTO
+}
+
+func (x *DiscussionOptions) GetMaxShards() uint32 {
+	if x != nil {
+		return x.MaxShards
+	}
+	return 0
+}
+
+func (x *DiscussionOptions) GetMaxShardSizeMsat() uint64 {
+	if x != nil {
+		return x.MaxShardSizeMsat
+	}
+	return 0
+}
+
+func (x *DiscussionOptions) GetAmp() bool {
+	if x != nil {
+		return x.Amp
+	}
+	return false
+}
+
+func (x *DiscussionOptions) GetOutgoingChanIds() []uint64 {
+	if x != nil {
+		return x.OutgoingChanIds
+	}
+	return nil
+}
+
+func (x *DiscussionOptions) GetLastHopAddress() string {
+	if x != nil {
+		return x.LastHopAddress
+	}
+	return ""
+}
+
+func (x *DiscussionOptions) GetIgnoredNodes() []string {
+	if x != nil {
+		return x.IgnoredNodes
+	}
+	return nil
+}
+
+func (x *DiscussionOptions) GetIgnoredChannels() []uint64 {
+	if x != nil {
+		return x.IgnoredChannels
+	}
+	return nil
+}
+
+func (x *DiscussionOptions) GetCltvLimit() uint32 {
+	if x != nil {
+		return x.CltvLimit
+	}
+	return 0
+}
+
+func (x *DiscussionOptions) GetMinSuccessProb() float64 {
+	if x != nil {
+		return x.MinSuccessProb
+	}
+	return 0
+}
+
+func (x *DiscussionOptions) GetMaxFeeLimitMsat() int64 {
+	if x != nil {
+		return x.MaxFeeLimitMsat
+	}
+	return 0
+}
+
+//* Corresponds to a request to receive all discussion info.
+type GetDiscussionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDiscussionsRequest) Reset() {
+	*x = GetDiscussionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionsRequest) ProtoMessage() {}
+
+func (x *GetDiscussionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscussionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{106}
+}
+
+//*
+//A GetDiscussionsResponse is received in the stream returned in response
+//to a GetDiscussions rpc call, and represents a discussion.
+type GetDiscussionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+}
+
+func (x *GetDiscussionsResponse) Reset() {
+	*x = GetDiscussionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionsResponse) ProtoMessage() {}
+
+func (x *GetDiscussionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscussionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetDiscussionsResponse) GetDiscussion() *DiscussionInfo {
+	if x != nil {
+		return x.Discussion
+	}
+	return nil
+}
+
+//*
+//Corresponds to a request to create a stream over which to receive
+//previously exchanged messages of the identified discussion.
+type GetDiscussionHistoryByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id of interest.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* The pagination options of the request.
+	PageOptions *KeySetPageOptions `protobuf:"bytes,2,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
+}
+
+func (x *GetDiscussionHistoryByIDRequest) Reset() {
+	*x = GetDiscussionHistoryByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionHistoryByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionHistoryByIDRequest) ProtoMessage() {}
+
+func (x *GetDiscussionHistoryByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionHistoryByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscussionHistoryByIDRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetDiscussionHistoryByIDRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetDiscussionHistoryByIDRequest) GetPageOptions() *KeySetPageOptions {
+	if x != nil {
+		return x.PageOptions
+	}
+	return nil
+}
+
+//*
+//A GetDiscussionHistoryResponse is received in response to
+//a GetHistory rpc call, and represents an exchanged message.
+type GetDiscussionHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The exchanged message.
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetDiscussionHistoryResponse) Reset() {
+	*x = GetDiscussionHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionHistoryResponse) ProtoMessage() {}
+
+func (x *GetDiscussionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscussionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetDiscussionHistoryResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+//*
+//Corresponds to a request for statistics about the requested
+//discussion, identified by its id.
+type GetDiscussionStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDiscussionStatisticsRequest) Reset() {
+	*x = GetDiscussionStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionStatisticsRequest) ProtoMessage() {}
+
+func (x *GetDiscussionStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscussionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetDiscussionStatisticsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+//*
+//A GetDiscussionStatisticsResponse is received in response to
+//a GetDiscussionStatistics rpc call.
+type GetDiscussionStatisticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The total amount sent in the discussion (in millisatoshi).
+	AmtMsatSent uint64 `protobuf:"varint,1,opt,name=amt_msat_sent,json=amtMsatSent,proto3" json:"amt_msat_sent,omitempty"`
+	//* The total amount received in the discussion (in millisatoshi).
+	AmtMsatReceived uint64 `protobuf:"varint,2,opt,name=amt_msat_received,json=amtMsatReceived,proto3" json:"amt_msat_received,omitempty"`
+	//* The total amount of fees for sent messages in the discussion (in millisatoshi).
+	AmtMsatFees uint64 `protobuf:"varint,3,opt,name=amt_msat_fees,json=amtMsatFees,proto3" json:"amt_msat_fees,omitempty"`
+	//* The total amount of sent messages in the discussion.
+	MessagesSent uint64 `protobuf:"varint,4,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
+	//* The total amount of received messages in the discussion.
+	MessagesReceived uint64 `protobuf:"varint,5,opt,name=messages_received,json=messagesReceived,proto3" json:"messages_received,omitempty"`
+}
+
+func (x *GetDiscussionStatisticsResponse) Reset() {
+	*x = GetDiscussionStatisticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionStatisticsResponse) ProtoMessage() {}
+
+func (x *GetDiscussionStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscussionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetDiscussionStatisticsResponse) GetAmtMsatSent() uint64 {
+	if x != nil {
+		return x.AmtMsatSent
+	}
+	return 0
+}
+
+func (x *GetDiscussionStatisticsResponse) GetAmtMsatReceived() uint64 {
+	if x != nil {
+		return x.AmtMsatReceived
+	}
+	return 0
+}
+
+func (x *GetDiscussionStatisticsResponse) GetAmtMsatFees() uint64 {
+	if x != nil {
+		return x.AmtMsatFees
+	}
+	return 0
+}
+
+func (x *GetDiscussionStatisticsResponse) GetMessagesSent() uint64 {
+	if x != nil {
+		return x.MessagesSent
+	}
+	return 0
+}
+
+func (x *GetDiscussionStatisticsResponse) GetMessagesReceived() uint64 {
+	if x != nil {
+		return x.MessagesReceived
+	}
+	return 0
+}
+
+//* Corresponds to a request to add a discussion to database.
+type AddDiscussionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+}
+
+func (x *AddDiscussionRequest) Reset() {
+	*x = AddDiscussionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDiscussionRequest) ProtoMessage() {}
+
+func (x *AddDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*AddDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *AddDiscussionRequest) GetDiscussion() *DiscussionInfo {
+	if x != nil {
+		return x.Discussion
+	}
+	return nil
+}
+
+//* An AddDiscussionResponse is received in response to an AddDiscussion rpc call.
+type AddDiscussionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+}
+
+func (x *AddDiscussionResponse) Reset() {
+	*x = AddDiscussionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDiscussionResponse) ProtoMessage() {}
+
+func (x *AddDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*AddDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *AddDiscussionResponse) GetDiscussion() *DiscussionInfo {
+	if x != nil {
+		return x.Discussion
+	}
+	return nil
+}
+
+//* Represents a request to update the last read discussion message.
+type UpdateDiscussionLastReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The message id to mark as the last read.
+	LastReadMsgId uint64 `protobuf:"varint,2,opt,name=last_read_msg_id,json=lastReadMsgId,proto3" json:"last_read_msg_id,omitempty"`
+}
+
+func (x *UpdateDiscussionLastReadRequest) Reset() {
+	*x = UpdateDiscussionLastReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDiscussionLastReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscussionLastReadRequest) ProtoMessage() {}
+
+func (x *UpdateDiscussionLastReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscussionLastReadRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDiscussionLastReadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateDiscussionLastReadRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *UpdateDiscussionLastReadRequest) GetLastReadMsgId() uint64 {
+	if x != nil {
+		return x.LastReadMsgId
+	}
+	return 0
+}
+
+//*
+//An UpdateDiscussionResponse is returned in reponse
+//to an UpdateDiscussionLastRead request.
+type UpdateDiscussionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateDiscussionResponse) Reset() {
+	*x = UpdateDiscussionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscussionResponse) ProtoMessage() {}
+
+func (x *UpdateDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{115}
+}
+
+//* Corresponds to a request to remove a discussion.
+type RemoveDiscussionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The id of the discussion to remove.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveDiscussionRequest) Reset() {
+	*x = RemoveDiscussionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscussionRequest) ProtoMessage() {}
+
+func (x *RemoveDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *RemoveDiscussionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+//* A RemoveDiscussionResponse is received in response to a RemoveDiscussion rpc call.
+type RemoveDiscussionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveDiscussionResponse) Reset() {
+	*x = RemoveDiscussionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscussionResponse) ProtoMessage() {}
+
+func (x *RemoveDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{117}
+}
+
+//* Represents a request to send a message.
+type SendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Destination:
+	//	*SendRequest_DiscussionId
+	//	*SendRequest_PayReq
+	//	*SendRequest_ContactId
+	Destination isSendRequest_Destination `protobuf_oneof:"destination"`
+	//* The intended amount to be used for payment to each recipient.
+	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The message payload.
+	Payload string `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	//* The message options for the current message (overriding any discussion options).
+	Options *MessageOptions `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	//*
+	//The routes to send the message along, one for each discussion participant.
+	//
+	//If set, the message is sent along exactly these routes,
+	//as returned by a message estimate, and the route amounts
+	//and fees are paid instead of amt_msat and the fee limit.
+	//Routes can only be used for messages sent to a discussion.
+	Routes []*PaymentRoute `protobuf:"bytes,6,rep,name=routes,proto3" json:"routes,omitempty"`
+	//*
+	//The control of the message (reply, reaction, edit or deletion request).
+	//
+	//Controls can only be used for messages sent to a discussion,
+	//and cannot be used with routes.
+	//Edits and deletions may only reference messages sent by the node,
+	//and cannot be anonymous.
+	Control *MessageControl `protobuf:"bytes,7,opt,name=control,proto3" json:"control,omitempty"`
+}
+
+func (x *SendRequest) Reset() {
+	*x = SendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendRequest) ProtoMessage() {}
+
+func (x *SendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendRequest.ProtoReflect.Descriptor instead.
+func (*SendRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{118}
+}
+
+func (m *SendRequest) GetDestination() isSendRequest_Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (x *SendRequest) GetDiscussionId() uint64 {
+	if x, ok := x.GetDestination().(*SendRequest_DiscussionId); ok {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *SendRequest) GetPayReq() string {
+	if x, ok := x.GetDestination().(*SendRequest_PayReq); ok {
+		return x.PayReq
+	}
+	return ""
+}
+
+func (x *SendRequest) GetContactId() uint64 {
+	if x, ok := x.GetDestination().(*SendRequest_ContactId); ok {
+		return x.ContactId
+	}
+	return 0
+}
+
+func (x *SendRequest) GetAmtMsat() int64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *SendRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *SendRequest) GetOptions() *MessageOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *SendRequest) GetRoutes() []*PaymentRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *SendRequest) GetControl() *MessageControl {
+	if x != nil {
+		return x.Control
+	}
+	return nil
+}
+
+type isSendRequest_Destination interface {
+	isSendRequest_Destination()
+}
+
+type SendRequest_DiscussionId struct {
+	//* The discussion where the message is to be sent.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3,oneof"`
+}
+
+type SendRequest_PayReq struct {
+	//* The payment request to fulfil.
+	//
+	//If empty, a spontaneous message is sent.
+	//A discussion with the recipient node will be created if it does not exist.
+	//An LNURL or a Lightning Address (user@domain) may also be specified,
+	//in which case an invoice for the amount is requested from the service,
+	//carrying the message payload as comment.
+	//Payloads longer than the comments accepted by the service are rejected.
+	PayReq string `protobuf:"bytes,2,opt,name=pay_req,json=payReq,proto3,oneof"`
+}
+
+type SendRequest_ContactId struct {
+	//* The contact the message is to be sent to.
+	//
+	//If the contact has a Lightning Address, the message is paid through it,
+	//otherwise it is sent to the discussion with the contact node,
+	//which will be created if it does not exist.
+	ContactId uint64 `protobuf:"varint,8,opt,name=contact_id,json=contactId,proto3,oneof"`
+}
+
+func (*SendRequest_DiscussionId) isSendRequest_Destination() {}
+
+func (*SendRequest_PayReq) isSendRequest_Destination() {}
+
+func (*SendRequest_ContactId) isSendRequest_Destination() {}
+
+//* A SendResponse is received in response to a Send rpc call.
+type SendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The sent message.
+	SentMessage *Message `protobuf:"bytes,1,opt,name=sent_message,json=sentMessage,proto3" json:"sent_message,omitempty"`
+}
+
+func (x *SendResponse) Reset() {
+	*x = SendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendResponse) ProtoMessage() {}
+
+func (x *SendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendResponse.ProtoReflect.Descriptor instead.
+func (*SendResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SendResponse) GetSentMessage() *Message {
+	if x != nil {
+		return x.SentMessage
+	}
+	return nil
+}
+
+//* Corresponds to an invoice creation request.
+type CreateInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* Memo of the invoice.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	//* The invoice amount (in millisatoshi).
+	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* Invoice expiry time (in seconds since creation).
+	Expiry int64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	//* Whether to include hints for private channels.
+	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *CreateInvoiceRequest) Reset() {
+	*x = CreateInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceRequest) ProtoMessage() {}
+
+func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CreateInvoiceRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreateInvoiceRequest) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequest) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+//* A CreateInvoiceResponse is received in response to an invoice creation request.
+type CreateInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The created invoice.
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *CreateInvoiceResponse) Reset() {
+	*x = CreateInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceResponse) ProtoMessage() {}
+
+func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *CreateInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+//* Corresponds to a hold invoice creation request.
+type CreateHoldInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* Memo of the invoice.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	//* The payment hash of the invoice (hex encoded).
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	//* The invoice amount (in millisatoshi).
+	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* Invoice expiry time (in seconds since creation).
+	Expiry int64 `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	//* Whether to include hints for private channels.
+	Private bool `protobuf:"varint,5,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *CreateHoldInvoiceRequest) Reset() {
+	*x = CreateHoldInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateHoldInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHoldInvoiceRequest) ProtoMessage() {}
+
+func (x *CreateHoldInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHoldInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateHoldInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *CreateHoldInvoiceRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreateHoldInvoiceRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *CreateHoldInvoiceRequest) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *CreateHoldInvoiceRequest) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *CreateHoldInvoiceRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+//* A CreateHoldInvoiceResponse is received in response to a hold invoice creation request.
+type CreateHoldInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The created invoice.
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *CreateHoldInvoiceResponse) Reset() {
+	*x = CreateHoldInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateHoldInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHoldInvoiceResponse) ProtoMessage() {}
+
+func (x *CreateHoldInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHoldInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*CreateHoldInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CreateHoldInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+//* Corresponds to a hold invoice settlement request.
+type SettleInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The preimage of the invoice payment hash (hex encoded).
+	Preimage string `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
+}
+
+func (x *SettleInvoiceRequest) Reset() {
+	*x = SettleInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettleInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleInvoiceRequest) ProtoMessage() {}
+
+func (x *SettleInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*SettleInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SettleInvoiceRequest) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
+	}
+	return ""
+}
+
+//* A SettleInvoiceResponse is received in response to an invoice settlement request.
+type SettleInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SettleInvoiceResponse) Reset() {
+	*x = SettleInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettleInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleInvoiceResponse) ProtoMessage() {}
+
+func (x *SettleInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*SettleInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{125}
+}
+
+//* Corresponds to an invoice cancellation request.
+type CancelInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The payment hash of the invoice (hex encoded).
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *CancelInvoiceRequest) Reset() {
+	*x = CancelInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceRequest) ProtoMessage() {}
+
+func (x *CancelInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CancelInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *CancelInvoiceRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+//* A CancelInvoiceResponse is received in response to an invoice cancellation request.
+type CancelInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelInvoiceResponse) Reset() {
+	*x = CancelInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvoiceResponse) ProtoMessage() {}
+
+func (x *CancelInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*CancelInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{127}
+}
+
+//* Corresponds to an invoice lookup request.
+type LookupInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* Payment Request
+	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+}
+
+func (x *LookupInvoiceRequest) Reset() {
+	*x = LookupInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupInvoiceRequest) ProtoMessage() {}
+
+func (x *LookupInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*LookupInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *LookupInvoiceRequest) GetPayReq() string {
+	if x != nil {
+		return x.PayReq
+	}
+	return ""
+}
+
+//* A LookupResponse is received in response to an invoice lookup request.
+type LookupInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The returned invoice.
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *LookupInvoiceResponse) Reset() {
+	*x = LookupInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupInvoiceResponse) ProtoMessage() {}
+
+func (x *LookupInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*LookupInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *LookupInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+//* Corresponds to a pay request.
+type PayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Destination:
+	//	*PayRequest_PayReq
+	//	*PayRequest_Address
+	Destination isPayRequest_Destination `protobuf_oneof:"destination"`
+	//* The payment amount (in millisatoshi).
+	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The payment options.
+	Options *PaymentOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *PayRequest) Reset() {
+	*x = PayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayRequest) ProtoMessage() {}
+
+func (x *PayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayRequest.ProtoReflect.Descriptor instead.
+func (*PayRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{130}
+}
+
+func (m *PayRequest) GetDestination() isPayRequest_Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (x *PayRequest) GetPayReq() string {
+	if x, ok := x.GetDestination().(*PayRequest_PayReq); ok {
+		return x.PayReq
+	}
+	return ""
+}
+
+func (x *PayRequest) GetAddress() string {
+	if x, ok := x.GetDestination().(*PayRequest_Address); ok {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PayRequest) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *PayRequest) GetOptions() *PaymentOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type isPayRequest_Destination interface {
+	isPayRequest_Destination()
+}
+
+type PayRequest_PayReq struct {
+	//* The payment request to pay to.
+	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3,oneof"`
+}
+
+type PayRequest_Address struct {
+	//*
+	//The address to pay to.
+	//
+	//An LNURL or a Lightning Address (user@domain) may also be specified,
+	//in which case an invoice for the amount is requested from the service.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
+}
+
+func (*PayRequest_PayReq) isPayRequest_Destination() {}
+
+func (*PayRequest_Address) isPayRequest_Destination() {}
+
+//* PaymentOptions represents the payment's options.
+type PaymentOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The maximum fee allowed for sending a payment.
+	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
+	//*
+	//The maximum number of partial payments the payment may be split into.
+	//
+	//If not set, the node default is used.
+	MaxShards uint32 `protobuf:"varint,2,opt,name=max_shards,json=maxShards,proto3" json:"max_shards,omitempty"`
+	//*
+	//The maximum amount carried by each partial payment (in millisatoshi).
+	//
+	//If not set, partial payment amounts are not restricted.
+	MaxShardSizeMsat uint64 `protobuf:"varint,3,opt,name=max_shard_size_msat,json=maxShardSizeMsat,proto3" json:"max_shard_size_msat,omitempty"`
+	//*
+	//Whether to send a payment to an address as an AMP payment,
+	//which can be split across channels.
+	//The recipient must accept AMP payments.
+	Amp bool `protobuf:"varint,4,opt,name=amp,proto3" json:"amp,omitempty"`
+	//*
+	//The ids of the channels the payment may be sent over.
+	//
+	//If not set, it is not restricted.
+	OutgoingChanIds []uint64 `protobuf:"varint,5,rep,packed,name=outgoing_chan_ids,json=outgoingChanIds,proto3" json:"outgoing_chan_ids,omitempty"`
+	//*
+	//The address of the node the payment must reach the recipient through.
+	//
+	//If not set, it is not restricted.
+	LastHopAddress string `protobuf:"bytes,6,opt,name=last_hop_address,json=lastHopAddress,proto3" json:"last_hop_address,omitempty"`
+	//*
+	//The addresses of nodes the payment must not be routed through.
+	//
+	//Ignored nodes or channels and a minimum success probability
+	//restrict the payment to queried routes, which are not split
+	//and cannot pay payment requests (including LNURLs) or AMP payments.
+	IgnoredNodes []string `protobuf:"bytes,7,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	//* The ids of channels the payment must not be routed over.
+	IgnoredChannels []uint64 `protobuf:"varint,8,rep,packed,name=ignored_channels,json=ignoredChannels,proto3" json:"ignored_channels,omitempty"`
+	//*
+	//The maximum total timelock delta (in blocks) of the payment routes.
+	//
+	//If not set, it is not restricted.
+	CltvLimit uint32 `protobuf:"varint,9,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	//*
+	//The minimum estimated success probability of the payment routes.
+	//
+	//If not set, it is not restricted.
+	MinSuccessProb float64 `protobuf:"fixed64,10,opt,name=min_success_prob,json=minSuccessProb,proto3" json:"min_success_prob,omitempty"`
+}
+
+func (x *PaymentOptions) Reset() {
+	*x = PaymentOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentOptions) ProtoMessage() {}
+
+func (x *PaymentOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentOptions.ProtoReflect.Descriptor instead.
+func (*PaymentOptions) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *PaymentOptions) GetFeeLimitMsat() int64 {
+	if x != nil {
+		return x.FeeLimitMsat
+	}
+	return 0
+}
+
+func (x *PaymentOptions) GetMaxShards() uint32 {
+	if x != nil {
+		return x.MaxShards
+	}
+	return 0
+}
+
+func (x *PaymentOptions) GetMaxShardSizeMsat() uint64 {
+	if x != nil {
+		return x.MaxShardSizeMsat
+	}
+	return 0
+}
+
+func (x *PaymentOptions) GetAmp() bool {
+	if x != nil {
+		return x.Amp
+	}
+	return false
+}
+
+func (x *PaymentOptions) GetOutgoingChanIds() []uint64 {
+	if x != nil {
+		return x.OutgoingChanIds
+	}
+	return nil
+}
+
+func (x *PaymentOptions) GetLastHopAddress() string {
+	if x != nil {
+		return x.LastHopAddress
+	}
+	return ""
+}
+
+func (x *PaymentOptions) GetIgnoredNodes() []string {
+	if x != nil {
+		return x.IgnoredNodes
+	}
+	return nil
+}
+
+func (x *PaymentOptions) GetIgnoredChannels() []uint64 {
+	if x != nil {
+		return x.IgnoredChannels
+	}
+	return nil
+}
+
+func (x *PaymentOptions) GetCltvLimit() uint32 {
+	if x != nil {
+		return x.CltvLimit
+	}
+	return 0
+}
+
+func (x *PaymentOptions) GetMinSuccessProb() float64 {
+	if x != nil {
+		return x.MinSuccessProb
+	}
+	return 0
+}
+
+//* A PayResponse is received in response to a pay request.
+type PayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The returned payment.
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *PayResponse) Reset() {
+	*x = PayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayResponse) ProtoMessage() {}
+
+func (x *PayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayResponse.ProtoReflect.Descriptor instead.
+func (*PayResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *PayResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+//* Represents a Lightning network payment.
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The payment hash of the payment.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	//* The preimage of the payment hash.
+	Preimage string `protobuf:"bytes,2,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//* The payment amount.
+	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The time the payment was created.
+	CreatedTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+	//* The time the payment was finalized.
+	ResolvedTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolved_timestamp,json=resolvedTimestamp,proto3" json:"resolved_timestamp,omitempty"`
+	//* The fulfilled payment request (if any).
+	PayReq string `protobuf:"bytes,6,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+	//* The payment state.
+	State PaymentState `protobuf:"varint,7,opt,name=state,proto3,enum=services.PaymentState" json:"state,omitempty"`
+	//* The payment index.
+	PaymentIndex uint64 `protobuf:"varint,8,opt,name=payment_index,json=paymentIndex,proto3" json:"payment_index,omitempty"`
+	//* The payment HTLCs.
+	HTLCs []*PaymentHTLC `protobuf:"bytes,9,rep,name=HTLCs,proto3" json:"HTLCs,omitempty"`
+	//* The failure category of the payment, set only for failed payments.
+	FailureCategory PaymentFailureCategory `protobuf:"varint,10,opt,name=failure_category,json=failureCategory,proto3,enum=services.PaymentFailureCategory" json:"failure_category,omitempty"`
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *Payment) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Payment) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
+	}
+	return ""
+}
+
+func (x *Payment) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *Payment) GetCreatedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTimestamp
+	}
+	return nil
+}
+
+func (x *Payment) GetResolvedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedTimestamp
+	}
+	return nil
+}
+
+func (x *Payment) GetPayReq() string {
+	if x != nil {
+		return x.PayReq
+	}
+	return ""
+}
+
+func (x *Payment) GetState() PaymentState {
+	if x != nil {
+		return x.State
+	}
+	return PaymentState_PAYMENT_UNKNOWN
+}
+
+func (x *Payment) GetPaymentIndex() uint64 {
+	if x != nil {
+		return x.PaymentIndex
+	}
+	return 0
+}
+
+func (x *Payment) GetHTLCs() []*PaymentHTLC {
+	if x != nil {
+		return x.HTLCs
+	}
+	return nil
+}
+
+func (x *Payment) GetFailureCategory() PaymentFailureCategory {
+	if x != nil {
+		return x.FailureCategory
+	}
+	return PaymentFailureCategory_FAILURE_UNKNOWN
+}
+
+//* Represents an HTLC attempt of a payment.
+type PaymentHTLC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The route of the HTLC.
+	Route *PaymentRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	//* The time the HTLC was sent.
+	AttemptTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=attempt_timestamp,json=attemptTimestamp,proto3" json:"attempt_timestamp,omitempty"`
+	//* The time the HTLC was resolved.
+	ResolveTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=resolve_timestamp,json=resolveTimestamp,proto3" json:"resolve_timestamp,omitempty"`
+	//* The HTLC state.
+	State HTLCState `protobuf:"varint,4,opt,name=state,proto3,enum=services.HTLCState" json:"state,omitempty"`
+	//* The preimage used to settle the HTLC.
+	Preimage string `protobuf:"bytes,5,opt,name=preimage,proto3" json:"preimage,omitempty"`
+}
+
+func (x *PaymentHTLC) Reset() {
+	*x = PaymentHTLC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentHTLC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentHTLC) ProtoMessage() {}
+
+func (x *PaymentHTLC) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentHTLC.ProtoReflect.Descriptor instead.
+func (*PaymentHTLC) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *PaymentHTLC) GetRoute() *PaymentRoute {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *PaymentHTLC) GetAttemptTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AttemptTimestamp
+	}
+	return nil
+}
+
+func (x *PaymentHTLC) GetResolveTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolveTimestamp
+	}
+	return nil
+}
+
+func (x *PaymentHTLC) GetState() HTLCState {
+	if x != nil {
+		return x.State
+	}
+	return HTLCState_HTLC_IN_FLIGHT
+}
+
+func (x *PaymentHTLC) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
+	}
+	return ""
+}
+
+//* Represents an Lightning network invoice.
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The invoice memo.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	//* The preimage hash.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	//* The invoice preimage.
+	Preimage string `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//* The payment request of the invoice.
+	PaymentRequest string `protobuf:"bytes,4,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	//* The value (amount requested) of the invoice (in millisatoshi).
+	ValueMsat uint64 `protobuf:"varint,5,opt,name=value_msat,json=valueMsat,proto3" json:"value_msat,omitempty"`
+	//* The amount paid to the invoice (in millisatoshi).
+	AmtPaidMsat uint64 `protobuf:"varint,6,opt,name=amt_paid_msat,json=amtPaidMsat,proto3" json:"amt_paid_msat,omitempty"`
+	//* The time the invoice was created.
+	CreatedTimestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+	//* The time the invoice was settled.
+	SettledTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=settled_timestamp,json=settledTimestamp,proto3" json:"settled_timestamp,omitempty"`
+	//* The invoice expiry (in seconds since creation time).
+	Expiry int64 `protobuf:"varint,9,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	//* Whether the invoice contains hints for private channels.
+	Private bool `protobuf:"varint,10,opt,name=private,proto3" json:"private,omitempty"`
+	//* Invoice route hints.
+	RouteHints []*RouteHint `protobuf:"bytes,11,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+	//* The invoice state.
+	State InvoiceState `protobuf:"varint,12,opt,name=state,proto3,enum=services.InvoiceState" json:"state,omitempty"`
+	//* The add index of the invoice.
+	AddIndex uint64 `protobuf:"varint,13,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
+	//* The settle index of the invoice.
+	SettleIndex uint64 `protobuf:"varint,14,opt,name=settle_index,json=settleIndex,proto3" json:"settle_index,omitempty"`
+	//* The set of HTLCs paying to the invoice.
+	InvoiceHtlcs []*InvoiceHTLC `protobuf:"bytes,15,rep,name=invoice_htlcs,json=invoiceHtlcs,proto3" json:"invoice_htlcs,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *Invoice) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Invoice) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Invoice) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
+	}
+	return ""
+}
+
+func (x *Invoice) GetPaymentRequest() string {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return ""
+}
+
+func (x *Invoice) GetValueMsat() uint64 {
+	if x != nil {
+		return x.ValueMsat
+	}
+	return 0
+}
+
+func (x *Invoice) GetAmtPaidMsat() uint64 {
+	if x != nil {
+		return x.AmtPaidMsat
+	}
+	return 0
+}
+
+func (x *Invoice) GetCreatedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTimestamp
+	}
+	return nil
+}
+
+func (x *Invoice) GetSettledTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledTimestamp
+	}
+	return nil
+}
+
+func (x *Invoice) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *Invoice) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Invoice) GetRouteHints() []*RouteHint {
+	if x != nil {
+		return x.RouteHints
+	}
+	return nil
+}
+
+func (x *Invoice) GetState() InvoiceState {
+	if x != nil {
+		return x.State
+	}
+	return InvoiceState_INVOICE_OPEN
+}
+
+func (x *Invoice) GetAddIndex() uint64 {
+	if x != nil {
+		return x.AddIndex
+	}
+	return 0
+}
+
+func (x *Invoice) GetSettleIndex() uint64 {
+	if x != nil {
+		return x.SettleIndex
+	}
+	return 0
+}
+
+func (x *Invoice) GetInvoiceHtlcs() []*InvoiceHTLC {
+	if x != nil {
+		return x.InvoiceHtlcs
+	}
+	return nil
+}
+
+//* Represents a route hint for assistance in invoice payment.
+type RouteHint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* A chain of hop hints that can reach the desetination.
+	HopHints []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,json=hopHints,proto3" json:"hop_hints,omitempty"`
+}
+
+func (x *RouteHint) Reset() {
+	*x = RouteHint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteHint) ProtoMessage() {}
+
+func (x *RouteHint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteHint.ProtoReflect.Descriptor instead.
+func (*RouteHint) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RouteHint) GetHopHints() []*HopHint {
+	if x != nil {
+		return x.HopHints
+	}
+	return nil
+}
+
+//* Represents a hop hint.
+type HopHint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* Public key of hop ingress node.
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//* The short channel id of the channel to be used for the hop.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The base fee of the channel (in millisatoshi).
+	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
+	//* The fee rate of the channel (in microsatoshi/sat).
+	FeeRate uint32 `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	//* The timelock delta of the channel.
+	CltvExpiryDelta uint32 `protobuf:"varint,5,opt,name=cltv_expiry_delta,json=cltvExpiryDelta,proto3" json:"cltv_expiry_delta,omitempty"`
+}
+
+func (x *HopHint) Reset() {
+	*x = HopHint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HopHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HopHint) ProtoMessage() {}
+
+func (x *HopHint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HopHint.ProtoReflect.Descriptor instead.
+func (*HopHint) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *HopHint) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *HopHint) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
+	}
+	return 0
+}
+
+func (x *HopHint) GetFeeBaseMsat() uint32 {
+	if x != nil {
+		return x.FeeBaseMsat
+	}
+	return 0
+}
+
+func (x *HopHint) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *HopHint) GetCltvExpiryDelta() uint32 {
+	if x != nil {
+		return x.CltvExpiryDelta
+	}
+	return 0
+}
+
+//* Represents an HTLC paying to an invoice.
+type InvoiceHTLC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The short channel id of the channel the HTLC was arrived.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The amount of this HTLC (in millisatoshi).
+	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* State of the HTLC.
+	State InvoiceHTLCState `protobuf:"varint,3,opt,name=state,proto3,enum=services.InvoiceHTLCState" json:"state,omitempty"`
+	//* HTLC accept timestamp.
+	AcceptTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accept_timestamp,json=acceptTimestamp,proto3" json:"accept_timestamp,omitempty"`
+	//* HTLC resolve timestamp.
+	ResolveTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolve_timestamp,json=resolveTimestamp,proto3" json:"resolve_timestamp,omitempty"`
+	//* Block height at which this HTLC expires.
+	ExpiryHeight int32 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (x *InvoiceHTLC) Reset() {
+	*x = InvoiceHTLC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceHTLC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceHTLC) ProtoMessage() {}
+
+func (x *InvoiceHTLC) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceHTLC.ProtoReflect.Descriptor instead.
+func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *InvoiceHTLC) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
+	}
+	return 0
+}
+
+func (x *InvoiceHTLC) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *InvoiceHTLC) GetState() InvoiceHTLCState {
+	if x != nil {
+		return x.State
+	}
+	return InvoiceHTLCState_INVOICE_HTLC_ACCEPTED
+}
+
+func (x *InvoiceHTLC) GetAcceptTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptTimestamp
+	}
+	return nil
+}
+
+func (x *InvoiceHTLC) GetResolveTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolveTimestamp
+	}
+	return nil
+}
+
+func (x *InvoiceHTLC) GetExpiryHeight() int32 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+//* Corresponds to a subscription request for invoice updates.
+type SubscribeInvoicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeInvoicesRequest) Reset() {
+	*x = SubscribeInvoicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeInvoicesRequest) ProtoMessage() {}
+
+func (x *SubscribeInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{139}
+}
+
+//* Corresponds to a subscription request for payment updates.
+type SubscribePaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribePaymentsRequest) Reset() {
+	*x = SubscribePaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribePaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePaymentsRequest) ProtoMessage() {}
+
+func (x *SubscribePaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePaymentsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{140}
+}
+
+//* Corresponds to a message subscription request.
+type SubscribeMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeMessagesRequest) Reset() {
+	*x = SubscribeMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeMessagesRequest) ProtoMessage() {}
+
+func (x *SubscribeMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{141}
+}
+
+//* Corresponds to a route discovery request.
+type RouteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Destination:
+	//	*RouteRequest_PayReq
+	//	*RouteRequest_Address
+	Destination isRouteRequest_Destination `protobuf_oneof:"destination"`
+	//* The payment amount (in millisatoshi).
+	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The payment options.
+	Options *PaymentOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *RouteRequest) Reset() {
+	*x = RouteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteRequest) ProtoMessage() {}
+
+func (x *RouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteRequest.ProtoReflect.Descriptor instead.
+func (*RouteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{142}
+}
+
+func (m *RouteRequest) GetDestination() isRouteRequest_Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (x *RouteRequest) GetPayReq() string {
+	if x, ok := x.GetDestination().(*RouteRequest_PayReq); ok {
+		return x.PayReq
+	}
+	return ""
+}
+
+func (x *RouteRequest) GetAddress() string {
+	if x, ok := x.GetDestination().(*RouteRequest_Address); ok {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RouteRequest) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *RouteRequest) GetOptions() *PaymentOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type isRouteRequest_Destination interface {
+	isRouteRequest_Destination()
+}
+
+type RouteRequest_PayReq struct {
+	//* The payment request to pay to.
+	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3,oneof"`
+}
+
+type RouteRequest_Address struct {
+	//*
+	//The address to pay to.
+	//
+	//An LNURL or a Lightning Address (user@domain) may also be specified,
+	//in which case an invoice for the amount is requested from the service.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
+}
+
+func (*RouteRequest_PayReq) isRouteRequest_Destination() {}
+
+func (*RouteRequest_Address) isRouteRequest_Destination() {}
+
+//* A RouteResponse is received in response to a route discovery request.
+type RouteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* A route that can fulfil the requested payment.
+	Route *PaymentRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+}
+
+func (x *RouteResponse) Reset() {
+	*x = RouteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteResponse) ProtoMessage() {}
+
+func (x *RouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteResponse.ProtoReflect.Descriptor instead.
+func (*RouteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RouteResponse) GetRoute() *PaymentRoute {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+//* Corresponds to an invoice retrieval request.
+type GetInvoicesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The pagination options of the request.
+	PageOptions *KeySetPageOptions `protobuf:"bytes,1,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
+}
+
+func (x *GetInvoicesRequest) Reset() {
+	*x = GetInvoicesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInvoicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvoicesRequest) ProtoMessage() {}
+
+func (x *GetInvoicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvoicesRequest.ProtoReflect.Descriptor instead.
+func (*GetInvoicesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetInvoicesRequest) GetPageOptions() *KeySetPageOptions {
+	if x != nil {
+		return x.PageOptions
+	}
+	return nil
+}
+
+//* Corresponds to a payment retrieval request.
+type GetPaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The pagination options of the request.
+	PageOptions *KeySetPageOptions `protobuf:"bytes,1,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
+}
+
+func (x *GetPaymentsRequest) Reset() {
+	*x = GetPaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentsRequest) ProtoMessage() {}
+
+func (x *GetPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetPaymentsRequest) GetPageOptions() *KeySetPageOptions {
+	if x != nil {
+		return x.PageOptions
+	}
+	return nil
+}
+
+var File_rpc_services_rpc_proto protoreflect.FileDescriptor
+
+var file_rpc_services_rpc_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6d, 0x77, 0x69, 0x74, 0x6b, 0x6f, 0x77, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a, 0x11, 0x4b,
+	0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x5c, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x41, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x52, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e,
+	0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x66,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x05, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x22, 0x6b, 0x0a, 0x10, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x20, 0x01, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb1, 0x03, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x66,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x14, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x53, 0x61,
+	0x74, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f,
+	0x70, 0x65, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x37, 0x0a, 0x18,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x12, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c,
+	0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2,
+	0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36,
+	0x36, 0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x18,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x3c,
+	0x0a, 0x10, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x43, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xe8, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x08,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x22, 0x60, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a,
+	0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x12,
+	0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x5f,
+	0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x70, 0x75, 0x73, 0x68, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62,
+	0x79, 0x74, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x6a, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x37, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b,
+	0x61, 0x2d, 0x66, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x24, 0x52, 0x0b, 0x66, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x88, 0x04, 0x0a,
+	0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x6e, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65,
+	0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x65, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xe2, 0xdf, 0x1f, 0x13, 0x0a, 0x11, 0x5e,
+	0x28, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x29, 0x3f, 0x24,
+	0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0xf4, 0x03,
+	0x0a, 0x0e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x3b, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52,
+	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x61, 0x63,
+	0x69, 0x74, 0x79, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c,
+	0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12, 0x2c, 0x0a,
+	0x12, 0x6c, 0x69, 0x6d, 0x62, 0x6f, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6c, 0x69, 0x6d, 0x62, 0x6f,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d,
+	0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x74,
+	0x69, 0x6c, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x11, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x54, 0x69, 0x6c, 0x4d, 0x61, 0x74, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x53, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x43, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73,
+	0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x6f,
+	0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe0, 0x02, 0x0a, 0x0d, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x54,
+	0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x74, 0x69, 0x6d, 0x65,
+	0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0xe8, 0x01, 0x0a,
+	0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a,
+	0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x3e, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x5e, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x61, 0x73,
+	0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x20, 0x0a,
+	0x0c, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x50, 0x70, 0x6d, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x68,
+	0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x6d, 0x69, 0x6e, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x22,
+	0x92, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0x1d, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x18, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0f, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x4e, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x6e, 0x49, 0x64, 0x49, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x49, 0x64, 0x4f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x6d, 0x74, 0x5f, 0x69, 0x6e, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6d, 0x74, 0x49,
+	0x6e, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x6d, 0x74, 0x5f, 0x6f, 0x75, 0x74,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6d, 0x74,
+	0x4f, 0x75, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73,
+	0x61, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x6d,
+	0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x6d, 0x74, 0x49, 0x6e, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x6d,
+	0x74, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x61, 0x6d, 0x74, 0x4f, 0x75, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x66, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x19, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22, 0x3e, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61,
+	0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a,
+	0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74,
+	0x65, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x04, 0x55,
+	0x74, 0x78, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a,
+	0x07, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x3b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x75, 0x74,
+	0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73,
+	0x22, 0x5b, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x93, 0x02,
+	0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x65,
+	0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x65, 0x65,
+	0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x64, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x22, 0x55, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x19,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x66, 0x65, 0x65, 0x53, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0x89, 0x02, 0x0a,
+	0x0b, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x6f, 0x64, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x54, 0x6f, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x66,
+	0x61, 0x69, 0x6c, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x3d, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x05, 0x70, 0x61, 0x69,
+	0x72, 0x73, 0x22, 0x7a, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x1f, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x06, 0x74, 0x6f, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x6d,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x1c, 0x0a,
+	0x1a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x74, 0x0a, 0x10, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3e, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x6b, 0x0a, 0x07, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62,
+	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x62, 0x69, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0xf5, 0x01,
+	0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x70, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x36, 0x36, 0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x18, 0x0a,
+	0x16, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x66, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf,
+	0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36,
+	0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22,
+	0x4d, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
+	0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1e,
+	0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5,
+	0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x22, 0x4d, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x22, 0x32, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x0a,
+	0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20,
+	0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x08, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2d, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xef,
+	0x07, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
+	0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x0a,
+	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0x18, 0x01, 0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d,
+	0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01,
+	0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x08, 0x18, 0x01, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x73,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49,
+	0x0a, 0x12, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x0e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x08,
+	0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x48,
+	0x00, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64, 0x69, 0x74, 0x52, 0x05, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x10,
+	0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x4d, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22,
+	0xa1, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x61, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x64,
+	0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x70, 0x52, 0x04, 0x68, 0x6f, 0x70,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x65,
+	0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x35,
+	0x0a, 0x0b, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x0a, 0x68, 0x6f, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x61, 0x6d, 0x74, 0x5f, 0x74, 0x6f, 0x5f,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x61, 0x6d, 0x74, 0x54, 0x6f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x8a, 0x04, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69,
+	0x7a, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x63, 0x6c, 0x74, 0x76, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f,
+	0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x66,
+	0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4d, 0x73, 0x61, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21,
+	0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x17, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x20, 0x01, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62,
+	0x12, 0x33, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a,
+	0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x02, 0x18,
+	0x01, 0x22, 0x53, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x1d, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x60, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x03, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xcf, 0x01, 0x0a,
+	0x15, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52,
+	0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x76, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x18, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x3f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x0e,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
+	0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x60, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6e, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6e, 0x75, 0x72, 0x6c, 0x22, 0x8d, 0x04, 0x0a, 0x11, 0x44, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24,
+	0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f,
+	0x75, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x53, 0x68, 0x61,
+	0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11,
+	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x0f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6c, 0x74, 0x76, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x12, 0x2b, 0x0a, 0x12, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x5a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x64,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20,
+	0x01, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a,
+	0x1f, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61,
+	0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x6d, 0x74, 0x4d,
+	0x73, 0x61, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x61,
+	0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x46, 0x65, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x53, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x22, 0x58, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x64, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x15, 0x41,
+	0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x6f,
+	0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x22,
+	0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x17, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a,
+	0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcc, 0x02, 0x0a, 0x0b, 0x53,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x00, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1f, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x0c, 0x53, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x65, 0x6e,
+	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x77, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61,
+	0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x44, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xa5,
+	0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12,
+	0x28, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2,
+	0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x66, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36,
+	0x34, 0x7d, 0x24, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x48, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x48, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x22, 0x48, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10,
+	0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x66, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x24,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x40, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a,
+	0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x66, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x24, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x0a, 0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65,
+	0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x22,
+	0x44, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61,
+	0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61,
+	0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x03, 0x0a, 0x0e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x6d, 0x61, 0x78, 0x53, 0x68, 0x61, 0x72, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61,
+	0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x6f,
+	0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x48, 0x6f,
+	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x74, 0x76,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6c,
+	0x74, 0x76, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f,
+	0x62, 0x22, 0x3a, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xce, 0x03,
+	0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a,
+	0x12, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f,
+	0x72, 0x65, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x05, 0x48, 0x54, 0x4c, 0x43, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x52, 0x05, 0x48, 0x54, 0x4c, 0x43,
+	0x73, 0x12, 0x4b, 0x0a, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x94,
+	0x02, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x54, 0x4c, 0x43, 0x12, 0x2c,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x11,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x10, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xdd, 0x04, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x61, 0x6d, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x47, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x10, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x52,
+	0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x64,
+	0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x0d, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x48, 0x74, 0x6c, 0x63, 0x73, 0x22, 0x3b, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x48, 0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x48, 0x69, 0x6e,
+	0x74, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x07, 0x48, 0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x22, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6c, 0x74, 0x76, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xa8, 0x02, 0x0a, 0x0b, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61,
+	0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x45, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a,
+	0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70,
+	0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3d, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x54,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x78, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
+	0x15, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x4c, 0x4c, 0x45, 0x54, 0x5f, 0x4c, 0x4f,
+	0x43, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x59, 0x10, 0x03, 0x2a, 0x5d, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47,
+	0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x4e,
+	0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43,
+	0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x01,
+	0x2a, 0xbc, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4f,
+	0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41, 0x4e, 0x4e,
+	0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54, 0x49, 0x56,
+	0x45, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x04, 0x12, 0x20, 0x0a,
+	0x1c, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x46,
+	0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x05, 0x2a,
+	0x90, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x56, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x4c,
+	0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x46, 0x4f, 0x52,
+	0x43, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x52,
+	0x45, 0x41, 0x43, 0x48, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x46, 0x55, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44,
+	0x10, 0x05, 0x2a, 0x3e, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x49, 0x54, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x55, 0x42,
+	0x4b, 0x45, 0x59, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x45,
+	0x53, 0x54, 0x45, 0x44, 0x5f, 0x50, 0x55, 0x42, 0x4b, 0x45, 0x59, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x10, 0x01, 0x2a, 0x6e, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x52,
+	0x45, 0x50, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f,
+	0x4c, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x10, 0x03, 0x12, 0x12,
+	0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x10, 0x04, 0x2a, 0x51, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x52,
+	0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50,
+	0x45, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x64, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x46, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x41, 0x59, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xb2, 0x01, 0x0a, 0x16,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52,
+	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x55, 0x46, 0x46, 0x49, 0x43, 0x49,
+	0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a,
+	0x10, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x4e, 0x4f, 0x5f, 0x52, 0x4f, 0x55, 0x54,
+	0x45, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x49,
+	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x45, 0x54, 0x41, 0x49, 0x4c, 0x53,
+	0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x46, 0x45,
+	0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x57, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x46,
+	0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x05,
+	0x2a, 0x44, 0x0a, 0x09, 0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x0e, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x62, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43,
+	0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x4f,
+	0x49, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x10, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x15, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56,
+	0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48,
+	0x54, 0x4c, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x32,
+	0xbc, 0x05, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c,
+	0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x59, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x11, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73,
+	0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xdf,
+	0x05, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12,
+	0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x64, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5d, 0x0a, 0x16, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5e, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x32, 0x9b, 0x03, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x09, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73,
+	0x70, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4c, 0x0a, 0x0b, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12,
+	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xbd,
+	0x02, 0x0a, 0x12, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x64, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x24, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xd8,
+	0x03, 0x0a, 0x0b, 0x50, 0x65, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x11, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x67, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xeb, 0x02, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x64, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa1, 0x02, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x4f, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x61, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x30, 0x01, 0x32, 0x9d, 0x02, 0x0a, 0x11,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x55, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xf0, 0x05, 0x0a, 0x11,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x12, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x70, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a,
+	0x04, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xdd,
+	0x06, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x22,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x48, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
+	0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1e,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x34, 0x0a, 0x03, 0x50, 0x61, 0x79, 0x12, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x29,
+	0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x33,
+	0x6e, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x31, 0x33, 0x6e, 0x2d, 0x67, 0x6f, 0x2f, 0x72, 0x70, 0x63,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpc_services_rpc_proto_rawDescOnce sync.Once
+	file_rpc_services_rpc_proto_rawDescData = file_rpc_services_rpc_proto_rawDesc
+)
+
+func file_rpc_services_rpc_proto_rawDescGZIP() []byte {
+	file_rpc_services_rpc_proto_rawDescOnce.Do(func() {
+		file_rpc_services_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_services_rpc_proto_rawDescData)
+	})
+	return file_rpc_services_rpc_proto_rawDescData
+}
+
+var file_rpc_services_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_rpc_services_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 146)
+var file_rpc_services_rpc_proto_goTypes = []interface{}{
+	(ConnectionState)(0),                    // 0: services.ConnectionState
+	(PendingChannelState)(0),                // 1: services.PendingChannelState
+	(ChannelCloseState)(0),                  // 2: services.ChannelCloseState
+	(ChannelEventType)(0),                   // 3: services.ChannelEventType
+	(ChannelCloseType)(0),                   // 4: services.ChannelCloseType
+	(AddressType)(0),                        // 5: services.AddressType
+	(ControlType)(0),                        // 6: services.ControlType
+	(MessageTransport)(0),                   // 7: services.MessageTransport
+	(PaymentState)(0),                       // 8: services.PaymentState
+	(PaymentFailureCategory)(0),             // 9: services.PaymentFailureCategory
+	(HTLCState)(0),                          // 10: services.HTLCState
+	(InvoiceState)(0),                       // 11: services.InvoiceState
+	(InvoiceHTLCState)(0),                   // 12: services.InvoiceHTLCState
+	(*KeySetPageOptions)(nil),               // 13: services.KeySetPageOptions
+	(*VersionRequest)(nil),                  // 14: services.VersionRequest
+	(*Version)(nil),                         // 15: services.Version
+	(*StatusRequest)(nil),                   // 16: services.StatusRequest
+	(*StatusResponse)(nil),                  // 17: services.StatusResponse
+	(*NodeInfo)(nil),                        // 18: services.NodeInfo
+	(*SelfInfoRequest)(nil),                 // 19: services.SelfInfoRequest
+	(*Chain)(nil),                           // 20: services.Chain
+	(*SelfInfoResponse)(nil),                // 21: services.SelfInfoResponse
+	(*SelfBalanceRequest)(nil),              // 22: services.SelfBalanceRequest
+	(*SelfBalanceResponse)(nil),             // 23: services.SelfBalanceResponse
+	(*GetNodesRequest)(nil),                 // 24: services.GetNodesRequest
+	(*SearchNodeByAddressRequest)(nil),      // 25: services.SearchNodeByAddressRequest
+	(*SearchNodeByAliasRequest)(nil),        // 26: services.SearchNodeByAliasRequest
+	(*NodeInfoResponse)(nil),                // 27: services.NodeInfoResponse
+	(*GetNodeInfoRequest)(nil),              // 28: services.GetNodeInfoRequest
+	(*NodeAddress)(nil),                     // 29: services.NodeAddress
+	(*GetNodeInfoResponse)(nil),             // 30: services.GetNodeInfoResponse
+	(*ConnectNodeRequest)(nil),              // 31: services.ConnectNodeRequest
+	(*ConnectNodeResponse)(nil),             // 32: services.ConnectNodeResponse
+	(*OpenChannelRequest)(nil),              // 33: services.OpenChannelRequest
+	(*OpenChannelResponse)(nil),             // 34: services.OpenChannelResponse
+	(*ChannelPoint)(nil),                    // 35: services.ChannelPoint
+	(*Channel)(nil),                         // 36: services.Channel
+	(*ListChannelsRequest)(nil),             // 37: services.ListChannelsRequest
+	(*ListChannelsResponse)(nil),            // 38: services.ListChannelsResponse
+	(*PendingChannel)(nil),                  // 39: services.PendingChannel
+	(*ListPendingChannelsRequest)(nil),      // 40: services.ListPendingChannelsRequest
+	(*ListPendingChannelsResponse)(nil),     // 41: services.ListPendingChannelsResponse
+	(*CloseChannelRequest)(nil),             // 42: services.CloseChannelRequest
+	(*CloseChannelResponse)(nil),            // 43: services.CloseChannelResponse
+	(*SubscribeChannelEventsRequest)(nil),   // 44: services.SubscribeChannelEventsRequest
+	(*ClosedChannel)(nil),                   // 45: services.ClosedChannel
+	(*ChannelEvent)(nil),                    // 46: services.ChannelEvent
+	(*GetChannelPolicyRequest)(nil),         // 47: services.GetChannelPolicyRequest
+	(*ChannelPolicy)(nil),                   // 48: services.ChannelPolicy
+	(*UpdateChannelPolicyRequest)(nil),      // 49: services.UpdateChannelPolicyRequest
+	(*UpdateChannelPolicyResponse)(nil),     // 50: services.UpdateChannelPolicyResponse
+	(*ForwardingHistoryRequest)(nil),        // 51: services.ForwardingHistoryRequest
+	(*ForwardingEvent)(nil),                 // 52: services.ForwardingEvent
+	(*ChannelForwardingStats)(nil),          // 53: services.ChannelForwardingStats
+	(*ForwardingHistoryResponse)(nil),       // 54: services.ForwardingHistoryResponse
+	(*NewAddressRequest)(nil),               // 55: services.NewAddressRequest
+	(*NewAddressResponse)(nil),              // 56: services.NewAddressResponse
+	(*SendCoinsRequest)(nil),                // 57: services.SendCoinsRequest
+	(*SendCoinsResponse)(nil),               // 58: services.SendCoinsResponse
+	(*ListUnspentRequest)(nil),              // 59: services.ListUnspentRequest
+	(*Utxo)(nil),                            // 60: services.Utxo
+	(*ListUnspentResponse)(nil),             // 61: services.ListUnspentResponse
+	(*ListTransactionsRequest)(nil),         // 62: services.ListTransactionsRequest
+	(*Transaction)(nil),                     // 63: services.Transaction
+	(*ListTransactionsResponse)(nil),        // 64: services.ListTransactionsResponse
+	(*EstimateFeeRequest)(nil),              // 65: services.EstimateFeeRequest
+	(*EstimateFeeResponse)(nil),             // 66: services.EstimateFeeResponse
+	(*PairHistory)(nil),                     // 67: services.PairHistory
+	(*QueryMissionControlRequest)(nil),      // 68: services.QueryMissionControlRequest
+	(*QueryMissionControlResponse)(nil),     // 69: services.QueryMissionControlResponse
+	(*QueryProbabilityRequest)(nil),         // 70: services.QueryProbabilityRequest
+	(*QueryProbabilityResponse)(nil),        // 71: services.QueryProbabilityResponse
+	(*ResetMissionControlRequest)(nil),      // 72: services.ResetMissionControlRequest
+	(*ResetMissionControlResponse)(nil),     // 73: services.ResetMissionControlResponse
+	(*RouteDiagnostics)(nil),                // 74: services.RouteDiagnostics
+	(*Feature)(nil),                         // 75: services.Feature
+	(*Peer)(nil),                            // 76: services.Peer
+	(*ListPeersRequest)(nil),                // 77: services.ListPeersRequest
+	(*ListPeersResponse)(nil),               // 78: services.ListPeersResponse
+	(*DisconnectPeerRequest)(nil),           // 79: services.DisconnectPeerRequest
+	(*DisconnectPeerResponse)(nil),          // 80: services.DisconnectPeerResponse
+	(*PersistentPeer)(nil),                  // 81: services.PersistentPeer
+	(*AddPersistentPeerRequest)(nil),        // 82: services.AddPersistentPeerRequest
+	(*AddPersistentPeerResponse)(nil),       // 83: services.AddPersistentPeerResponse
+	(*GetPersistentPeersRequest)(nil),       // 84: services.GetPersistentPeersRequest
+	(*GetPersistentPeersResponse)(nil),      // 85: services.GetPersistentPeersResponse
+	(*RemovePersistentPeerRequest)(nil),     // 86: services.RemovePersistentPeerRequest
+	(*RemovePersistentPeerResponse)(nil),    // 87: services.RemovePersistentPeerResponse
+	(*ContactInfo)(nil),                     // 88: services.ContactInfo
+	(*GetContactsRequest)(nil),              // 89: services.GetContactsRequest
+	(*GetContactsResponse)(nil),             // 90: services.GetContactsResponse
+	(*AddContactRequest)(nil),               // 91: services.AddContactRequest
+	(*AddContactResponse)(nil),              // 92: services.AddContactResponse
+	(*RemoveContactByIDRequest)(nil),        // 93: services.RemoveContactByIDRequest
+	(*RemoveContactByAddressRequest)(nil),   // 94: services.RemoveContactByAddressRequest
+	(*RemoveContactResponse)(nil),           // 95: services.RemoveContactResponse
+	(*Payments)(nil),                        // 96: services.Payments
+	(*Message)(nil),                         // 97: services.Message
+	(*MessageControl)(nil),                  // 98: services.MessageControl
+	(*Reaction)(nil),                        // 99: services.Reaction
+	(*MessageEdit)(nil),                     // 100: services.MessageEdit
+	(*PaymentRoute)(nil),                    // 101: services.PaymentRoute
+	(*PaymentHop)(nil),                      // 102: services.PaymentHop
+	(*MessageOptions)(nil),                  // 103: services.MessageOptions
+	(*EstimateMessageRequest)(nil),          // 104: services.EstimateMessageRequest
+	(*EstimateMessageResponse)(nil),         // 105: services.EstimateMessageResponse
+	(*SendMessageRequest)(nil),              // 106: services.SendMessageRequest
+	(*SendMessageResponse)(nil),             // 107: services.SendMessageResponse
+	(*SubscribeMessageRequest)(nil),         // 108: services.SubscribeMessageRequest
+	(*SubscribeMessageResponse)(nil),        // 109: services.SubscribeMessageResponse
+	(*AttachmentInfo)(nil),                  // 110: services.AttachmentInfo
+	(*SendAttachmentRequest)(nil),           // 111: services.SendAttachmentRequest
+	(*SendAttachmentResponse)(nil),          // 112: services.SendAttachmentResponse
+	(*ResumeAttachmentRequest)(nil),         // 113: services.ResumeAttachmentRequest
+	(*ResumeAttachmentResponse)(nil),        // 114: services.ResumeAttachmentResponse
+	(*GetAttachmentRequest)(nil),            // 115: services.GetAttachmentRequest
+	(*GetAttachmentResponse)(nil),           // 116: services.GetAttachmentResponse
+	(*DiscussionInfo)(nil),                  // 117: services.DiscussionInfo
+	(*DiscussionOptions)(nil),               // 118: services.DiscussionOptions
+	(*GetDiscussionsRequest)(nil),           // 119: services.GetDiscussionsRequest
+	(*GetDiscussionsResponse)(nil),          // 120: services.GetDiscussionsResponse
+	(*GetDiscussionHistoryByIDRequest)(nil), // 121: services.GetDiscussionHistoryByIDRequest
+	(*GetDiscussionHistoryResponse)(nil),    // 122: services.GetDiscussionHistoryResponse
+	(*GetDiscussionStatisticsRequest)(nil),  // 123: services.GetDiscussionStatisticsRequest
+	(*GetDiscussionStatisticsResponse)(nil), // 124: services.GetDiscussionStatisticsResponse
+	(*AddDiscussionRequest)(nil),            // 125: services.AddDiscussionRequest
+	(*AddDiscussionResponse)(nil),           // 126: services.AddDiscussionResponse
+	(*UpdateDiscussionLastReadRequest)(nil), // 127: services.UpdateDiscussionLastReadRequest
+	(*UpdateDiscussionResponse)(nil),        // 128: services.UpdateDiscussionResponse
+	(*RemoveDiscussionRequest)(nil),         // 129: services.RemoveDiscussionRequest
+	(*RemoveDiscussionResponse)(nil),        // 130: services.RemoveDiscussionResponse
+	(*SendRequest)(nil),                     // 131: services.SendRequest
+	(*SendResponse)(nil),                    // 132: services.SendResponse
+	(*CreateInvoiceRequest)(nil),            // 133: services.CreateInvoiceRequest
+	(*CreateInvoiceResponse)(nil),           // 134: services.CreateInvoiceResponse
+	(*CreateHoldInvoiceRequest)(nil),        // 135: services.CreateHoldInvoiceRequest
+	(*CreateHoldInvoiceResponse)(nil),       // 136: services.CreateHoldInvoiceResponse
+	(*SettleInvoiceRequest)(nil),            // 137: services.SettleInvoiceRequest
+	(*SettleInvoiceResponse)(nil),           // 138: services.SettleInvoiceResponse
+	(*CancelInvoiceRequest)(nil),            // 139: services.CancelInvoiceRequest
+	(*CancelInvoiceResponse)(nil),           // 140: services.CancelInvoiceResponse
+	(*LookupInvoiceRequest)(nil),            // 141: services.LookupInvoiceRequest
+	(*LookupInvoiceResponse)(nil),           // 142: services.LookupInvoiceResponse
+	(*PayRequest)(nil),                      // 143: services.PayRequest
+	(*PaymentOptions)(nil),                  // 144: services.PaymentOptions
+	(*PayResponse)(nil),                     // 145: services.PayResponse
+	(*Payment)(nil),                         // 146: services.Payment
+	(*PaymentHTLC)(nil),                     // 147: services.PaymentHTLC
+	(*Invoice)(nil),                         // 148: services.Invoice
+	(*RouteHint)(nil),                       // 149: services.RouteHint
+	(*HopHint)(nil),                         // 150: services.HopHint
+	(*InvoiceHTLC)(nil),                     // 151: services.InvoiceHTLC
+	(*SubscribeInvoicesRequest)(nil),        // 152: services.SubscribeInvoicesRequest
+	(*SubscribePaymentsRequest)(nil),        // 153: services.SubscribePaymentsRequest
+	(*SubscribeMessagesRequest)(nil),        // 154: services.SubscribeMessagesRequest
+	(*RouteRequest)(nil),                    // 155: services.RouteRequest
+	(*RouteResponse)(nil),                   // 156: services.RouteResponse
+	(*GetInvoicesRequest)(nil),              // 157: services.GetInvoicesRequest
+	(*GetPaymentsRequest)(nil),              // 158: services.GetPaymentsRequest
+	(*timestamppb.Timestamp)(nil),           // 159: google.protobuf.Timestamp
+}
+var file_rpc_services_rpc_proto_depIdxs = []int32{
+	0,   // 0: services.StatusResponse.state:type_name -> services.ConnectionState
+	18,  // 1: services.SelfInfoResponse.info:type_name -> services.NodeInfo
+	20,  // 2: services.SelfInfoResponse.chains:type_name -> services.Chain
+	18,  // 3: services.NodeInfoResponse.nodes:type_name -> services.NodeInfo
+	18,  // 4: services.GetNodeInfoResponse.node:type_name -> services.NodeInfo
+	29,  // 5: services.GetNodeInfoResponse.addresses:type_name -> services.NodeAddress
+	75,  // 6: services.GetNodeInfoResponse.features:type_name -> services.Feature
+	159, // 7: services.GetNodeInfoResponse.last_update:type_name -> google.protobuf.Timestamp
+	35,  // 8: services.Channel.channel_point:type_name -> services.ChannelPoint
+	36,  // 9: services.ListChannelsResponse.channels:type_name -> services.Channel
+	35,  // 10: services.PendingChannel.channel_point:type_name -> services.ChannelPoint
+	1,   // 11: services.PendingChannel.state:type_name -> services.PendingChannelState
+	39,  // 12: services.ListPendingChannelsResponse.channels:type_name -> services.PendingChannel
+	35,  // 13: services.CloseChannelRequest.channel_point:type_name -> services.ChannelPoint
+	2,   // 14: services.CloseChannelResponse.state:type_name -> services.ChannelCloseState
+	4,   // 15: services.ClosedChannel.close_type:type_name -> services.ChannelCloseType
+	3,   // 16: services.ChannelEvent.type:type_name -> services.ChannelEventType
+	35,  // 17: services.ChannelEvent.channel_point:type_name -> services.ChannelPoint
+	36,  // 18: services.ChannelEvent.channel:type_name -> services.Channel
+	45,  // 19: services.ChannelEvent.closed_channel:type_name -> services.ClosedChannel
+	35,  // 20: services.GetChannelPolicyRequest.channel_point:type_name -> services.ChannelPoint
+	35,  // 21: services.UpdateChannelPolicyRequest.channel_point:type_name -> services.ChannelPoint
+	48,  // 22: services.UpdateChannelPolicyRequest.policy:type_name -> services.ChannelPolicy
+	13,  // 23: services.ForwardingHistoryRequest.page_options:type_name -> services.KeySetPageOptions
+	52,  // 24: services.ForwardingHistoryResponse.events:type_name -> services.ForwardingEvent
+	53,  // 25: services.ForwardingHistoryResponse.channels:type_name -> services.ChannelForwardingStats
+	5,   // 26: services.NewAddressRequest.type:type_name -> services.AddressType
+	60,  // 27: services.ListUnspentResponse.utxos:type_name -> services.Utxo
+	159, // 28: services.Transaction.timestamp:type_name -> google.protobuf.Timestamp
+	63,  // 29: services.ListTransactionsResponse.transactions:type_name -> services.Transaction
+	159, // 30: services.PairHistory.fail_time:type_name -> google.protobuf.Timestamp
+	159, // 31: services.PairHistory.success_time:type_name -> google.protobuf.Timestamp
+	67,  // 32: services.QueryMissionControlResponse.pairs:type_name -> services.PairHistory
+	67,  // 33: services.QueryProbabilityResponse.history:type_name -> services.PairHistory
+	67,  // 34: services.RouteDiagnostics.recent_failures:type_name -> services.PairHistory
+	75,  // 35: services.Peer.features:type_name -> services.Feature
+	76,  // 36: services.ListPeersResponse.peers:type_name -> services.Peer
+	81,  // 37: services.GetPersistentPeersResponse.peers:type_name -> services.PersistentPeer
+	18,  // 38: services.ContactInfo.node:type_name -> services.NodeInfo
+	88,  // 39: services.GetContactsResponse.contacts:type_name -> services.ContactInfo
+	88,  // 40: services.AddContactRequest.contact:type_name -> services.ContactInfo
+	88,  // 41: services.AddContactResponse.contact:type_name -> services.ContactInfo
+	146, // 42: services.Payments.payments:type_name -> services.Payment
+	159, // 43: services.Message.sent_timestamp:type_name -> google.protobuf.Timestamp
+	159, // 44: services.Message.received_timestamp:type_name -> google.protobuf.Timestamp
+	101, // 45: services.Message.payment_routes:type_name -> services.PaymentRoute
+	96,  // 46: services.Message.payments:type_name -> services.Payments
+	148, // 47: services.Message.invoice:type_name -> services.Invoice
+	98,  // 48: services.Message.control:type_name -> services.MessageControl
+	99,  // 49: services.Message.reactions:type_name -> services.Reaction
+	100, // 50: services.Message.edits:type_name -> services.MessageEdit
+	6,   // 51: services.MessageControl.type:type_name -> services.ControlType
+	159, // 52: services.Reaction.timestamp:type_name -> google.protobuf.Timestamp
+	159, // 53: services.MessageEdit.timestamp:type_name -> google.protobuf.Timestamp
+	102, // 54: services.PaymentRoute.hops:type_name -> services.PaymentHop
+	7,   // 55: services.MessageOptions.transport:type_name -> services.MessageTransport
+	103, // 56: services.EstimateMessageRequest.options:type_name -> services.MessageOptions
+	97,  // 57: services.EstimateMessageResponse.message:type_name -> services.Message
+	103, // 58: services.SendMessageRequest.options:type_name -> services.MessageOptions
+	97,  // 59: services.SendMessageResponse.sent_message:type_name -> services.Message
+	97,  // 60: services.SubscribeMessageResponse.received_message:type_name -> services.Message
+	159, // 61: services.AttachmentInfo.created_timestamp:type_name -> google.protobuf.Timestamp
+	103, // 62: services.SendAttachmentRequest.options:type_name -> services.MessageOptions
+	110, // 63: services.SendAttachmentResponse.attachment:type_name -> services.AttachmentInfo
+	103, // 64: services.ResumeAttachmentRequest.options:type_name -> services.MessageOptions
+	110, // 65: services.ResumeAttachmentResponse.attachment:type_name -> services.AttachmentInfo
+	110, // 66: services.GetAttachmentResponse.info:type_name -> services.AttachmentInfo
+	118, // 67: services.DiscussionInfo.options:type_name -> services.DiscussionOptions
+	7,   // 68: services.DiscussionOptions.transport:type_name -> services.MessageTransport
+	117, // 69: services.GetDiscussionsResponse.discussion:type_name -> services.DiscussionInfo
+	13,  // 70: services.GetDiscussionHistoryByIDRequest.page_options:type_name -> services.KeySetPageOptions
+	97,  // 71: services.GetDiscussionHistoryResponse.message:type_name -> services.Message
+	117, // 72: services.AddDiscussionRequest.discussion:type_name -> services.DiscussionInfo
+	117, // 73: services.AddDiscussionResponse.discussion:type_name -> services.DiscussionInfo
+	103, // 74: services.SendRequest.options:type_name -> services.MessageOptions
+	101, // 75: services.SendRequest.routes:type_name -> services.PaymentRoute
+	98,  // 76: services.SendRequest.control:type_name -> services.MessageControl
+	97,  // 77: services.SendResponse.sent_message:type_name -> services.Message
+	148, // 78: services.CreateInvoiceResponse.invoice:type_name -> services.Invoice
+	148, // 79: services.CreateHoldInvoiceResponse.invoice:type_name -> services.Invoice
+	148, // 80: services.LookupInvoiceResponse.invoice:type_name -> services.Invoice
+	144, // 81: services.PayRequest.options:type_name -> services.PaymentOptions
+	146, // 82: services.PayResponse.payment:type_name -> services.Payment
+	159, // 83: services.Payment.created_timestamp:type_name -> google.protobuf.Timestamp
+	159, // 84: services.Payment.resolved_timestamp:type_name -> google.protobuf.Timestamp
+	8,   // 85: services.Payment.state:type_name -> services.PaymentState
+	147, // 86: services.Payment.HTLCs:type_name -> services.PaymentHTLC
+	9,   // 87: services.Payment.failure_category:type_name -> services.PaymentFailureCategory
+	101, // 88: services.PaymentHTLC.route:type_name -> services.PaymentRoute
+	159, // 89: services.PaymentHTLC.attempt_timestamp:type_name -> google.protobuf.Timestamp
+	159, // 90: services.PaymentHTLC.resolve_timestamp:type_name -> google.protobuf.Timestamp
+	10,  // 91: services.PaymentHTLC.state:type_name -> services.HTLCState
+	159, // 92: services.Invoice.created_timestamp:type_name -> google.protobuf.Timestamp
+	159, // 93: services.Invoice.settled_timestamp:type_name -> google.protobuf.Timestamp
+	149, // 94: services.Invoice.route_hints:type_name -> services.RouteHint
+	11,  // 95: services.Invoice.state:type_name -> services.InvoiceState
+	151, // 96: services.Invoice.invoice_htlcs:type_name -> services.InvoiceHTLC
+	150, // 97: services.RouteHint.hop_hints:type_name -> services.HopHint
+	12,  // 98: services.InvoiceHTLC.state:type_name -> services.InvoiceHTLCState
+	159, // 99: services.InvoiceHTLC.accept_timestamp:type_name -> google.protobuf.Timestamp
+	159, // 100: services.InvoiceHTLC.resolve_timestamp:type_name -> google.protobuf.Timestamp
+	144, // 101: services.RouteRequest.options:type_name -> services.PaymentOptions
+	101, // 102: services.RouteResponse.route:type_name -> services.PaymentRoute
+	13,  // 103: services.GetInvoicesRequest.page_options:type_name -> services.KeySetPageOptions
+	13,  // 104: services.GetPaymentsRequest.page_options:type_name -> services.KeySetPageOptions
+	14,  // 105: services.NodeInfoService.GetVersion:input_type -> services.VersionRequest
+	16,  // 106: services.NodeInfoService.GetStatus:input_type -> services.StatusRequest
+	19,  // 107: services.NodeInfoService.GetSelfInfo:input_type -> services.SelfInfoRequest
+	22,  // 108: services.NodeInfoService.GetSelfBalance:input_type -> services.SelfBalanceRequest
+	24,  // 109: services.NodeInfoService.GetNodes:input_type -> services.GetNodesRequest
+	25,  // 110: services.NodeInfoService.SearchNodeByAddress:input_type -> services.SearchNodeByAddressRequest
+	26,  // 111: services.NodeInfoService.SearchNodeByAlias:input_type -> services.SearchNodeByAliasRequest
+	28,  // 112: services.NodeInfoService.GetNodeInfo:input_type -> services.GetNodeInfoRequest
+	31,  // 113: services.NodeInfoService.ConnectNode:input_type -> services.ConnectNodeRequest
+	33,  // 114: services.ChannelService.OpenChannel:input_type -> services.OpenChannelRequest
+	37,  // 115: services.ChannelService.ListChannels:input_type -> services.ListChannelsRequest
+	40,  // 116: services.ChannelService.ListPendingChannels:input_type -> services.ListPendingChannelsRequest
+	42,  // 117: services.ChannelService.CloseChannel:input_type -> services.CloseChannelRequest
+	44,  // 118: services.ChannelService.SubscribeChannelEvents:input_type -> services.SubscribeChannelEventsRequest
+	47,  // 119: services.ChannelService.GetChannelPolicy:input_type -> services.GetChannelPolicyRequest
+	49,  // 120: services.ChannelService.UpdateChannelPolicy:input_type -> services.UpdateChannelPolicyRequest
+	51,  // 121: services.ChannelService.ForwardingHistory:input_type -> services.ForwardingHistoryRequest
+	55,  // 122: services.WalletService.NewAddress:input_type -> services.NewAddressRequest
+	57,  // 123: services.WalletService.SendCoins:input_type -> services.SendCoinsRequest
+	59,  // 124: services.WalletService.ListUnspent:input_type -> services.ListUnspentRequest
+	62,  // 125: services.WalletService.ListTransactions:input_type -> services.ListTransactionsRequest
+	65,  // 126: services.WalletService.EstimateFee:input_type -> services.EstimateFeeRequest
+	68,  // 127: services.DiagnosticsService.QueryMissionControl:input_type -> services.QueryMissionControlRequest
+	70,  // 128: services.DiagnosticsService.QueryProbability:input_type -> services.QueryProbabilityRequest
+	72,  // 129: services.DiagnosticsService.ResetMissionControl:input_type -> services.ResetMissionControlRequest
+	77,  // 130: services.PeerService.ListPeers:input_type -> services.ListPeersRequest
+	79,  // 131: services.PeerService.DisconnectPeer:input_type -> services.DisconnectPeerRequest
+	82,  // 132: services.PeerService.AddPersistentPeer:input_type -> services.AddPersistentPeerRequest
+	84,  // 133: services.PeerService.GetPersistentPeers:input_type -> services.GetPersistentPeersRequest
+	86,  // 134: services.PeerService.RemovePersistentPeer:input_type -> services.RemovePersistentPeerRequest
+	89,  // 135: services.ContactService.GetContacts:input_type -> services.GetContactsRequest
+	91,  // 136: services.ContactService.AddContact:input_type -> services.AddContactRequest
+	93,  // 137: services.ContactService.RemoveContactByID:input_type -> services.RemoveContactByIDRequest
+	94,  // 138: services.ContactService.RemoveContactByAddress:input_type -> services.RemoveContactByAddressRequest
+	104, // 139: services.MessageService.EstimateMessage:input_type -> services.EstimateMessageRequest
+	106, // 140: services.MessageService.SendMessage:input_type -> services.SendMessageRequest
+	108, // 141: services.MessageService.SubscribeMessages:input_type -> services.SubscribeMessageRequest
+	111, // 142: services.AttachmentService.SendAttachment:input_type -> services.SendAttachmentRequest
+	113, // 143: services.AttachmentService.ResumeAttachment:input_type -> services.ResumeAttachmentRequest
+	115, // 144: services.AttachmentService.GetAttachment:input_type -> services.GetAttachmentRequest
+	119, // 145: services.DiscussionService.GetDiscussions:input_type -> services.GetDiscussionsRequest
+	121, // 146: services.DiscussionService.GetDiscussionHistoryByID:input_type -> services.GetDiscussionHistoryByIDRequest
+	123, // 147: services.DiscussionService.GetDiscussionStatistics:input_type -> services.GetDiscussionStatisticsRequest
+	125, // 148: services.DiscussionService.AddDiscussion:input_type -> services.AddDiscussionRequest
+	127, // 149: services.DiscussionService.UpdateDiscussionLastRead:input_type -> services.UpdateDiscussionLastReadRequest
+	129, // 150: services.DiscussionService.RemoveDiscussion:input_type -> services.RemoveDiscussionRequest
+	131, // 151: services.DiscussionService.Send:input_type -> services.SendRequest
+	154, // 152: services.DiscussionService.Subscribe:input_type -> services.SubscribeMessagesRequest
+	133, // 153: services.PaymentService.CreateInvoice:input_type -> services.CreateInvoiceRequest
+	141, // 154: services.PaymentService.LookupInvoice:input_type -> services.LookupInvoiceRequest
+	135, // 155: services.PaymentService.CreateHoldInvoice:input_type -> services.CreateHoldInvoiceRequest
+	137, // 156: services.PaymentService.SettleInvoice:input_type -> services.SettleInvoiceRequest
+	139, // 157: services.PaymentService.CancelInvoice:input_type -> services.CancelInvoiceRequest
+	143, // 158: services.PaymentService.Pay:input_type -> services.PayRequest
+	152, // 159: services.PaymentService.SubscribeInvoices:input_type -> services.SubscribeInvoicesRequest
+	153, // 160: services.PaymentService.SubscribePayments:input_type -> services.SubscribePaymentsRequest
+	155, // 161: services.PaymentService.GetRoute:input_type -> services.RouteRequest
+	157, // 162: services.PaymentService.GetInvoices:input_type -> services.GetInvoicesRequest
+	158, // 163: services.PaymentService.GetPayments:input_type -> services.GetPaymentsRequest
+	15,  // 164: services.NodeInfoService.GetVersion:output_type -> services.Version
+	17,  // 165: services.NodeInfoService.GetStatus:output_type -> services.StatusResponse
+	21,  // 166: services.NodeInfoService.GetSelfInfo:output_type -> services.SelfInfoResponse
+	23,  // 167: services.NodeInfoService.GetSelfBalance:output_type -> services.SelfBalanceResponse
+	27,  // 168: services.NodeInfoService.GetNodes:output_type -> services.NodeInfoResponse
+	27,  // 169: services.NodeInfoService.SearchNodeByAddress:output_type -> services.NodeInfoResponse
+	27,  // 170: services.NodeInfoService.SearchNodeByAlias:output_type -> services.NodeInfoResponse
+	30,  // 171: services.NodeInfoService.GetNodeInfo:output_type -> services.GetNodeInfoResponse
+	32,  // 172: services.NodeInfoService.ConnectNode:output_type -> services.ConnectNodeResponse
+	34,  // 173: services.ChannelService.OpenChannel:output_type -> services.OpenChannelResponse
+	38,  // 174: services.ChannelService.ListChannels:output_type -> services.ListChannelsResponse
+	41,  // 175: services.ChannelService.ListPendingChannels:output_type -> services.ListPendingChannelsResponse
+	43,  // 176: services.ChannelService.CloseChannel:output_type -> services.CloseChannelResponse
+	46,  // 177: services.ChannelService.SubscribeChannelEvents:output_type -> services.ChannelEvent
+	48,  // 178: services.ChannelService.GetChannelPolicy:output_type -> services.ChannelPolicy
+	50,  // 179: services.ChannelService.UpdateChannelPolicy:output_type -> services.UpdateChannelPolicyResponse
+	54,  // 180: services.ChannelService.ForwardingHistory:output_type -> services.ForwardingHistoryResponse
+	56,  // 181: services.WalletService.NewAddress:output_type -> services.NewAddressResponse
+	58,  // 182: services.WalletService.SendCoins:output_type -> services.SendCoinsResponse
+	61,  // 183: services.WalletService.ListUnspent:output_type -> services.ListUnspentResponse
+	64,  // 184: services.WalletService.ListTransactions:output_type -> services.ListTransactionsResponse
+	66,  // 185: services.WalletService.EstimateFee:output_type -> services.EstimateFeeResponse
+	69,  // 186: services.DiagnosticsService.QueryMissionControl:output_type -> services.QueryMissionControlResponse
+	71,  // 187: services.DiagnosticsService.QueryProbability:output_type -> services.QueryProbabilityResponse
+	73,  // 188: services.DiagnosticsService.ResetMissionControl:output_type -> services.ResetMissionControlResponse
+	78,  // 189: services.PeerService.ListPeers:output_type -> services.ListPeersResponse
+	80,  // 190: services.PeerService.DisconnectPeer:output_type -> services.DisconnectPeerResponse
+	83,  // 191: services.PeerService.AddPersistentPeer:output_type -> services.AddPersistentPeerResponse
+	85,  // 192: services.PeerService.GetPersistentPeers:output_type -> services.GetPersistentPeersResponse
+	87,  // 193: services.PeerService.RemovePersistentPeer:output_type -> services.RemovePersistentPeerResponse
+	90,  // 194: services.ContactService.GetContacts:output_type -> services.GetContactsResponse
+	92,  // 195: services.ContactService.AddContact:output_type -> services.AddContactResponse
+	95,  // 196: services.ContactService.RemoveContactByID:output_type -> services.RemoveContactResponse
+	95,  // 197: services.ContactService.RemoveContactByAddress:output_type -> services.RemoveContactResponse
+	105, // 198: services.MessageService.EstimateMessage:output_type -> services.EstimateMessageResponse
+	107, // 199: services.MessageService.SendMessage:output_type -> services.SendMessageResponse
+	109, // 200: services.MessageService.SubscribeMessages:output_type -> services.SubscribeMessageResponse
+	112, // 201: services.AttachmentService.SendAttachment:output_type -> services.SendAttachmentResponse
+	114, // 202: services.AttachmentService.ResumeAttachment:output_type -> services.ResumeAttachmentResponse
+	116, // 203: services.AttachmentService.GetAttachment:output_type -> services.GetAttachmentResponse
+	120, // 204: services.DiscussionService.GetDiscussions:output_type -> services.GetDiscussionsResponse
+	122, // 205: services.DiscussionService.GetDiscussionHistoryByID:output_type -> services.GetDiscussionHistoryResponse
+	124, // 206: services.DiscussionService.GetDiscussionStatistics:output_type -> services.GetDiscussionStatisticsResponse
+	126, // 207: services.DiscussionService.AddDiscussion:output_type -> services.AddDiscussionResponse
+	128, // 208: services.DiscussionService.UpdateDiscussionLastRead:output_type -> services.UpdateDiscussionResponse
+	130, // 209: services.DiscussionService.RemoveDiscussion:output_type -> services.RemoveDiscussionResponse
+	132, // 210: services.DiscussionService.Send:output_type -> services.SendResponse
+	97,  // 211: services.DiscussionService.Subscribe:output_type -> services.Message
+	134, // 212: services.PaymentService.CreateInvoice:output_type -> services.CreateInvoiceResponse
+	142, // 213: services.PaymentService.LookupInvoice:output_type -> services.LookupInvoiceResponse
+	136, // 214: services.PaymentService.CreateHoldInvoice:output_type -> services.CreateHoldInvoiceResponse
+	138, // 215: services.PaymentService.SettleInvoice:output_type -> services.SettleInvoiceResponse
+	140, // 216: services.PaymentService.CancelInvoice:output_type -> services.CancelInvoiceResponse
+	145, // 217: services.PaymentService.Pay:output_type -> services.PayResponse
+	148, // 218: services.PaymentService.SubscribeInvoices:output_type -> services.Invoice
+	146, // 219: services.PaymentService.SubscribePayments:output_type -> services.Payment
+	156, // 220: services.PaymentService.GetRoute:output_type -> services.RouteResponse
+	148, // 221: services.PaymentService.GetInvoices:output_type -> services.Invoice
+	146, // 222: services.PaymentService.GetPayments:output_type -> services.Payment
+	164, // [164:223] is the sub-list for method output_type
+	105, // [105:164] is the sub-list for method input_type
+	105, // [105:105] is the sub-list for extension type_name
+	105, // [105:105] is the sub-list for extension extendee
+	0,   // [0:105] is the sub-list for field type_name
+}
+
+func init() { file_rpc_services_rpc_proto_init() }
+func file_rpc_services_rpc_proto_init() {
+	if File_rpc_services_rpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_services_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeySetPageOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Version); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchNodeByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchNodeByAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectNodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Channel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeChannelEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosedChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChannelPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChannelPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateChannelPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardingHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardingEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelForwardingStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardingHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NewAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendCoinsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendCoinsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUnspentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Utxo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUnspentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTransactionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryMissionControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProbabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryProbabilityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetMissionControlRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetMissionControlResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RouteDiagnostics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5751,8 +13450,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Feature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5763,8 +13462,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Version); i {
+		file_rpc_services_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5775,8 +13474,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeInfo); i {
+		file_rpc_services_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5787,8 +13486,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfInfoRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5799,8 +13498,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chain); i {
+		file_rpc_services_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectPeerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5811,8 +13510,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfInfoResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5823,8 +13522,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfBalanceRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersistentPeer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5835,8 +13534,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfBalanceResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPersistentPeerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5847,8 +13546,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodesRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPersistentPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5859,8 +13558,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchNodeByAddressRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersistentPeersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5871,8 +13570,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchNodeByAliasRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersistentPeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5883,8 +13582,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeInfoResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePersistentPeerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5895,8 +13594,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectNodeRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePersistentPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5907,8 +13606,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectNodeResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5919,8 +13618,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenChannelRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5931,8 +13630,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenChannelResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5943,8 +13642,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContactInfo); i {
+		file_rpc_services_rpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddContactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5955,8 +13654,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactsRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5967,8 +13666,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactsResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContactByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5979,8 +13678,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddContactRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContactByAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5991,8 +13690,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddContactResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6003,8 +13702,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveContactByIDRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payments); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6015,8 +13714,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveContactByAddressRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6027,8 +13726,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveContactResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6039,8 +13738,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Payments); i {
+		file_rpc_services_rpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6051,8 +13750,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+		file_rpc_services_rpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageEdit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6063,7 +13762,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaymentRoute); i {
 			case 0:
 				return &v.state
@@ -6075,7 +13774,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaymentHop); i {
 			case 0:
 				return &v.state
@@ -6087,7 +13786,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MessageOptions); i {
 			case 0:
 				return &v.state
@@ -6099,7 +13798,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EstimateMessageRequest); i {
 			case 0:
 				return &v.state
@@ -6111,7 +13810,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EstimateMessageResponse); i {
 			case 0:
 				return &v.state
@@ -6123,7 +13822,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendMessageRequest); i {
 			case 0:
 				return &v.state
@@ -6135,7 +13834,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendMessageResponse); i {
 			case 0:
 				return &v.state
@@ -6147,7 +13846,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeMessageRequest); i {
 			case 0:
 				return &v.state
@@ -6159,7 +13858,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeMessageResponse); i {
 			case 0:
 				return &v.state
@@ -6171,7 +13870,91 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DiscussionInfo); i {
 			case 0:
 				return &v.state
@@ -6183,7 +13966,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DiscussionOptions); i {
 			case 0:
 				return &v.state
@@ -6195,7 +13978,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDiscussionsRequest); i {
 			case 0:
 				return &v.state
@@ -6207,7 +13990,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDiscussionsResponse); i {
 			case 0:
 				return &v.state
@@ -6219,7 +14002,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDiscussionHistoryByIDRequest); i {
 			case 0:
 				return &v.state
@@ -6231,7 +14014,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDiscussionHistoryResponse); i {
 			case 0:
 				return &v.state
@@ -6243,7 +14026,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDiscussionStatisticsRequest); i {
 			case 0:
 				return &v.state
@@ -6255,7 +14038,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetDiscussionStatisticsResponse); i {
 			case 0:
 				return &v.state
@@ -6267,7 +14050,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddDiscussionRequest); i {
 			case 0:
 				return &v.state
@@ -6279,7 +14062,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddDiscussionResponse); i {
 			case 0:
 				return &v.state
@@ -6291,7 +14074,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateDiscussionLastReadRequest); i {
 			case 0:
 				return &v.state
@@ -6303,7 +14086,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateDiscussionResponse); i {
 			case 0:
 				return &v.state
@@ -6315,7 +14098,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveDiscussionRequest); i {
 			case 0:
 				return &v.state
@@ -6327,7 +14110,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveDiscussionResponse); i {
 			case 0:
 				return &v.state
@@ -6339,7 +14122,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendRequest); i {
 			case 0:
 				return &v.state
@@ -6351,7 +14134,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendResponse); i {
 			case 0:
 				return &v.state
@@ -6363,7 +14146,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateInvoiceRequest); i {
 			case 0:
 				return &v.state
@@ -6375,7 +14158,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateInvoiceResponse); i {
 			case 0:
 				return &v.state
@@ -6387,7 +14170,79 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateHoldInvoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateHoldInvoiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettleInvoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettleInvoiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelInvoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelInvoiceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupInvoiceRequest); i {
 			case 0:
 				return &v.state
@@ -6399,7 +14254,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupInvoiceResponse); i {
 			case 0:
 				return &v.state
@@ -6411,7 +14266,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PayRequest); i {
 			case 0:
 				return &v.state
@@ -6423,7 +14278,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaymentOptions); i {
 			case 0:
 				return &v.state
@@ -6435,7 +14290,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PayResponse); i {
 			case 0:
 				return &v.state
@@ -6447,7 +14302,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Payment); i {
 			case 0:
 				return &v.state
@@ -6459,7 +14314,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaymentHTLC); i {
 			case 0:
 				return &v.state
@@ -6471,7 +14326,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Invoice); i {
 			case 0:
 				return &v.state
@@ -6483,7 +14338,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RouteHint); i {
 			case 0:
 				return &v.state
@@ -6495,7 +14350,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HopHint); i {
 			case 0:
 				return &v.state
@@ -6507,7 +14362,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InvoiceHTLC); i {
 			case 0:
 				return &v.state
@@ -6519,7 +14374,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeInvoicesRequest); i {
 			case 0:
 				return &v.state
@@ -6531,7 +14386,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribePaymentsRequest); i {
 			case 0:
 				return &v.state
@@ -6543,7 +14398,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeMessagesRequest); i {
 			case 0:
 				return &v.state
@@ -6555,7 +14410,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RouteRequest); i {
 			case 0:
 				return &v.state
@@ -6567,7 +14422,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RouteResponse); i {
 			case 0:
 				return &v.state
@@ -6579,7 +14434,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInvoicesRequest); i {
 			case 0:
 				return &v.state
@@ -6591,7 +14446,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPaymentsRequest); i {
 			case 0:
 				return &v.state
@@ -6604,19 +14459,24 @@ func file_rpc_services_rpc_proto_init() {
 			}
 		}
 	}
-	file_rpc_services_rpc_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_rpc_services_rpc_proto_msgTypes[84].OneofWrappers = []interface{}{
 		(*Message_Payments)(nil),
 		(*Message_Invoice)(nil),
 	}
-	file_rpc_services_rpc_proto_msgTypes[50].OneofWrappers = []interface{}{
+	file_rpc_services_rpc_proto_msgTypes[103].OneofWrappers = []interface{}{
+		(*GetAttachmentResponse_Info)(nil),
+		(*GetAttachmentResponse_Data)(nil),
+	}
+	file_rpc_services_rpc_proto_msgTypes[118].OneofWrappers = []interface{}{
 		(*SendRequest_DiscussionId)(nil),
 		(*SendRequest_PayReq)(nil),
+		(*SendRequest_ContactId)(nil),
 	}
-	file_rpc_services_rpc_proto_msgTypes[56].OneofWrappers = []interface{}{
+	file_rpc_services_rpc_proto_msgTypes[130].OneofWrappers = []interface{}{
 		(*PayRequest_PayReq)(nil),
 		(*PayRequest_Address)(nil),
 	}
-	file_rpc_services_rpc_proto_msgTypes[68].OneofWrappers = []interface{}{
+	file_rpc_services_rpc_proto_msgTypes[142].OneofWrappers = []interface{}{
 		(*RouteRequest_PayReq)(nil),
 		(*RouteRequest_Address)(nil),
 	}
@@ -6625,10 +14485,10 @@ func file_rpc_services_rpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_services_rpc_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   72,
+			NumEnums:      13,
+			NumMessages:   146,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   10,
 		},
 		GoTypes:           file_rpc_services_rpc_proto_goTypes,
 		DependencyIndexes: file_rpc_services_rpc_proto_depIdxs,
@@ -28,6 +28,12 @@ func (this *VersionRequest) Validate() error {
 func (this *Version) Validate() error {
 	return nil
 }
+func (this *StatusRequest) Validate() error {
+	return nil
+}
+func (this *StatusResponse) Validate() error {
+	return nil
+}
 
 var _regex_NodeInfo_Address = regexp.MustCompile(`^[a-z0-9]{66}$`)
 
@@ -93,6 +99,45 @@ func (this *NodeInfoResponse) Validate() error {
 	return nil
 }
 
+var _regex_GetNodeInfoRequest_Address = regexp.MustCompile(`^[a-z0-9]{66}$`)
+
+func (this *GetNodeInfoRequest) Validate() error {
+	if !_regex_GetNodeInfoRequest_Address.MatchString(this.Address) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Address", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-z0-9]{66}$"`, this.Address))
+	}
+	return nil
+}
+func (this *NodeAddress) Validate() error {
+	return nil
+}
+func (this *GetNodeInfoResponse) Validate() error {
+	if this.Node != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Node); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Node", err)
+		}
+	}
+	for _, item := range this.Addresses {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Addresses", err)
+			}
+		}
+	}
+	for _, item := range this.Features {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Features", err)
+			}
+		}
+	}
+	if this.LastUpdate != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastUpdate); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastUpdate", err)
+		}
+	}
+	return nil
+}
+
 var _regex_ConnectNodeRequest_Address = regexp.MustCompile(`^[a-z0-9]{66}$`)
 
 func (this *ConnectNodeRequest) Validate() error {
@@ -110,6 +155,363 @@ func (this *OpenChannelRequest) Validate() error {
 func (this *OpenChannelResponse) Validate() error {
 	return nil
 }
+
+var _regex_ChannelPoint_FundingTxid = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+func (this *ChannelPoint) Validate() error {
+	if !_regex_ChannelPoint_FundingTxid.MatchString(this.FundingTxid) {
+		return github_com_mwitkow_go_proto_validators.FieldError("FundingTxid", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-f0-9]{64}$"`, this.FundingTxid))
+	}
+	return nil
+}
+func (this *Channel) Validate() error {
+	if this.ChannelPoint != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ChannelPoint); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ChannelPoint", err)
+		}
+	}
+	return nil
+}
+
+var _regex_ListChannelsRequest_Peer = regexp.MustCompile(`^([a-z0-9]{66})?$`)
+
+func (this *ListChannelsRequest) Validate() error {
+	if !_regex_ListChannelsRequest_Peer.MatchString(this.Peer) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Peer", fmt.Errorf(`value '%v' must be a string conforming to regex "^([a-z0-9]{66})?$"`, this.Peer))
+	}
+	return nil
+}
+func (this *ListChannelsResponse) Validate() error {
+	for _, item := range this.Channels {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Channels", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *PendingChannel) Validate() error {
+	if this.ChannelPoint != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ChannelPoint); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ChannelPoint", err)
+		}
+	}
+	return nil
+}
+func (this *ListPendingChannelsRequest) Validate() error {
+	return nil
+}
+func (this *ListPendingChannelsResponse) Validate() error {
+	for _, item := range this.Channels {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Channels", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *CloseChannelRequest) Validate() error {
+	if nil == this.ChannelPoint {
+		return github_com_mwitkow_go_proto_validators.FieldError("ChannelPoint", fmt.Errorf("message must exist"))
+	}
+	if this.ChannelPoint != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ChannelPoint); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ChannelPoint", err)
+		}
+	}
+	return nil
+}
+func (this *CloseChannelResponse) Validate() error {
+	return nil
+}
+func (this *SubscribeChannelEventsRequest) Validate() error {
+	return nil
+}
+func (this *ClosedChannel) Validate() error {
+	return nil
+}
+func (this *ChannelEvent) Validate() error {
+	if this.ChannelPoint != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ChannelPoint); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ChannelPoint", err)
+		}
+	}
+	if this.Channel != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Channel); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Channel", err)
+		}
+	}
+	if this.ClosedChannel != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ClosedChannel); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ClosedChannel", err)
+		}
+	}
+	return nil
+}
+func (this *GetChannelPolicyRequest) Validate() error {
+	if nil == this.ChannelPoint {
+		return github_com_mwitkow_go_proto_validators.FieldError("ChannelPoint", fmt.Errorf("message must exist"))
+	}
+	if this.ChannelPoint != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ChannelPoint); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ChannelPoint", err)
+		}
+	}
+	return nil
+}
+func (this *ChannelPolicy) Validate() error {
+	return nil
+}
+func (this *UpdateChannelPolicyRequest) Validate() error {
+	if this.ChannelPoint != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ChannelPoint); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ChannelPoint", err)
+		}
+	}
+	if nil == this.Policy {
+		return github_com_mwitkow_go_proto_validators.FieldError("Policy", fmt.Errorf("message must exist"))
+	}
+	if this.Policy != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Policy); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Policy", err)
+		}
+	}
+	return nil
+}
+func (this *UpdateChannelPolicyResponse) Validate() error {
+	return nil
+}
+func (this *ForwardingHistoryRequest) Validate() error {
+	if this.PageOptions != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.PageOptions); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("PageOptions", err)
+		}
+	}
+	return nil
+}
+func (this *ForwardingEvent) Validate() error {
+	return nil
+}
+func (this *ChannelForwardingStats) Validate() error {
+	return nil
+}
+func (this *ForwardingHistoryResponse) Validate() error {
+	for _, item := range this.Events {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Events", err)
+			}
+		}
+	}
+	for _, item := range this.Channels {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Channels", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *NewAddressRequest) Validate() error {
+	return nil
+}
+func (this *NewAddressResponse) Validate() error {
+	return nil
+}
+func (this *SendCoinsRequest) Validate() error {
+	if this.Address == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Address", fmt.Errorf(`value '%v' must not be an empty string`, this.Address))
+	}
+	return nil
+}
+func (this *SendCoinsResponse) Validate() error {
+	return nil
+}
+func (this *ListUnspentRequest) Validate() error {
+	return nil
+}
+func (this *Utxo) Validate() error {
+	return nil
+}
+func (this *ListUnspentResponse) Validate() error {
+	for _, item := range this.Utxos {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Utxos", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ListTransactionsRequest) Validate() error {
+	return nil
+}
+func (this *Transaction) Validate() error {
+	if this.Timestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Timestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Timestamp", err)
+		}
+	}
+	return nil
+}
+func (this *ListTransactionsResponse) Validate() error {
+	for _, item := range this.Transactions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Transactions", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *EstimateFeeRequest) Validate() error {
+	if this.Address == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("Address", fmt.Errorf(`value '%v' must not be an empty string`, this.Address))
+	}
+	return nil
+}
+func (this *EstimateFeeResponse) Validate() error {
+	return nil
+}
+func (this *PairHistory) Validate() error {
+	if this.FailTime != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FailTime); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FailTime", err)
+		}
+	}
+	if this.SuccessTime != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.SuccessTime); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("SuccessTime", err)
+		}
+	}
+	return nil
+}
+func (this *QueryMissionControlRequest) Validate() error {
+	return nil
+}
+func (this *QueryMissionControlResponse) Validate() error {
+	for _, item := range this.Pairs {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Pairs", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *QueryProbabilityRequest) Validate() error {
+	if this.FromNode == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("FromNode", fmt.Errorf(`value '%v' must not be an empty string`, this.FromNode))
+	}
+	if this.ToNode == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("ToNode", fmt.Errorf(`value '%v' must not be an empty string`, this.ToNode))
+	}
+	return nil
+}
+func (this *QueryProbabilityResponse) Validate() error {
+	if this.History != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.History); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("History", err)
+		}
+	}
+	return nil
+}
+func (this *ResetMissionControlRequest) Validate() error {
+	return nil
+}
+func (this *ResetMissionControlResponse) Validate() error {
+	return nil
+}
+func (this *RouteDiagnostics) Validate() error {
+	for _, item := range this.RecentFailures {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("RecentFailures", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *Feature) Validate() error {
+	return nil
+}
+func (this *Peer) Validate() error {
+	for _, item := range this.Features {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Features", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *ListPeersRequest) Validate() error {
+	return nil
+}
+func (this *ListPeersResponse) Validate() error {
+	for _, item := range this.Peers {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Peers", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_DisconnectPeerRequest_Address = regexp.MustCompile(`^[a-z0-9]{66}$`)
+
+func (this *DisconnectPeerRequest) Validate() error {
+	if !_regex_DisconnectPeerRequest_Address.MatchString(this.Address) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Address", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-z0-9]{66}$"`, this.Address))
+	}
+	return nil
+}
+func (this *DisconnectPeerResponse) Validate() error {
+	return nil
+}
+func (this *PersistentPeer) Validate() error {
+	return nil
+}
+
+var _regex_AddPersistentPeerRequest_Address = regexp.MustCompile(`^[a-z0-9]{66}$`)
+
+func (this *AddPersistentPeerRequest) Validate() error {
+	if !_regex_AddPersistentPeerRequest_Address.MatchString(this.Address) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Address", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-z0-9]{66}$"`, this.Address))
+	}
+	return nil
+}
+func (this *AddPersistentPeerResponse) Validate() error {
+	return nil
+}
+func (this *GetPersistentPeersRequest) Validate() error {
+	return nil
+}
+func (this *GetPersistentPeersResponse) Validate() error {
+	for _, item := range this.Peers {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Peers", err)
+			}
+		}
+	}
+	return nil
+}
+
+var _regex_RemovePersistentPeerRequest_Address = regexp.MustCompile(`^[a-z0-9]{66}$`)
+
+func (this *RemovePersistentPeerRequest) Validate() error {
+	if !_regex_RemovePersistentPeerRequest_Address.MatchString(this.Address) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Address", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-z0-9]{66}$"`, this.Address))
+	}
+	return nil
+}
+func (this *RemovePersistentPeerResponse) Validate() error {
+	return nil
+}
 func (this *ContactInfo) Validate() error {
 	if nil == this.Node {
 		return github_com_mwitkow_go_proto_validators.FieldError("Node", fmt.Errorf("message must exist"))
@@ -223,6 +625,44 @@ func (this *Message) Validate() error {
 			}
 		}
 	}
+	if this.Control != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Control); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Control", err)
+		}
+	}
+	for _, item := range this.Reactions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Reactions", err)
+			}
+		}
+	}
+	for _, item := range this.Edits {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Edits", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *MessageControl) Validate() error {
+	return nil
+}
+func (this *Reaction) Validate() error {
+	if this.Timestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Timestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Timestamp", err)
+		}
+	}
+	return nil
+}
+func (this *MessageEdit) Validate() error {
+	if this.Timestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Timestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Timestamp", err)
+		}
+	}
 	return nil
 }
 func (this *PaymentRoute) Validate() error {
@@ -299,6 +739,65 @@ func (this *SubscribeMessageResponse) Validate() error {
 	}
 	return nil
 }
+func (this *AttachmentInfo) Validate() error {
+	if this.CreatedTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.CreatedTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("CreatedTimestamp", err)
+		}
+	}
+	return nil
+}
+func (this *SendAttachmentRequest) Validate() error {
+	if this.Options != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Options); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Options", err)
+		}
+	}
+	return nil
+}
+func (this *SendAttachmentResponse) Validate() error {
+	if this.Attachment != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Attachment); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Attachment", err)
+		}
+	}
+	return nil
+}
+func (this *ResumeAttachmentRequest) Validate() error {
+	if this.TransferId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TransferId", fmt.Errorf(`value '%v' must not be an empty string`, this.TransferId))
+	}
+	if this.Options != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Options); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Options", err)
+		}
+	}
+	return nil
+}
+func (this *ResumeAttachmentResponse) Validate() error {
+	if this.Attachment != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Attachment); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Attachment", err)
+		}
+	}
+	return nil
+}
+func (this *GetAttachmentRequest) Validate() error {
+	if this.TransferId == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("TransferId", fmt.Errorf(`value '%v' must not be an empty string`, this.TransferId))
+	}
+	return nil
+}
+func (this *GetAttachmentResponse) Validate() error {
+	if oneOfNester, ok := this.GetContent().(*GetAttachmentResponse_Info); ok {
+		if oneOfNester.Info != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(oneOfNester.Info); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Info", err)
+			}
+		}
+	}
+	return nil
+}
 func (this *DiscussionInfo) Validate() error {
 	if len(this.Participants) < 1 {
 		return github_com_mwitkow_go_proto_validators.FieldError("Participants", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Participants))
@@ -386,6 +885,18 @@ func (this *SendRequest) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Options", err)
 		}
 	}
+	for _, item := range this.Routes {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Routes", err)
+			}
+		}
+	}
+	if this.Control != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Control); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Control", err)
+		}
+	}
 	return nil
 }
 func (this *SendResponse) Validate() error {
@@ -407,6 +918,47 @@ func (this *CreateInvoiceResponse) Validate() error {
 	}
 	return nil
 }
+
+var _regex_CreateHoldInvoiceRequest_Hash = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+func (this *CreateHoldInvoiceRequest) Validate() error {
+	if !_regex_CreateHoldInvoiceRequest_Hash.MatchString(this.Hash) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Hash", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-f0-9]{64}$"`, this.Hash))
+	}
+	return nil
+}
+func (this *CreateHoldInvoiceResponse) Validate() error {
+	if this.Invoice != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Invoice); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Invoice", err)
+		}
+	}
+	return nil
+}
+
+var _regex_SettleInvoiceRequest_Preimage = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+func (this *SettleInvoiceRequest) Validate() error {
+	if !_regex_SettleInvoiceRequest_Preimage.MatchString(this.Preimage) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Preimage", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-f0-9]{64}$"`, this.Preimage))
+	}
+	return nil
+}
+func (this *SettleInvoiceResponse) Validate() error {
+	return nil
+}
+
+var _regex_CancelInvoiceRequest_Hash = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+func (this *CancelInvoiceRequest) Validate() error {
+	if !_regex_CancelInvoiceRequest_Hash.MatchString(this.Hash) {
+		return github_com_mwitkow_go_proto_validators.FieldError("Hash", fmt.Errorf(`value '%v' must be a string conforming to regex "^[a-f0-9]{64}$"`, this.Hash))
+	}
+	return nil
+}
+func (this *CancelInvoiceResponse) Validate() error {
+	return nil
+}
 func (this *LookupInvoiceRequest) Validate() error {
 	return nil
 }
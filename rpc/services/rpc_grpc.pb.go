@@ -23,6 +23,9 @@ type NodeInfoServiceClient interface {
 	//Returns version information about the current daemon.
 	GetVersion(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*Version, error)
 	//*
+	//Returns the status of the connection to the underlying node.
+	GetStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	//*
 	//Returns info about the current underlying node.
 	GetSelfInfo(ctx context.Context, in *SelfInfoRequest, opts ...grpc.CallOption) (*SelfInfoResponse, error)
 	//*
@@ -48,6 +51,15 @@ type NodeInfoServiceClient interface {
 	//Returns a list of all nodes with that alias visible from the underlying node.
 	SearchNodeByAlias(ctx context.Context, in *SearchNodeByAliasRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
 	//*
+	//Returns the details of a Lightning node.
+	//
+	//Returns the network addresses, features and channel statistics
+	//advertised by the node, as well as whether the underlying node
+	//has an open channel with it.
+	//The advertised network addresses can be used to connect to the node.
+	//The node must be visible from the underlying node.
+	GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error)
+	//*
 	//Connects a node as a peer.
 	ConnectNode(ctx context.Context, in *ConnectNodeRequest, opts ...grpc.CallOption) (*ConnectNodeResponse, error)
 }
@@ -69,6 +81,15 @@ func (c *nodeInfoServiceClient) GetVersion(ctx context.Context, in *VersionReque
 	return out, nil
 }
 
+func (c *nodeInfoServiceClient) GetStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/services.NodeInfoService/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodeInfoServiceClient) GetSelfInfo(ctx context.Context, in *SelfInfoRequest, opts ...grpc.CallOption) (*SelfInfoResponse, error) {
 	out := new(SelfInfoResponse)
 	err := c.cc.Invoke(ctx, "/services.NodeInfoService/GetSelfInfo", in, out, opts...)
@@ -114,6 +135,15 @@ func (c *nodeInfoServiceClient) SearchNodeByAlias(ctx context.Context, in *Searc
 	return out, nil
 }
 
+func (c *nodeInfoServiceClient) GetNodeInfo(ctx context.Context, in *GetNodeInfoRequest, opts ...grpc.CallOption) (*GetNodeInfoResponse, error) {
+	out := new(GetNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/services.NodeInfoService/GetNodeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodeInfoServiceClient) ConnectNode(ctx context.Context, in *ConnectNodeRequest, opts ...grpc.CallOption) (*ConnectNodeResponse, error) {
 	out := new(ConnectNodeResponse)
 	err := c.cc.Invoke(ctx, "/services.NodeInfoService/ConnectNode", in, out, opts...)
@@ -131,6 +161,9 @@ type NodeInfoServiceServer interface {
 	//Returns version information about the current daemon.
 	GetVersion(context.Context, *VersionRequest) (*Version, error)
 	//*
+	//Returns the status of the connection to the underlying node.
+	GetStatus(context.Context, *StatusRequest) (*StatusResponse, error)
+	//*
 	//Returns info about the current underlying node.
 	GetSelfInfo(context.Context, *SelfInfoRequest) (*SelfInfoResponse, error)
 	//*
@@ -156,6 +189,15 @@ type NodeInfoServiceServer interface {
 	//Returns a list of all nodes with that alias visible from the underlying node.
 	SearchNodeByAlias(context.Context, *SearchNodeByAliasRequest) (*NodeInfoResponse, error)
 	//*
+	//Returns the details of a Lightning node.
+	//
+	//Returns the network addresses, features and channel statistics
+	//advertised by the node, as well as whether the underlying node
+	//has an open channel with it.
+	//The advertised network addresses can be used to connect to the node.
+	//The node must be visible from the underlying node.
+	GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error)
+	//*
 	//Connects a node as a peer.
 	ConnectNode(context.Context, *ConnectNodeRequest) (*ConnectNodeResponse, error)
 	mustEmbedUnimplementedNodeInfoServiceServer()
@@ -168,6 +210,9 @@ type UnimplementedNodeInfoServiceServer struct {
 func (UnimplementedNodeInfoServiceServer) GetVersion(context.Context, *VersionRequest) (*Version, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
+func (UnimplementedNodeInfoServiceServer) GetStatus(context.Context, *StatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
 func (UnimplementedNodeInfoServiceServer) GetSelfInfo(context.Context, *SelfInfoRequest) (*SelfInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSelfInfo not implemented")
 }
@@ -183,6 +228,9 @@ func (UnimplementedNodeInfoServiceServer) SearchNodeByAddress(context.Context, *
 func (UnimplementedNodeInfoServiceServer) SearchNodeByAlias(context.Context, *SearchNodeByAliasRequest) (*NodeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchNodeByAlias not implemented")
 }
+func (UnimplementedNodeInfoServiceServer) GetNodeInfo(context.Context, *GetNodeInfoRequest) (*GetNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeInfo not implemented")
+}
 func (UnimplementedNodeInfoServiceServer) ConnectNode(context.Context, *ConnectNodeRequest) (*ConnectNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectNode not implemented")
 }
@@ -217,6 +265,24 @@ func _NodeInfoService_GetVersion_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeInfoService_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeInfoServiceServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.NodeInfoService/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeInfoServiceServer).GetStatus(ctx, req.(*StatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodeInfoService_GetSelfInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SelfInfoRequest)
 	if err := dec(in); err != nil {
@@ -307,6 +373,24 @@ func _NodeInfoService_SearchNodeByAlias_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodeInfoService_GetNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeInfoServiceServer).GetNodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.NodeInfoService/GetNodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeInfoServiceServer).GetNodeInfo(ctx, req.(*GetNodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodeInfoService_ConnectNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConnectNodeRequest)
 	if err := dec(in); err != nil {
@@ -336,6 +420,10 @@ var NodeInfoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersion",
 			Handler:    _NodeInfoService_GetVersion_Handler,
 		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _NodeInfoService_GetStatus_Handler,
+		},
 		{
 			MethodName: "GetSelfInfo",
 			Handler:    _NodeInfoService_GetSelfInfo_Handler,
@@ -356,6 +444,10 @@ var NodeInfoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchNodeByAlias",
 			Handler:    _NodeInfoService_SearchNodeByAlias_Handler,
 		},
+		{
+			MethodName: "GetNodeInfo",
+			Handler:    _NodeInfoService_GetNodeInfo_Handler,
+		},
 		{
 			MethodName: "ConnectNode",
 			Handler:    _NodeInfoService_ConnectNode_Handler,
@@ -375,6 +467,37 @@ type ChannelServiceClient interface {
 	//Returns immediately after the funding transaction has been published,
 	//but does not wait for the channel to be considered open.
 	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*OpenChannelResponse, error)
+	//*
+	//Lists the open channels of the underlying node.
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	//*
+	//Lists the channels of the underlying node that are pending opening or closing.
+	ListPendingChannels(ctx context.Context, in *ListPendingChannelsRequest, opts ...grpc.CallOption) (*ListPendingChannelsResponse, error)
+	//*
+	//Closes a channel, either cooperatively or forcibly.
+	//
+	//Returns a stream over which the progress of the closing is received.
+	//The stream terminates when the closing transaction is confirmed.
+	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (ChannelService_CloseChannelClient, error)
+	//*
+	//Subscribes to events concerning the channels of the underlying node.
+	//
+	//Events are received when a channel is pending opening, opened,
+	//becomes active or inactive, is closed or is fully resolved.
+	SubscribeChannelEvents(ctx context.Context, in *SubscribeChannelEventsRequest, opts ...grpc.CallOption) (ChannelService_SubscribeChannelEventsClient, error)
+	//*
+	//Returns the forwarding policy of the underlying node for a channel.
+	GetChannelPolicy(ctx context.Context, in *GetChannelPolicyRequest, opts ...grpc.CallOption) (*ChannelPolicy, error)
+	//*
+	//Updates the forwarding policy of the underlying node
+	//for a channel, or for all channels.
+	UpdateChannelPolicy(ctx context.Context, in *UpdateChannelPolicyRequest, opts ...grpc.CallOption) (*UpdateChannelPolicyResponse, error)
+	//*
+	//Returns the forwarding events of the underlying node within a time range,
+	//along with per-channel aggregates over the returned events.
+	//
+	//Events are identified by their index within the requested time range.
+	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
 }
 
 type channelServiceClient struct {
@@ -394,6 +517,115 @@ func (c *channelServiceClient) OpenChannel(ctx context.Context, in *OpenChannelR
 	return out, nil
 }
 
+func (c *channelServiceClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, "/services.ChannelService/ListChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ListPendingChannels(ctx context.Context, in *ListPendingChannelsRequest, opts ...grpc.CallOption) (*ListPendingChannelsResponse, error) {
+	out := new(ListPendingChannelsResponse)
+	err := c.cc.Invoke(ctx, "/services.ChannelService/ListPendingChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (ChannelService_CloseChannelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChannelService_ServiceDesc.Streams[0], "/services.ChannelService/CloseChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &channelServiceCloseChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChannelService_CloseChannelClient interface {
+	Recv() (*CloseChannelResponse, error)
+	grpc.ClientStream
+}
+
+type channelServiceCloseChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *channelServiceCloseChannelClient) Recv() (*CloseChannelResponse, error) {
+	m := new(CloseChannelResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *channelServiceClient) SubscribeChannelEvents(ctx context.Context, in *SubscribeChannelEventsRequest, opts ...grpc.CallOption) (ChannelService_SubscribeChannelEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChannelService_ServiceDesc.Streams[1], "/services.ChannelService/SubscribeChannelEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &channelServiceSubscribeChannelEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChannelService_SubscribeChannelEventsClient interface {
+	Recv() (*ChannelEvent, error)
+	grpc.ClientStream
+}
+
+type channelServiceSubscribeChannelEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *channelServiceSubscribeChannelEventsClient) Recv() (*ChannelEvent, error) {
+	m := new(ChannelEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *channelServiceClient) GetChannelPolicy(ctx context.Context, in *GetChannelPolicyRequest, opts ...grpc.CallOption) (*ChannelPolicy, error) {
+	out := new(ChannelPolicy)
+	err := c.cc.Invoke(ctx, "/services.ChannelService/GetChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) UpdateChannelPolicy(ctx context.Context, in *UpdateChannelPolicyRequest, opts ...grpc.CallOption) (*UpdateChannelPolicyResponse, error) {
+	out := new(UpdateChannelPolicyResponse)
+	err := c.cc.Invoke(ctx, "/services.ChannelService/UpdateChannelPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
+	out := new(ForwardingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/services.ChannelService/ForwardingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChannelServiceServer is the server API for ChannelService service.
 // All implementations must embed UnimplementedChannelServiceServer
 // for forward compatibility
@@ -404,6 +636,37 @@ type ChannelServiceServer interface {
 	//Returns immediately after the funding transaction has been published,
 	//but does not wait for the channel to be considered open.
 	OpenChannel(context.Context, *OpenChannelRequest) (*OpenChannelResponse, error)
+	//*
+	//Lists the open channels of the underlying node.
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	//*
+	//Lists the channels of the underlying node that are pending opening or closing.
+	ListPendingChannels(context.Context, *ListPendingChannelsRequest) (*ListPendingChannelsResponse, error)
+	//*
+	//Closes a channel, either cooperatively or forcibly.
+	//
+	//Returns a stream over which the progress of the closing is received.
+	//The stream terminates when the closing transaction is confirmed.
+	CloseChannel(*CloseChannelRequest, ChannelService_CloseChannelServer) error
+	//*
+	//Subscribes to events concerning the channels of the underlying node.
+	//
+	//Events are received when a channel is pending opening, opened,
+	//becomes active or inactive, is closed or is fully resolved.
+	SubscribeChannelEvents(*SubscribeChannelEventsRequest, ChannelService_SubscribeChannelEventsServer) error
+	//*
+	//Returns the forwarding policy of the underlying node for a channel.
+	GetChannelPolicy(context.Context, *GetChannelPolicyRequest) (*ChannelPolicy, error)
+	//*
+	//Updates the forwarding policy of the underlying node
+	//for a channel, or for all channels.
+	UpdateChannelPolicy(context.Context, *UpdateChannelPolicyRequest) (*UpdateChannelPolicyResponse, error)
+	//*
+	//Returns the forwarding events of the underlying node within a time range,
+	//along with per-channel aggregates over the returned events.
+	//
+	//Events are identified by their index within the requested time range.
+	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
 	mustEmbedUnimplementedChannelServiceServer()
 }
 
@@ -414,47 +677,923 @@ type UnimplementedChannelServiceServer struct {
 func (UnimplementedChannelServiceServer) OpenChannel(context.Context, *OpenChannelRequest) (*OpenChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenChannel not implemented")
 }
-func (UnimplementedChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {}
+func (UnimplementedChannelServiceServer) ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) ListPendingChannels(context.Context, *ListPendingChannelsRequest) (*ListPendingChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) CloseChannel(*CloseChannelRequest, ChannelService_CloseChannelServer) error {
+	return status.Errorf(codes.Unimplemented, "method CloseChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) SubscribeChannelEvents(*SubscribeChannelEventsRequest, ChannelService_SubscribeChannelEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeChannelEvents not implemented")
+}
+func (UnimplementedChannelServiceServer) GetChannelPolicy(context.Context, *GetChannelPolicyRequest) (*ChannelPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelPolicy not implemented")
+}
+func (UnimplementedChannelServiceServer) UpdateChannelPolicy(context.Context, *UpdateChannelPolicyRequest) (*UpdateChannelPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannelPolicy not implemented")
+}
+func (UnimplementedChannelServiceServer) ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardingHistory not implemented")
+}
+func (UnimplementedChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {}
+
+// UnsafeChannelServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChannelServiceServer will
+// result in compilation errors.
+type UnsafeChannelServiceServer interface {
+	mustEmbedUnimplementedChannelServiceServer()
+}
+
+func RegisterChannelServiceServer(s grpc.ServiceRegistrar, srv ChannelServiceServer) {
+	s.RegisterService(&ChannelService_ServiceDesc, srv)
+}
+
+func _ChannelService_OpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).OpenChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.ChannelService/OpenChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).OpenChannel(ctx, req.(*OpenChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.ChannelService/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ListPendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListPendingChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.ChannelService/ListPendingChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListPendingChannels(ctx, req.(*ListPendingChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloseChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChannelServiceServer).CloseChannel(m, &channelServiceCloseChannelServer{stream})
+}
+
+type ChannelService_CloseChannelServer interface {
+	Send(*CloseChannelResponse) error
+	grpc.ServerStream
+}
+
+type channelServiceCloseChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *channelServiceCloseChannelServer) Send(m *CloseChannelResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChannelService_SubscribeChannelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeChannelEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChannelServiceServer).SubscribeChannelEvents(m, &channelServiceSubscribeChannelEventsServer{stream})
+}
+
+type ChannelService_SubscribeChannelEventsServer interface {
+	Send(*ChannelEvent) error
+	grpc.ServerStream
+}
+
+type channelServiceSubscribeChannelEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *channelServiceSubscribeChannelEventsServer) Send(m *ChannelEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChannelService_GetChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).GetChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.ChannelService/GetChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).GetChannelPolicy(ctx, req.(*GetChannelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).UpdateChannelPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.ChannelService/UpdateChannelPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).UpdateChannelPolicy(ctx, req.(*UpdateChannelPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ForwardingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.ChannelService/ForwardingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ForwardingHistory(ctx, req.(*ForwardingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChannelService_ServiceDesc is the grpc.ServiceDesc for ChannelService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChannelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.ChannelService",
+	HandlerType: (*ChannelServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OpenChannel",
+			Handler:    _ChannelService_OpenChannel_Handler,
+		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _ChannelService_ListChannels_Handler,
+		},
+		{
+			MethodName: "ListPendingChannels",
+			Handler:    _ChannelService_ListPendingChannels_Handler,
+		},
+		{
+			MethodName: "GetChannelPolicy",
+			Handler:    _ChannelService_GetChannelPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateChannelPolicy",
+			Handler:    _ChannelService_UpdateChannelPolicy_Handler,
+		},
+		{
+			MethodName: "ForwardingHistory",
+			Handler:    _ChannelService_ForwardingHistory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CloseChannel",
+			Handler:       _ChannelService_CloseChannel_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeChannelEvents",
+			Handler:       _ChannelService_SubscribeChannelEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc/services/rpc.proto",
+}
+
+// WalletServiceClient is the client API for WalletService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WalletServiceClient interface {
+	//*
+	//Generates a new address of the on-chain wallet of the underlying node.
+	//
+	//The address can be used to deposit funds, e.g. for opening channels.
+	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
+	//*
+	//Sends an on-chain payment from the wallet of the underlying node.
+	//
+	//Returns immediately after the transaction has been published.
+	SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error)
+	//*
+	//Lists the unspent outputs of the on-chain wallet.
+	ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error)
+	//*
+	//Lists the transactions of the on-chain wallet.
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	//*
+	//Estimates the fee of an on-chain payment.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+}
+
+type walletServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletServiceClient(cc grpc.ClientConnInterface) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error) {
+	out := new(NewAddressResponse)
+	err := c.cc.Invoke(ctx, "/services.WalletService/NewAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SendCoins(ctx context.Context, in *SendCoinsRequest, opts ...grpc.CallOption) (*SendCoinsResponse, error) {
+	out := new(SendCoinsResponse)
+	err := c.cc.Invoke(ctx, "/services.WalletService/SendCoins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListUnspent(ctx context.Context, in *ListUnspentRequest, opts ...grpc.CallOption) (*ListUnspentResponse, error) {
+	out := new(ListUnspentResponse)
+	err := c.cc.Invoke(ctx, "/services.WalletService/ListUnspent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/services.WalletService/ListTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/services.WalletService/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService service.
+// All implementations must embed UnimplementedWalletServiceServer
+// for forward compatibility
+type WalletServiceServer interface {
+	//*
+	//Generates a new address of the on-chain wallet of the underlying node.
+	//
+	//The address can be used to deposit funds, e.g. for opening channels.
+	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
+	//*
+	//Sends an on-chain payment from the wallet of the underlying node.
+	//
+	//Returns immediately after the transaction has been published.
+	SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error)
+	//*
+	//Lists the unspent outputs of the on-chain wallet.
+	ListUnspent(context.Context, *ListUnspentRequest) (*ListUnspentResponse, error)
+	//*
+	//Lists the transactions of the on-chain wallet.
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	//*
+	//Estimates the fee of an on-chain payment.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+// UnimplementedWalletServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWalletServiceServer struct {
+}
+
+func (UnimplementedWalletServiceServer) NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewAddress not implemented")
+}
+func (UnimplementedWalletServiceServer) SendCoins(context.Context, *SendCoinsRequest) (*SendCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCoins not implemented")
+}
+func (UnimplementedWalletServiceServer) ListUnspent(context.Context, *ListUnspentRequest) (*ListUnspentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnspent not implemented")
+}
+func (UnimplementedWalletServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedWalletServiceServer) EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
+func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
+
+// UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletServiceServer will
+// result in compilation errors.
+type UnsafeWalletServiceServer interface {
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+func RegisterWalletServiceServer(s grpc.ServiceRegistrar, srv WalletServiceServer) {
+	s.RegisterService(&WalletService_ServiceDesc, srv)
+}
+
+func _WalletService_NewAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).NewAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.WalletService/NewAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).NewAddress(ctx, req.(*NewAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SendCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SendCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.WalletService/SendCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SendCoins(ctx, req.(*SendCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListUnspent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnspentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListUnspent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.WalletService/ListUnspent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListUnspent(ctx, req.(*ListUnspentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.WalletService/ListTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.WalletService/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WalletService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.WalletService",
+	HandlerType: (*WalletServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NewAddress",
+			Handler:    _WalletService_NewAddress_Handler,
+		},
+		{
+			MethodName: "SendCoins",
+			Handler:    _WalletService_SendCoins_Handler,
+		},
+		{
+			MethodName: "ListUnspent",
+			Handler:    _WalletService_ListUnspent_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _WalletService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _WalletService_EstimateFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/services/rpc.proto",
+}
+
+// DiagnosticsServiceClient is the client API for DiagnosticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DiagnosticsServiceClient interface {
+	//*
+	//Lists the payment attempt history of the underlying node for all node pairs.
+	QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error)
+	//*
+	//Estimates the probability that the underlying node can forward
+	//a payment from a node to another.
+	QueryProbability(ctx context.Context, in *QueryProbabilityRequest, opts ...grpc.CallOption) (*QueryProbabilityResponse, error)
+	//*
+	//Clears the payment attempt history of the underlying node.
+	ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error)
+}
+
+type diagnosticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiagnosticsServiceClient(cc grpc.ClientConnInterface) DiagnosticsServiceClient {
+	return &diagnosticsServiceClient{cc}
+}
+
+func (c *diagnosticsServiceClient) QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error) {
+	out := new(QueryMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/services.DiagnosticsService/QueryMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diagnosticsServiceClient) QueryProbability(ctx context.Context, in *QueryProbabilityRequest, opts ...grpc.CallOption) (*QueryProbabilityResponse, error) {
+	out := new(QueryProbabilityResponse)
+	err := c.cc.Invoke(ctx, "/services.DiagnosticsService/QueryProbability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diagnosticsServiceClient) ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error) {
+	out := new(ResetMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/services.DiagnosticsService/ResetMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiagnosticsServiceServer is the server API for DiagnosticsService service.
+// All implementations must embed UnimplementedDiagnosticsServiceServer
+// for forward compatibility
+type DiagnosticsServiceServer interface {
+	//*
+	//Lists the payment attempt history of the underlying node for all node pairs.
+	QueryMissionControl(context.Context, *QueryMissionControlRequest) (*QueryMissionControlResponse, error)
+	//*
+	//Estimates the probability that the underlying node can forward
+	//a payment from a node to another.
+	QueryProbability(context.Context, *QueryProbabilityRequest) (*QueryProbabilityResponse, error)
+	//*
+	//Clears the payment attempt history of the underlying node.
+	ResetMissionControl(context.Context, *ResetMissionControlRequest) (*ResetMissionControlResponse, error)
+	mustEmbedUnimplementedDiagnosticsServiceServer()
+}
+
+// UnimplementedDiagnosticsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDiagnosticsServiceServer struct {
+}
+
+func (UnimplementedDiagnosticsServiceServer) QueryMissionControl(context.Context, *QueryMissionControlRequest) (*QueryMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMissionControl not implemented")
+}
+func (UnimplementedDiagnosticsServiceServer) QueryProbability(context.Context, *QueryProbabilityRequest) (*QueryProbabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProbability not implemented")
+}
+func (UnimplementedDiagnosticsServiceServer) ResetMissionControl(context.Context, *ResetMissionControlRequest) (*ResetMissionControlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetMissionControl not implemented")
+}
+func (UnimplementedDiagnosticsServiceServer) mustEmbedUnimplementedDiagnosticsServiceServer() {}
+
+// UnsafeDiagnosticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DiagnosticsServiceServer will
+// result in compilation errors.
+type UnsafeDiagnosticsServiceServer interface {
+	mustEmbedUnimplementedDiagnosticsServiceServer()
+}
+
+func RegisterDiagnosticsServiceServer(s grpc.ServiceRegistrar, srv DiagnosticsServiceServer) {
+	s.RegisterService(&DiagnosticsService_ServiceDesc, srv)
+}
+
+func _DiagnosticsService_QueryMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiagnosticsServiceServer).QueryMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.DiagnosticsService/QueryMissionControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiagnosticsServiceServer).QueryMissionControl(ctx, req.(*QueryMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiagnosticsService_QueryProbability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProbabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiagnosticsServiceServer).QueryProbability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.DiagnosticsService/QueryProbability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiagnosticsServiceServer).QueryProbability(ctx, req.(*QueryProbabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiagnosticsService_ResetMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiagnosticsServiceServer).ResetMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.DiagnosticsService/ResetMissionControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiagnosticsServiceServer).ResetMissionControl(ctx, req.(*ResetMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DiagnosticsService_ServiceDesc is the grpc.ServiceDesc for DiagnosticsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DiagnosticsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.DiagnosticsService",
+	HandlerType: (*DiagnosticsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryMissionControl",
+			Handler:    _DiagnosticsService_QueryMissionControl_Handler,
+		},
+		{
+			MethodName: "QueryProbability",
+			Handler:    _DiagnosticsService_QueryProbability_Handler,
+		},
+		{
+			MethodName: "ResetMissionControl",
+			Handler:    _DiagnosticsService_ResetMissionControl_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/services/rpc.proto",
+}
+
+// PeerServiceClient is the client API for PeerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PeerServiceClient interface {
+	//*
+	//Lists the nodes currently connected to the underlying node.
+	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	//*
+	//Terminates the peer connection with a node.
+	//
+	//Persistent peers are reconnected, unless removed from the persistent peer list.
+	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
+	//*
+	//Adds a node to the persistent peer list and connects to it.
+	//
+	//Nodes in the persistent peer list are reconnected upon peer loss.
+	AddPersistentPeer(ctx context.Context, in *AddPersistentPeerRequest, opts ...grpc.CallOption) (*AddPersistentPeerResponse, error)
+	//*
+	//Lists the nodes in the persistent peer list.
+	GetPersistentPeers(ctx context.Context, in *GetPersistentPeersRequest, opts ...grpc.CallOption) (*GetPersistentPeersResponse, error)
+	//*
+	//Removes a node from the persistent peer list.
+	//
+	//Any existing peer connection with the node is not terminated.
+	RemovePersistentPeer(ctx context.Context, in *RemovePersistentPeerRequest, opts ...grpc.CallOption) (*RemovePersistentPeerResponse, error)
+}
+
+type peerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPeerServiceClient(cc grpc.ClientConnInterface) PeerServiceClient {
+	return &peerServiceClient{cc}
+}
+
+func (c *peerServiceClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
+	out := new(ListPeersResponse)
+	err := c.cc.Invoke(ctx, "/services.PeerService/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerServiceClient) DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error) {
+	out := new(DisconnectPeerResponse)
+	err := c.cc.Invoke(ctx, "/services.PeerService/DisconnectPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerServiceClient) AddPersistentPeer(ctx context.Context, in *AddPersistentPeerRequest, opts ...grpc.CallOption) (*AddPersistentPeerResponse, error) {
+	out := new(AddPersistentPeerResponse)
+	err := c.cc.Invoke(ctx, "/services.PeerService/AddPersistentPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerServiceClient) GetPersistentPeers(ctx context.Context, in *GetPersistentPeersRequest, opts ...grpc.CallOption) (*GetPersistentPeersResponse, error) {
+	out := new(GetPersistentPeersResponse)
+	err := c.cc.Invoke(ctx, "/services.PeerService/GetPersistentPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *peerServiceClient) RemovePersistentPeer(ctx context.Context, in *RemovePersistentPeerRequest, opts ...grpc.CallOption) (*RemovePersistentPeerResponse, error) {
+	out := new(RemovePersistentPeerResponse)
+	err := c.cc.Invoke(ctx, "/services.PeerService/RemovePersistentPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PeerServiceServer is the server API for PeerService service.
+// All implementations must embed UnimplementedPeerServiceServer
+// for forward compatibility
+type PeerServiceServer interface {
+	//*
+	//Lists the nodes currently connected to the underlying node.
+	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	//*
+	//Terminates the peer connection with a node.
+	//
+	//Persistent peers are reconnected, unless removed from the persistent peer list.
+	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+	//*
+	//Adds a node to the persistent peer list and connects to it.
+	//
+	//Nodes in the persistent peer list are reconnected upon peer loss.
+	AddPersistentPeer(context.Context, *AddPersistentPeerRequest) (*AddPersistentPeerResponse, error)
+	//*
+	//Lists the nodes in the persistent peer list.
+	GetPersistentPeers(context.Context, *GetPersistentPeersRequest) (*GetPersistentPeersResponse, error)
+	//*
+	//Removes a node from the persistent peer list.
+	//
+	//Any existing peer connection with the node is not terminated.
+	RemovePersistentPeer(context.Context, *RemovePersistentPeerRequest) (*RemovePersistentPeerResponse, error)
+	mustEmbedUnimplementedPeerServiceServer()
+}
+
+// UnimplementedPeerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPeerServiceServer struct {
+}
+
+func (UnimplementedPeerServiceServer) ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (UnimplementedPeerServiceServer) DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisconnectPeer not implemented")
+}
+func (UnimplementedPeerServiceServer) AddPersistentPeer(context.Context, *AddPersistentPeerRequest) (*AddPersistentPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPersistentPeer not implemented")
+}
+func (UnimplementedPeerServiceServer) GetPersistentPeers(context.Context, *GetPersistentPeersRequest) (*GetPersistentPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersistentPeers not implemented")
+}
+func (UnimplementedPeerServiceServer) RemovePersistentPeer(context.Context, *RemovePersistentPeerRequest) (*RemovePersistentPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePersistentPeer not implemented")
+}
+func (UnimplementedPeerServiceServer) mustEmbedUnimplementedPeerServiceServer() {}
+
+// UnsafePeerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PeerServiceServer will
+// result in compilation errors.
+type UnsafePeerServiceServer interface {
+	mustEmbedUnimplementedPeerServiceServer()
+}
+
+func RegisterPeerServiceServer(s grpc.ServiceRegistrar, srv PeerServiceServer) {
+	s.RegisterService(&PeerService_ServiceDesc, srv)
+}
+
+func _PeerService_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.PeerService/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).ListPeers(ctx, req.(*ListPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PeerService_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisconnectPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).DisconnectPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.PeerService/DisconnectPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).DisconnectPeer(ctx, req.(*DisconnectPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-// UnsafeChannelServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ChannelServiceServer will
-// result in compilation errors.
-type UnsafeChannelServiceServer interface {
-	mustEmbedUnimplementedChannelServiceServer()
+func _PeerService_AddPersistentPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPersistentPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).AddPersistentPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.PeerService/AddPersistentPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).AddPersistentPeer(ctx, req.(*AddPersistentPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterChannelServiceServer(s grpc.ServiceRegistrar, srv ChannelServiceServer) {
-	s.RegisterService(&ChannelService_ServiceDesc, srv)
+func _PeerService_GetPersistentPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersistentPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PeerServiceServer).GetPersistentPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.PeerService/GetPersistentPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PeerServiceServer).GetPersistentPeers(ctx, req.(*GetPersistentPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ChannelService_OpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenChannelRequest)
+func _PeerService_RemovePersistentPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePersistentPeerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChannelServiceServer).OpenChannel(ctx, in)
+		return srv.(PeerServiceServer).RemovePersistentPeer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/services.ChannelService/OpenChannel",
+		FullMethod: "/services.PeerService/RemovePersistentPeer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChannelServiceServer).OpenChannel(ctx, req.(*OpenChannelRequest))
+		return srv.(PeerServiceServer).RemovePersistentPeer(ctx, req.(*RemovePersistentPeerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ChannelService_ServiceDesc is the grpc.ServiceDesc for ChannelService service.
+// PeerService_ServiceDesc is the grpc.ServiceDesc for PeerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var ChannelService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "services.ChannelService",
-	HandlerType: (*ChannelServiceServer)(nil),
+var PeerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.PeerService",
+	HandlerType: (*PeerServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "OpenChannel",
-			Handler:    _ChannelService_OpenChannel_Handler,
+			MethodName: "ListPeers",
+			Handler:    _PeerService_ListPeers_Handler,
+		},
+		{
+			MethodName: "DisconnectPeer",
+			Handler:    _PeerService_DisconnectPeer_Handler,
+		},
+		{
+			MethodName: "AddPersistentPeer",
+			Handler:    _PeerService_AddPersistentPeer_Handler,
+		},
+		{
+			MethodName: "GetPersistentPeers",
+			Handler:    _PeerService_GetPersistentPeers_Handler,
+		},
+		{
+			MethodName: "RemovePersistentPeer",
+			Handler:    _PeerService_RemovePersistentPeer_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -912,6 +2051,224 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "rpc/services/rpc.proto",
 }
 
+// AttachmentServiceClient is the client API for AttachmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttachmentServiceClient interface {
+	//*
+	//Sends an attachment, along with a message, to a discussion.
+	//
+	//If the transfer is interrupted, it can be resumed with ResumeAttachment.
+	//Once all chunks are delivered, the message is sent to
+	//the message stream, referencing the attachment.
+	SendAttachment(ctx context.Context, in *SendAttachmentRequest, opts ...grpc.CallOption) (*SendAttachmentResponse, error)
+	//*
+	//Resumes the transfer of an interrupted outgoing attachment,
+	//sending the chunks not yet delivered to each discussion participant.
+	ResumeAttachment(ctx context.Context, in *ResumeAttachmentRequest, opts ...grpc.CallOption) (*ResumeAttachmentResponse, error)
+	//*
+	//Creates a unidirectional stream from server to client
+	//over which an attachment is sent.
+	//
+	//The first message carries the attachment info, and
+	//is followed by the attachment contents, if the transfer is complete.
+	//The stream terminates when the attachment is transmitted.
+	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (AttachmentService_GetAttachmentClient, error)
+}
+
+type attachmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentServiceClient(cc grpc.ClientConnInterface) AttachmentServiceClient {
+	return &attachmentServiceClient{cc}
+}
+
+func (c *attachmentServiceClient) SendAttachment(ctx context.Context, in *SendAttachmentRequest, opts ...grpc.CallOption) (*SendAttachmentResponse, error) {
+	out := new(SendAttachmentResponse)
+	err := c.cc.Invoke(ctx, "/services.AttachmentService/SendAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) ResumeAttachment(ctx context.Context, in *ResumeAttachmentRequest, opts ...grpc.CallOption) (*ResumeAttachmentResponse, error) {
+	out := new(ResumeAttachmentResponse)
+	err := c.cc.Invoke(ctx, "/services.AttachmentService/ResumeAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (AttachmentService_GetAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[0], "/services.AttachmentService/GetAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attachmentServiceGetAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AttachmentService_GetAttachmentClient interface {
+	Recv() (*GetAttachmentResponse, error)
+	grpc.ClientStream
+}
+
+type attachmentServiceGetAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *attachmentServiceGetAttachmentClient) Recv() (*GetAttachmentResponse, error) {
+	m := new(GetAttachmentResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AttachmentServiceServer is the server API for AttachmentService service.
+// All implementations must embed UnimplementedAttachmentServiceServer
+// for forward compatibility
+type AttachmentServiceServer interface {
+	//*
+	//Sends an attachment, along with a message, to a discussion.
+	//
+	//If the transfer is interrupted, it can be resumed with ResumeAttachment.
+	//Once all chunks are delivered, the message is sent to
+	//the message stream, referencing the attachment.
+	SendAttachment(context.Context, *SendAttachmentRequest) (*SendAttachmentResponse, error)
+	//*
+	//Resumes the transfer of an interrupted outgoing attachment,
+	//sending the chunks not yet delivered to each discussion participant.
+	ResumeAttachment(context.Context, *ResumeAttachmentRequest) (*ResumeAttachmentResponse, error)
+	//*
+	//Creates a unidirectional stream from server to client
+	//over which an attachment is sent.
+	//
+	//The first message carries the attachment info, and
+	//is followed by the attachment contents, if the transfer is complete.
+	//The stream terminates when the attachment is transmitted.
+	GetAttachment(*GetAttachmentRequest, AttachmentService_GetAttachmentServer) error
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+// UnimplementedAttachmentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAttachmentServiceServer struct {
+}
+
+func (UnimplementedAttachmentServiceServer) SendAttachment(context.Context, *SendAttachmentRequest) (*SendAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) ResumeAttachment(context.Context, *ResumeAttachmentRequest) (*ResumeAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) GetAttachment(*GetAttachmentRequest, AttachmentService_GetAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
+
+// UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServiceServer will
+// result in compilation errors.
+type UnsafeAttachmentServiceServer interface {
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+func RegisterAttachmentServiceServer(s grpc.ServiceRegistrar, srv AttachmentServiceServer) {
+	s.RegisterService(&AttachmentService_ServiceDesc, srv)
+}
+
+func _AttachmentService_SendAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).SendAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.AttachmentService/SendAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).SendAttachment(ctx, req.(*SendAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_ResumeAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).ResumeAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.AttachmentService/ResumeAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).ResumeAttachment(ctx, req.(*ResumeAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_GetAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttachmentServiceServer).GetAttachment(m, &attachmentServiceGetAttachmentServer{stream})
+}
+
+type AttachmentService_GetAttachmentServer interface {
+	Send(*GetAttachmentResponse) error
+	grpc.ServerStream
+}
+
+type attachmentServiceGetAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *attachmentServiceGetAttachmentServer) Send(m *GetAttachmentResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttachmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.AttachmentService",
+	HandlerType: (*AttachmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendAttachment",
+			Handler:    _AttachmentService_SendAttachment_Handler,
+		},
+		{
+			MethodName: "ResumeAttachment",
+			Handler:    _AttachmentService_ResumeAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetAttachment",
+			Handler:       _AttachmentService_GetAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc/services/rpc.proto",
+}
+
 // DiscussionServiceClient is the client API for DiscussionService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
@@ -1391,10 +2748,24 @@ type PaymentServiceClient interface {
 	//Performs an invoice lookup.
 	LookupInvoice(ctx context.Context, in *LookupInvoiceRequest, opts ...grpc.CallOption) (*LookupInvoiceResponse, error)
 	//*
+	//Creates a new hold invoice for a payment hash.
+	//Payments to a hold invoice are accepted, but not settled
+	//until the invoice is explicitly settled or cancelled.
+	CreateHoldInvoice(ctx context.Context, in *CreateHoldInvoiceRequest, opts ...grpc.CallOption) (*CreateHoldInvoiceResponse, error)
+	//*
+	//Settles an accepted hold invoice.
+	SettleInvoice(ctx context.Context, in *SettleInvoiceRequest, opts ...grpc.CallOption) (*SettleInvoiceResponse, error)
+	//*
+	//Cancels an open or accepted invoice.
+	CancelInvoice(ctx context.Context, in *CancelInvoiceRequest, opts ...grpc.CallOption) (*CancelInvoiceResponse, error)
+	//*
 	//Performs a payment.
+	//
+	//Failed payments result in an error corresponding to their
+	//failure category, with the failed payment attached to its details.
 	Pay(ctx context.Context, in *PayRequest, opts ...grpc.CallOption) (*PayResponse, error)
 	//*
-	//Subscribes to invoice (final state) updates.
+	//Subscribes to invoice (accepted and final state) updates.
 	SubscribeInvoices(ctx context.Context, in *SubscribeInvoicesRequest, opts ...grpc.CallOption) (PaymentService_SubscribeInvoicesClient, error)
 	//*
 	//Subscribes to payment (final state) updates.
@@ -1437,6 +2808,33 @@ func (c *paymentServiceClient) LookupInvoice(ctx context.Context, in *LookupInvo
 	return out, nil
 }
 
+func (c *paymentServiceClient) CreateHoldInvoice(ctx context.Context, in *CreateHoldInvoiceRequest, opts ...grpc.CallOption) (*CreateHoldInvoiceResponse, error) {
+	out := new(CreateHoldInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/services.PaymentService/CreateHoldInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SettleInvoice(ctx context.Context, in *SettleInvoiceRequest, opts ...grpc.CallOption) (*SettleInvoiceResponse, error) {
+	out := new(SettleInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/services.PaymentService/SettleInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CancelInvoice(ctx context.Context, in *CancelInvoiceRequest, opts ...grpc.CallOption) (*CancelInvoiceResponse, error) {
+	out := new(CancelInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/services.PaymentService/CancelInvoice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) Pay(ctx context.Context, in *PayRequest, opts ...grpc.CallOption) (*PayResponse, error) {
 	out := new(PayResponse)
 	err := c.cc.Invoke(ctx, "/services.PaymentService/Pay", in, out, opts...)
@@ -1594,10 +2992,24 @@ type PaymentServiceServer interface {
 	//Performs an invoice lookup.
 	LookupInvoice(context.Context, *LookupInvoiceRequest) (*LookupInvoiceResponse, error)
 	//*
+	//Creates a new hold invoice for a payment hash.
+	//Payments to a hold invoice are accepted, but not settled
+	//until the invoice is explicitly settled or cancelled.
+	CreateHoldInvoice(context.Context, *CreateHoldInvoiceRequest) (*CreateHoldInvoiceResponse, error)
+	//*
+	//Settles an accepted hold invoice.
+	SettleInvoice(context.Context, *SettleInvoiceRequest) (*SettleInvoiceResponse, error)
+	//*
+	//Cancels an open or accepted invoice.
+	CancelInvoice(context.Context, *CancelInvoiceRequest) (*CancelInvoiceResponse, error)
+	//*
 	//Performs a payment.
+	//
+	//Failed payments result in an error corresponding to their
+	//failure category, with the failed payment attached to its details.
 	Pay(context.Context, *PayRequest) (*PayResponse, error)
 	//*
-	//Subscribes to invoice (final state) updates.
+	//Subscribes to invoice (accepted and final state) updates.
 	SubscribeInvoices(*SubscribeInvoicesRequest, PaymentService_SubscribeInvoicesServer) error
 	//*
 	//Subscribes to payment (final state) updates.
@@ -1625,6 +3037,15 @@ func (UnimplementedPaymentServiceServer) CreateInvoice(context.Context, *CreateI
 func (UnimplementedPaymentServiceServer) LookupInvoice(context.Context, *LookupInvoiceRequest) (*LookupInvoiceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupInvoice not implemented")
 }
+func (UnimplementedPaymentServiceServer) CreateHoldInvoice(context.Context, *CreateHoldInvoiceRequest) (*CreateHoldInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHoldInvoice not implemented")
+}
+func (UnimplementedPaymentServiceServer) SettleInvoice(context.Context, *SettleInvoiceRequest) (*SettleInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleInvoice not implemented")
+}
+func (UnimplementedPaymentServiceServer) CancelInvoice(context.Context, *CancelInvoiceRequest) (*CancelInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInvoice not implemented")
+}
 func (UnimplementedPaymentServiceServer) Pay(context.Context, *PayRequest) (*PayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pay not implemented")
 }
@@ -1692,6 +3113,60 @@ func _PaymentService_LookupInvoice_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_CreateHoldInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHoldInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CreateHoldInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.PaymentService/CreateHoldInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CreateHoldInvoice(ctx, req.(*CreateHoldInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_SettleInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SettleInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.PaymentService/SettleInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SettleInvoice(ctx, req.(*SettleInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CancelInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CancelInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.PaymentService/CancelInvoice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CancelInvoice(ctx, req.(*CancelInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_Pay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PayRequest)
 	if err := dec(in); err != nil {
@@ -1827,6 +3302,18 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupInvoice",
 			Handler:    _PaymentService_LookupInvoice_Handler,
 		},
+		{
+			MethodName: "CreateHoldInvoice",
+			Handler:    _PaymentService_CreateHoldInvoice_Handler,
+		},
+		{
+			MethodName: "SettleInvoice",
+			Handler:    _PaymentService_SettleInvoice_Handler,
+		},
+		{
+			MethodName: "CancelInvoice",
+			Handler:    _PaymentService_CancelInvoice_Handler,
+		},
 		{
 			MethodName: "Pay",
 			Handler:    _PaymentService_Pay_Handler,
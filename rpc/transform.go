@@ -40,7 +40,8 @@ func messageModelToRPCMessage(message *model.Message) (*pb.Message, error) {
 				HopAddress:       h.HopAddress,
 				AmtToForwardMsat: h.AmtToForwardMsat,
 				FeeMsat:          h.FeeMsat,
-				// TODO: Custom records and expiry are missing
+				Expiry:           h.Expiry,
+				// TODO: Custom records are missing
 			}
 		}
 		paymentRoutes[rIdx] = &pb.PaymentRoute{
@@ -70,10 +71,33 @@ func messageModelToRPCMessage(message *model.Message) (*pb.Message, error) {
 	}, nil
 }
 
+var messageTransportMap = map[pb.MessageTransport]model.MessageTransport{
+	pb.MessageTransport_TRANSPORT_AUTO:    model.TransportAUTO,
+	pb.MessageTransport_TRANSPORT_PAYMENT: model.TransportPAYMENT,
+	pb.MessageTransport_TRANSPORT_PEER:    model.TransportPEER,
+}
+
+var pbMessageTransportMap = map[model.MessageTransport]pb.MessageTransport{
+	model.TransportAUTO:    pb.MessageTransport_TRANSPORT_AUTO,
+	model.TransportPAYMENT: pb.MessageTransport_TRANSPORT_PAYMENT,
+	model.TransportPEER:    pb.MessageTransport_TRANSPORT_PEER,
+}
+
 func messageOptionsFromRequest(opts *pb.MessageOptions) model.MessageOptions {
 	return model.MessageOptions{
-		FeeLimitMsat: opts.GetFeeLimitMsat(),
-		Anonymous:    opts.GetAnonymous(),
+		FeeLimitMsat:     opts.GetFeeLimitMsat(),
+		MaxFeeLimitMsat:  opts.GetMaxFeeLimitMsat(),
+		Anonymous:        opts.GetAnonymous(),
+		Transport:        messageTransportMap[opts.GetTransport()],
+		MaxShards:        opts.GetMaxShards(),
+		MaxShardSizeMsat: opts.GetMaxShardSizeMsat(),
+		AMP:              opts.GetAmp(),
+		OutgoingChanIDs:  opts.GetOutgoingChanIds(),
+		LastHopAddress:   opts.GetLastHopAddress(),
+		IgnoredNodes:     opts.GetIgnoredNodes(),
+		IgnoredChannels:  opts.GetIgnoredChannels(),
+		CltvLimit:        opts.GetCltvLimit(),
+		MinSuccessProb:   opts.GetMinSuccessProb(),
 	}
 }
 
@@ -84,8 +108,9 @@ func messageModelToEstimateMessageResponse(message *model.Message) (*pb.Estimate
 	}
 
 	return &pb.EstimateMessageResponse{
-		Message:     rpcMessage,
-		SuccessProb: message.SuccessProb,
+		Message:            rpcMessage,
+		SuccessProb:        message.SuccessProb,
+		PayloadBudgetBytes: message.PayloadBudgetBytes,
 	}, nil
 }
 
@@ -146,14 +171,106 @@ func messageModelToHistoryMessageResponse(message *model.Message) (*pb.GetDiscus
 	}, nil
 }
 
+func routesFromRequest(routes []*pb.PaymentRoute) []model.Route {
+	res := make([]model.Route, len(routes))
+	for i, r := range routes {
+		hops := make([]model.Hop, len(r.GetHops()))
+		for j, h := range r.GetHops() {
+			hops[j] = model.Hop{
+				ChanID:           h.GetChanId(),
+				HopAddress:       h.GetHopAddress(),
+				AmtToForwardMsat: h.GetAmtToForwardMsat(),
+				FeeMsat:          h.GetFeeMsat(),
+				Expiry:           h.GetExpiry(),
+			}
+		}
+		res[i] = model.Route{
+			TotalTimeLock: r.GetTotalTimelock(),
+			RouteAmtMsat:  r.GetRouteAmtMsat(),
+			RouteFeesMsat: r.GetRouteFeesMsat(),
+			RouteHops:     hops,
+		}
+	}
+
+	return res
+}
+
+func controlFromRequest(ctrl *pb.MessageControl) *model.Control {
+	if ctrl == nil {
+		return nil
+	}
+
+	return &model.Control{
+		Type: model.ControlType(ctrl.GetType()),
+		Ref:  ctrl.GetRef(),
+	}
+}
+
+func newMessageControl(ctrl *model.Control) *pb.MessageControl {
+	if ctrl == nil {
+		return nil
+	}
+
+	return &pb.MessageControl{
+		Type: pb.ControlType(ctrl.Type),
+		Ref:  ctrl.Ref,
+	}
+}
+
+func newReactions(reactions []model.Reaction) ([]*pb.Reaction, error) {
+	var res []*pb.Reaction
+	for _, r := range reactions {
+		ts, err := newProtoTimestamp(r.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: invalid timestamp: %v", err)
+		}
+		res = append(res, &pb.Reaction{
+			Sender:         r.Sender,
+			SenderVerified: r.SenderVerified,
+			Reaction:       r.Reaction,
+			Timestamp:      ts,
+		})
+	}
+
+	return res, nil
+}
+
+func newMessageEdits(edits []model.MessageEdit) ([]*pb.MessageEdit, error) {
+	var res []*pb.MessageEdit
+	for _, e := range edits {
+		ts, err := newProtoTimestamp(e.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: invalid timestamp: %v", err)
+		}
+		res = append(res, &pb.MessageEdit{
+			Payload:   e.Payload,
+			Timestamp: ts,
+		})
+	}
+
+	return res, nil
+}
+
 // Discussion Transformations
 
 func discussionInfoToDiscussionModel(discussion *pb.DiscussionInfo) model.Discussion {
+	opts := discussion.GetOptions()
 	discussionInfo := model.Discussion{
 		Participants: discussion.GetParticipants(),
 		Options: model.MessageOptions{
-			FeeLimitMsat: discussion.GetOptions().GetFeeLimitMsat(),
-			Anonymous:    discussion.GetOptions().GetAnonymous(),
+			FeeLimitMsat:     opts.GetFeeLimitMsat(),
+			MaxFeeLimitMsat:  opts.GetMaxFeeLimitMsat(),
+			Anonymous:        opts.GetAnonymous(),
+			Transport:        messageTransportMap[opts.GetTransport()],
+			MaxShards:        opts.GetMaxShards(),
+			MaxShardSizeMsat: opts.GetMaxShardSizeMsat(),
+			AMP:              opts.GetAmp(),
+			OutgoingChanIDs:  opts.GetOutgoingChanIds(),
+			LastHopAddress:   opts.GetLastHopAddress(),
+			IgnoredNodes:     opts.GetIgnoredNodes(),
+			IgnoredChannels:  opts.GetIgnoredChannels(),
+			CltvLimit:        opts.GetCltvLimit(),
+			MinSuccessProb:   opts.GetMinSuccessProb(),
 		},
 	}
 
@@ -164,9 +281,21 @@ func discussionModelToDiscussionInfo(discussion *model.Discussion) (*pb.Discussi
 	discInfo := &pb.DiscussionInfo{
 		Id:           discussion.ID,
 		Participants: discussion.Participants,
+		Lnurl:        discussion.LNURL,
 		Options: &pb.DiscussionOptions{
-			FeeLimitMsat: discussion.Options.FeeLimitMsat,
-			Anonymous:    discussion.Options.Anonymous,
+			FeeLimitMsat:     discussion.Options.FeeLimitMsat,
+			MaxFeeLimitMsat:  discussion.Options.MaxFeeLimitMsat,
+			Anonymous:        discussion.Options.Anonymous,
+			Transport:        pbMessageTransportMap[discussion.Options.Transport],
+			MaxShards:        discussion.Options.MaxShards,
+			MaxShardSizeMsat: discussion.Options.MaxShardSizeMsat,
+			Amp:              discussion.Options.AMP,
+			OutgoingChanIds:  discussion.Options.OutgoingChanIDs,
+			LastHopAddress:   discussion.Options.LastHopAddress,
+			IgnoredNodes:     discussion.Options.IgnoredNodes,
+			IgnoredChannels:  discussion.Options.IgnoredChannels,
+			CltvLimit:        discussion.Options.CltvLimit,
+			MinSuccessProb:   discussion.Options.MinSuccessProb,
 		},
 		LastReadMsgId: discussion.LastReadID,
 		LastMsgId:     discussion.LastMessageID,
@@ -190,8 +319,9 @@ func nodeModelToNodeInfo(node model.Node) *pb.NodeInfo {
 
 func contactModelToContactInfo(contact model.Contact) *pb.ContactInfo {
 	contactInfo := pb.ContactInfo{
-		Id:          contact.ID,
-		DisplayName: contact.DisplayName,
+		Id:               contact.ID,
+		DisplayName:      contact.DisplayName,
+		LightningAddress: contact.LightningAddress,
 		Node: &pb.NodeInfo{
 			Alias:   contact.Alias,
 			Address: contact.Address,
@@ -204,7 +334,8 @@ func contactModelToContactInfo(contact model.Contact) *pb.ContactInfo {
 func contactInfoToContactModel(contact *pb.ContactInfo) model.Contact {
 	node := contact.GetNode()
 	contactInfo := model.Contact{
-		DisplayName: contact.GetDisplayName(),
+		DisplayName:      contact.GetDisplayName(),
+		LightningAddress: contact.GetLightningAddress(),
 		Node: model.Node{
 			Alias:   node.GetAlias(),
 			Address: node.GetAddress(),
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -288,3 +289,35 @@ func TestContactInfoToContactModel(t *testing.T) {
 		})
 	}
 }
+
+func TestNewPaymentFailureCategory(t *testing.T) {
+	cases := []struct {
+		name     string
+		payment  lnchat.Payment
+		expected pb.PaymentFailureCategory
+	}{
+		{
+			name: "Succeeded payment",
+			payment: lnchat.Payment{
+				Status: lnchat.PaymentSUCCEEDED,
+			},
+			expected: pb.PaymentFailureCategory_FAILURE_UNKNOWN,
+		},
+		{
+			name: "Failed payment",
+			payment: lnchat.Payment{
+				Status:        lnchat.PaymentFAILED,
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS,
+			},
+			expected: pb.PaymentFailureCategory_FAILURE_INCORRECT_DETAILS,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			payment, err := newPayment(&model.Payment{Payment: c.payment})
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, payment.FailureCategory)
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/c13n-io/c13n-go/app"
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+	pb "github.com/c13n-io/c13n-go/rpc/services"
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+type walletServiceServer struct {
+	Log *slog.Logger
+
+	App *app.App
+
+	pb.UnimplementedWalletServiceServer
+}
+
+func (s *walletServiceServer) logError(err error) error {
+	if err != nil {
+		s.Log.Errorf("%+v", err)
+	}
+	return err
+}
+
+// Interface implementation
+
+var addressTypeMap = map[pb.AddressType]lnchat.AddressType{
+	pb.AddressType_WITNESS_PUBKEY_HASH: lnchat.AddressWITNESSPUBKEYHASH,
+	pb.AddressType_NESTED_PUBKEY_HASH:  lnchat.AddressNESTEDPUBKEYHASH,
+}
+
+// NewAddress generates a new address of the underlying node's wallet.
+func (s *walletServiceServer) NewAddress(ctx context.Context,
+	req *pb.NewAddressRequest) (*pb.NewAddressResponse, error) {
+
+	addrType, ok := addressTypeMap[req.GetType()]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"unknown address type %v", req.GetType())
+	}
+
+	address, err := s.App.NewAddress(ctx, addrType)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.NewAddressResponse{
+		Address: address,
+	}, nil
+}
+
+// SendCoins sends an on-chain payment from the underlying node's wallet
+// and returns the published transaction.
+func (s *walletServiceServer) SendCoins(ctx context.Context,
+	req *pb.SendCoinsRequest) (*pb.SendCoinsResponse, error) {
+
+	txid, err := s.App.SendCoins(ctx, req.GetAddress(),
+		req.GetAmtSat(), req.GetSendAll(),
+		model.TxFeeOptions{
+			SatPerVByte:     req.GetSatPerVbyte(),
+			TargetConfBlock: req.GetTargetConfirmationBlock(),
+		},
+	)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.SendCoinsResponse{
+		Txid: txid,
+	}, nil
+}
+
+// ListUnspent returns the unspent outputs of the underlying node's wallet.
+func (s *walletServiceServer) ListUnspent(ctx context.Context,
+	req *pb.ListUnspentRequest) (*pb.ListUnspentResponse, error) {
+
+	maxConfs := req.GetMaxConfs()
+	if maxConfs == 0 {
+		maxConfs = math.MaxInt32
+	}
+
+	utxos, err := s.App.ListUnspent(ctx, req.GetMinConfs(), maxConfs)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp := make([]*pb.Utxo, len(utxos))
+	for i, u := range utxos {
+		resp[i] = &pb.Utxo{
+			Address:       u.Address,
+			AmtSat:        u.AmountSat,
+			Txid:          u.Txid,
+			OutputIndex:   u.OutputIndex,
+			Confirmations: u.Confirmations,
+		}
+	}
+
+	return &pb.ListUnspentResponse{
+		Utxos: resp,
+	}, nil
+}
+
+// ListTransactions returns the transactions of the underlying node's wallet.
+func (s *walletServiceServer) ListTransactions(ctx context.Context,
+	req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+
+	txs, err := s.App.ListTransactions(ctx, req.GetStartHeight(), req.GetEndHeight())
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp := make([]*pb.Transaction, len(txs))
+	for i, tx := range txs {
+		if resp[i], err = newTransaction(tx); err != nil {
+			return nil, associateStatusCode(s.logError(err))
+		}
+	}
+
+	return &pb.ListTransactionsResponse{
+		Transactions: resp,
+	}, nil
+}
+
+// EstimateFee estimates the fee of an on-chain payment.
+func (s *walletServiceServer) EstimateFee(ctx context.Context,
+	req *pb.EstimateFeeRequest) (*pb.EstimateFeeResponse, error) {
+
+	estimate, err := s.App.EstimateFee(ctx, req.GetAddress(),
+		req.GetAmtSat(), req.GetTargetConfirmationBlock())
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.EstimateFeeResponse{
+		FeeSat:      estimate.FeeSat,
+		SatPerVbyte: estimate.SatPerVByte,
+	}, nil
+}
+
+func newTransaction(tx model.Transaction) (*pb.Transaction, error) {
+	var timestamp *timestamppb.Timestamp
+	if tx.TimestampSec != 0 {
+		var err error
+		if timestamp, err = newProtoTimestamp(time.Unix(tx.TimestampSec, 0)); err != nil {
+			return nil, fmt.Errorf("marshal error: invalid timestamp: %v", err)
+		}
+	}
+
+	return &pb.Transaction{
+		Txid:          tx.Txid,
+		AmtSat:        tx.AmountSat,
+		FeeSat:        tx.FeeSat,
+		Confirmations: tx.Confirmations,
+		BlockHeight:   tx.BlockHeight,
+		Timestamp:     timestamp,
+		DestAddresses: tx.DestAddresses,
+		Label:         tx.Label,
+	}, nil
+}
+
+// NewWalletServiceServer initializes a new wallet service.
+func NewWalletServiceServer(app *app.App) pb.WalletServiceServer {
+	return &walletServiceServer{
+		Log: slog.NewLogger("wallet-service"),
+		App: app,
+	}
+}
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+var (
+	// ErrAttachmentNotFound is returned in case
+	// an attachment was not found.
+	ErrAttachmentNotFound = fmt.Errorf("Attachment not found")
+)
+
+// attachmentChunkID returns the id of an attachment chunk.
+func attachmentChunkID(transferID string, index uint32) string {
+	return fmt.Sprintf("%s/%010d", transferID, index)
+}
+
+// SaveAttachment stores an attachment.
+// If the attachment already exists, it is updated.
+func (db *bhDatabase) SaveAttachment(att *model.Attachment) error {
+	return retryConflicts(db.bh.Badger().Update, func(txn *badger.Txn) error {
+		return db.bh.TxUpsert(txn, att.TransferID, att)
+	})
+}
+
+// GetAttachment retrieves an attachment.
+func (db *bhDatabase) GetAttachment(transferID string) (*model.Attachment, error) {
+	att := new(model.Attachment)
+
+	switch err := db.bh.Get(transferID, att); err {
+	case nil:
+		return att, nil
+	case badgerhold.ErrNotFound:
+		return nil, ErrAttachmentNotFound
+	default:
+		return nil, err
+	}
+}
+
+// AddAttachmentChunk stores an attachment chunk.
+// Storing an already stored chunk replaces it.
+func (db *bhDatabase) AddAttachmentChunk(chunk *model.AttachmentChunk) error {
+	chunk.ID = attachmentChunkID(chunk.TransferID, chunk.Index)
+
+	return retryConflicts(db.bh.Badger().Update, func(txn *badger.Txn) error {
+		return db.bh.TxUpsert(txn, chunk.ID, chunk)
+	})
+}
+
+// GetAttachmentChunks retrieves the stored chunks
+// of an attachment, ordered by index.
+func (db *bhDatabase) GetAttachmentChunks(transferID string) (
+	[]model.AttachmentChunk, error) {
+
+	query := badgerhold.Where("TransferID").Eq(transferID).
+		Index("TransferID").SortBy("Index")
+
+	chunks := make([]model.AttachmentChunk, 0)
+	if err := db.bh.Find(&chunks, query); err != nil {
+		return nil, err
+	}
+
+	return chunks, nil
+}
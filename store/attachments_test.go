@@ -0,0 +1,60 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestSaveAttachment(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	_, err := db.GetAttachment("0123")
+	assert.ErrorIs(t, err, ErrAttachmentNotFound)
+
+	att := &model.Attachment{
+		TransferID:     "0123",
+		Sender:         "012345678901234567890123456789012345678901234567890123456789012345",
+		Incoming:       true,
+		TotalChunks:    3,
+		ReceivedChunks: []uint32{1},
+	}
+	require.NoError(t, db.SaveAttachment(att))
+
+	att.ReceivedChunks = append(att.ReceivedChunks, 0)
+	require.NoError(t, db.SaveAttachment(att))
+
+	res, err := db.GetAttachment(att.TransferID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, att, res)
+}
+
+func TestAttachmentChunks(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	chunks := []model.AttachmentChunk{
+		{TransferID: "0123", Index: 10, Data: []byte("k")},
+		{TransferID: "0123", Index: 2, Data: []byte("c")},
+		{TransferID: "4567", Index: 0, Data: []byte("x")},
+		{TransferID: "0123", Index: 0, Data: []byte("a")},
+		{TransferID: "0123", Index: 2, Data: []byte("c")},
+	}
+	for i := range chunks {
+		require.NoError(t, db.AddAttachmentChunk(&chunks[i]))
+	}
+
+	res, err := db.GetAttachmentChunks("0123")
+	assert.NoError(t, err)
+	assert.EqualValues(t, []model.AttachmentChunk{
+		chunks[3], chunks[1], chunks[0],
+	}, res)
+
+	res, err = db.GetAttachmentChunks("89ab")
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
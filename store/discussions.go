@@ -52,11 +52,28 @@ func (db *bhDatabase) GetDiscussion(uid uint64) (discussion *model.Discussion, e
 }
 
 // GetDiscussionByParticipants retrieves a discussion based on its participant set.
+// Discussions paid through an LNURL are not considered.
 func (db *bhDatabase) GetDiscussionByParticipants(
 	participants []string) (discussion *model.Discussion, err error) {
 
 	sort.Strings(participants)
-	query := badgerhold.Where("Participants").Eq(participants)
+	query := badgerhold.Where("Participants").Eq(participants).
+		And("LNURL").Eq("")
+
+	err = db.bh.Badger().View(func(txn *badger.Txn) error {
+		discussion, err = db.findSingleDiscussion(txn, query)
+		return err
+	})
+
+	return
+}
+
+// GetDiscussionByLNURL retrieves the discussion paid through
+// the provided LNURL or Lightning Address.
+func (db *bhDatabase) GetDiscussionByLNURL(
+	target string) (discussion *model.Discussion, err error) {
+
+	query := badgerhold.Where("LNURL").Eq(target)
 
 	err = db.bh.Badger().View(func(txn *badger.Txn) error {
 		discussion, err = db.findSingleDiscussion(txn, query)
@@ -178,6 +178,47 @@ func TestGetDiscussionByParticipants(t *testing.T) {
 	assert.Nil(t, notFound)
 }
 
+func TestGetDiscussionByLNURL(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	custodian := []string{
+		"012345678901234567890123456789012345678901234567890123456789012345",
+	}
+
+	plain := generateDiscussion(custodian)
+	alice := generateDiscussion(custodian)
+	alice.LNURL = "alice@wallet.example.com"
+	bob := generateDiscussion(custodian)
+	bob.LNURL = "bob@wallet.example.com"
+
+	for _, disc := range []*model.Discussion{&plain, &alice, &bob} {
+		_, err := db.AddDiscussion(disc)
+		require.NoError(t, err)
+	}
+
+	retrieved, err := db.GetDiscussionByLNURL(alice.LNURL)
+	assert.NoError(t, err)
+	assert.EqualValues(t, &alice, retrieved)
+
+	retrieved, err = db.GetDiscussionByLNURL(bob.LNURL)
+	assert.NoError(t, err)
+	assert.EqualValues(t, &bob, retrieved)
+
+	retrieved, err = db.GetDiscussionByParticipants(custodian)
+	assert.NoError(t, err)
+	assert.EqualValues(t, &plain, retrieved)
+
+	duplicate := generateDiscussion(custodian)
+	duplicate.LNURL = alice.LNURL
+	_, err = db.AddDiscussion(&duplicate)
+	assert.Error(t, err)
+
+	notFound, err := db.GetDiscussionByLNURL("carol@wallet.example.com")
+	assert.EqualError(t, err, ErrDiscussionNotFound.Error())
+	assert.Nil(t, notFound)
+}
+
 func TestRemoveDiscussion(t *testing.T) {
 	db, cleanup := createInMemoryDB(t)
 	defer cleanup()
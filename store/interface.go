@@ -16,10 +16,16 @@ type Database interface {
 	RemoveContactByID(uid uint64) (*model.Contact, error)
 	GetContacts() ([]model.Contact, error)
 
+	// Persistent peers
+	AddPersistentPeer(peer *model.PersistentPeer) error
+	GetPersistentPeers() ([]model.PersistentPeer, error)
+	RemovePersistentPeer(address string) error
+
 	// Discussions
 	AddDiscussion(disc *model.Discussion) (discussion *model.Discussion, err error)
 	GetDiscussion(uid uint64) (*model.Discussion, error)
 	GetDiscussionByParticipants(participants []string) (*model.Discussion, error)
+	GetDiscussionByLNURL(target string) (*model.Discussion, error)
 	RemoveDiscussion(uid uint64) (*model.Discussion, error)
 	GetDiscussions(seekIndex, pageSize uint64) ([]model.Discussion, error)
 	UpdateDiscussionLastRead(uid uint64, readMsgID uint64) error
@@ -32,8 +38,17 @@ type Database interface {
 	GetInvoices(pageOpts model.PageOptions) ([]*model.Invoice, error)
 	GetPayments(pageOpts model.PageOptions) ([]*model.Payment, error)
 	AddRawMessage(*model.RawMessage) error
+	HasInvoiceMessage(invSettleIdx uint64) (bool, error)
+	HasPaymentMessage(paymentIdx uint64) (bool, error)
 	GetMessages(discussionUID uint64,
 		pageOpts model.PageOptions) ([]model.MessageAggregate, error)
+	GetMessageByHash(discussionUID uint64, msgHash string) (*model.RawMessage, error)
+
+	// Attachments
+	SaveAttachment(att *model.Attachment) error
+	GetAttachment(transferID string) (*model.Attachment, error)
+	AddAttachmentChunk(chunk *model.AttachmentChunk) error
+	GetAttachmentChunks(transferID string) ([]model.AttachmentChunk, error)
 
 	// Close closes the database
 	Close() error
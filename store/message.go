@@ -11,13 +11,17 @@ import (
 
 // AddRawMessage stores a raw message under a discussion
 // and updates the last discussion message.
-// An error is returned if its associated invoice or payment indexes are missing.
+// The message hash and any control the message carries
+// are indexed, relating it to the message it references.
+// An error is returned if its associated invoice or payment indexes are missing,
+// unless the message was exchanged over custom peer messages.
 func (db *bhDatabase) AddRawMessage(rawMsg *model.RawMessage) error {
 	return retryConflicts(db.bh.Badger().Update, func(txn *badger.Txn) error {
 		// Verify the existence of the associated invoice or payment
 		invIdx := rawMsg.InvoiceSettleIndex
 		paymentIdxs := rawMsg.PaymentIndexes
 		switch {
+		case rawMsg.PeerTransport:
 		case len(paymentIdxs) == 0 && invIdx == 0:
 			return fmt.Errorf("message not associated with invoice or payment")
 		case invIdx != 0:
@@ -37,6 +41,7 @@ func (db *bhDatabase) AddRawMessage(rawMsg *model.RawMessage) error {
 		}
 
 		rawMsg.WithTimestamp(getCurrentTime())
+		rawMsg.IndexRelations()
 
 		// Insert the raw message
 		if err := db.bh.TxInsert(txn, badgerhold.NextSequence(), rawMsg); err != nil {
@@ -132,3 +137,27 @@ func sameUnorderedIDSlice(x, y []uint64) bool {
 
 	return len(diff) == 0
 }
+
+// HasInvoiceMessage returns whether a message associated
+// with the invoice with the provided settle index is stored.
+func (db *bhDatabase) HasInvoiceMessage(invSettleIdx uint64) (bool, error) {
+	count, err := db.bh.Count(&model.RawMessage{},
+		badgerhold.Where("InvoiceSettleIndex").Eq(invSettleIdx))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// HasPaymentMessage returns whether a message associated
+// with the payment with the provided payment index is stored.
+func (db *bhDatabase) HasPaymentMessage(paymentIdx uint64) (bool, error) {
+	count, err := db.bh.Count(&model.RawMessage{},
+		badgerhold.Where("PaymentIndexes").Contains(paymentIdx))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
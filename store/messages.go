@@ -2,6 +2,7 @@ package store
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/timshannon/badgerhold/v4"
@@ -44,9 +45,11 @@ func (db *bhDatabase) GetMessages(discussionUID uint64,
 			return err
 		}
 
-		// RawMessage retrieval query
+		// RawMessage retrieval query, excluding annotations
+		// (which are retrieved along with the messages they reference)
 		query := badgerhold.Where("DiscussionID").Eq(discussionUID).
-			Index("DiscussionID")
+			Index("DiscussionID").
+			And("ControlType").MatchFunc(notAnnotation)
 		switch {
 		case pageOpts.Reverse && pageOpts.LastID > 0:
 			query = query.And(badgerhold.Key).Le(pageOpts.LastID).
@@ -63,28 +66,20 @@ func (db *bhDatabase) GetMessages(discussionUID uint64,
 		}
 		messages = make([]model.MessageAggregate, len(raws))
 
-		for i, raw := range raws {
-			switch {
-			case raw.InvoiceSettleIndex != 0:
-				inv, err := db.findInvoice(txn, raw.InvoiceSettleIndex)
-				if err != nil {
-					return fmt.Errorf("could not retrieve invoice "+
-						"associated to message %d: %w", raw.ID, err)
-				}
-
-				messages[i] = newMsgAggregate(raws[i], inv, nil)
-			case raw.PaymentIndexes != nil:
-				pays, err := db.findPayments(txn, raw.PaymentIndexes...)
-				if err != nil {
-					return fmt.Errorf("could not retrieve payments "+
-						"associated with message %d: %w", raw.ID, err)
-				}
-
-				messages[i] = newMsgAggregate(raws[i], nil, pays)
-			default:
-				return fmt.Errorf("stored message not " +
-					"associated with invoice or payments")
+		for i := range raws {
+			msg, err := db.findMsgAggregate(txn, raws[i])
+			if err != nil {
+				return err
 			}
+
+			anns, err := db.findAnnotations(txn, discussionUID, raws[i].MessageHash)
+			if err != nil {
+				return fmt.Errorf("could not retrieve annotations "+
+					"of message %d: %w", raws[i].ID, err)
+			}
+			msg.Annotations = anns
+
+			messages[i] = msg
 		}
 
 		return nil
@@ -103,3 +98,106 @@ func (db *bhDatabase) GetMessages(discussionUID uint64,
 
 	return messages, nil
 }
+
+// findMsgAggregate retrieves the invoice or payments
+// associated with a raw message.
+func (db *bhDatabase) findMsgAggregate(txn *badger.Txn,
+	raw model.RawMessage) (model.MessageAggregate, error) {
+
+	switch {
+	case raw.PeerTransport:
+		return newMsgAggregate(raw, nil, nil), nil
+	case raw.InvoiceSettleIndex != 0:
+		inv, err := db.findInvoice(txn, raw.InvoiceSettleIndex)
+		if err != nil {
+			return model.MessageAggregate{}, fmt.Errorf("could not retrieve "+
+				"invoice associated to message %d: %w", raw.ID, err)
+		}
+
+		return newMsgAggregate(raw, inv, nil), nil
+	case raw.PaymentIndexes != nil:
+		pays, err := db.findPayments(txn, raw.PaymentIndexes...)
+		if err != nil {
+			return model.MessageAggregate{}, fmt.Errorf("could not retrieve "+
+				"payments associated with message %d: %w", raw.ID, err)
+		}
+
+		return newMsgAggregate(raw, nil, pays), nil
+	default:
+		return model.MessageAggregate{}, fmt.Errorf("stored message not " +
+			"associated with invoice or payments")
+	}
+}
+
+// isAnnotation matches raw messages carrying an annotation control.
+func isAnnotation(ra *badgerhold.RecordAccess) (bool, error) {
+	ctrlType, ok := ra.Field().(model.ControlType)
+	if !ok {
+		return false, fmt.Errorf("unexpected control type field %T", ra.Field())
+	}
+
+	return ctrlType.IsAnnotation(), nil
+}
+
+// notAnnotation matches raw messages carrying no annotation control.
+func notAnnotation(ra *badgerhold.RecordAccess) (bool, error) {
+	annotation, err := isAnnotation(ra)
+	return !annotation, err
+}
+
+// findAnnotations retrieves the annotations (reactions, edits and deletions)
+// of a discussion referencing the message with the provided hash,
+// in the order they were stored.
+func (db *bhDatabase) findAnnotations(txn *badger.Txn,
+	discussionUID uint64, msgHash string) ([]model.MessageAggregate, error) {
+
+	if msgHash == "" {
+		return nil, nil
+	}
+
+	query := badgerhold.Where("ControlRef").Eq(msgHash).Index("ControlRef").
+		And("DiscussionID").Eq(discussionUID).
+		And("ControlType").MatchFunc(isAnnotation)
+
+	raws := make([]model.RawMessage, 0)
+	if err := db.bh.TxFind(txn, &raws, query); err != nil {
+		return nil, err
+	}
+	sort.Slice(raws, func(i, j int) bool {
+		return raws[i].ID < raws[j].ID
+	})
+
+	var anns []model.MessageAggregate
+	for _, raw := range raws {
+		ann, err := db.findMsgAggregate(txn, raw)
+		if err != nil {
+			return nil, err
+		}
+		anns = append(anns, ann)
+	}
+
+	return anns, nil
+}
+
+// GetMessageByHash retrieves the message of a discussion
+// with the provided hash.
+// If several messages share the hash, the earliest one is returned.
+func (db *bhDatabase) GetMessageByHash(discussionUID uint64,
+	msgHash string) (*model.RawMessage, error) {
+
+	query := badgerhold.Where("MessageHash").Eq(msgHash).Index("MessageHash").
+		And("DiscussionID").Eq(discussionUID)
+
+	raws := make([]model.RawMessage, 0)
+	if err := db.bh.Find(&raws, query); err != nil {
+		return nil, err
+	}
+	if len(raws) == 0 {
+		return nil, ErrMessageNotFound
+	}
+	sort.Slice(raws, func(i, j int) bool {
+		return raws[i].ID < raws[j].ID
+	})
+
+	return &raws[0], nil
+}
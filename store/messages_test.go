@@ -368,6 +368,51 @@ func TestAddRawMessageMissingDiscussion(t *testing.T) {
 	}
 }
 
+func TestHasMessage(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	discussion := generateDiscussion([]string{
+		"012345678901234567890123456789012345678901234567890123456789012345",
+	})
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	inRaw, inv := generateIncoming(t, generateHex(t, 33))
+	inRaw.DiscussionID = disc.ID
+	require.NoError(t, db.AddInvoice(inv))
+
+	outRaw, payments := generateOutgoing(t, generateHex(t, 33), generateHex(t, 33))
+	outRaw.DiscussionID = disc.ID
+	require.NoError(t, db.AddPayments(payments...))
+
+	has, err := db.HasInvoiceMessage(inv.SettleIndex)
+	require.NoError(t, err)
+	assert.False(t, has)
+	has, err = db.HasPaymentMessage(payments[1].PaymentIndex)
+	require.NoError(t, err)
+	assert.False(t, has)
+
+	require.NoError(t, db.AddRawMessage(inRaw))
+	require.NoError(t, db.AddRawMessage(outRaw))
+
+	has, err = db.HasInvoiceMessage(inv.SettleIndex)
+	require.NoError(t, err)
+	assert.True(t, has)
+	for _, p := range payments {
+		has, err = db.HasPaymentMessage(p.PaymentIndex)
+		require.NoError(t, err)
+		assert.True(t, has)
+	}
+
+	has, err = db.HasInvoiceMessage(inv.SettleIndex + 1)
+	require.NoError(t, err)
+	assert.False(t, has)
+	has, err = db.HasPaymentMessage(payments[1].PaymentIndex + 1)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
+
 func TestGetMessages(t *testing.T) {
 	db, cleanup := createInMemoryDB(t)
 	defer cleanup()
@@ -443,6 +488,140 @@ func TestGetMessages(t *testing.T) {
 	assert.EqualValues(t, msgs, list)
 }
 
+func TestGetMessagesPeerTransport(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	discussion := generateDiscussion([]string{
+		"012345678901234567890123456789012345678901234567890123456789012345",
+	})
+
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	raw := &model.RawMessage{
+		DiscussionID:      disc.ID,
+		RawPayload:        []byte("this is a peer message"),
+		Sender:            discussion.Participants[0],
+		Signature:         []byte("a fake signature"),
+		SignatureVerified: true,
+		PeerTransport:     true,
+	}
+	require.NoError(t, db.AddRawMessage(raw))
+
+	list, err := db.GetMessages(disc.ID, model.PageOptions{})
+	assert.NoError(t, err)
+	assert.EqualValues(t, []model.MessageAggregate{
+		{
+			RawMessage: raw,
+		},
+	}, list)
+}
+
+func TestGetMessagesAnnotations(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	discussion := generateDiscussion([]string{
+		"012345678901234567890123456789012345678901234567890123456789012345",
+	})
+
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	newMessage := func(ctrl *model.Control, payload string) *model.RawMessage {
+		raw, err := model.NewControlRawMessage(disc, ctrl, payload,
+			model.PayloadFormatJSON)
+		require.NoError(t, err)
+		raw.Sender = discussion.Participants[0]
+		raw.SignatureVerified = true
+		raw.PeerTransport = true
+		require.NoError(t, db.AddRawMessage(raw))
+		return raw
+	}
+
+	msg := newMessage(nil, "a message")
+	ref := msg.Hash()
+	reaction := newMessage(&model.Control{Type: model.ControlReaction, Ref: ref}, "+1")
+	reply := newMessage(&model.Control{Type: model.ControlReply, Ref: ref}, "a reply")
+	edit := newMessage(&model.Control{Type: model.ControlEdit, Ref: ref}, "an edit")
+
+	assert.Equal(t, hex.EncodeToString(ref), msg.MessageHash)
+	assert.Equal(t, msg.MessageHash, reply.ControlRef)
+	assert.Equal(t, model.ControlReply, reply.ControlType)
+
+	list, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	assert.EqualValues(t, []model.MessageAggregate{
+		{
+			RawMessage: msg,
+			Annotations: []model.MessageAggregate{
+				{RawMessage: reaction},
+				{RawMessage: edit},
+			},
+		},
+		{
+			RawMessage: reply,
+		},
+	}, list)
+
+	found, err := db.GetMessageByHash(disc.ID, msg.MessageHash)
+	require.NoError(t, err)
+	assert.Equal(t, msg, found)
+
+	_, err = db.GetMessageByHash(disc.ID, generateHex(t, 32))
+	assert.EqualError(t, err, ErrMessageNotFound.Error())
+}
+
+func TestGetMessagesIdenticalMessages(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	discussion := generateDiscussion([]string{
+		"012345678901234567890123456789012345678901234567890123456789012345",
+	})
+
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	newMessage := func(ctrl *model.Control, payload string) *model.RawMessage {
+		raw, err := model.NewControlRawMessage(disc, ctrl, payload,
+			model.PayloadFormatCompact)
+		require.NoError(t, err)
+		raw.Sender = discussion.Participants[0]
+		raw.Signature = []byte("a fake signature")
+		raw.SignatureVerified = true
+		raw.PeerTransport = true
+		require.NoError(t, db.AddRawMessage(raw))
+		return raw
+	}
+
+	// Identical messages of the same sender are referenced separately.
+	first, second := newMessage(nil, "ok"), newMessage(nil, "ok")
+	require.NotEqual(t, first.MessageHash, second.MessageHash)
+
+	edit := newMessage(&model.Control{Type: model.ControlEdit,
+		Ref: second.Hash()}, "ok!")
+
+	list, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	assert.EqualValues(t, []model.MessageAggregate{
+		{
+			RawMessage: first,
+		},
+		{
+			RawMessage:  second,
+			Annotations: []model.MessageAggregate{{RawMessage: edit}},
+		},
+	}, list)
+
+	for _, msg := range []*model.RawMessage{first, second} {
+		found, err := db.GetMessageByHash(disc.ID, msg.MessageHash)
+		require.NoError(t, err)
+		assert.Equal(t, msg, found)
+	}
+}
+
 func TestGetMessagesMissingDiscussion(t *testing.T) {
 	db, cleanup := createInMemoryDB(t)
 	defer cleanup()
@@ -13,6 +13,20 @@ type Database struct {
 	mock.Mock
 }
 
+// AddAttachmentChunk provides a mock function with given fields: chunk
+func (_m *Database) AddAttachmentChunk(chunk *model.AttachmentChunk) error {
+	ret := _m.Called(chunk)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.AttachmentChunk) error); ok {
+		r0 = rf(chunk)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddContact provides a mock function with given fields: c
 func (_m *Database) AddContact(c *model.Contact) (*model.Contact, error) {
 	ret := _m.Called(c)
@@ -93,6 +107,20 @@ func (_m *Database) AddPayments(payments ...*model.Payment) error {
 	return r0
 }
 
+// AddPersistentPeer provides a mock function with given fields: peer
+func (_m *Database) AddPersistentPeer(peer *model.PersistentPeer) error {
+	ret := _m.Called(peer)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.PersistentPeer) error); ok {
+		r0 = rf(peer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddRawMessage provides a mock function with given fields: _a0
 func (_m *Database) AddRawMessage(_a0 *model.RawMessage) error {
 	ret := _m.Called(_a0)
@@ -121,6 +149,52 @@ func (_m *Database) Close() error {
 	return r0
 }
 
+// GetAttachment provides a mock function with given fields: transferID
+func (_m *Database) GetAttachment(transferID string) (*model.Attachment, error) {
+	ret := _m.Called(transferID)
+
+	var r0 *model.Attachment
+	if rf, ok := ret.Get(0).(func(string) *model.Attachment); ok {
+		r0 = rf(transferID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Attachment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttachmentChunks provides a mock function with given fields: transferID
+func (_m *Database) GetAttachmentChunks(transferID string) ([]model.AttachmentChunk, error) {
+	ret := _m.Called(transferID)
+
+	var r0 []model.AttachmentChunk
+	if rf, ok := ret.Get(0).(func(string) []model.AttachmentChunk); ok {
+		r0 = rf(transferID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AttachmentChunk)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(transferID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetContact provides a mock function with given fields: address
 func (_m *Database) GetContact(address string) (*model.Contact, error) {
 	ret := _m.Called(address)
@@ -213,6 +287,29 @@ func (_m *Database) GetDiscussion(uid uint64) (*model.Discussion, error) {
 	return r0, r1
 }
 
+// GetDiscussionByLNURL provides a mock function with given fields: target
+func (_m *Database) GetDiscussionByLNURL(target string) (*model.Discussion, error) {
+	ret := _m.Called(target)
+
+	var r0 *model.Discussion
+	if rf, ok := ret.Get(0).(func(string) *model.Discussion); ok {
+		r0 = rf(target)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Discussion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(target)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDiscussionByParticipants provides a mock function with given fields: participants
 func (_m *Database) GetDiscussionByParticipants(participants []string) (*model.Discussion, error) {
 	ret := _m.Called(participants)
@@ -324,6 +421,29 @@ func (_m *Database) GetLastPaymentIndex() (uint64, error) {
 	return r0, r1
 }
 
+// GetMessageByHash provides a mock function with given fields: discussionUID, msgHash
+func (_m *Database) GetMessageByHash(discussionUID uint64, msgHash string) (*model.RawMessage, error) {
+	ret := _m.Called(discussionUID, msgHash)
+
+	var r0 *model.RawMessage
+	if rf, ok := ret.Get(0).(func(uint64, string) *model.RawMessage); ok {
+		r0 = rf(discussionUID, msgHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RawMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64, string) error); ok {
+		r1 = rf(discussionUID, msgHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMessages provides a mock function with given fields: discussionUID, pageOpts
 func (_m *Database) GetMessages(discussionUID uint64, pageOpts model.PageOptions) ([]model.MessageAggregate, error) {
 	ret := _m.Called(discussionUID, pageOpts)
@@ -370,6 +490,71 @@ func (_m *Database) GetPayments(pageOpts model.PageOptions) ([]*model.Payment, e
 	return r0, r1
 }
 
+// GetPersistentPeers provides a mock function with given fields:
+func (_m *Database) GetPersistentPeers() ([]model.PersistentPeer, error) {
+	ret := _m.Called()
+
+	var r0 []model.PersistentPeer
+	if rf, ok := ret.Get(0).(func() []model.PersistentPeer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PersistentPeer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasInvoiceMessage provides a mock function with given fields: invSettleIdx
+func (_m *Database) HasInvoiceMessage(invSettleIdx uint64) (bool, error) {
+	ret := _m.Called(invSettleIdx)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uint64) bool); ok {
+		r0 = rf(invSettleIdx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(invSettleIdx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasPaymentMessage provides a mock function with given fields: paymentIdx
+func (_m *Database) HasPaymentMessage(paymentIdx uint64) (bool, error) {
+	ret := _m.Called(paymentIdx)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(uint64) bool); ok {
+		r0 = rf(paymentIdx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(paymentIdx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveContact provides a mock function with given fields: address
 func (_m *Database) RemoveContact(address string) (*model.Contact, error) {
 	ret := _m.Called(address)
@@ -439,6 +624,34 @@ func (_m *Database) RemoveDiscussion(uid uint64) (*model.Discussion, error) {
 	return r0, r1
 }
 
+// RemovePersistentPeer provides a mock function with given fields: address
+func (_m *Database) RemovePersistentPeer(address string) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveAttachment provides a mock function with given fields: att
+func (_m *Database) SaveAttachment(att *model.Attachment) error {
+	ret := _m.Called(att)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Attachment) error); ok {
+		r0 = rf(att)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDiscussionLastRead provides a mock function with given fields: uid, readMsgID
 func (_m *Database) UpdateDiscussionLastRead(uid uint64, readMsgID uint64) error {
 	ret := _m.Called(uid, readMsgID)
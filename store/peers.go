@@ -0,0 +1,46 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+var (
+	// ErrPersistentPeerNotFound is returned in case
+	// a persistent peer was not found.
+	ErrPersistentPeerNotFound = fmt.Errorf("Persistent peer not found")
+)
+
+// AddPersistentPeer stores a persistent peer.
+// If the peer already exists, its network address is updated.
+func (db *bhDatabase) AddPersistentPeer(peer *model.PersistentPeer) error {
+	return retryConflicts(db.bh.Badger().Update, func(txn *badger.Txn) error {
+		return db.bh.TxUpsert(txn, peer.Address, peer)
+	})
+}
+
+// GetPersistentPeers retrieves all persistent peers.
+func (db *bhDatabase) GetPersistentPeers() ([]model.PersistentPeer, error) {
+	peers := make([]model.PersistentPeer, 0)
+
+	if err := db.bh.Find(&peers, nil); err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
+// RemovePersistentPeer removes a persistent peer.
+func (db *bhDatabase) RemovePersistentPeer(address string) error {
+	return retryConflicts(db.bh.Badger().Update, func(txn *badger.Txn) error {
+		err := db.bh.TxDelete(txn, address, model.PersistentPeer{})
+		if err == badgerhold.ErrNotFound {
+			return ErrPersistentPeerNotFound
+		}
+		return err
+	})
+}
@@ -0,0 +1,86 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestAddPersistentPeer(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	peers := []model.PersistentPeer{
+		{
+			Address:  "012345678901234567890123456789012345678901234567890123456789012345",
+			Hostport: "127.0.0.1:9735",
+		},
+		{
+			Address:  "123456789012345678901234567890123456789012345678901234567890123456",
+			Hostport: "127.0.0.1:9736",
+		},
+	}
+
+	for i := range peers {
+		err := db.AddPersistentPeer(&peers[i])
+		require.NoError(t, err)
+	}
+
+	res, err := db.GetPersistentPeers()
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, peers, res)
+}
+
+func TestAddPersistentPeerUpdatesHostport(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	peer := model.PersistentPeer{
+		Address:  "012345678901234567890123456789012345678901234567890123456789012345",
+		Hostport: "127.0.0.1:9735",
+	}
+	require.NoError(t, db.AddPersistentPeer(&peer))
+
+	updated := model.PersistentPeer{
+		Address:  peer.Address,
+		Hostport: "127.0.0.1:19735",
+	}
+	require.NoError(t, db.AddPersistentPeer(&updated))
+
+	res, err := db.GetPersistentPeers()
+	assert.NoError(t, err)
+	assert.EqualValues(t, []model.PersistentPeer{updated}, res)
+}
+
+func TestRemovePersistentPeer(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	peers := []model.PersistentPeer{
+		{
+			Address:  "012345678901234567890123456789012345678901234567890123456789012345",
+			Hostport: "127.0.0.1:9735",
+		},
+		{
+			Address:  "123456789012345678901234567890123456789012345678901234567890123456",
+			Hostport: "127.0.0.1:9736",
+		},
+	}
+
+	for i := range peers {
+		require.NoError(t, db.AddPersistentPeer(&peers[i]))
+	}
+
+	err := db.RemovePersistentPeer(peers[0].Address)
+	assert.NoError(t, err)
+
+	res, err := db.GetPersistentPeers()
+	assert.NoError(t, err)
+	assert.EqualValues(t, peers[1:], res)
+
+	err = db.RemovePersistentPeer(peers[0].Address)
+	assert.EqualError(t, err, ErrPersistentPeerNotFound.Error())
+}